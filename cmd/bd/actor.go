@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// resolveActor returns the actor recorded in the audit trail. Priority:
+// --actor flag > BD_ACTOR env > actor in config.yaml > git config user.name >
+// $USER > "unknown". An explicitly empty --actor is an error, since every
+// change is attributed to someone.
+func resolveActor(flagValue string, flagSet bool) (string, error) {
+	if flagSet {
+		if name := strings.TrimSpace(flagValue); name != "" {
+			return name, nil
+		}
+		return "", fmt.Errorf("--actor must not be empty")
+	}
+	// Viper gives BD_ACTOR precedence over config.yaml
+	if name := strings.TrimSpace(config.GetString("actor")); name != "" {
+		return name, nil
+	}
+	if name := gitUserName(); name != "" {
+		return name, nil
+	}
+	if user := os.Getenv("USER"); user != "" {
+		return user, nil
+	}
+	return "unknown", nil
+}
+
+// gitUserName returns git's user.name for the current directory, or "".
+func gitUserName() string {
+	output, err := exec.Command("git", "config", "user.name").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
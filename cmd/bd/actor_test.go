@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+)
+
+func TestResolveActorPrecedence(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("actor: from-config\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	gitConfig := filepath.Join(tmpDir, "gitconfig")
+	if err := os.WriteFile(gitConfig, []byte("[user]\n\tname = From Git\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", gitConfig)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("USER", "from-user")
+	t.Setenv("BD_ACTOR", "from-env")
+	t.Chdir(tmpDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize() error = %v", err)
+	}
+
+	check := func(name, flagValue string, flagSet bool, want string) {
+		t.Helper()
+		got, err := resolveActor(flagValue, flagSet)
+		if err != nil {
+			t.Fatalf("%s: resolveActor() error = %v", name, err)
+		}
+		if got != want {
+			t.Errorf("%s: resolveActor() = %q, want %q", name, got, want)
+		}
+	}
+
+	check("flag", "from-flag", true, "from-flag")
+	check("env", "", false, "from-env")
+
+	os.Unsetenv("BD_ACTOR")
+	check("config", "", false, "from-config")
+
+	if err := os.WriteFile(configPath, []byte("no-push: true\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize() error = %v", err)
+	}
+	check("git", "", false, "From Git")
+
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(tmpDir, "missing"))
+	check("user", "", false, "from-user")
+
+	if _, err := resolveActor("  ", true); err == nil {
+		t.Error("an explicitly empty --actor should be rejected")
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var archiveCmd = &cobra.Command{
+	Use:     "archive",
+	GroupID: "maint",
+	Short:   "Move old closed issues into the archive",
+	Long: `Move closed issues older than a cutoff into the archived_issues table.
+
+Archived issues are excluded from default queries and from the JSONL export,
+which keeps both fast as closed issues accumulate. Issues in any of the
+closed-statuses count as closed too. Pinned issues are never archived.
+Dependencies that point at archived issues are kept and still resolve to the
+archived snapshot, and the events of archived issues are kept.
+
+Use 'bd list --archived' to browse the archive and 'bd restore <id>' to move
+an issue back.
+
+Examples:
+  bd archive                   # Archive issues closed more than 90 days ago
+  bd archive --older-than 30   # Archive issues closed more than 30 days ago
+  bd archive --dry-run         # List what would be archived, change nothing`,
+	Run: func(cmd *cobra.Command, args []string) {
+		olderThanDays, _ := cmd.Flags().GetInt("older-than")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !dryRun {
+			CheckReadonly("archive")
+		}
+		if olderThanDays < 0 {
+			FatalErrorRespectJSON("--older-than must be non-negative")
+		}
+
+		if err := ensureDirectMode("archive requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("archive requires SQLite storage")
+		}
+
+		cutoff := time.Now().AddDate(0, 0, -olderThanDays)
+		ids, err := sqliteStore.Archive(rootCtx, cutoff, dryRun)
+		if err != nil {
+			FatalErrorRespectJSON("archive failed: %v", err)
+		}
+		count := len(ids)
+
+		// Archived issues must disappear from the JSONL, so rewrite it in full
+		if count > 0 && !dryRun {
+			markDirtyAndScheduleFullExport()
+		}
+
+		if jsonOutput {
+			if ids == nil {
+				ids = []string{}
+			}
+			outputJSON(map[string]interface{}{
+				"archived_count": count,
+				"archived_ids":   ids,
+				"older_than":     olderThanDays,
+				"dry_run":        dryRun,
+			})
+			return
+		}
+
+		if count == 0 {
+			fmt.Printf("No closed issues older than %d days to archive\n", olderThanDays)
+			return
+		}
+		if dryRun {
+			fmt.Printf("Would archive %d issue(s) closed more than %d days ago:\n", count, olderThanDays)
+			for _, id := range ids {
+				fmt.Printf("  %s\n", id)
+			}
+			fmt.Println("(dry run, nothing archived)")
+			return
+		}
+		fmt.Printf("%s Archived %d issue(s) closed more than %d days ago\n", ui.RenderPass("✓"), count, olderThanDays)
+	},
+}
+
+func init() {
+	archiveCmd.Flags().Int("older-than", 90, "Archive issues closed more than N days ago")
+	archiveCmd.Flags().Bool("dry-run", false, "List the issues that would be archived without archiving them")
+	rootCmd.AddCommand(archiveCmd)
+}
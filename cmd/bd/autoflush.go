@@ -18,6 +18,7 @@ import (
 	"github.com/steveyegge/beads/internal/beads"
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -175,7 +176,7 @@ func autoImportIfNewer() {
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err != nil {
 			// Parse error, skip this import
 			snippet := line
 			if len(snippet) > 80 {
@@ -401,18 +402,20 @@ func validateJSONLIntegrity(ctx context.Context, jsonlPath string) (bool, error)
 	return false, nil
 }
 
+// writeJSONLAtomic replaces jsonlPath with issues via a temp file and rename,
+// so readers never see a partial file. The caller must hold lockJSONL.
 func writeJSONLAtomic(jsonlPath string, issues []*types.Issue) ([]string, error) {
 	// Sort issues by ID for consistent output
 	slices.SortFunc(issues, func(a, b *types.Issue) int {
 		return cmp.Compare(a.ID, b.ID)
 	})
 
-	// Create temp file with PID suffix to avoid collisions (bd-306)
-	tempPath := fmt.Sprintf("%s.tmp.%d", jsonlPath, os.Getpid())
-	f, err := os.Create(tempPath)
+	// Unique temp name: a PID suffix alone collides between goroutines (bd-306)
+	f, err := os.CreateTemp(filepath.Dir(jsonlPath), filepath.Base(jsonlPath)+".tmp.*")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temp file: %w", err)
 	}
+	tempPath := f.Name()
 
 	// Ensure cleanup on failure
 	defer func() {
@@ -423,12 +426,13 @@ func writeJSONLAtomic(jsonlPath string, issues []*types.Issue) ([]string, error)
 	}()
 
 	// Write all issues as JSONL (timestamp-only deduplication DISABLED - bd-160)
-	encoder := json.NewEncoder(f)
+	format := config.TimestampFormat()
 	skippedCount := 0
 	exportedIDs := make([]string, 0, len(issues))
 	
 	for _, issue := range issues {
-		if err := encoder.Encode(issue); err != nil {
+		issue.SchemaVersion = types.JSONLSchemaVersion
+		if err := types.EncodeIssueJSONL(f, issue, format); err != nil {
 		 return nil, fmt.Errorf("failed to encode issue %s: %w", issue.ID, err)
 		}
 		
@@ -440,6 +444,11 @@ func writeJSONLAtomic(jsonlPath string, issues []*types.Issue) ([]string, error)
 		debug.Logf("auto-flush skipped %d issue(s) with timestamp-only changes", skippedCount)
 	}
 
+	// Flush to disk so a crash can't leave an empty file after the rename
+	if err := f.Sync(); err != nil {
+		return nil, fmt.Errorf("failed to sync temp file: %w", err)
+	}
+
 	// Close temp file before renaming
 	if err := f.Close(); err != nil {
 		return nil, fmt.Errorf("failed to close temp file: %w", err)
@@ -452,9 +461,9 @@ func writeJSONLAtomic(jsonlPath string, issues []*types.Issue) ([]string, error)
 		return nil, fmt.Errorf("failed to rename file: %w", err)
 	}
 
-	// Set appropriate file permissions (0644: rw-r--r--)
+	// Set appropriate file permissions (0644: rw-r--r-- unless file-mode is configured)
 	// nolint:gosec // G302: JSONL needs to be readable by other tools
-	if err := os.Chmod(jsonlPath, 0644); err != nil {
+	if err := os.Chmod(jsonlPath, config.FileMode(0644)); err != nil {
 		// Non-fatal - file is already written
 		debug.Logf("failed to set file permissions: %v", err)
 	}
@@ -507,7 +516,8 @@ func flushToJSONLWithState(state flushState) {
 	storeMutex.Unlock()
 
 	ctx := rootCtx
-	
+	flushStart := time.Now() // Flush watermark: changes after this aren't in this export
+
 	// Validate JSONL integrity BEFORE checking isDirty (bd-c6cf)
 	// This detects if JSONL and export_hashes are out of sync (e.g., after git operations)
 	// If export_hashes was cleared, we need to do a full export even if nothing is dirty
@@ -575,6 +585,11 @@ func flushToJSONLWithState(state flushState) {
 		flushMutex.Unlock()
 	}
 
+	if err := runFlushHook("pre-flush-hook", jsonlPath, nil, debug.Logf); err != nil {
+		recordFailure(err)
+		return
+	}
+
 	// Determine which issues to export
 	var dirtyIDs []string
 
@@ -605,6 +620,15 @@ func flushToJSONLWithState(state flushState) {
 		}
 	}
 
+	// Hold the JSONL lock from reading the old file until the new one is in
+	// place, so a concurrent flush can't interleave or drop our changes
+	unlockJSONL, err := lockJSONL(jsonlPath)
+	if err != nil {
+		recordFailure(err)
+		return
+	}
+	defer unlockJSONL()
+
 	// Read existing JSONL into a map (skip for full export - we'll rebuild from scratch)
 	issueMap := make(map[string]*types.Issue)
 	if !fullExport {
@@ -621,7 +645,7 @@ func flushToJSONLWithState(state flushState) {
 					continue
 				}
 				var issue types.Issue
-				if err := json.Unmarshal([]byte(line), &issue); err == nil {
+				if err := types.UnmarshalJSONL([]byte(line), &issue); err == nil {
 					issue.SetDefaults() // Apply defaults for omitted fields (beads-399)
 					issueMap[issue.ID] = &issue
 				} else {
@@ -660,6 +684,14 @@ func flushToJSONLWithState(state flushState) {
 		}
 		issue.Dependencies = deps
 
+		// Get references for this issue
+		refs, err := store.GetReferences(ctx, issueID)
+		if err != nil {
+			recordFailure(fmt.Errorf("failed to get references for %s: %w", issueID, err))
+			return
+		}
+		issue.References = refs
+
 		// Update map
 		issueMap[issueID] = issue
 	}
@@ -706,12 +738,12 @@ func flushToJSONLWithState(state flushState) {
 			if !isPrimary {
 				// Filter to only issues matching our prefix
 				filtered := make([]*types.Issue, 0, len(issues))
-				prefixWithDash := prefix
-				if !strings.HasSuffix(prefixWithDash, "-") {
-					prefixWithDash = prefix + "-"
+				prefixWithSep := prefix
+				if sep := idgen.Separator(); !strings.HasSuffix(prefixWithSep, sep) {
+					prefixWithSep = prefix + sep
 				}
 				for _, issue := range issues {
-					if strings.HasPrefix(issue.ID, prefixWithDash) {
+					if strings.HasPrefix(issue.ID, prefixWithSep) {
 						filtered = append(filtered, issue)
 					}
 				}
@@ -723,6 +755,7 @@ func flushToJSONLWithState(state flushState) {
 
 	// Write atomically using common helper
 	exportedIDs, err := writeJSONLAtomic(jsonlPath, issues)
+	unlockJSONL() // Don't hold other writers up while hooks run
 	if err != nil {
 		recordFailure(err)
 		return
@@ -767,6 +800,18 @@ func flushToJSONLWithState(state flushState) {
 		}
 	}
 
+	if err := setFlushWatermark(ctx, store, flushStart); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
 	// Success! FlushManager manages its local state in run() goroutine.
 	recordSuccess()
+
+	// The JSONL is already written, so a failing post-flush hook only warns
+	if err := runFlushHook("post-flush-hook", jsonlPath, postFlushHookEnv(len(issues), len(exportedIDs)), debug.Logf); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	if err := autoCommitJSONL(ctx, jsonlPath, len(issues), len(exportedIDs)); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: auto-commit-jsonl failed: %v\n", err)
+	}
 }
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -218,16 +217,18 @@ func importFromGit(ctx context.Context, dbFilePath string, store storage.Storage
 	// Increase buffer size to handle large JSONL lines (e.g., big descriptions)
 	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024) // allow up to 64MB per line
 	var issues []*types.Issue
+	lineNum := 0
 
 	for scanner.Scan() {
+		lineNum++
 		line := scanner.Text()
 		if line == "" {
 			continue
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
-			return fmt.Errorf("failed to parse issue: %w", err)
+		if err := types.UnmarshalJSONLRecord([]byte(line), &issue); err != nil {
+			return fmt.Errorf("failed to parse issue at line %d: %w", lineNum, err)
 		}
 		issue.SetDefaults() // Apply defaults for omitted fields (beads-399)
 		issues = append(issues, &issue)
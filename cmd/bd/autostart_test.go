@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 	
+	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/config"
 )
 
@@ -245,3 +246,64 @@ func TestGetSocketPath(t *testing.T) {
 		}
 	})
 }
+
+func TestSkipAutoStartForCommand(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(func() { config.Set("no-daemon-commands", []string{}) })
+
+	root := &cobra.Command{Use: "bd"}
+	list := &cobra.Command{Use: "list"}
+	show := &cobra.Command{Use: "show"}
+	dep := &cobra.Command{Use: "dep"}
+	depTree := &cobra.Command{Use: "tree"}
+	depAdd := &cobra.Command{Use: "add"}
+	dep.AddCommand(depTree, depAdd)
+	root.AddCommand(list, show, dep)
+
+	// Nothing listed: auto-start applies everywhere
+	if skipAutoStartForCommand(list) {
+		t.Error("list should auto-start when no-daemon-commands is empty")
+	}
+
+	config.Set("no-daemon-commands", []string{"list", "dep tree"})
+	tests := []struct {
+		cmd  *cobra.Command
+		skip bool
+	}{
+		{list, true},
+		{show, false},
+		{depTree, true},
+		{depAdd, false},
+		{root, false},
+	}
+	for _, tt := range tests {
+		if got := skipAutoStartForCommand(tt.cmd); got != tt.skip {
+			t.Errorf("skipAutoStartForCommand(%q) = %v, want %v", tt.cmd.CommandPath(), got, tt.skip)
+		}
+	}
+
+	// A parent covers its subcommands; comma-separated values are split
+	config.Set("no-daemon-commands", []string{"show, dep"})
+	for _, cmd := range []*cobra.Command{show, depTree, depAdd} {
+		if !skipAutoStartForCommand(cmd) {
+			t.Errorf("%q should not auto-start", cmd.CommandPath())
+		}
+	}
+	if skipAutoStartForCommand(list) {
+		t.Error("list is no longer listed and should auto-start")
+	}
+
+	// The real list command is covered even with auto-start-daemon on
+	t.Setenv("BEADS_AUTO_START_DAEMON", "true")
+	t.Setenv("BEADS_NO_DAEMON", "")
+	config.Set("no-daemon-commands", []string{"list"})
+	if !shouldAutoStartDaemon() {
+		t.Fatal("expected auto-start-daemon to be enabled")
+	}
+	if shouldAutoStartDaemon() && !skipAutoStartForCommand(listCmd) {
+		t.Error("bd list would auto-start the daemon despite no-daemon-commands")
+	}
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var backupCmd = &cobra.Command{
+	Use:     "backup <archive.tar.gz>",
+	GroupID: "sync",
+	Short:   "Snapshot the .beads directory into a tarball",
+	Long: `Snapshot the .beads directory into a gzipped tarball for disaster recovery.
+
+The archive contains config.yaml, metadata.json, the database and the JSONL
+export. The database WAL is checkpointed first so the .db file in the archive
+is self-contained.
+
+Use 'bd backup restore <archive.tar.gz>' to recreate the .beads directory.
+
+Examples:
+  bd backup beads-backup.tar.gz
+  bd backup restore beads-backup.tar.gz
+  bd backup restore beads-backup.tar.gz --force`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		archivePath := args[0]
+
+		if err := ensureDirectMode("backup requires direct database access"); err != nil {
+			FatalError("%v", err)
+		}
+
+		// Checkpoint so the .db file alone holds every committed write
+		if sqliteStore, ok := store.(*sqlite.SQLiteStorage); ok {
+			if err := sqliteStore.CheckpointWAL(rootCtx); err != nil {
+				FatalError("failed to checkpoint database: %v", err)
+			}
+		}
+
+		beadsDir := filepath.Dir(dbPath)
+		files := backupFileList(beadsDir, dbPath, findJSONLPath())
+		if err := writeBackupArchive(archivePath, files); err != nil {
+			FatalError("%v", err)
+		}
+
+		if jsonOutput {
+			names := make([]string, 0, len(files))
+			for _, f := range files {
+				names = append(names, filepath.Base(f))
+			}
+			outputJSON(map[string]interface{}{
+				"archive": archivePath,
+				"files":   names,
+			})
+			return
+		}
+
+		fmt.Printf("%s Backed up %d file(s) to %s\n", ui.RenderPass("✓"), len(files), archivePath)
+	},
+}
+
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore <archive.tar.gz>",
+	Short: "Recreate the .beads directory from a backup archive",
+	Long: `Recreate the .beads directory from an archive written by 'bd backup'.
+
+Restore refuses to overwrite an existing, non-empty .beads directory unless
+--force is given. Stale WAL/SHM sidecar files for the restored database are
+removed so SQLite does not replay them over the restored snapshot.
+
+Stop any running daemon before restoring with --force.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		archivePath := args[0]
+		force, _ := cmd.Flags().GetBool("force")
+
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			cwd, err := os.Getwd()
+			if err != nil {
+				FatalError("failed to get current directory: %v", err)
+			}
+			beadsDir = filepath.Join(cwd, ".beads")
+		}
+
+		restored, err := restoreBackupArchive(archivePath, beadsDir, force)
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"archive":   archivePath,
+				"beads_dir": beadsDir,
+				"files":     restored,
+			})
+			return
+		}
+
+		fmt.Printf("%s Restored %d file(s) into %s\n", ui.RenderPass("✓"), len(restored), beadsDir)
+	},
+}
+
+// backupFileList returns the files in beadsDir that make up a backup.
+// Files that don't exist (e.g. no JSONL export yet) are skipped.
+func backupFileList(beadsDir, databasePath, jsonlPath string) []string {
+	candidates := []string{
+		filepath.Join(beadsDir, "config.yaml"),
+		configfile.ConfigPath(beadsDir),
+		databasePath,
+		jsonlPath,
+	}
+
+	var files []string
+	seen := make(map[string]bool)
+	for _, path := range candidates {
+		if path == "" || seen[path] {
+			continue
+		}
+		seen[path] = true
+		if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+			files = append(files, path)
+		}
+	}
+	return files
+}
+
+// writeBackupArchive writes files into a gzipped tarball at archivePath.
+// Entries are stored by base name so the archive can be restored into any .beads directory.
+func writeBackupArchive(archivePath string, files []string) (err error) {
+	// #nosec G304 -- archivePath is provided by the user
+	out, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to close archive: %w", closeErr)
+		}
+	}()
+
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	for _, path := range files {
+		if err := addFileToArchive(tw, path); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	return nil
+}
+
+func addFileToArchive(tw *tar.Writer, path string) error {
+	// #nosec G304 -- path comes from the .beads directory
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return fmt.Errorf("failed to build archive header for %s: %w", path, err)
+	}
+	header.Name = filepath.Base(path)
+
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write archive header for %s: %w", path, err)
+	}
+	if _, err := io.Copy(tw, f); err != nil {
+		return fmt.Errorf("failed to archive %s: %w", path, err)
+	}
+	return nil
+}
+
+// restoreBackupArchive extracts a backup archive into beadsDir and returns the
+// names of the restored files. It refuses to touch a non-empty beadsDir unless force is set.
+func restoreBackupArchive(archivePath, beadsDir string, force bool) ([]string, error) {
+	if entries, err := os.ReadDir(beadsDir); err == nil && len(entries) > 0 && !force {
+		return nil, fmt.Errorf("%s already exists and is not empty (use --force to overwrite)", beadsDir)
+	}
+
+	// #nosec G304 -- archivePath is provided by the user
+	in, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer in.Close()
+
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(beadsDir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", beadsDir, err)
+	}
+
+	var restored []string
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return restored, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// Backups only ever contain flat file names; reject anything else
+		name := header.Name
+		if name != filepath.Base(name) || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return restored, fmt.Errorf("refusing to restore unexpected archive entry %q", name)
+		}
+
+		target := filepath.Join(beadsDir, name)
+		if strings.HasSuffix(name, ".db") {
+			// Stale sidecars would be replayed over the restored snapshot
+			_ = os.Remove(target + "-wal")
+			_ = os.Remove(target + "-shm")
+		}
+
+		if err := extractArchiveFile(tr, target); err != nil {
+			return restored, err
+		}
+		restored = append(restored, name)
+	}
+
+	return restored, nil
+}
+
+func extractArchiveFile(r io.Reader, target string) error {
+	// #nosec G304 -- target is a flat file name inside the .beads directory
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", target, err)
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		_ = out.Close()
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	return nil
+}
+
+func init() {
+	backupRestoreCmd.Flags().Bool("force", false, "Overwrite an existing non-empty .beads directory")
+	backupCmd.AddCommand(backupRestoreCmd)
+	rootCmd.AddCommand(backupCmd)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestBackupRestoreRoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	testDB := filepath.Join(beadsDir, "beads.db")
+	s := newTestStore(t, testDB)
+	ctx := context.Background()
+
+	issue := &types.Issue{
+		Title:     "Survives a backup",
+		Status:    types.StatusOpen,
+		Priority:  1,
+		IssueType: types.TypeTask,
+	}
+	if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CheckpointWAL(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	configYaml := "issue-prefix: \"test\"\n"
+	if err := os.WriteFile(filepath.Join(beadsDir, "config.yaml"), []byte(configYaml), 0600); err != nil {
+		t.Fatal(err)
+	}
+	jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+	if err := os.WriteFile(jsonlPath, []byte(`{"id":"`+issue.ID+`"}`+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	files := backupFileList(beadsDir, testDB, jsonlPath)
+	if len(files) != 3 {
+		t.Fatalf("expected config.yaml, db and jsonl in backup, got %v", files)
+	}
+
+	archive := filepath.Join(tmpDir, "backup.tar.gz")
+	if err := writeBackupArchive(archive, files); err != nil {
+		t.Fatalf("writeBackupArchive failed: %v", err)
+	}
+
+	// Restoring over the live directory must be refused without --force
+	if _, err := restoreBackupArchive(archive, beadsDir, false); err == nil {
+		t.Fatal("expected restore into non-empty .beads to fail without force")
+	}
+
+	restoreDir := filepath.Join(tmpDir, "restored", ".beads")
+	restored, err := restoreBackupArchive(archive, restoreDir, false)
+	if err != nil {
+		t.Fatalf("restoreBackupArchive failed: %v", err)
+	}
+	if len(restored) != 3 {
+		t.Fatalf("expected 3 restored files, got %v", restored)
+	}
+
+	gotConfig, err := os.ReadFile(filepath.Join(restoreDir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(gotConfig) != configYaml {
+		t.Errorf("config.yaml = %q, want %q", gotConfig, configYaml)
+	}
+
+	gotJSONL, err := os.ReadFile(filepath.Join(restoreDir, "issues.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(gotJSONL), issue.ID) {
+		t.Errorf("restored JSONL missing %s: %q", issue.ID, gotJSONL)
+	}
+
+	restoredStore := newTestStore(t, filepath.Join(restoreDir, "beads.db"))
+	got, err := restoredStore.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue on restored db failed: %v", err)
+	}
+	if got == nil || got.Title != issue.Title {
+		t.Fatalf("restored issue = %+v, want title %q", got, issue.Title)
+	}
+
+	// --force overwrites an existing directory
+	if _, err := restoreBackupArchive(archive, restoreDir, true); err != nil {
+		t.Fatalf("forced restore failed: %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/util"
+)
+
+// projectLocation returns the timezone named by the "timezone" config key,
+// or local time when it is unset.
+func projectLocation() (*time.Location, error) {
+	name := config.GetString("timezone")
+	if name == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q in config: %w", name, err)
+	}
+	return loc, nil
+}
+
+// projectWeekStart returns the first day of the week from the "week-start"
+// config key.
+func projectWeekStart() (time.Weekday, error) {
+	weekStart, err := util.ParseWeekStart(config.GetString("week-start"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid week-start in config: %w", err)
+	}
+	return weekStart, nil
+}
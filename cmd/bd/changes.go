@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var changesCmd = &cobra.Command{
+	Use:     "changes <git-ref>",
+	GroupID: "sync",
+	Short:   "Show issues added, closed, or modified since a git ref",
+	Long: `Compare the database with the JSONL committed at a git ref (a tag, branch,
+or commit) and report the issues added, closed, modified, and deleted since
+then. Handy for release notes.
+
+Issues created and closed since the ref are listed both as added and closed.
+If the JSONL did not exist at the ref, every issue counts as added.
+
+Examples:
+  bd changes v1.2.0
+  bd changes main~10 --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := ensureDirectMode("changes requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		jsonlPath := findJSONLPath()
+		if jsonlPath == "" {
+			FatalErrorRespectJSON("no .beads directory found")
+		}
+
+		result, err := changesSinceRef(rootCtx, store, jsonlPath, args[0])
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(result)
+			return
+		}
+		printChanges(result)
+	},
+}
+
+// changesResult lists what changed between the JSONL at a git ref and the
+// database, each list sorted by ID.
+type changesResult struct {
+	Ref string `json:"ref"`
+	// JSONLInRef is false when the JSONL didn't exist at the ref
+	JSONLInRef bool          `json:"jsonl_in_ref"`
+	Added      []issueRef    `json:"added"`
+	Closed     []issueRef    `json:"closed"`
+	Modified   []issueChange `json:"modified"` // excluding the closed issues
+	Deleted    []issueRef    `json:"deleted"`
+}
+
+// changesSinceRef compares the issues in s with the JSONL at jsonlPath as of
+// ref. Deleted issues (tombstones) count as absent on both sides.
+func changesSinceRef(ctx context.Context, s storage.Storage, jsonlPath, ref string) (*changesResult, error) {
+	oldIssues, inRef, err := loadIssuesFromGitRef(jsonlPath, ref)
+	if err != nil {
+		return nil, err
+	}
+	newIssues, err := loadIssuesForExport(ctx, s, types.IssueFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("loading issues: %w", err)
+	}
+
+	// schema_version describes the JSONL record format, not the issue
+	for _, issue := range oldIssues {
+		issue.SchemaVersion = 0
+	}
+
+	diff, err := diffIssues(withoutTombstones(oldIssues), newIssues, config.TimestampFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	wasClosed := make(map[string]bool, len(oldIssues))
+	for _, issue := range oldIssues {
+		wasClosed[issue.ID] = issue.Status == types.StatusClosed
+	}
+	result := &changesResult{
+		Ref:        ref,
+		JSONLInRef: inRef,
+		Added:      diff.Added,
+		Closed:     []issueRef{},
+		Modified:   []issueChange{},
+		Deleted:    diff.Removed,
+	}
+	closed := make(map[string]bool)
+	for _, issue := range newIssues { // sorted by ID
+		if issue.Status == types.StatusClosed && !wasClosed[issue.ID] {
+			result.Closed = append(result.Closed, issueRef{ID: issue.ID, Title: issue.Title})
+			closed[issue.ID] = true
+		}
+	}
+	for _, change := range diff.Modified {
+		if !closed[change.ID] {
+			result.Modified = append(result.Modified, change)
+		}
+	}
+	return result, nil
+}
+
+// loadIssuesFromGitRef reads the issues in the JSONL at jsonlPath as it was
+// committed at ref. It reports false, with no issues, if the file was not in
+// the ref, and fails if ref is not a commit.
+func loadIssuesFromGitRef(jsonlPath, ref string) ([]*types.Issue, bool, error) {
+	dir := filepath.Dir(jsonlPath)
+	// git resolves "<ref>:./<path>" relative to the directory it runs in
+	spec := ref + ":./" + filepath.Base(jsonlPath)
+
+	verify := exec.Command("git", "-C", dir, "rev-parse", "--verify", "--quiet", ref+"^{commit}") // #nosec G204 - git command with safe args
+	if err := verify.Run(); err != nil {
+		if _, lookErr := exec.LookPath("git"); lookErr != nil {
+			return nil, false, fmt.Errorf("git is not installed")
+		}
+		if exec.Command("git", "-C", dir, "rev-parse", "--git-dir").Run() != nil { // #nosec G204 - git command with safe args
+			return nil, false, fmt.Errorf("%s is not in a git repository", dir)
+		}
+		return nil, false, fmt.Errorf("unknown git ref %q", ref)
+	}
+	if exec.Command("git", "-C", dir, "cat-file", "-e", spec).Run() != nil { // #nosec G204 - git command with safe args
+		return nil, false, nil
+	}
+
+	var stderr bytes.Buffer
+	show := exec.Command("git", "-C", dir, "show", spec) // #nosec G204 - git command with safe args
+	show.Stderr = &stderr
+	data, err := show.Output()
+	if err != nil {
+		return nil, false, fmt.Errorf("reading %s: %v: %s", spec, err, strings.TrimSpace(stderr.String()))
+	}
+	issues, err := parseIssuesJSONL(bytes.NewReader(data))
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing %s: %w", spec, err)
+	}
+	return issues, true, nil
+}
+
+func withoutTombstones(issues []*types.Issue) []*types.Issue {
+	live := make([]*types.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if !issue.IsTombstone() {
+			live = append(live, issue)
+		}
+	}
+	return live
+}
+
+func printChanges(result *changesResult) {
+	if !result.JSONLInRef {
+		fmt.Printf("%s\n\n", ui.RenderMuted(fmt.Sprintf("No JSONL at %s; every issue counts as added", result.Ref)))
+	}
+	if len(result.Added)+len(result.Closed)+len(result.Modified)+len(result.Deleted) == 0 {
+		fmt.Printf("No changes since %s\n", result.Ref)
+		return
+	}
+
+	printRefs := func(heading, marker string, refs []issueRef) {
+		if len(refs) == 0 {
+			return
+		}
+		fmt.Printf("%s (%d):\n", heading, len(refs))
+		for _, ref := range refs {
+			fmt.Printf("  %s %s %s\n", marker, ui.RenderID(ref.ID), ref.Title)
+		}
+		fmt.Println()
+	}
+	printRefs("Added", ui.RenderPass("+"), result.Added)
+	printRefs("Closed", ui.RenderPass("✓"), result.Closed)
+	if len(result.Modified) > 0 {
+		fmt.Printf("Modified (%d):\n", len(result.Modified))
+		for _, change := range result.Modified {
+			fmt.Printf("  %s %s %s %s\n", ui.RenderWarn("~"), ui.RenderID(change.ID), change.Title,
+				ui.RenderMuted("("+strings.Join(change.Fields, ", ")+")"))
+		}
+		fmt.Println()
+	}
+	printRefs("Deleted", ui.RenderFail("-"), result.Deleted)
+
+	fmt.Printf("%d added, %d closed, %d modified, %d deleted since %s\n",
+		len(result.Added), len(result.Closed), len(result.Modified), len(result.Deleted), result.Ref)
+}
+
+func init() {
+	rootCmd.AddCommand(changesCmd)
+}
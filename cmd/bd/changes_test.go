@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestChangesSinceRef(t *testing.T) {
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test User")
+	beadsDir := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// A commit from before the project used beads
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("hello\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "README.md")
+	git("commit", "-q", "-m", "initial")
+	git("tag", "before-beads")
+
+	ctx := context.Background()
+	s := newTestStore(t, filepath.Join(beadsDir, "beads.db"))
+	jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+
+	create := func(title string) *types.Issue {
+		t.Helper()
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		return issue
+	}
+	toClose := create("Fix crash")
+	toEdit := create("Write docs")
+	toDelete := create("Obsolete")
+	unchanged := create("Untouched")
+
+	// Commit the JSONL as a release
+	issues, err := loadIssuesForExport(ctx, s, types.IssueFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf strings.Builder
+	for _, issue := range issues {
+		issue.SchemaVersion = types.JSONLSchemaVersion // as the JSONL writers do
+		data, err := json.Marshal(issue)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if err := os.WriteFile(jsonlPath, []byte(buf.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", ".beads/issues.jsonl")
+	git("commit", "-q", "-m", "release")
+	git("tag", "v1")
+
+	// Work done since the release
+	if err := s.CloseIssue(ctx, toClose.ID, "fixed", "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateIssue(ctx, toEdit.ID, map[string]interface{}{"priority": 0}, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateTombstone(ctx, toDelete.ID, "test", "obsolete"); err != nil {
+		t.Fatal(err)
+	}
+	added := create("New feature")
+
+	result, err := changesSinceRef(ctx, s, jsonlPath, "v1")
+	if err != nil {
+		t.Fatalf("changesSinceRef failed: %v", err)
+	}
+	ids := func(refs []issueRef) []string {
+		var out []string
+		for _, ref := range refs {
+			out = append(out, ref.ID)
+		}
+		return out
+	}
+	if got := ids(result.Added); len(got) != 1 || got[0] != added.ID {
+		t.Errorf("Added = %v, want [%s]", got, added.ID)
+	}
+	if got := ids(result.Closed); len(got) != 1 || got[0] != toClose.ID {
+		t.Errorf("Closed = %v, want [%s]", got, toClose.ID)
+	}
+	if got := ids(result.Deleted); len(got) != 1 || got[0] != toDelete.ID {
+		t.Errorf("Deleted = %v, want [%s]", got, toDelete.ID)
+	}
+	if len(result.Modified) != 1 || result.Modified[0].ID != toEdit.ID || !strings.Contains(strings.Join(result.Modified[0].Fields, ","), "priority") {
+		t.Errorf("Modified = %+v, want %s with a priority change", result.Modified, toEdit.ID)
+	}
+	for _, change := range result.Modified {
+		if change.ID == unchanged.ID {
+			t.Errorf("unchanged issue %s reported as modified: %v", unchanged.ID, change.Fields)
+		}
+	}
+
+	// The JSONL didn't exist yet: everything is new
+	result, err = changesSinceRef(ctx, s, jsonlPath, "before-beads")
+	if err != nil {
+		t.Fatalf("changesSinceRef(before-beads) failed: %v", err)
+	}
+	if result.JSONLInRef || len(result.Added) != 4 || len(result.Deleted) != 0 {
+		t.Errorf("changesSinceRef(before-beads) = %+v, want 4 added and no JSONL in ref", result)
+	}
+
+	if _, err := changesSinceRef(ctx, s, jsonlPath, "no-such-tag"); err == nil || !strings.Contains(err.Error(), "unknown git ref") {
+		t.Errorf("changesSinceRef(no-such-tag) error = %v, want unknown git ref", err)
+	}
+}
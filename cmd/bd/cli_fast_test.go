@@ -123,6 +123,7 @@ func runBDInProcess(t *testing.T, dir string, args ...string) string {
 	dbPath = ""
 	actor = ""
 	jsonOutput = false
+	quietFlag = false
 	noDaemon = false
 	noAutoFlush = false
 	noAutoImport = false
@@ -206,6 +207,27 @@ func TestCLI_Create(t *testing.T) {
 	}
 }
 
+func TestCLI_Quiet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping slow CLI test in short mode")
+	}
+	// Note: Not using t.Parallel() because inProcessMutex serializes execution anyway
+	tmpDir := setupCLITestDB(t)
+	if out := runBDInProcess(t, tmpDir, "--quiet", "create", "Quiet issue", "-p", "1"); out != "" {
+		t.Errorf("Expected no stdout under --quiet, got: %q", out)
+	}
+
+	// JSON output is still printed when explicitly requested
+	out := runBDInProcess(t, tmpDir, "--quiet", "list", "--json")
+	var issues []map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &issues); err != nil {
+		t.Fatalf("Failed to parse JSON: %v\nOutput: %s", err, out)
+	}
+	if len(issues) != 1 || issues[0]["title"] != "Quiet issue" {
+		t.Errorf("Expected the quietly created issue, got: %v", issues)
+	}
+}
+
 func TestCLI_List(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping slow CLI test in short mode")
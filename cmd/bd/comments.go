@@ -64,10 +64,10 @@ Examples:
 				os.Exit(1)
 			}
 			ctx := rootCtx
-			fullID, err := utils.ResolvePartialID(ctx, store, issueID)
+			fullID, err := utils.ResolveIssueID(ctx, store, issueID)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", issueID, err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			issueID = fullID
 			
@@ -191,10 +191,10 @@ Examples:
 			}
 			ctx := rootCtx
 			
-			fullID, err := utils.ResolvePartialID(ctx, store, issueID)
+			fullID, err := utils.ResolveIssueID(ctx, store, issueID)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", issueID, err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			issueID = fullID
 			
@@ -183,3 +183,61 @@ func TestIsUnknownOperationError(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentsExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	oldRootCtx, oldDBPath, oldStore := rootCtx, dbPath, store
+	defer func() {
+		rootCtx, dbPath, store = oldRootCtx, oldDBPath, oldStore
+		storeMutex.Lock()
+		storeActive = false
+		storeMutex.Unlock()
+	}()
+
+	tmpDir := t.TempDir()
+	t.Chdir(tmpDir)
+	rootCtx = ctx
+	dbPath = filepath.Join(tmpDir, ".beads", "beads.db")
+	store = newTestStore(t, dbPath)
+	storeMutex.Lock()
+	storeActive = true
+	storeMutex.Unlock()
+
+	issue := &types.Issue{Title: "Discussed", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct{ author, text string }{{testUserAlice, "First thought"}, {"bob", "Second thought"}} {
+		if _, err := store.AddIssueComment(ctx, issue.ID, c.author, c.text); err != nil {
+			t.Fatalf("AddIssueComment() error = %v", err)
+		}
+	}
+
+	jsonlPath := filepath.Join(tmpDir, ".beads", "issues.jsonl")
+	if err := exportToJSONL(ctx, jsonlPath); err != nil {
+		t.Fatalf("exportToJSONL() error = %v", err)
+	}
+	issues, err := loadIssuesFromJSONL(jsonlPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 || len(issues[0].Comments) != 2 {
+		t.Fatalf("exported issues = %d with comments %v, want 1 issue with 2 comments", len(issues), issues)
+	}
+
+	importedDB := filepath.Join(tmpDir, "imported", "beads.db")
+	imported := newTestStore(t, importedDB)
+	if _, err := importIssuesCore(ctx, importedDB, imported, issues, ImportOptions{}); err != nil {
+		t.Fatalf("importIssuesCore() error = %v", err)
+	}
+	comments, err := imported.GetIssueComments(ctx, issue.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("imported comments = %d, want 2", len(comments))
+	}
+	if comments[0].Author != testUserAlice || comments[0].Text != "First thought" || comments[1].Author != "bob" || comments[1].Text != "Second thought" {
+		t.Errorf("imported comments = %+v, %+v", comments[0], comments[1])
+	}
+}
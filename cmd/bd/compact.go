@@ -11,6 +11,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/compact"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -960,7 +961,7 @@ func pruneExpiredTombstones(customTTL time.Duration) (*TombstonePruneResult, err
 	decoder := json.NewDecoder(file)
 	for {
 		var issue types.Issue
-		if err := decoder.Decode(&issue); err != nil {
+		if err := types.DecodeIssueJSONL(decoder, &issue); err != nil {
 			if err.Error() == "EOF" {
 				break
 			}
@@ -1004,9 +1005,9 @@ func pruneExpiredTombstones(customTTL time.Duration) (*TombstonePruneResult, err
 	}
 	tempPath := tempFile.Name()
 
-	encoder := json.NewEncoder(tempFile)
+	format := config.TimestampFormat()
 	for _, issue := range kept {
-		if err := encoder.Encode(issue); err != nil {
+		if err := types.EncodeIssueJSONL(tempFile, issue, format); err != nil {
 			_ = tempFile.Close()
 			_ = os.Remove(tempPath)
 			return nil, fmt.Errorf("failed to write issue %s: %w", issue.ID, err)
@@ -1056,7 +1057,7 @@ func previewPruneTombstones(customTTL time.Duration) (*TombstonePruneResult, err
 	decoder := json.NewDecoder(file)
 	for {
 		var issue types.Issue
-		if err := decoder.Decode(&issue); err != nil {
+		if err := types.DecodeIssueJSONL(decoder, &issue); err != nil {
 			if err.Error() == "EOF" {
 				break
 			}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// completionLimit caps the issue IDs offered per completion, to keep it fast.
+const completionLimit = 50
+
+var completionCmd = &cobra.Command{
+	Use:     "completion [bash|zsh|fish|powershell]",
+	GroupID: "setup",
+	Short:   "Generate a shell completion script",
+	Long: `Generate a completion script for bd for the given shell. Besides commands
+and flags, it completes issue IDs (for show, update, close, dep add, ...) from
+the current project's database, and config keys for bd config.
+
+Bash (needs the bash-completion package):
+  source <(bd completion bash)
+  bd completion bash > /etc/bash_completion.d/bd     # Linux, permanently
+
+Zsh:
+  bd completion zsh > "${fpath[1]}/_bd"
+
+Fish:
+  bd completion fish > ~/.config/fish/completions/bd.fish
+
+PowerShell:
+  bd completion powershell | Out-String | Invoke-Expression`,
+	ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		out := cmd.OutOrStdout()
+		var err error
+		switch args[0] {
+		case "bash":
+			err = rootCmd.GenBashCompletionV2(out, true)
+		case "zsh":
+			err = rootCmd.GenZshCompletion(out)
+		case "fish":
+			err = rootCmd.GenFishCompletion(out, true)
+		case "powershell":
+			err = rootCmd.GenPowerShellCompletionWithDesc(out)
+		}
+		if err != nil {
+			FatalError("generating %s completion: %v", args[0], err)
+		}
+	},
+}
+
+// completeIssueIDs completes issue IDs starting with toComplete, with their
+// titles as descriptions. It reads the database directly and read-only,
+// without the daemon or auto-import, so completion stays fast.
+func completeIssueIDs(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	path := dbPath
+	if path == "" {
+		path = beads.FindDatabasePath()
+	}
+	if path == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ids, err := issueIDCompletions(ctx, path, toComplete, completionLimit)
+	if err != nil {
+		cobra.CompDebugln(fmt.Sprintf("completing issue IDs: %v", err), true)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
+// issueIDCompletions returns up to limit "id\ttitle" entries for the issues
+// in the database at path whose ID starts with prefix. Tombstones are left out.
+func issueIDCompletions(ctx context.Context, path, prefix string, limit int) ([]string, error) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro&_pragma=busy_timeout(200)")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, title FROM issues
+		WHERE id LIKE ? ESCAPE '\' AND status != ?
+		ORDER BY id
+		LIMIT ?
+	`, escaped+"%", types.StatusTombstone, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var completions []string
+	for rows.Next() {
+		var id, title string
+		if err := rows.Scan(&id, &title); err != nil {
+			return nil, err
+		}
+		completions = append(completions, id+"\t"+title)
+	}
+	return completions, rows.Err()
+}
+
+// completeConfigKeys completes the first argument with known config keys,
+// and for bd config set the second with the key's allowed values, if any.
+func completeConfigKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch {
+	case len(args) == 0:
+		var keys []string
+		for _, key := range config.Schema() {
+			if strings.HasPrefix(key.Key, toComplete) {
+				keys = append(keys, key.Key+"\t"+key.Description)
+			}
+		}
+		return keys, cobra.ShellCompDirectiveNoFileComp
+	case len(args) == 1 && cmd == configSetCmd:
+		for _, key := range config.Schema() {
+			if key.Key == args[0] {
+				return key.Enum, cobra.ShellCompDirectiveNoFileComp
+			}
+		}
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{
+		showCmd, updateCmd, editCmd, closeCmd, reopenCmd, deleteCmd,
+		depAddCmd, depRemoveCmd, depTreeCmd,
+		labelAddCmd, labelRemoveCmd, labelListCmd,
+		commentsCmd, commentsAddCmd,
+	} {
+		cmd.ValidArgsFunction = completeIssueIDs
+	}
+	for _, cmd := range []*cobra.Command{configGetCmd, configSetCmd, configUnsetCmd} {
+		cmd.ValidArgsFunction = completeConfigKeys
+	}
+	rootCmd.AddCommand(completionCmd)
+}
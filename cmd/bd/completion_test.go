@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCompletionCommand(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		t.Run(shell, func(t *testing.T) {
+			var buf bytes.Buffer
+			completionCmd.SetOut(&buf)
+			defer completionCmd.SetOut(nil)
+
+			completionCmd.Run(completionCmd, []string{shell})
+			if buf.Len() == 0 {
+				t.Fatalf("bd completion %s produced no output", shell)
+			}
+			if !strings.Contains(buf.String(), "bd") {
+				t.Errorf("bd completion %s output doesn't mention bd", shell)
+			}
+		})
+	}
+}
+
+func TestCompleteIssueIDs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".beads", "beads.db")
+	store := newTestStore(t, path)
+	ctx := context.Background()
+	for i, title := range []string{"First", "Second", "Third"} {
+		issue := &types.Issue{ID: fmt.Sprintf("test-a%d", i), Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue() error = %v", err)
+		}
+	}
+	other := &types.Issue{ID: "test-b0", Title: "Other", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, other, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	oldDBPath := dbPath
+	dbPath = path
+	defer func() { dbPath = oldDBPath }()
+
+	got, _ := completeIssueIDs(showCmd, nil, "test-a")
+	want := []string{"test-a0\tFirst", "test-a1\tSecond", "test-a2\tThird"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("completeIssueIDs(test-a) = %q, want %q", got, want)
+	}
+
+	// LIKE wildcards in the typed text match literally
+	if got, _ := completeIssueIDs(showCmd, nil, "test_"); len(got) != 0 {
+		t.Errorf("completeIssueIDs(test_) = %q, want none", got)
+	}
+
+	ids, err := issueIDCompletions(ctx, path, "test-", 2)
+	if err != nil {
+		t.Fatalf("issueIDCompletions() error = %v", err)
+	}
+	if len(ids) != 2 {
+		t.Errorf("issueIDCompletions() with limit 2 returned %d IDs", len(ids))
+	}
+}
+
+func TestCompleteConfigKeys(t *testing.T) {
+	keys, _ := completeConfigKeys(configGetCmd, nil, "week-")
+	if len(keys) != 1 || !strings.HasPrefix(keys[0], "week-start\t") {
+		t.Errorf("completeConfigKeys(week-) = %q, want week-start", keys)
+	}
+
+	values, _ := completeConfigKeys(configSetCmd, []string{"week-start"}, "")
+	if strings.Join(values, ",") != "monday,sunday" {
+		t.Errorf("completing the value of week-start = %q, want monday, sunday", values)
+	}
+}
@@ -2,13 +2,21 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
 	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/syncbranch"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"gopkg.in/yaml.v3"
 )
 
 var configCmd = &cobra.Command{
@@ -36,13 +44,39 @@ Custom Status States:
   This enables issues to use statuses like 'awaiting_review' in addition to
   the built-in statuses (open, in_progress, blocked, deferred, closed).
 
+Default Status:
+  New issues start as 'open'. Set default-status to start them in another
+  built-in or custom status instead:
+    bd config set default-status backlog
+
+Workflow Statuses:
+  Set statuses to the complete, ordered list of statuses your workflow uses.
+  Issues can then only be created in or moved to those statuses (closing and
+  deleting always work). Unset, every built-in and custom status is allowed.
+  List the statuses that mean "done" in closed-statuses; issues in them no
+  longer block their dependents, just like closed issues:
+    bd config set statuses "triage,open,in_progress,in-review,done"
+    bd config set closed-statuses "done"
+
+Assignees:
+  Set assignees to the people issues may be assigned to; other assignees are
+  then rejected. Set assignee-case-insensitive to true so "Alice" and "alice"
+  are the same person (stored as the listed spelling, or lowercase without a
+  list):
+    bd config set assignees "alice,bob,carol"
+    bd config set assignee-case-insensitive true
+
 Examples:
   bd config set jira.url "https://company.atlassian.net"
   bd config set jira.project "PROJ"
   bd config set status.custom "awaiting_review,awaiting_testing"
   bd config get jira.url
   bd config list
-  bd config unset jira.url`,
+  bd config unset jira.url
+  bd config search debounce          # find a key by part of its name
+  bd config reset flush-debounce     # back to the default
+  bd config export > standard.yaml   # config.yaml settings, for other projects
+  bd config import standard.yaml     # merge them in, keeping other keys`,
 }
 
 var configSetCmd = &cobra.Command{
@@ -53,10 +87,22 @@ var configSetCmd = &cobra.Command{
 		key := args[0]
 		value := args[1]
 
+		// Secrets are read from the environment only, never stored
+		if config.IsSecretKey(key) {
+			FatalErrorCode(ExitConfig, "%s is a secret and is never stored; set the %s environment variable instead",
+				key, config.SecretEnvVar(key))
+		}
+
 		// Check if this is a yaml-only key (startup settings like no-db, no-daemon, etc.)
 		// These must be written to config.yaml, not SQLite, because they're read
 		// before the database is opened. (GH#536)
 		if config.IsYamlOnlyKey(key) {
+			// An invalid timestamp-format would stop every later command at startup
+			if key == "timestamp-format" {
+				if _, err := types.ParseTimestampFormat(value); err != nil {
+					FatalErrorCode(ExitConfig, "%v", err)
+				}
+			}
 			if err := config.SetYamlConfig(key, value); err != nil {
 				fmt.Fprintf(os.Stderr, "Error setting config: %v\n", err)
 				os.Exit(1)
@@ -82,6 +128,26 @@ var configSetCmd = &cobra.Command{
 
 		ctx := rootCtx
 
+		// default-status must name a status issues can actually have
+		if strings.TrimSpace(key) == sqlite.DefaultStatusConfigKey {
+			customStatuses, err := store.GetCustomStatuses(ctx)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading custom statuses: %v\n", err)
+				os.Exit(1)
+			}
+			if _, err := types.DefaultStatus(value, customStatuses); err != nil {
+				fmt.Fprintf(os.Stderr, "Error setting config: %v\n", err)
+				os.Exit(ExitConfig)
+			}
+		}
+
+		if strings.TrimSpace(key) == sqlite.AssigneeCaseInsensitiveConfigKey {
+			if _, err := strconv.ParseBool(value); err != nil {
+				fmt.Fprintf(os.Stderr, "Error setting config: %s must be true or false\n", key)
+				os.Exit(ExitConfig)
+			}
+		}
+
 		// Special handling for sync.branch to apply validation
 		if strings.TrimSpace(key) == syncbranch.ConfigKey {
 			if err := syncbranch.Set(ctx, store, value); err != nil {
@@ -113,6 +179,24 @@ var configGetCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		key := args[0]
 
+		// Never print a secret, only where it comes from
+		if config.IsSecretKey(key) {
+			envVar := config.SecretEnvVar(key)
+			isSet := config.GetSecret(key) != ""
+			if jsonOutput {
+				outputJSON(map[string]interface{}{
+					"key":      key,
+					"set":      isSet,
+					"location": envVar,
+				})
+			} else if isSet {
+				fmt.Printf("%s (set in %s)\n", key, envVar)
+			} else {
+				fmt.Printf("%s (not set; set the %s environment variable)\n", key, envVar)
+			}
+			return
+		}
+
 		// Check if this is a yaml-only key (startup settings)
 		// These are read from config.yaml via viper, not SQLite. (GH#536)
 		if config.IsYamlOnlyKey(key) {
@@ -241,10 +325,310 @@ var configUnsetCmd = &cobra.Command{
 	},
 }
 
+var configResetCmd = &cobra.Command{
+	Use:   "reset [key]",
+	Short: "Reset a setting, or all of config.yaml, to the defaults",
+	Long: `Remove a setting so that its default applies again. YAML-only settings are
+removed from config.yaml; others are removed from the database, like
+'bd config unset'. Nested keys are dotted, e.g. routing.mode.
+
+With --all (and --yes, to confirm), every setting in config.yaml is removed,
+leaving only its comments. Settings stored in the database are not touched.
+
+Examples:
+  bd config reset flush-debounce
+  bd config reset --all --yes`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("config reset")
+		all, _ := cmd.Flags().GetBool("all")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		if all {
+			if len(args) > 0 {
+				FatalErrorCode(ExitUsage, "give either a key or --all, not both")
+			}
+			if !yes {
+				FatalErrorCode(ExitUsage, "--all removes every setting from config.yaml; pass --yes to confirm")
+			}
+			keys, err := config.ResetYamlConfig()
+			if err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
+			if keys == nil {
+				keys = []string{}
+			}
+			if jsonOutput {
+				outputJSON(map[string]interface{}{"reset": keys})
+				return
+			}
+			for _, key := range keys {
+				fmt.Printf("Reset %s\n", key)
+			}
+			fmt.Printf("%s Reset %d setting(s) in config.yaml to the defaults\n", ui.RenderPass("✓"), len(keys))
+			return
+		}
+		if len(args) == 0 {
+			FatalErrorCode(ExitUsage, "give a key to reset, or --all")
+		}
+
+		key := args[0]
+		if config.IsSecretKey(key) {
+			FatalErrorCode(ExitUsage, "%s is only read from %s; unset that environment variable instead", key, config.SecretEnvVar(key))
+		}
+		removed, err := resetConfigKey(key)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		value := config.GetString(key)
+		if !config.IsYamlOnlyKey(key) {
+			value = ""
+		}
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"key": key, "reset": removed, "value": value})
+			return
+		}
+		if !removed {
+			fmt.Printf("%s is not set; it already has its default\n", key)
+			return
+		}
+		if value == "" {
+			fmt.Printf("Reset %s (now unset)\n", key)
+		} else {
+			fmt.Printf("Reset %s to its default: %s\n", key, value)
+		}
+		if config.GetValueSource(key) == config.SourceEnvVar {
+			fmt.Fprintf(os.Stderr, "Note: an environment variable still sets %s\n", key)
+		}
+	},
+}
+
+// resetConfigKey removes key from config.yaml or, for settings kept there,
+// the database, and reports whether it was set.
+func resetConfigKey(key string) (bool, error) {
+	if config.IsYamlOnlyKey(key) {
+		return config.UnsetYamlConfig(key)
+	}
+	if err := ensureDirectMode("config reset requires direct database access"); err != nil {
+		return false, err
+	}
+	current, err := store.GetConfig(rootCtx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	if current == "" {
+		return false, nil
+	}
+	if err := store.DeleteConfig(rootCtx, key); err != nil {
+		return false, fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+	return true, nil
+}
+
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print a JSON Schema for config.yaml",
+	Long: `Print a JSON Schema describing the settings in config.yaml: their types,
+defaults, allowed values and descriptions. Editors use it to complete and
+validate the file, e.g. with the VS Code YAML extension:
+
+  bd config schema > .beads/config.schema.json
+
+and then, as the first line of .beads/config.yaml:
+
+  # yaml-language-server: $schema=./config.schema.json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		outputJSON(config.JSONSchema())
+	},
+}
+
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write a config.yaml documenting every setting",
+	Long: `Write .beads/config.yaml listing every known setting with a comment giving
+its description and default. Settings are commented out, so their defaults
+apply, until you uncomment them or set them with 'bd config set'.
+
+An existing config.yaml is left alone unless --force is given; then it is
+regenerated, keeping the settings it has (comments other than the generated
+ones are dropped).
+
+Examples:
+  bd config init
+  bd config init --force`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("config init")
+		force, _ := cmd.Flags().GetBool("force")
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			FatalErrorRespectJSON("no .beads directory found; run 'bd init' first")
+		}
+		configPath := filepath.Join(beadsDir, "config.yaml")
+
+		existing, err := os.ReadFile(configPath) // #nosec G304 - config file path from beads dir
+		switch {
+		case err == nil && !force:
+			FatalErrorRespectJSON("%s already exists; pass --force to regenerate it, keeping its settings", configPath)
+		case err != nil && !os.IsNotExist(err):
+			FatalErrorRespectJSON("failed to read config.yaml: %v", err)
+		}
+		content, err := config.GenerateConfigYaml(existing)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if err := os.WriteFile(configPath, content, 0600); err != nil {
+			FatalErrorRespectJSON("failed to write config.yaml: %v", err)
+		}
+		if err := config.ApplyFileMode(configPath); err != nil {
+			FatalErrorRespectJSON("failed to set config.yaml permissions: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"path": configPath, "regenerated": existing != nil})
+			return
+		}
+		if existing != nil {
+			fmt.Printf("%s Regenerated %s, keeping its settings\n", ui.RenderPass("✓"), configPath)
+		} else {
+			fmt.Printf("%s Wrote %s\n", ui.RenderPass("✓"), configPath)
+		}
+	},
+}
+
+var configSearchCmd = &cobra.Command{
+	Use:   "search <text>",
+	Short: "Find config keys by part of their name",
+	Long: `List the known config keys whose name contains the text, ignoring case,
+with their current values. Useful when you remember "debounce" but not
+"flush-debounce". Values of secret keys are not shown.
+
+Examples:
+  bd config search debounce
+  bd config search sync --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		keys := config.SearchKeys(args[0])
+
+		if jsonOutput {
+			results := make([]map[string]string, 0, len(keys))
+			for _, key := range keys {
+				results = append(results, map[string]string{"key": key, "value": configSearchValue(key)})
+			}
+			outputJSON(results)
+			return
+		}
+		if len(keys) == 0 {
+			fmt.Printf("No config keys match %q\n", args[0])
+			return
+		}
+		for _, key := range keys {
+			fmt.Printf("  %s = %s\n", key, configSearchValue(key))
+		}
+	},
+}
+
+// configSearchValue returns key's effective value for bd config search.
+func configSearchValue(key string) string {
+	if config.IsSecretKey(key) {
+		return "(secret, read from " + config.SecretEnvVar(key) + ")"
+	}
+	return config.GetString(key)
+}
+
+var configExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the settings in config.yaml",
+	Long: `Print the settings in this project's .beads/config.yaml as YAML, without
+comments, for 'bd config import' in other projects. Settings stored in the
+database ('bd config list') are not included.
+
+Examples:
+  bd config export > standard.yaml
+  bd config export --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		settings, err := config.ExportYamlConfig()
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(settings)
+			return
+		}
+		if len(settings) == 0 {
+			return
+		}
+		encoder := yaml.NewEncoder(os.Stdout)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(settings); err != nil {
+			FatalError("failed to encode config: %v", err)
+		}
+		_ = encoder.Close()
+	},
+}
+
+var configImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Merge settings from a YAML file into config.yaml",
+	Long: `Merge the settings in a YAML file, such as the output of 'bd config export',
+into this project's .beads/config.yaml. Keys the file sets are added or
+replaced; all other keys, and comments, are left as they are. Nested sections
+are merged key by key. Use - to read the file from stdin.
+
+Examples:
+  bd config import standard.yaml
+  bd config export | (cd ../other-project && bd config import -)`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("config import")
+
+		var data []byte
+		var err error
+		if args[0] == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(args[0]) // #nosec G304 - user-provided import file
+		}
+		if err != nil {
+			FatalErrorRespectJSON("failed to read %s: %v", args[0], err)
+		}
+
+		keys, err := config.ImportYamlConfig(data)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			if keys == nil {
+				keys = []string{}
+			}
+			outputJSON(map[string]interface{}{"imported": keys})
+			return
+		}
+		for _, key := range keys {
+			fmt.Printf("Set %s (in config.yaml)\n", key)
+		}
+		fmt.Printf("%s Imported %d setting(s) into config.yaml\n", ui.RenderPass("✓"), len(keys))
+	},
+}
+
 func init() {
+	configCmd.AddCommand(configExportCmd)
+	configCmd.AddCommand(configImportCmd)
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configListCmd)
 	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configSearchCmd)
+	configResetCmd.Flags().Bool("all", false, "Remove every setting from config.yaml")
+	configResetCmd.Flags().Bool("yes", false, "Confirm --all")
+	configCmd.AddCommand(configResetCmd)
+	configCmd.AddCommand(configSchemaCmd)
+	configInitCmd.Flags().Bool("force", false, "Regenerate an existing config.yaml, keeping its settings")
+	configCmd.AddCommand(configInitCmd)
 	rootCmd.AddCommand(configCmd)
 }
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/ui"
+	"gopkg.in/yaml.v3"
+)
+
+var configHistoryCmd = &cobra.Command{
+	Use:   "history [key]",
+	Short: "Show who changed which config.yaml settings, from git",
+	Long: `List the commits that changed .beads/config.yaml, newest first, with the
+author, date, and the settings each one added, changed, or removed. Comment and
+formatting changes are left out. Values of secret keys are never shown.
+
+Pass a key to show only changes to it, e.g. 'bd config history issue-prefix'.
+Settings stored in the database ('bd config set' for non-YAML keys) are not
+in git and so have no history.
+
+Examples:
+  bd config history
+  bd config history actor --limit 5
+  bd config history --json`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		limit, _ := cmd.Flags().GetInt("limit")
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			FatalErrorRespectJSON("no .beads directory found")
+		}
+		configPath := filepath.Join(beadsDir, "config.yaml")
+
+		history, err := configHistory(configPath, limit)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if len(args) == 1 {
+			history = filterConfigHistory(history, args[0])
+		}
+
+		if jsonOutput {
+			outputJSON(history)
+			return
+		}
+		if len(history) == 0 {
+			fmt.Println("No committed changes to config.yaml settings")
+			return
+		}
+		for _, commit := range history {
+			fmt.Printf("%s %s %s\n", ui.RenderAccent(shortCommit(commit.Commit)),
+				commit.Date.Local().Format("2006-01-02 15:04"), commit.Author)
+			if commit.Subject != "" {
+				fmt.Printf("  %s\n", ui.RenderMuted(commit.Subject))
+			}
+			for _, change := range commit.Changes {
+				switch change.Action {
+				case "added":
+					fmt.Printf("  %s %s: %s\n", ui.RenderPass("+"), change.Key, change.New)
+				case "removed":
+					fmt.Printf("  %s %s (was %s)\n", ui.RenderFail("-"), change.Key, change.Old)
+				default:
+					fmt.Printf("  %s %s: %s → %s\n", ui.RenderWarn("~"), change.Key, change.Old, change.New)
+				}
+			}
+			fmt.Println()
+		}
+	},
+}
+
+// configCommit is a commit that changed settings in config.yaml.
+type configCommit struct {
+	Commit  string            `json:"commit"`
+	Author  string            `json:"author"`
+	Date    time.Time         `json:"date"`
+	Subject string            `json:"subject"`
+	Changes []configKeyChange `json:"changes"`
+}
+
+// configKeyChange is one setting a commit added, changed, or removed. Nested
+// keys are dotted, e.g. "sync.branch".
+type configKeyChange struct {
+	Key    string `json:"key"`
+	Action string `json:"action"` // "added", "changed" or "removed"
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// configHistory returns the commits that changed settings in configPath,
+// newest first, looking at no more than limit commits if limit > 0. It runs
+// git log with the whole file as diff context, so that both versions of the
+// file can be rebuilt from each diff and compared setting by setting.
+func configHistory(configPath string, limit int) ([]configCommit, error) {
+	dir := filepath.Dir(configPath)
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, fmt.Errorf("config history needs git, which is not installed")
+	}
+	if exec.Command("git", "-C", dir, "rev-parse", "--git-dir").Run() != nil { // #nosec G204 - git command with safe args
+		return nil, fmt.Errorf("config history needs git: %s is not in a git repository", dir)
+	}
+
+	gitArgs := []string{"-C", dir, "log", "-p", "--no-color", "--no-ext-diff", "--unified=1000000",
+		"--format=%x1e%H%x1f%an%x1f%aI%x1f%s"}
+	if limit > 0 {
+		gitArgs = append(gitArgs, fmt.Sprintf("-n%d", limit))
+	}
+	gitArgs = append(gitArgs, "--", filepath.Base(configPath))
+	var stderr bytes.Buffer
+	gitLog := exec.Command("git", gitArgs...) // #nosec G204 - git command with safe args
+	gitLog.Stderr = &stderr
+	out, err := gitLog.Output()
+	if err != nil {
+		// A repository without commits has no history yet
+		if exec.Command("git", "-C", dir, "rev-parse", "--verify", "--quiet", "HEAD").Run() != nil { // #nosec G204 - git command with safe args
+			return []configCommit{}, nil
+		}
+		return nil, fmt.Errorf("git log failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return parseConfigHistory(string(out)), nil
+}
+
+// parseConfigHistory parses the output of configHistory's git log.
+func parseConfigHistory(out string) []configCommit {
+	history := []configCommit{}
+	for _, record := range strings.Split(out, "\x1e") {
+		header, diff, _ := strings.Cut(record, "\n")
+		fields := strings.SplitN(header, "\x1f", 4)
+		if len(fields) != 4 {
+			continue
+		}
+		commit := configCommit{Commit: fields[0], Author: fields[1], Subject: fields[3]}
+		commit.Date, _ = time.Parse(time.RFC3339, fields[2])
+
+		oldText, newText := splitDiffSides(diff)
+		oldSettings, err := flattenConfigYAML(oldText)
+		if err != nil {
+			debug.Logf("config history: skipping %s: %v", commit.Commit, err)
+			continue
+		}
+		newSettings, err := flattenConfigYAML(newText)
+		if err != nil {
+			debug.Logf("config history: skipping %s: %v", commit.Commit, err)
+			continue
+		}
+		commit.Changes = diffConfigSettings(oldSettings, newSettings)
+		if len(commit.Changes) > 0 {
+			history = append(history, commit)
+		}
+	}
+	return history
+}
+
+// splitDiffSides rebuilds the old and new file from a unified diff that has
+// the whole file as context.
+func splitDiffSides(diff string) (string, string) {
+	var oldText, newText strings.Builder
+	inHunk := false
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "@@") {
+			inHunk = true
+			continue
+		}
+		if !inHunk || line == "" {
+			continue
+		}
+		switch line[0] {
+		case ' ':
+			oldText.WriteString(line[1:] + "\n")
+			newText.WriteString(line[1:] + "\n")
+		case '-':
+			oldText.WriteString(line[1:] + "\n")
+		case '+':
+			newText.WriteString(line[1:] + "\n")
+		}
+	}
+	return oldText.String(), newText.String()
+}
+
+// flattenConfigYAML parses config.yaml text into dotted keys and their values
+// as strings; lists are comma-separated.
+func flattenConfigYAML(text string) (map[string]string, error) {
+	var root map[string]interface{}
+	if err := yaml.Unmarshal([]byte(text), &root); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	settings := make(map[string]string)
+	var walk func(prefix string, value interface{})
+	walk = func(prefix string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				walk(prefix+key+".", child)
+			}
+		case []interface{}:
+			items := make([]string, len(v))
+			for i, item := range v {
+				items[i] = fmt.Sprint(item)
+			}
+			settings[strings.TrimSuffix(prefix, ".")] = strings.Join(items, ",")
+		case nil:
+			settings[strings.TrimSuffix(prefix, ".")] = ""
+		default:
+			settings[strings.TrimSuffix(prefix, ".")] = fmt.Sprint(v)
+		}
+	}
+	walk("", root)
+	return settings, nil
+}
+
+// diffConfigSettings lists the keys that differ between two flattened
+// config.yaml versions, sorted by key. Secret values are masked.
+func diffConfigSettings(oldSettings, newSettings map[string]string) []configKeyChange {
+	var changes []configKeyChange
+	for key, newValue := range newSettings {
+		oldValue, existed := oldSettings[key]
+		switch {
+		case !existed:
+			changes = append(changes, configKeyChange{Key: key, Action: "added", New: newValue})
+		case oldValue != newValue:
+			changes = append(changes, configKeyChange{Key: key, Action: "changed", Old: oldValue, New: newValue})
+		}
+	}
+	for key, oldValue := range oldSettings {
+		if _, exists := newSettings[key]; !exists {
+			changes = append(changes, configKeyChange{Key: key, Action: "removed", Old: oldValue})
+		}
+	}
+	for i := range changes {
+		if config.IsSecretKey(changes[i].Key) {
+			if changes[i].Old != "" {
+				changes[i].Old = "***"
+			}
+			if changes[i].New != "" {
+				changes[i].New = "***"
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// filterConfigHistory keeps the changes to key (or its sub-keys), dropping
+// commits left without any.
+func filterConfigHistory(history []configCommit, key string) []configCommit {
+	filtered := []configCommit{}
+	for _, commit := range history {
+		var changes []configKeyChange
+		for _, change := range commit.Changes {
+			if change.Key == key || strings.HasPrefix(change.Key, key+".") {
+				changes = append(changes, change)
+			}
+		}
+		if len(changes) > 0 {
+			commit.Changes = changes
+			filtered = append(filtered, commit)
+		}
+	}
+	return filtered
+}
+
+func init() {
+	configHistoryCmd.Flags().IntP("limit", "n", 20, "Maximum number of commits to look at (0 for all)")
+	configCmd.AddCommand(configHistoryCmd)
+}
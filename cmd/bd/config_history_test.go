@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestConfigHistory(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	repo := t.TempDir()
+	beadsDir := filepath.Join(repo, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	commitConfig := func(author, content, message string) {
+		t.Helper()
+		if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		git("add", ".beads/config.yaml")
+		git("-c", "user.name="+author, "-c", "user.email=test@test.com", "commit", "-q", "-m", message)
+	}
+
+	// Not yet a repository
+	if _, err := configHistory(configPath, 0); err == nil || !strings.Contains(err.Error(), "not in a git repository") {
+		t.Fatalf("configHistory() outside git error = %v, want not-a-repository", err)
+	}
+
+	git("init", "-q")
+	if history, err := configHistory(configPath, 0); err != nil || len(history) != 0 {
+		t.Fatalf("configHistory() with no commits = %v, %v; want none", history, err)
+	}
+
+	commitConfig("Alice", "# Beads config\nissue-prefix: api\nactor: bot\n", "Add beads config")
+	commitConfig("Bob", "# Beads config\nissue-prefix: web\nsync:\n  branch: beads-sync\n", "Switch prefix")
+	commitConfig("Carol", "# Beads config (shared)\nissue-prefix: web\nsync:\n  branch: beads-sync\n", "Reword comment")
+
+	history, err := configHistory(configPath, 0)
+	if err != nil {
+		t.Fatalf("configHistory() error = %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("configHistory() = %d commits, want 2 (the comment-only commit is skipped): %+v", len(history), history)
+	}
+
+	bob, alice := history[0], history[1]
+	if bob.Author != "Bob" || bob.Subject != "Switch prefix" || bob.Date.IsZero() {
+		t.Errorf("newest commit = %+v, want Bob's", bob)
+	}
+	wantBob := []configKeyChange{
+		{Key: "actor", Action: "removed", Old: "bot"},
+		{Key: "issue-prefix", Action: "changed", Old: "api", New: "web"},
+		{Key: "sync.branch", Action: "added", New: "beads-sync"},
+	}
+	if !reflect.DeepEqual(bob.Changes, wantBob) {
+		t.Errorf("Bob's changes = %+v, want %+v", bob.Changes, wantBob)
+	}
+	wantAlice := []configKeyChange{
+		{Key: "actor", Action: "added", New: "bot"},
+		{Key: "issue-prefix", Action: "added", New: "api"},
+	}
+	if alice.Author != "Alice" || !reflect.DeepEqual(alice.Changes, wantAlice) {
+		t.Errorf("Alice's commit = %+v, want changes %+v", alice, wantAlice)
+	}
+
+	prefixOnly := filterConfigHistory(history, "issue-prefix")
+	if len(prefixOnly) != 2 || len(prefixOnly[0].Changes) != 1 || prefixOnly[0].Changes[0].New != "web" {
+		t.Errorf("filterConfigHistory(issue-prefix) = %+v", prefixOnly)
+	}
+	if syncOnly := filterConfigHistory(history, "sync"); len(syncOnly) != 1 || syncOnly[0].Author != "Bob" {
+		t.Errorf("filterConfigHistory(sync) = %+v, want Bob's commit", syncOnly)
+	}
+
+	limited, err := configHistory(configPath, 1)
+	if err != nil || len(limited) != 0 {
+		t.Errorf("configHistory(limit 1) = %+v, %v; want only the comment-only commit, skipped", limited, err)
+	}
+}
+
+func TestDiffConfigSettingsMasksSecrets(t *testing.T) {
+	changes := diffConfigSettings(map[string]string{"github-token": "old"}, map[string]string{"github-token": "new"})
+	if len(changes) != 1 || changes[0].Old != "***" || changes[0].New != "***" {
+		t.Errorf("diffConfigSettings() = %+v, want masked values", changes)
+	}
+}
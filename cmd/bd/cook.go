@@ -90,7 +90,7 @@ func runCook(cmd *cobra.Command, args []string) {
 	resolved, err := parser.Resolve(f)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error resolving formula: %v\n", err)
-		os.Exit(1)
+		os.Exit(exitCodeFor(err))
 	}
 
 	// Apply prefix to proto ID if specified (bd-47qx)
@@ -32,6 +32,9 @@ var createCmd = &cobra.Command{
 			if len(args) > 0 {
 				FatalError("cannot specify both title and --file flag")
 			}
+			if edit, _ := cmd.Flags().GetBool("edit"); edit {
+				FatalError("cannot use --edit with --file")
+			}
 			createIssuesFromMarkdown(cmd, file)
 			return
 		}
@@ -67,6 +70,24 @@ var createCmd = &cobra.Command{
 		// Get field values
 		description, _ := getDescriptionFlag(cmd)
 
+		// Prefill the body from .beads/templates/<name>.md
+		if templateName := issueTemplateName(cmd); templateName != "" {
+			rendered, err := applyIssueTemplate(cmd, templateName, title, description)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			description = rendered
+		}
+
+		// Let the user write the body, starting from the template or --description
+		if edit, _ := cmd.Flags().GetBool("edit"); edit {
+			edited, err := editIssueBody(description)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			description = edited
+		}
+
 		// Check if description is required by config
 		if description == "" && !strings.Contains(strings.ToLower(title), "test") {
 			if config.GetBool("create.require-description") {
@@ -84,8 +105,7 @@ var createCmd = &cobra.Command{
 		acceptance, _ := cmd.Flags().GetString("acceptance")
 
 		// Parse priority (supports both "1" and "P1" formats)
-		priorityStr, _ := cmd.Flags().GetString("priority")
-		priority, err := validation.ValidatePriority(priorityStr)
+		priority, err := newIssuePriority(cmd)
 		if err != nil {
 			FatalError("%v", err)
 		}
@@ -165,7 +185,7 @@ var createCmd = &cobra.Command{
 				FatalError("failed to check parent issue: %v", err)
 			}
 			if parentIssue == nil {
-				FatalError("parent issue %s not found", parentID)
+				FatalErrorCode(ExitNotFound, "parent issue %s not found", parentID)
 			}
 			childID, err := store.GetNextChildID(ctx, parentID)
 			if err != nil {
@@ -191,7 +211,7 @@ var createCmd = &cobra.Command{
 				// For now, skip validation in daemon mode (needs RPC enhancement)
 			} else {
 				// Direct mode - check config
-				dbPrefix, _ = store.GetConfig(ctx, "issue_prefix")
+				dbPrefix, _ = issuePrefix(ctx, store)
 			}
 
 			if err := validation.ValidatePrefix(requestedPrefix, dbPrefix, forceCreate); err != nil {
@@ -261,7 +281,6 @@ var createCmd = &cobra.Command{
 			Description:        description,
 			Design:             design,
 			AcceptanceCriteria: acceptance,
-			Status:             types.StatusOpen,
 			Priority:           priority,
 			IssueType:          types.IssueType(issueType),
 			Assignee:           assignee,
@@ -432,7 +451,7 @@ func init() {
 	createCmd.Flags().StringP("file", "f", "", "Create multiple issues from markdown file")
 	createCmd.Flags().String("title", "", "Issue title (alternative to positional argument)")
 	createCmd.Flags().Bool("silent", false, "Output only the issue ID (for scripting)")
-	registerPriorityFlag(createCmd, "2")
+	registerPriorityFlag(createCmd, "2") // default-priority config overrides the flag default
 	createCmd.Flags().StringP("type", "t", "task", "Issue type (bug|feature|task|epic|chore|merge-request|molecule|gate)")
 	registerCommonIssueFlags(createCmd)
 	createCmd.Flags().StringSliceP("labels", "l", []string{}, "Labels (comma-separated)")
@@ -447,6 +466,9 @@ func init() {
 	createCmd.Flags().String("repo", "", "Target repository for issue (overrides auto-routing)")
 	createCmd.Flags().IntP("estimate", "e", 0, "Time estimate in minutes (e.g., 60 for 1 hour)")
 	createCmd.Flags().Bool("wisp", false, "Create as wisp (ephemeral, not exported to JSONL)")
+	createCmd.Flags().String("template", "", "Prefill the description from .beads/templates/<name>.md (default: default-template config)")
+	createCmd.Flags().StringArray("var", []string{}, "Template placeholder value (key=value, repeatable)")
+	createCmd.Flags().Bool("edit", false, "Write the description in $EDITOR (or the editor config key)")
 	// Note: --json flag is defined as a persistent flag in main.go, not here
 	rootCmd.AddCommand(createCmd)
 }
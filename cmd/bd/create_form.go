@@ -106,7 +106,6 @@ func CreateIssueFromFormValues(ctx context.Context, s storage.Storage, fv *creat
 		Description:        fv.Description,
 		Design:             fv.Design,
 		AcceptanceCriteria: fv.AcceptanceCriteria,
-		Status:             types.StatusOpen,
 		Priority:           fv.Priority,
 		IssueType:          types.IssueType(fv.IssueType),
 		Assignee:           fv.Assignee,
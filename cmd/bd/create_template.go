@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// issueTemplateDir is the directory under .beads holding issue body templates.
+const issueTemplateDir = "templates"
+
+// issuePlaceholderPattern matches {name} placeholders in issue templates
+var issuePlaceholderPattern = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_-]*)\}`)
+
+// issueTemplateName returns the template to use for bd create: --template if
+// given (an explicit empty value disables templates), otherwise the
+// default-template config key.
+func issueTemplateName(cmd *cobra.Command) string {
+	if cmd.Flags().Changed("template") {
+		name, _ := cmd.Flags().GetString("template")
+		return name
+	}
+	return config.GetString("default-template")
+}
+
+// loadIssueTemplate reads .beads/templates/<name>.md.
+func loadIssueTemplate(beadsDir, name string) (string, error) {
+	name = strings.TrimSuffix(name, ".md")
+	if name == "" || strings.HasPrefix(name, ".") || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("invalid template name %q", name)
+	}
+	if beadsDir == "" {
+		return "", fmt.Errorf("template %q not found: no .beads directory", name)
+	}
+	dir := filepath.Join(beadsDir, issueTemplateDir)
+	// #nosec G304 - name is a plain file name inside .beads/templates
+	data, err := os.ReadFile(filepath.Join(dir, name+".md"))
+	if os.IsNotExist(err) {
+		available := listIssueTemplates(beadsDir)
+		if len(available) == 0 {
+			return "", fmt.Errorf("template %q not found: no templates in %s", name, dir)
+		}
+		return "", fmt.Errorf("template %q not found in %s (available: %s)", name, dir, strings.Join(available, ", "))
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read template %q: %w", name, err)
+	}
+	return string(data), nil
+}
+
+// listIssueTemplates returns the sorted names of the templates in .beads/templates.
+func listIssueTemplates(beadsDir string) []string {
+	entries, err := os.ReadDir(filepath.Join(beadsDir, issueTemplateDir))
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".md") {
+			names = append(names, strings.TrimSuffix(entry.Name(), ".md"))
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// renderIssueTemplate replaces each {name} in tmpl with vars[name]. Unknown
+// placeholders are left as they are, so literal braces in a template survive.
+func renderIssueTemplate(tmpl string, vars map[string]string) string {
+	return issuePlaceholderPattern.ReplaceAllStringFunc(tmpl, func(match string) string {
+		if value, ok := vars[match[1:len(match)-1]]; ok {
+			return value
+		}
+		return match
+	})
+}
+
+// parseTemplateVars parses --var key=value pairs.
+func parseTemplateVars(pairs []string) (map[string]string, error) {
+	vars := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --var %q (expected key=value)", pair)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// applyIssueTemplate renders the named template into an issue body. Fields
+// given on the command line fill the matching placeholders ({title},
+// {description}, {type}, {priority}, {assignee}, {design}, {acceptance}),
+// and --var key=value fills any other. A description with no {description}
+// placeholder to go into is appended after the template.
+func applyIssueTemplate(cmd *cobra.Command, name, title, description string) (string, error) {
+	tmpl, err := loadIssueTemplate(beads.FindBeadsDir(), name)
+	if err != nil {
+		return "", err
+	}
+
+	pairs, _ := cmd.Flags().GetStringArray("var")
+	vars, err := parseTemplateVars(pairs)
+	if err != nil {
+		return "", err
+	}
+	vars["title"] = title
+	vars["description"] = description
+	for _, flag := range []string{"type", "priority", "assignee", "design", "acceptance"} {
+		vars[flag], _ = cmd.Flags().GetString(flag)
+	}
+
+	body := renderIssueTemplate(tmpl, vars)
+	if description != "" && !strings.Contains(tmpl, "{description}") {
+		body = strings.TrimRight(body, "\n") + "\n\n" + description
+	}
+	return strings.TrimRight(body, "\n"), nil
+}
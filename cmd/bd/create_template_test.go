@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestRenderIssueTemplate(t *testing.T) {
+	tmpl := "## {title}\n\nSeverity: {severity}\nReported by {reporter}\n\n{description}\n\n```go\nmap[string]int{}\n```\n"
+	got := renderIssueTemplate(tmpl, map[string]string{
+		"title":       "Login fails",
+		"severity":    "high",
+		"description": "Steps to reproduce",
+	})
+	want := "## Login fails\n\nSeverity: high\nReported by {reporter}\n\nSteps to reproduce\n\n```go\nmap[string]int{}\n```\n"
+	if got != want {
+		t.Errorf("renderIssueTemplate =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestApplyIssueTemplate(t *testing.T) {
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	templateDir := filepath.Join(beadsDir, issueTemplateDir)
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	bug := "## Bug: {title}\n\nPriority: P{priority}\nComponent: {component}\n\n### Details\n{description}\n"
+	if err := os.WriteFile(filepath.Join(templateDir, "bug.md"), []byte(bug), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(templateDir, "plain.md"), []byte("## Context\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, "issues.jsonl"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("BEADS_DIR", beadsDir)
+
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("type", "task", "")
+		cmd.Flags().String("priority", "2", "")
+		cmd.Flags().String("template", "", "")
+		cmd.Flags().StringArray("var", []string{}, "")
+		registerCommonIssueFlags(cmd)
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatal(err)
+		}
+		return cmd
+	}
+
+	t.Run("placeholders from flags and vars", func(t *testing.T) {
+		cmd := newCmd("--priority", "1", "--var", "component=auth")
+		got, err := applyIssueTemplate(cmd, "bug", "Login fails", "Crashes on submit")
+		if err != nil {
+			t.Fatalf("applyIssueTemplate failed: %v", err)
+		}
+		want := "## Bug: Login fails\n\nPriority: P1\nComponent: auth\n\n### Details\nCrashes on submit"
+		if got != want {
+			t.Errorf("body =\n%q\nwant\n%q", got, want)
+		}
+	})
+
+	t.Run("description appended without placeholder", func(t *testing.T) {
+		got, err := applyIssueTemplate(newCmd(), "plain.md", "Title", "Some context")
+		if err != nil {
+			t.Fatalf("applyIssueTemplate failed: %v", err)
+		}
+		if want := "## Context\n\nSome context"; got != want {
+			t.Errorf("body = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := applyIssueTemplate(newCmd(), "missing", "Title", ""); err == nil || !strings.Contains(err.Error(), "available: bug, plain") {
+			t.Errorf("missing template error = %v, want list of available templates", err)
+		}
+		if _, err := applyIssueTemplate(newCmd(), "../bug", "Title", ""); err == nil {
+			t.Error("expected error for template name outside .beads/templates")
+		}
+		if _, err := applyIssueTemplate(newCmd("--var", "novalue"), "bug", "Title", ""); err == nil {
+			t.Error("expected error for --var without '='")
+		}
+	})
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -552,3 +554,48 @@ func TestCreateSuite(t *testing.T) {
 		}
 	})
 }
+
+func TestNewIssuePriority(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(func() { config.Set("default-priority", "2") })
+
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "create"}
+		registerPriorityFlag(cmd, "2")
+		if err := cmd.Flags().Parse(args); err != nil {
+			t.Fatalf("Parse(%v) failed: %v", args, err)
+		}
+		return cmd
+	}
+
+	tests := []struct {
+		name    string
+		config  string
+		args    []string
+		want    int
+		wantErr bool
+	}{
+		{"built-in default", "2", nil, 2, false},
+		{"config default", "1", nil, 1, false},
+		{"config P-format", "P4", nil, 4, false},
+		{"flag overrides config", "1", []string{"--priority", "3"}, 3, false},
+		{"flag P0 overrides config", "4", []string{"-p", "P0"}, 0, false},
+		{"invalid config", "urgent", nil, -1, true},
+		{"invalid flag", "2", []string{"--priority", "9"}, -1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Set("default-priority", tt.config)
+			got, err := newIssuePriority(newCmd(tt.args...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newIssuePriority() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("newIssuePriority() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -14,7 +15,9 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/cmd/bd/doctor"
 	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/daemon"
+	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/rpc"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 )
@@ -37,6 +40,7 @@ Common operations:
   bd daemon --start              Start the daemon (background)
   bd daemon --start --foreground Start in foreground (for systemd/supervisord)
   bd daemon --stop               Stop a running daemon
+  bd daemon stop                 Stop it gracefully, flushing pending exports
   bd daemon --stop-all           Stop ALL running bd daemons
   bd daemon --status             Check if daemon is running
   bd daemon --health             Check daemon health and metrics
@@ -251,6 +255,51 @@ Run 'bd daemon' with no flags to see available options.`,
 	},
 }
 
+var daemonStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the daemon for this workspace",
+	Long: `Stop the daemon serving this workspace.
+
+The daemon is asked over its socket to flush pending exports and exit. If it
+hasn't exited within --timeout it is sent SIGTERM, and if that times out too it
+is killed. Stopping when no daemon is running is not an error.
+
+  bd daemon stop
+  bd daemon stop --timeout 30s --json   # {"running": true, "pid": 4242, "method": "rpc"}`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		if timeout <= 0 {
+			FatalErrorCode(ExitUsage, "--timeout must be positive (got %v)", timeout)
+		}
+
+		pidFile, err := getPIDFilePath()
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		result, err := shutdownDaemon(pidFile, timeout)
+		if err != nil {
+			FatalErrorRespectJSON("failed to stop daemon: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(result)
+			return
+		}
+		switch result.Method {
+		case "":
+			fmt.Println("No daemon running")
+		case stopMethodRPC:
+			fmt.Printf("Daemon stopped (PID %d)\n", result.PID)
+		case stopMethodSignal:
+			fmt.Printf("Daemon stopped by SIGTERM (PID %d)\n", result.PID)
+		case stopMethodKill:
+			fmt.Printf("Daemon killed (PID %d): it did not exit within %v\n", result.PID, timeout)
+		}
+	},
+}
+
 func init() {
 	daemonCmd.Flags().Bool("start", false, "Start the daemon")
 	daemonCmd.Flags().Duration("interval", 5*time.Second, "Sync check interval")
@@ -268,6 +317,8 @@ func init() {
 	daemonCmd.Flags().String("log-level", "info", "Log level (debug, info, warn, error)")
 	daemonCmd.Flags().Bool("log-json", false, "Output logs in JSON format (structured logging)")
 	daemonCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output JSON format")
+	daemonStopCmd.Flags().Duration("timeout", 5*time.Second, "How long to wait for each graceful shutdown attempt")
+	daemonCmd.AddCommand(daemonStopCmd)
 	rootCmd.AddCommand(daemonCmd)
 }
 
@@ -285,6 +336,9 @@ func computeDaemonParentPID() int {
 }
 func runDaemonLoop(interval time.Duration, autoCommit, autoPush, autoPull, localMode bool, logPath, pidFile, logLevel string, logJSON bool) {
 	level := parseLogLevel(logLevel)
+	if debug.IsQuiet() && level < slog.LevelError {
+		level = slog.LevelError
+	}
 	logF, log := setupDaemonLogger(logPath, logJSON, level)
 	defer func() { _ = logF.Close() }()
 
@@ -410,6 +464,7 @@ func runDaemonLoop(interval time.Duration, autoCommit, autoPush, autoPull, local
 		return // Use return instead of os.Exit to allow defers to run
 	}
 	defer func() { _ = store.Close() }()
+	store.SetWriteMaxRetries(config.GetInt("write-max-retries"))
 
 	// Enable freshness checking to detect external database file modifications
 	// (e.g., when git merge replaces the database file)
@@ -449,6 +504,15 @@ func runDaemonLoop(interval time.Duration, autoCommit, autoPush, autoPull, local
 		log.Warn("repository mismatch ignored (BEADS_IGNORE_REPO_MISMATCH=1)")
 	}
 
+	// With strict-prefix, refuse to serve issues under the wrong prefix;
+	// clients fall back to direct mode and report the mismatch
+	if config.GetBool("strict-prefix") {
+		if err := store.CheckIssuePrefix(ctx); err != nil {
+			log.Error("issue prefix check failed (strict-prefix)", "error", err)
+			return // Use return instead of os.Exit to allow defers to run
+		}
+	}
+
 	// Validate schema version matches daemon version
 	versionCtx := context.Background()
 	dbVersion, err := store.GetMetadata(versionCtx, "bd_version")
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/rpc"
@@ -53,6 +54,37 @@ func shouldAutoStartDaemon() bool {
 	return config.GetBool("auto-start-daemon") // Defaults to true
 }
 
+// skipAutoStartForCommand reports whether cmd is listed in the
+// no-daemon-commands config key. Listed commands still use a daemon that is
+// already running but never start one. Entries are command paths without the
+// leading "bd" ("list", "dep tree"), and a parent command covers its
+// subcommands. Comma-separated values (from BD_NO_DAEMON_COMMANDS) work too.
+func skipAutoStartForCommand(cmd *cobra.Command) bool {
+	var names []string
+	for _, entry := range config.GetStringSlice("no-daemon-commands") {
+		names = append(names, strings.Split(entry, ",")...)
+	}
+	return commandMatches(cmd, names)
+}
+
+// commandMatches reports whether cmd is one of names, or a subcommand of one.
+// Names are command paths without the leading "bd", such as "dep tree".
+func commandMatches(cmd *cobra.Command, names []string) bool {
+	fields := strings.Fields(cmd.CommandPath())
+	if len(fields) < 2 {
+		return false
+	}
+	path := strings.Join(fields[1:], " ")
+
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		if name != "" && (path == name || strings.HasPrefix(path, name+" ")) {
+			return true
+		}
+	}
+	return false
+}
+
 
 // restartDaemonForVersionMismatch stops the old daemon and starts a new one
 // Returns true if restart was successful
@@ -250,7 +282,9 @@ func handleExistingSocket(socketPath string) bool {
 
 	pidFile := getPIDFileForSocket(socketPath)
 	if pidFile != "" {
-		if pid, err := readPIDFromFile(pidFile); err == nil && isPIDAlive(pid) {
+		// Also drops a PID file left by a crashed daemon, even if its PID
+		// has since been reused
+		if running, pid := checkPIDFile(filepath.Dir(pidFile)); running {
 			debugLog("daemon PID %d alive, waiting for socket", pid)
 			return waitForSocketReadiness(socketPath, 5*time.Second)
 		}
@@ -64,3 +64,21 @@ func (d *Debouncer) Cancel() {
 		d.timer = nil
 	}
 }
+
+// Flush runs a pending action immediately instead of waiting for the quiet
+// period, and reports whether there was one. Used before shutting down so a
+// debounced export isn't lost.
+func (d *Debouncer) Flush() bool {
+	d.mu.Lock()
+	if d.timer == nil {
+		d.mu.Unlock()
+		return false
+	}
+	d.timer.Stop()
+	d.timer = nil
+	d.seq++ // Invalidate the timer in case it already fired and is waiting on mu
+	d.mu.Unlock()
+
+	d.action()
+	return true
+}
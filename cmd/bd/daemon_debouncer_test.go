@@ -191,3 +191,31 @@ func TestDebouncer_CancelImmediatelyAfterTrigger(t *testing.T) {
 		t.Errorf("action should not fire after immediate cancel: got %d, want 0", got)
 	}
 }
+
+func TestDebouncer_Flush(t *testing.T) {
+	var count int32
+	debouncer := NewDebouncer(time.Hour, func() {
+		atomic.AddInt32(&count, 1)
+	})
+	t.Cleanup(debouncer.Cancel)
+
+	if debouncer.Flush() {
+		t.Error("Flush with nothing pending should report false")
+	}
+
+	debouncer.Trigger()
+	if !debouncer.Flush() {
+		t.Error("Flush with a pending action should report true")
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Errorf("action count after Flush = %d, want 1", got)
+	}
+
+	// The flushed action doesn't run again
+	if debouncer.Flush() {
+		t.Error("second Flush should report false")
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Errorf("action count after second Flush = %d, want 1", got)
+	}
+}
@@ -87,6 +87,11 @@ func runEventDrivenLoop(
 				log.log("Mutation detected: %s %s", event.Type, event.IssueID)
 				exportDebouncer.Trigger()
 
+			case <-server.FlushedChan():
+				// bd flush already wrote the JSONL; don't export it again
+				log.log("JSONL flushed by client; resetting export debounce")
+				exportDebouncer.Cancel()
+
 			case <-ctx.Done():
 				return
 			}
@@ -124,6 +129,16 @@ func runEventDrivenLoop(
 	droppedEventsTicker := time.NewTicker(1 * time.Second)
 	defer droppedEventsTicker.Stop()
 
+	// Idle shutdown: exit once no client has talked to the daemon for
+	// daemon-idle-timeout; the next command auto-starts it again
+	idleTimeout := getDaemonIdleTimeout(log)
+	var idleTicker *time.Ticker
+	if idleTimeout > 0 {
+		idleTicker = time.NewTicker(idleCheckInterval(idleTimeout))
+		defer idleTicker.Stop()
+		log.log("Idle shutdown enabled: exiting after %v without requests", idleTimeout)
+	}
+
 	for {
 		select {
 		case <-droppedEventsTicker.C:
@@ -162,6 +177,27 @@ func runEventDrivenLoop(
 				return
 			}
 
+		case <-func() <-chan time.Time {
+			if idleTicker != nil {
+				return idleTicker.C
+			}
+			// Never fire if idle shutdown is disabled
+			return make(chan time.Time)
+		}():
+			idle := time.Since(server.LastActivity())
+			if idle < idleTimeout {
+				continue
+			}
+			log.log("Idle for %v, shutting down", idle.Round(time.Millisecond))
+			if exportDebouncer.Flush() {
+				log.log("Flushed pending export before idle shutdown")
+			}
+			cancel()
+			if err := server.Stop(); err != nil {
+				log.log("Error stopping server: %v", err)
+			}
+			return
+
 		case <-func() <-chan time.Time {
 			if fallbackTicker != nil {
 				return fallbackTicker.C
@@ -172,6 +208,17 @@ func runEventDrivenLoop(
 			log.log("Fallback ticker: checking for remote changes")
 			importDebouncer.Trigger()
 
+		case <-server.StopRequested():
+			log.log("Stop requested by client, shutting down...")
+			if exportDebouncer.Flush() {
+				log.log("Flushed pending export before shutdown")
+			}
+			cancel()
+			if err := server.Stop(); err != nil {
+				log.log("Error stopping server: %v", err)
+			}
+			return
+
 		case sig := <-sigChan:
 			if isReloadSignal(sig) {
 				log.log("Received reload signal, ignoring")
@@ -305,3 +352,28 @@ func getRemoteSyncInterval(log daemonLogger) time.Duration {
 	}
 	return duration
 }
+
+// getDaemonIdleTimeout returns the daemon-idle-timeout config value: how long
+// the daemon may go without client requests before exiting. 0 (the default)
+// keeps it running until stopped.
+func getDaemonIdleTimeout(log daemonLogger) time.Duration {
+	timeout := config.GetDuration("daemon-idle-timeout")
+	if timeout < 0 {
+		log.log("Warning: daemon-idle-timeout is negative (%v), idle shutdown disabled", timeout)
+		return 0
+	}
+	return timeout
+}
+
+// idleCheckInterval is how often to check for idleness: often enough to exit
+// reasonably close to the timeout without waking up needlessly.
+func idleCheckInterval(timeout time.Duration) time.Duration {
+	interval := timeout / 4
+	if interval > 30*time.Second {
+		interval = 30 * time.Second
+	}
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+	return interval
+}
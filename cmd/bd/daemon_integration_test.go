@@ -9,8 +9,12 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/rpc"
 )
 
 // TestStartRPCServer verifies RPC server initialization and startup
@@ -594,3 +598,76 @@ func TestEventDrivenLoop_PeriodicRemoteSync(t *testing.T) {
 	// the code structure is correct and doAutoImport is wired up
 	t.Log("Event-driven loop with periodic remote sync started successfully")
 }
+
+// TestEventDrivenLoop_IdleShutdown verifies that with daemon-idle-timeout set
+// the loop exits on its own after a quiet period, flushing a pending export
+// first, and that a new daemon can then start on the same socket.
+func TestEventDrivenLoop_IdleShutdown(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	tmpDir := makeSocketTempDir(t)
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create beads dir: %v", err)
+	}
+	jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+	if err := os.WriteFile(jsonlPath, []byte{}, 0644); err != nil {
+		t.Fatalf("Failed to create JSONL file: %v", err)
+	}
+
+	testDBPath := filepath.Join(beadsDir, "test.db")
+	testStore := newTestStore(t, testDBPath)
+	defer testStore.Close()
+
+	t.Chdir(tmpDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	config.Set("daemon-idle-timeout", 300*time.Millisecond)
+	defer config.Set("daemon-idle-timeout", 0)
+
+	log := createTestLogger(t)
+	socketPath := filepath.Join(tmpDir, "bd.sock")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	server, serverErrChan, err := startRPCServer(ctx, socketPath, testStore, tmpDir, testDBPath, log)
+	if err != nil {
+		t.Fatalf("Failed to start RPC server: %v", err)
+	}
+	<-server.WaitReady()
+
+	var exports int32
+	doExport := func() { atomic.AddInt32(&exports, 1) }
+	done := make(chan struct{})
+	go func() {
+		runEventDrivenLoop(ctx, cancel, server, serverErrChan, testStore, jsonlPath, doExport, func() {}, false, 0, log)
+		close(done)
+	}()
+
+	// A mutation leaves an export pending in the debouncer
+	client, err := rpc.TryConnect(socketPath)
+	if err != nil || client == nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	if _, err := client.Create(&rpc.CreateArgs{Title: "Idle test", IssueType: "task", Priority: 2}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	_ = client.Close()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("daemon did not exit after idle timeout")
+	}
+	if ctx.Err() != context.Canceled {
+		t.Errorf("loop should cancel the daemon context, got %v", ctx.Err())
+	}
+	if got := atomic.LoadInt32(&exports); got != 1 {
+		t.Errorf("pending export flushed %d times, want 1", got)
+	}
+	if _, err := os.Stat(socketPath); !os.IsNotExist(err) {
+		t.Errorf("socket should be removed on idle shutdown, stat err = %v", err)
+	}
+}
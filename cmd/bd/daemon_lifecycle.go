@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/beads/internal/daemon"
+	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/rpc"
 )
 
@@ -228,6 +229,10 @@ func showDaemonMetrics() {
 	fmt.Printf("  Active: %d\n", metrics.ActiveConns)
 	fmt.Printf("  Rejected: %d\n\n", metrics.RejectedConns)
 
+	// Storage metrics
+	fmt.Printf("Storage Metrics:\n")
+	fmt.Printf("  Write Retries: %d\n\n", metrics.WriteRetries)
+
 	// System metrics
 	fmt.Printf("System Metrics:\n")
 	fmt.Printf("  Memory Alloc: %d MB\n", metrics.MemoryAllocMB)
@@ -266,53 +271,94 @@ func stopDaemon(pidFile string) {
 
 	fmt.Printf("Stopping daemon (PID %d)...\n", pid)
 
-	process, err := os.FindProcess(pid)
+	result, err := shutdownDaemon(pidFile, daemonShutdownTimeout)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error finding process: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error stopping daemon: %v\n", err)
 		os.Exit(1)
 	}
+	if result.Method == stopMethodKill {
+		fmt.Fprintf(os.Stderr, "Warning: daemon did not stop after %v, forced termination\n", daemonShutdownTimeout)
+		fmt.Println("Daemon killed")
+		return
+	}
+	fmt.Println("Daemon stopped")
+}
 
-	if err := sendStopSignal(process); err != nil {
-		fmt.Fprintf(os.Stderr, "Error signaling daemon: %v\n", err)
-		os.Exit(1)
+// How shutdownDaemon got the daemon to exit
+const (
+	stopMethodRPC    = "rpc"    // Asked over the socket; pending exports flushed
+	stopMethodSignal = "signal" // SIGTERM
+	stopMethodKill   = "kill"   // SIGKILL after both timed out
+)
+
+// daemonStopResult reports what stopping the workspace daemon did
+type daemonStopResult struct {
+	Running bool   `json:"running"`
+	PID     int    `json:"pid,omitempty"`
+	Method  string `json:"method,omitempty"`
+}
+
+// shutdownDaemon stops the daemon for pidFile's workspace, if one is running.
+// It asks the daemon over its socket first, so pending exports get flushed,
+// then falls back to SIGTERM and finally kills it, waiting up to timeout for
+// each of the graceful attempts.
+func shutdownDaemon(pidFile string, timeout time.Duration) (daemonStopResult, error) {
+	isRunning, pid := isDaemonRunning(pidFile)
+	if !isRunning {
+		return daemonStopResult{}, nil
 	}
+	result := daemonStopResult{Running: true, PID: pid}
+	socketPath := getSocketPathForPID(pidFile)
 
-	for i := 0; i < daemonShutdownAttempts; i++ {
-		time.Sleep(daemonShutdownPollInterval)
-		if isRunning, _ := isDaemonRunning(pidFile); !isRunning {
-			fmt.Println("Daemon stopped")
-			return
+	if client, _ := rpc.TryConnectWithTimeout(socketPath, 500*time.Millisecond); client != nil {
+		err := client.Shutdown()
+		_ = client.Close()
+		if err == nil && waitForDaemonExit(pidFile, timeout) {
+			result.Method = stopMethodRPC
+			return result, nil
+		}
+		if err != nil {
+			debug.Logf("daemon shutdown request failed: %v", err)
 		}
 	}
 
-	fmt.Fprintf(os.Stderr, "Warning: daemon did not stop after %v, forcing termination\n", daemonShutdownTimeout)
+	if pid <= 0 {
+		return result, fmt.Errorf("daemon did not stop and its PID is unknown")
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return result, fmt.Errorf("finding process %d: %w", pid, err)
+	}
+	if err := sendStopSignal(process); err == nil && waitForDaemonExit(pidFile, timeout) {
+		result.Method = stopMethodSignal
+		return result, nil
+	}
 
-	// Check one more time before killing the process to avoid a race.
-	if isRunning, _ := isDaemonRunning(pidFile); !isRunning {
-		fmt.Println("Daemon stopped")
-		return
+	if err := process.Kill(); err != nil && !strings.Contains(err.Error(), "process already finished") {
+		return result, fmt.Errorf("killing process %d: %w", pid, err)
 	}
-	
-	socketPath := getSocketPathForPID(pidFile)
-	
-	if err := process.Kill(); err != nil {
-		// Ignore "process already finished" errors
-		if !strings.Contains(err.Error(), "process already finished") {
-			fmt.Fprintf(os.Stderr, "Error killing process: %v\n", err)
-		}
+	// A killed daemon can't clean up after itself
+	_ = os.Remove(pidFile)
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Warning: failed to remove stale socket: %v\n", err)
 	}
-	
-	// Clean up stale artifacts after forced kill
-	_ = os.Remove(pidFile) // Best-effort cleanup, file may not exist
-	
-	// Also remove socket file if it exists
-	if _, err := os.Stat(socketPath); err == nil {
-		if err := os.Remove(socketPath); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to remove stale socket: %v\n", err)
+	result.Method = stopMethodKill
+	return result, nil
+}
+
+// waitForDaemonExit polls until the daemon lock is released, giving up after
+// timeout.
+func waitForDaemonExit(pidFile string, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if isRunning, _ := isDaemonRunning(pidFile); !isRunning {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
 		}
+		time.Sleep(daemonShutdownPollInterval)
 	}
-	
-	fmt.Println("Daemon killed")
 }
 
 // stopAllDaemons stops all running bd daemons (bd-47tn)
@@ -6,9 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strconv"
-	"strings"
 	"time"
+
+	"github.com/steveyegge/beads/internal/lockfile"
 )
 
 var ErrDaemonLocked = errors.New("daemon lock already held by another process")
@@ -184,23 +184,8 @@ func validateDaemonLock(beadsDir string, expectedDB string) error {
 }
 
 // checkPIDFile checks if a daemon is running by reading the PID file.
-// This is used for backward compatibility with pre-lock daemons.
+// This is used for backward compatibility with pre-lock daemons. Stale PID
+// files (dead or reused PID) are removed.
 func checkPIDFile(beadsDir string) (running bool, pid int) {
-	pidFile := filepath.Join(beadsDir, "daemon.pid")
-	// #nosec G304 - controlled path from config
-	data, err := os.ReadFile(pidFile)
-	if err != nil {
-		return false, 0
-	}
-
-	pidVal, err := strconv.Atoi(strings.TrimSpace(string(data)))
-	if err != nil {
-		return false, 0
-	}
-
-	if !isProcessRunning(pidVal) {
-		return false, 0
-	}
-
-	return true, pidVal
+	return lockfile.CheckPIDFile(beadsDir)
 }
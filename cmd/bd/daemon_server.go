@@ -68,6 +68,15 @@ func runEventLoop(ctx context.Context, cancel context.CancelFunc, ticker *time.T
 	parentCheckTicker := time.NewTicker(10 * time.Second)
 	defer parentCheckTicker.Stop()
 
+	// Idle shutdown (daemon-idle-timeout); never fires when disabled
+	idleTimeout := getDaemonIdleTimeout(log)
+	idleChan := make(<-chan time.Time)
+	if idleTimeout > 0 {
+		idleTicker := time.NewTicker(idleCheckInterval(idleTimeout))
+		defer idleTicker.Stop()
+		idleChan = idleTicker.C
+	}
+
 	for {
 		select {
 		case <-ticker.C:
@@ -75,6 +84,17 @@ func runEventLoop(ctx context.Context, cancel context.CancelFunc, ticker *time.T
 				return
 			}
 			doSync()
+		case <-idleChan:
+			if time.Since(server.LastActivity()) < idleTimeout {
+				continue
+			}
+			log.Info("idle timeout reached, shutting down", "timeout", idleTimeout)
+			doSync() // Final flush
+			cancel()
+			if err := server.Stop(); err != nil {
+				log.Error("stopping RPC server", "error", err)
+			}
+			return
 		case <-parentCheckTicker.C:
 			// Check if parent process is still alive
 			if !checkParentProcessAlive(parentPID) {
@@ -85,6 +105,14 @@ func runEventLoop(ctx context.Context, cancel context.CancelFunc, ticker *time.T
 				}
 				return
 			}
+		case <-server.StopRequested():
+			log.Info("stop requested by client, shutting down")
+			doSync() // Final flush
+			cancel()
+			if err := server.Stop(); err != nil {
+				log.Error("stopping RPC server", "error", err)
+			}
+			return
 		case sig := <-sigChan:
 			if isReloadSignal(sig) {
 				log.Info("received reload signal, ignoring (daemon continues running)")
@@ -0,0 +1,100 @@
+//go:build unix
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/rpc"
+)
+
+// TestShutdownDaemon starts a daemon in-process and stops it the way
+// 'bd daemon stop' does, checking the pending export is flushed first.
+func TestShutdownDaemon(t *testing.T) {
+	// Keep the socket path short (macOS limits it to 104 bytes)
+	tmpDir, err := os.MkdirTemp("/tmp", "bd-stop-*")
+	if err != nil {
+		t.Fatalf("MkdirTemp() error = %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(tmpDir) })
+
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create beads dir: %v", err)
+	}
+	jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+	if err := os.WriteFile(jsonlPath, []byte{}, 0644); err != nil {
+		t.Fatalf("Failed to create JSONL file: %v", err)
+	}
+	dbPath := filepath.Join(beadsDir, "beads.db")
+	testStore := newTestStore(t, dbPath)
+
+	t.Chdir(tmpDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+
+	pidFile := filepath.Join(beadsDir, "daemon.pid")
+	if result, err := shutdownDaemon(pidFile, time.Second); err != nil || result.Running {
+		t.Fatalf("shutdownDaemon() with no daemon = %+v, %v; want not running", result, err)
+	}
+
+	// Start the daemon: lock, RPC server and event loop
+	lock, err := acquireDaemonLock(beadsDir, dbPath)
+	if err != nil {
+		t.Fatalf("acquireDaemonLock() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	log := newTestLogger()
+	socketPath := getSocketPathForPID(pidFile)
+	server, serverErrChan, err := startRPCServer(ctx, socketPath, testStore, tmpDir, dbPath, log)
+	if err != nil {
+		_ = lock.Close()
+		t.Fatalf("startRPCServer() error = %v", err)
+	}
+
+	var exports int32
+	doExport := func() { atomic.AddInt32(&exports, 1) }
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runEventDrivenLoop(ctx, cancel, server, serverErrChan, testStore, jsonlPath, doExport, func() {}, false, 0, log)
+		_ = lock.Close()
+	}()
+
+	// Leave an export pending in the debouncer
+	client, err := rpc.TryConnect(socketPath)
+	if err != nil || client == nil {
+		t.Fatalf("failed to connect to daemon: %v", err)
+	}
+	if _, err := client.Create(&rpc.CreateArgs{Title: "Stop test", IssueType: "task", Priority: 2}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	_ = client.Close()
+
+	result, err := shutdownDaemon(pidFile, 5*time.Second)
+	if err != nil {
+		t.Fatalf("shutdownDaemon() error = %v", err)
+	}
+	<-done
+	if !result.Running || result.PID != os.Getpid() || result.Method != stopMethodRPC {
+		t.Errorf("shutdownDaemon() = %+v, want running daemon (PID %d) stopped via %s", result, os.Getpid(), stopMethodRPC)
+	}
+	if got := atomic.LoadInt32(&exports); got != 1 {
+		t.Errorf("pending export flushed %d times, want 1", got)
+	}
+	if _, err := os.Stat(socketPath); !os.IsNotExist(err) {
+		t.Errorf("socket should be removed after stop, stat err = %v", err)
+	}
+
+	if result, err := shutdownDaemon(pidFile, time.Second); err != nil || result.Running {
+		t.Errorf("shutdownDaemon() after stop = %+v, %v; want not running", result, err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"cmp"
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -91,6 +90,18 @@ func exportToJSONLWithStore(ctx context.Context, store storage.Storage, jsonlPat
 		issue.Comments = comments
 	}
 
+	// Populate references for all issues
+	if err := populateReferences(ctx, store, issues); err != nil {
+		return err
+	}
+
+	// Serialize with other JSONL writers (see lockJSONL)
+	unlock, err := lockJSONL(jsonlPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	// Create temp file for atomic write
 	dir := filepath.Dir(jsonlPath)
 	base := filepath.Base(jsonlPath)
@@ -110,8 +121,10 @@ func exportToJSONLWithStore(ctx context.Context, store storage.Storage, jsonlPat
 	}()
 
 	// Write JSONL
+	format := config.TimestampFormat()
 	for _, issue := range issues {
-		data, marshalErr := json.Marshal(issue)
+		issue.SchemaVersion = types.JSONLSchemaVersion
+		data, marshalErr := types.MarshalIssueJSONL(issue, format)
 		if marshalErr != nil {
 			writeErr = fmt.Errorf("failed to marshal issue %s: %w", issue.ID, marshalErr)
 			return writeErr
@@ -126,6 +139,11 @@ func exportToJSONLWithStore(ctx context.Context, store storage.Storage, jsonlPat
 		}
 	}
 
+	if writeErr = tempFile.Sync(); writeErr != nil {
+		writeErr = fmt.Errorf("failed to sync temp file: %w", writeErr)
+		return writeErr
+	}
+
 	// Close before rename
 	if writeErr = tempFile.Close(); writeErr != nil {
 		writeErr = fmt.Errorf("failed to close temp file: %w", writeErr)
@@ -180,7 +198,7 @@ func importToJSONLWithStore(ctx context.Context, store storage.Storage, jsonlPat
 
 		// Parse JSON
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONLRecord([]byte(line), &issue); err != nil {
 			// Log error but continue - don't fail entire import
 			fmt.Fprintf(os.Stderr, "Warning: failed to parse JSONL line %d: %v\n", lineNum, err)
 			continue
@@ -430,12 +448,22 @@ func performExport(ctx context.Context, store storage.Storage, autoCommit, autoP
 			return
 		}
 
+		logHook := func(format string, args ...interface{}) { log.Info(fmt.Sprintf(format, args...)) }
+		if err := runFlushHook("pre-flush-hook", jsonlPath, nil, logHook); err != nil {
+			log.log("Skipping %s: %v", mode, err)
+			return
+		}
+
 		// Export to JSONL
+		exportStart := time.Now()
 		if err := exportToJSONLWithStore(exportCtx, store, jsonlPath); err != nil {
 			log.log("Export failed: %v", err)
 			return
 		}
 		log.log("Exported to JSONL")
+		if err := setFlushWatermark(exportCtx, store, exportStart); err != nil {
+			log.log("Warning: %v", err)
+		}
 
 		// Update export metadata (bd-ymj fix, bd-ar2.2 multi-repo support, bd-ar2.11 stable keys)
 		multiRepoPaths := getMultiRepoJSONLPaths()
@@ -458,6 +486,19 @@ func performExport(ctx context.Context, store storage.Storage, autoCommit, autoP
 			log.log("Warning: failed to update database mtime: %v", err)
 		}
 
+		// Runs before auto-commit so the hook can stage files; failures don't stop the export
+		if issueCount, err := countIssuesInJSONL(jsonlPath); err == nil {
+			if err := runFlushHook("post-flush-hook", jsonlPath, postFlushHookEnv(issueCount, issueCount), logHook); err != nil {
+				log.Warn(err.Error())
+			}
+			// --auto-commit already commits the JSONL below
+			if !autoCommit && !skipGit {
+				if err := autoCommitJSONL(exportCtx, jsonlPath, issueCount, issueCount); err != nil {
+					log.Warn(fmt.Sprintf("auto-commit-jsonl failed: %v", err))
+				}
+			}
+		}
+
 		// Auto-commit if enabled (skip in git-free mode)
 		if autoCommit && !skipGit {
 			// Try sync branch commit first
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var dbCmd = &cobra.Command{
+	Use:     "db",
+	GroupID: "maint",
+	Short:   "Database file operations",
+}
+
+var dbMergeCmd = &cobra.Command{
+	Use:   "merge <other.db>",
+	Short: "Merge issues from another beads database",
+	Long: `Copy the issues of another beads database into this one, with their labels,
+dependencies and comments.
+
+Issues that already exist here with identical content are skipped. An incoming
+issue whose ID is taken by a different issue is a collision:
+
+  --strategy renumber  Give the incoming issue a new ID (default). Its children
+                       move with it and dependencies are rewritten to match.
+  --strategy strict    Abort without changing anything.
+
+Deleted issues (tombstones) in the other database are not merged.
+
+NOTE: This is not the git merge driver ('bd merge'), which merges JSONL files.
+
+Examples:
+  bd db merge ../feature-branch/.beads/beads.db
+  bd db merge other.db --strategy strict --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("db merge")
+		otherPath := args[0]
+		strategyFlag, _ := cmd.Flags().GetString("strategy")
+		strategy := sqlite.MergeStrategy(strategyFlag)
+		if !strategy.IsValid() {
+			FatalErrorRespectJSON("invalid --strategy %q (must be %s or %s)", strategy, sqlite.MergeRenumber, sqlite.MergeStrict)
+		}
+
+		if err := ensureDirectMode("db merge requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("db merge requires SQLite storage")
+		}
+
+		// sqlite.New would create a missing file, so check first
+		if _, err := os.Stat(otherPath); err != nil {
+			FatalErrorRespectJSON("cannot open %s: %v", otherPath, err)
+		}
+		other, err := sqlite.New(rootCtx, otherPath)
+		if err != nil {
+			FatalErrorRespectJSON("failed to open %s: %v", otherPath, err)
+		}
+		defer func() { _ = other.Close() }()
+
+		result, err := sqliteStore.Merge(rootCtx, other, strategy, actor)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if result.Added > 0 {
+			markDirtyAndScheduleFlush()
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"source":               otherPath,
+				"strategy":             strategy,
+				"added":                result.Added,
+				"unchanged":            result.Unchanged,
+				"renumbered":           result.Renumbered,
+				"skipped_dependencies": result.SkippedDependencies,
+			})
+			return
+		}
+
+		fmt.Printf("%s Merged %s: %d added, %d unchanged\n", ui.RenderPass("✓"), otherPath, result.Added, result.Unchanged)
+		if len(result.Renumbered) > 0 {
+			fmt.Printf("\nRenumbered %d colliding issue(s):\n", len(result.Renumbered))
+			oldIDs := make([]string, 0, len(result.Renumbered))
+			for oldID := range result.Renumbered {
+				oldIDs = append(oldIDs, oldID)
+			}
+			sort.Strings(oldIDs)
+			for _, oldID := range oldIDs {
+				fmt.Printf("  %s → %s\n", oldID, ui.RenderID(result.Renumbered[oldID]))
+			}
+		}
+		for _, dep := range result.SkippedDependencies {
+			fmt.Fprintf(os.Stderr, "Warning: skipped dependency with missing target: %s\n", dep)
+		}
+	},
+}
+
+func init() {
+	dbMergeCmd.Flags().String("strategy", string(sqlite.MergeRenumber), "How to handle ID collisions: renumber or strict")
+	dbCmd.AddCommand(dbMergeCmd)
+	rootCmd.AddCommand(dbCmd)
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var dbMoveCmd = &cobra.Command{
+	Use:   "move <new-path>",
+	Short: "Relocate the database file and update metadata.json",
+	Long: `Move the beads database to a new path.
+
+The WAL is checkpointed, the database is copied and the copy is verified
+(checksum and SQLite integrity check) before metadata.json is pointed at it.
+The old file is removed only after that, so an interrupted move leaves a
+working database behind. If config.yaml sets 'db', it is updated too.
+
+If <new-path> is a directory, the database keeps its file name. Paths inside
+.beads/ are stored relative in metadata.json, others absolute.
+
+Note: the JSONL export is looked up next to the database, so moving the
+database out of .beads/ also moves where 'bd export' and auto-flush write.
+
+Examples:
+  bd db move .beads/issues.db
+  bd db move ~/beads-data/myproject.db`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("db move")
+
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			FatalErrorWithHint("no .beads directory found", "run 'bd init' to initialize bd")
+		}
+		source := dbPath
+		if source == "" {
+			source = beads.FindDatabasePath()
+		}
+		if source == "" {
+			FatalErrorCode(ExitNotFound, "no beads database found")
+		}
+
+		pidFile := filepath.Join(beadsDir, "daemon.pid")
+		if isRunning, pid := isDaemonRunning(pidFile); isRunning {
+			FatalErrorRespectJSON("daemon is running (PID %d); run 'bd daemon --stop' first", pid)
+		}
+
+		result, err := moveDatabase(rootCtx, beadsDir, source, args[0])
+		if err != nil {
+			FatalErrorRespectJSON("move failed: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(result)
+			return
+		}
+		fmt.Printf("%s Moved database %s → %s\n", ui.RenderPass("✓"), result.From, result.To)
+		if result.ConfigUpdated {
+			fmt.Println("  Updated 'db' in config.yaml")
+		}
+		if os.Getenv("BD_DB") != "" || os.Getenv("BEADS_DB") != "" {
+			fmt.Fprintf(os.Stderr, "Warning: BD_DB/BEADS_DB is set in your environment; update it to %s\n", result.To)
+		}
+	},
+}
+
+// dbMoveResult describes a completed database move.
+type dbMoveResult struct {
+	From          string `json:"from"`
+	To            string `json:"to"`
+	Database      string `json:"database"`       // Value written to metadata.json
+	ConfigUpdated bool   `json:"config_updated"` // config.yaml 'db' was rewritten
+}
+
+// moveDatabase relocates the database at source to target and points the
+// metadata.json in beadsDir at it. The source is only deleted once the copy
+// has been verified and the metadata saved.
+func moveDatabase(ctx context.Context, beadsDir, source, target string) (*dbMoveResult, error) {
+	source = utils.CanonicalizePath(source)
+	target, err := filepath.Abs(target)
+	if err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(target); err == nil && info.IsDir() {
+		target = filepath.Join(target, filepath.Base(source))
+	}
+	if utils.CanonicalizePath(target) == source {
+		return nil, fmt.Errorf("%s is already the database", target)
+	}
+	if _, err := os.Stat(target); err == nil {
+		return nil, fmt.Errorf("%s already exists", target)
+	}
+	if _, err := os.Stat(source); err != nil {
+		return nil, fmt.Errorf("cannot read database: %w", err)
+	}
+
+	// Fold the WAL into the .db file so copying it alone captures everything
+	s, err := sqlite.New(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", source, err)
+	}
+	if err := s.CheckpointWAL(ctx); err != nil {
+		_ = s.Close()
+		return nil, fmt.Errorf("failed to checkpoint database: %w", err)
+	}
+	if err := s.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close database: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0750); err != nil {
+		return nil, err
+	}
+	tmpPath := target + ".moving"
+	if err := copyFileSynced(source, tmpPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, fmt.Errorf("failed to copy database: %w", err)
+	}
+	if err := verifyDatabaseCopy(ctx, source, tmpPath); err != nil {
+		removeDatabaseFiles(tmpPath)
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, target); err != nil {
+		removeDatabaseFiles(tmpPath)
+		return nil, fmt.Errorf("failed to move copy into place: %w", err)
+	}
+	cleanupWALFiles(tmpPath)
+
+	cfg, err := loadOrCreateConfig(beadsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metadata.json: %w", err)
+	}
+	result := &dbMoveResult{From: source, To: target, Database: target}
+	if rel, err := filepath.Rel(utils.CanonicalizePath(beadsDir), utils.CanonicalizePath(target)); err == nil && !strings.HasPrefix(rel, "..") {
+		result.Database = rel
+	}
+	cfg.Database = result.Database
+	if err := cfg.Save(beadsDir); err != nil {
+		return nil, fmt.Errorf("failed to update metadata.json (copy left at %s): %w", target, err)
+	}
+
+	// An explicit db in config.yaml would keep pointing at the old file
+	if config.GetYamlConfig("db") != "" && os.Getenv("BD_DB") == "" {
+		if err := config.SetYamlConfig("db", target); err != nil {
+			return nil, fmt.Errorf("failed to update config.yaml: %w", err)
+		}
+		result.ConfigUpdated = true
+	}
+
+	removeDatabaseFiles(source)
+	return result, nil
+}
+
+// copyFileSynced copies src to dst and fsyncs dst before returning.
+func copyFileSynced(src, dst string) error {
+	in, err := os.Open(src) // #nosec G304 - database path chosen by the user
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// verifyDatabaseCopy checks that copyPath is byte-identical to source and
+// passes SQLite's integrity check.
+func verifyDatabaseCopy(ctx context.Context, source, copyPath string) error {
+	want, err := fileSHA256(source)
+	if err != nil {
+		return err
+	}
+	got, err := fileSHA256(copyPath)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(want, got) {
+		return fmt.Errorf("copy of %s does not match the original", source)
+	}
+
+	s, err := sqlite.New(ctx, copyPath)
+	if err != nil {
+		return fmt.Errorf("copy is not a usable database: %w", err)
+	}
+	defer func() { _ = s.Close() }()
+	var status string
+	if err := s.UnderlyingDB().QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&status); err != nil {
+		return fmt.Errorf("integrity check failed: %w", err)
+	}
+	if status != "ok" {
+		return fmt.Errorf("integrity check failed: %s", status)
+	}
+	return nil
+}
+
+func fileSHA256(path string) ([]byte, error) {
+	f, err := os.Open(path) // #nosec G304 - database path chosen by the user
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+func init() {
+	dbCmd.AddCommand(dbMoveCmd)
+}
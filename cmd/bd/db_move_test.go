@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestMoveDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (beadsDir, source string) {
+		t.Helper()
+		tmpDir, err := filepath.EvalSymlinks(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		beadsDir = filepath.Join(tmpDir, ".beads")
+		source = filepath.Join(beadsDir, "beads.db")
+		s := newTestStore(t, source)
+		issue := &types.Issue{Title: "Survives the move", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		s.Close()
+		if err := configfile.DefaultConfig().Save(beadsDir); err != nil {
+			t.Fatal(err)
+		}
+		return beadsDir, source
+	}
+
+	checkMoved := func(t *testing.T, beadsDir, source, target, wantDatabase string) {
+		t.Helper()
+		if _, err := os.Stat(source); !os.IsNotExist(err) {
+			t.Errorf("source %s still exists (err=%v)", source, err)
+		}
+		if leftovers, _ := filepath.Glob(target + ".moving*"); len(leftovers) > 0 {
+			t.Errorf("temporary files left behind: %v", leftovers)
+		}
+		cfg, err := configfile.Load(beadsDir)
+		if err != nil || cfg == nil {
+			t.Fatalf("loading metadata.json: %v", err)
+		}
+		if cfg.Database != wantDatabase {
+			t.Errorf("metadata.json database = %q, want %q", cfg.Database, wantDatabase)
+		}
+		if got := beads.ResolveDatabasePath("", beadsDir); got != target {
+			t.Errorf("resolved database = %q, want %q", got, target)
+		}
+
+		s, err := sqlite.New(ctx, target)
+		if err != nil {
+			t.Fatalf("opening moved database: %v", err)
+		}
+		defer s.Close()
+		issues, err := s.SearchIssues(ctx, "", types.IssueFilter{})
+		if err != nil {
+			t.Fatalf("SearchIssues failed: %v", err)
+		}
+		if len(issues) != 1 || issues[0].Title != "Survives the move" {
+			t.Errorf("moved database has %d issues, want the original one", len(issues))
+		}
+	}
+
+	t.Run("within .beads", func(t *testing.T) {
+		beadsDir, source := setup(t)
+		target := filepath.Join(beadsDir, "issues.db")
+
+		result, err := moveDatabase(ctx, beadsDir, source, target)
+		if err != nil {
+			t.Fatalf("moveDatabase failed: %v", err)
+		}
+		if result.To != target {
+			t.Errorf("result.To = %q, want %q", result.To, target)
+		}
+		checkMoved(t, beadsDir, source, target, "issues.db")
+	})
+
+	t.Run("to another directory", func(t *testing.T) {
+		beadsDir, source := setup(t)
+		otherDir, err := filepath.EvalSymlinks(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// A directory target keeps the file name
+		if _, err := moveDatabase(ctx, beadsDir, source, otherDir); err != nil {
+			t.Fatalf("moveDatabase failed: %v", err)
+		}
+		target := filepath.Join(otherDir, "beads.db")
+		checkMoved(t, beadsDir, source, target, target)
+	})
+
+	t.Run("refuses to overwrite", func(t *testing.T) {
+		beadsDir, source := setup(t)
+		target := filepath.Join(beadsDir, "taken.db")
+		if err := os.WriteFile(target, []byte("not a database"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := moveDatabase(ctx, beadsDir, source, target); err == nil {
+			t.Fatal("expected an error for an existing target")
+		}
+		if _, err := os.Stat(source); err != nil {
+			t.Errorf("source must be kept after a failed move: %v", err)
+		}
+		if _, err := os.Stat(target + ".moving"); !os.IsNotExist(err) {
+			t.Errorf("temporary copy left behind (err=%v)", err)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDBMergeFlushesJSONL(t *testing.T) {
+	if args := os.Getenv(exitCodeArgsEnv); args != "" {
+		rootCmd.SetArgs(strings.Split(args, "\n"))
+		main()
+		os.Exit(ExitSuccess)
+	}
+	if testing.Short() {
+		t.Skip("skipping subprocess test in short mode")
+	}
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, ".beads", "beads.db")
+	newTestStore(t, dbPath).Close()
+
+	otherPath := filepath.Join(t.TempDir(), "other.db")
+	other := newTestStore(t, otherPath)
+	issue := &types.Issue{Title: "Merged in", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := other.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	other.Close()
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestDBMergeFlushesJSONL$")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		exitCodeArgsEnv+"="+strings.Join([]string{"--no-daemon", "--db", dbPath, "db", "merge", otherPath}, "\n"),
+		"HOME="+dir,
+		"BEADS_DIR=",
+		"BEADS_DB=",
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("bd db merge failed: %v\n%s", err, out)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ".beads", "issues.jsonl"))
+	if err != nil {
+		t.Fatalf("failed to read JSONL: %v", err)
+	}
+	if !strings.Contains(string(data), `"id":"`+issue.ID+`"`) {
+		t.Errorf("JSONL missing merged issue %s:\n%s", issue.ID, data)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// Database statuses reported by bd db verify
+const (
+	dbStatusOK      = "ok"
+	dbStatusCorrupt = "corrupt"
+)
+
+var dbVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check the integrity of every database in .beads/",
+	Long: `Run SQLite's integrity check on each database 'bd migrate' would find, and
+report ok or corrupt for each. Databases are opened read-only.
+
+Use it as a batch health check before a big migration. Exits with status 1 if
+any database is corrupt.
+
+Examples:
+  bd db verify
+  bd db verify --recursive --json   # [{"name": "beads.db", "version": "0.30.0", "status": "ok", ...}]`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		recursive, _ := cmd.Flags().GetBool("recursive")
+
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			FatalErrorWithHint("no .beads directory found", "run 'bd init' to initialize bd")
+		}
+		databases, err := detectDatabases(beadsDir, recursive)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		results := formatDBList(databases)
+		corrupt := 0
+		for i, db := range databases {
+			status, detail := verifyDatabase(db.path)
+			results[i]["status"] = status
+			if detail != "" {
+				results[i]["error"] = detail
+			}
+			if status != dbStatusOK {
+				corrupt++
+			}
+		}
+
+		if jsonOutput {
+			outputJSON(results)
+		} else if len(results) == 0 {
+			fmt.Printf("No database files found in %s\n", beadsDir)
+		} else {
+			for _, r := range results {
+				if r["status"] == dbStatusOK {
+					fmt.Printf("%s %s (version %s)\n", ui.RenderPass("✓"), r["name"], r["version"])
+				} else {
+					fmt.Printf("%s %s (version %s): %s\n", ui.RenderFail("✗"), r["name"], r["version"], r["error"])
+				}
+			}
+		}
+		if corrupt > 0 {
+			if !jsonOutput {
+				fmt.Fprintf(os.Stderr, "\n%d of %d database(s) corrupt\n", corrupt, len(results))
+			}
+			os.Exit(ExitError)
+		}
+	},
+}
+
+// verifyDatabase opens the database at path read-only and runs PRAGMA
+// integrity_check. It returns dbStatusOK, or dbStatusCorrupt with what SQLite
+// reported.
+func verifyDatabase(path string) (status, detail string) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro&_pragma=busy_timeout(30000)")
+	if err != nil {
+		return dbStatusCorrupt, err.Error()
+	}
+	defer db.Close()
+
+	rows, err := db.Query("PRAGMA integrity_check")
+	if err != nil {
+		return dbStatusCorrupt, err.Error()
+	}
+	defer rows.Close()
+
+	var results []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return dbStatusCorrupt, err.Error()
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return dbStatusCorrupt, err.Error()
+	}
+
+	// "ok" means no corruption detected
+	if len(results) == 1 && results[0] == "ok" {
+		return dbStatusOK, ""
+	}
+	return dbStatusCorrupt, strings.Join(results, "; ")
+}
+
+func init() {
+	dbVerifyCmd.Flags().Bool("recursive", false, "Also check databases in subdirectories of .beads/ (except backups/)")
+	dbCmd.AddCommand(dbVerifyCmd)
+}
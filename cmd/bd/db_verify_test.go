@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestVerifyDatabase(t *testing.T) {
+	ctx := context.Background()
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+
+	createDB := func(name string) string {
+		t.Helper()
+		path := filepath.Join(beadsDir, name)
+		s := newTestStore(t, path)
+		for i := 0; i < 50; i++ {
+			issue := &types.Issue{Title: fmt.Sprintf("Issue %d", i), Description: "Some text to fill the pages",
+				Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+				t.Fatalf("CreateIssue failed: %v", err)
+			}
+		}
+		if err := s.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+		return path
+	}
+
+	good := createDB("beads.db")
+	bad := createDB("old.db")
+
+	// Overwrite a few pages past the header with garbage
+	f, err := os.OpenFile(bad, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	garbage := make([]byte, 3*4096)
+	for i := range garbage {
+		garbage[i] = 0xA5
+	}
+	if _, err := f.WriteAt(garbage, 2*4096); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+
+	before, err := os.Stat(good)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, detail := verifyDatabase(good); status != dbStatusOK {
+		t.Errorf("verifyDatabase(good) = %s (%s), want %s", status, detail, dbStatusOK)
+	}
+	status, detail := verifyDatabase(bad)
+	if status != dbStatusCorrupt {
+		t.Errorf("verifyDatabase(corrupt) = %s, want %s", status, dbStatusCorrupt)
+	}
+	if detail == "" {
+		t.Error("expected SQLite's report for the corrupt database")
+	}
+
+	// Verifying is read-only: the good database is untouched
+	after, err := os.Stat(good)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !after.ModTime().Equal(before.ModTime()) || after.Size() != before.Size() {
+		t.Errorf("verify modified %s", good)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"regexp"
 	"strings"
 
@@ -179,7 +178,7 @@ the issues will not resurrect from remote branches.`,
 		}
 		if issue == nil {
 			fmt.Fprintf(os.Stderr, "Error: issue %s not found\n", issueID)
-			os.Exit(1)
+			os.Exit(ExitNotFound)
 		}
 		// Find all connected issues (dependencies in both directions)
 		connectedIssues := make(map[string]*types.Issue)
@@ -452,7 +451,7 @@ func deleteBatch(_ *cobra.Command, issueIDs []string, force bool, dryRun bool, c
 	}
 	if len(notFound) > 0 {
 		fmt.Fprintf(os.Stderr, "Error: issues not found: %s\n", strings.Join(notFound, ", "))
-		os.Exit(1)
+		os.Exit(ExitNotFound)
 	}
 	// Dry-run or preview mode
 	if dryRun || !force {
@@ -667,11 +666,8 @@ func getActorWithGit() string {
 	}
 
 	// Try git config user.name
-	cmd := exec.Command("git", "config", "user.name")
-	if output, err := cmd.Output(); err == nil {
-		if gitUser := strings.TrimSpace(string(output)); gitUser != "" {
-			return gitUser
-		}
+	if gitUser := gitUserName(); gitUser != "" {
+		return gitUser
 	}
 
 	// Fall back to USER env
@@ -2,6 +2,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -34,6 +35,7 @@ func isChildOf(childID, parentID string) bool {
 
 var depCmd = &cobra.Command{
 	Use:     "dep",
+	Aliases: []string{"deps"},
 	GroupID: "deps",
 	Short:   "Manage dependencies",
 }
@@ -72,7 +74,7 @@ Examples:
 			resp, err := daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving issue ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			if err := json.Unmarshal(resp.Data, &fromID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
@@ -92,7 +94,7 @@ Examples:
 				resp, err = daemonClient.ResolveID(resolveArgs)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving dependency ID %s: %v\n", args[1], err)
-					os.Exit(1)
+					os.Exit(exitCodeFor(err))
 				}
 				if err := json.Unmarshal(resp.Data, &toID); err != nil {
 					fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
@@ -101,10 +103,10 @@ Examples:
 			}
 		} else {
 			var err error
-			fromID, err = utils.ResolvePartialID(ctx, store, args[0])
+			fromID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving issue ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 
 			if isExternalRef {
@@ -116,10 +118,10 @@ Examples:
 					os.Exit(1)
 				}
 			} else {
-				toID, err = utils.ResolvePartialID(ctx, store, args[1])
+				toID, err = utils.ResolveIssueID(ctx, store, args[1])
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving dependency ID %s: %v\n", args[1], err)
-					os.Exit(1)
+					os.Exit(exitCodeFor(err))
 				}
 			}
 		}
@@ -226,7 +228,7 @@ var depRemoveCmd = &cobra.Command{
 			resp, err := daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving issue ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			if err := json.Unmarshal(resp.Data, &fromID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
@@ -237,7 +239,7 @@ var depRemoveCmd = &cobra.Command{
 			resp, err = daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving dependency ID %s: %v\n", args[1], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			if err := json.Unmarshal(resp.Data, &toID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
@@ -245,16 +247,16 @@ var depRemoveCmd = &cobra.Command{
 			}
 		} else {
 			var err error
-			fromID, err = utils.ResolvePartialID(ctx, store, args[0])
+			fromID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving issue ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			
-			toID, err = utils.ResolvePartialID(ctx, store, args[1])
+			toID, err = utils.ResolveIssueID(ctx, store, args[1])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving dependency ID %s: %v\n", args[1], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		}
 
@@ -321,7 +323,12 @@ Examples:
   bd dep tree gt-0iqq                    # Show what blocks gt-0iqq
   bd dep tree gt-0iqq --direction=up     # Show what gt-0iqq blocks
   bd dep tree gt-0iqq --status=open      # Only show open issues
-  bd dep tree gt-0iqq --depth=3          # Limit to 3 levels deep`,
+  bd dep tree gt-0iqq --depth=3          # Limit to 3 levels deep
+  bd dep tree gt-0iqq --order            # Everything gt-0iqq waits for, in work order
+  bd dep tree gt-0iqq --order --direction=up  # What it blocks, in the order it unblocks
+
+With --order, the tree is flattened to a list of all transitive blocking
+dependencies (or dependents), each listed once, after every issue it waits on.`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := rootCtx
@@ -333,7 +340,7 @@ Examples:
 			resp, err := daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving issue ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			if err := json.Unmarshal(resp.Data, &fullID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
@@ -341,10 +348,10 @@ Examples:
 			}
 		} else {
 			var err error
-			fullID, err = utils.ResolvePartialID(ctx, store, args[0])
+			fullID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		}
 
@@ -384,6 +391,15 @@ Examples:
 			os.Exit(1)
 		}
 
+		if order, _ := cmd.Flags().GetBool("order"); order {
+			if direction == "both" || formatStr != "" {
+				fmt.Fprintf(os.Stderr, "Error: --order works with --direction down or up and no --format\n")
+				os.Exit(1)
+			}
+			outputDependencyOrder(ctx, fullID, direction, statusFilter)
+			return
+		}
+
 		// For "both" direction, we need to fetch both trees and merge them
 		var tree []*types.TreeNode
 		var err error
@@ -509,6 +525,104 @@ var depCyclesCmd = &cobra.Command{
 	},
 }
 
+var depPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove dependencies on deleted or missing issues",
+	Long: `Remove dependency references that dangle: dependencies on issues that no
+longer exist or were deleted, and dependencies left over from deleted issues.
+Dependencies on external refs and on archived issues are kept.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("dep prune")
+		if err := ensureDirectMode("dep prune requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("dep prune requires SQLite storage")
+		}
+
+		count, err := sqliteStore.PruneDanglingDeps(rootCtx)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if count > 0 {
+			markDirtyAndScheduleFlush()
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"pruned_count": count,
+			})
+			return
+		}
+
+		if count == 0 {
+			fmt.Println("No dangling dependencies")
+			return
+		}
+		fmt.Printf("%s Removed %d dangling dependency reference(s)\n", ui.RenderPass("✓"), count)
+	},
+}
+
+// outputDependencyOrder prints everything id transitively waits for (direction
+// "down") or blocks ("up"), in the order the work can be done.
+func outputDependencyOrder(ctx context.Context, id, direction, statusFilter string) {
+	sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: --order requires SQLite storage\n")
+		os.Exit(1)
+	}
+
+	var ids []string
+	var err error
+	if direction == "up" {
+		ids, err = sqliteStore.TransitiveDependents(ctx, id)
+	} else {
+		ids, err = sqliteStore.TransitiveDeps(ctx, id)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	issues := make([]*types.Issue, 0, len(ids))
+	for _, depID := range ids {
+		issue, err := store.GetIssue(ctx, depID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if issue == nil || (statusFilter != "" && issue.Status != types.Status(statusFilter)) {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+
+	if jsonOutput {
+		outputJSON(issues)
+		return
+	}
+
+	if len(issues) == 0 {
+		if direction == "up" {
+			fmt.Printf("\n%s has no dependents\n", id)
+		} else {
+			fmt.Printf("\n%s has no dependencies\n", id)
+		}
+		return
+	}
+
+	if direction == "up" {
+		fmt.Printf("\n%s Blocked by %s, in the order they unblock:\n\n", ui.RenderAccent("🌲"), id)
+	} else {
+		fmt.Printf("\n%s To do before %s, in order:\n\n", ui.RenderAccent("🌲"), id)
+	}
+	for i, issue := range issues {
+		fmt.Printf("%3d. %s\n", i+1, formatTreeNode(&types.TreeNode{Issue: *issue, Depth: 1}))
+	}
+	fmt.Println()
+}
+
 // outputMermaidTree outputs a dependency tree in Mermaid.js flowchart format
 func outputMermaidTree(tree []*types.TreeNode, rootID string) {
 	if len(tree) == 0 {
@@ -861,6 +975,7 @@ func init() {
 	depTreeCmd.Flags().String("direction", "", "Tree direction: 'down' (dependencies), 'up' (dependents), or 'both'")
 	depTreeCmd.Flags().String("status", "", "Filter to only show issues with this status (open, in_progress, blocked, deferred, closed)")
 	depTreeCmd.Flags().String("format", "", "Output format: 'mermaid' for Mermaid.js flowchart")
+	depTreeCmd.Flags().Bool("order", false, "List all transitive dependencies (or dependents) in the order they can be worked, instead of a tree")
 	// Note: --json flag is defined as a persistent flag in main.go, not here
 
 	// Note: --json flag is defined as a persistent flag in main.go, not here
@@ -869,5 +984,6 @@ func init() {
 	depCmd.AddCommand(depRemoveCmd)
 	depCmd.AddCommand(depTreeCmd)
 	depCmd.AddCommand(depCyclesCmd)
+	depCmd.AddCommand(depPruneCmd)
 	rootCmd.AddCommand(depCmd)
 }
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var diffCmd = &cobra.Command{
+	Use:     "diff <old.jsonl> <new.jsonl>",
+	GroupID: "sync",
+	Short:   "Compare two JSONL exports issue by issue",
+	Long: `Compare two JSONL exports and report which issues were added, removed, or
+modified, matched by ID. For modified issues the changed fields are listed.
+
+Unlike a text diff, this ignores line order, so reordered exports don't show
+up as changes. No database is needed.
+
+Examples:
+  bd diff old.jsonl .beads/issues.jsonl
+  git show main:.beads/issues.jsonl > /tmp/main.jsonl && bd diff /tmp/main.jsonl .beads/issues.jsonl
+  bd diff old.jsonl new.jsonl --json`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		oldIssues, err := loadIssuesFromJSONL(args[0])
+		if err != nil {
+			FatalErrorRespectJSON("reading %s: %v", args[0], err)
+		}
+		newIssues, err := loadIssuesFromJSONL(args[1])
+		if err != nil {
+			FatalErrorRespectJSON("reading %s: %v", args[1], err)
+		}
+
+		result, err := diffIssues(oldIssues, newIssues, config.TimestampFormat())
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(result)
+			return
+		}
+		printIssueDiff(result)
+	},
+}
+
+// issueRef identifies an issue that was added or removed.
+type issueRef struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// issueChange describes an issue present in both exports whose fields differ.
+type issueChange struct {
+	ID     string   `json:"id"`
+	Title  string   `json:"title"`
+	Fields []string `json:"fields"` // JSONL field names, sorted
+}
+
+// issueDiff is the result of comparing two exports, each list sorted by ID.
+type issueDiff struct {
+	Added    []issueRef    `json:"added"`
+	Removed  []issueRef    `json:"removed"`
+	Modified []issueChange `json:"modified"`
+}
+
+// diffIssues compares two sets of issues by ID. Fields are compared on their
+// JSONL encoding in format, so every exported field is covered and timestamps
+// that export identically compare equal; labels are compared as a set.
+func diffIssues(oldIssues, newIssues []*types.Issue, format types.TimestampFormat) (*issueDiff, error) {
+	oldByID := make(map[string]*types.Issue, len(oldIssues))
+	for _, issue := range oldIssues {
+		oldByID[issue.ID] = issue
+	}
+	newByID := make(map[string]*types.Issue, len(newIssues))
+	for _, issue := range newIssues {
+		newByID[issue.ID] = issue
+	}
+
+	result := &issueDiff{
+		Added:    []issueRef{},
+		Removed:  []issueRef{},
+		Modified: []issueChange{},
+	}
+	for _, issue := range oldIssues {
+		if _, ok := newByID[issue.ID]; !ok {
+			result.Removed = append(result.Removed, issueRef{ID: issue.ID, Title: issue.Title})
+		}
+	}
+	for _, issue := range newIssues {
+		old, ok := oldByID[issue.ID]
+		if !ok {
+			result.Added = append(result.Added, issueRef{ID: issue.ID, Title: issue.Title})
+			continue
+		}
+		fields, err := changedFields(old, issue, format)
+		if err != nil {
+			return nil, fmt.Errorf("comparing %s: %w", issue.ID, err)
+		}
+		if len(fields) > 0 {
+			result.Modified = append(result.Modified, issueChange{ID: issue.ID, Title: issue.Title, Fields: fields})
+		}
+	}
+
+	slices.SortFunc(result.Added, func(a, b issueRef) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(result.Removed, func(a, b issueRef) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(result.Modified, func(a, b issueChange) int { return strings.Compare(a.ID, b.ID) })
+	return result, nil
+}
+
+// changedFields returns the sorted JSONL field names whose values differ
+// between old and updated.
+func changedFields(old, updated *types.Issue, format types.TimestampFormat) ([]string, error) {
+	oldFields, err := issueFields(old, format)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := issueFields(updated, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	for name, value := range oldFields {
+		if !bytes.Equal(value, newFields[name]) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range newFields {
+		if _, ok := oldFields[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+	slices.Sort(fields)
+	return fields, nil
+}
+
+// issueFields encodes issue as it appears in a JSONL written in format, keyed
+// by field name. This puts database times (local offset, full precision) and
+// JSONL times (UTC, maybe whole seconds) on the same footing.
+func issueFields(issue *types.Issue, format types.TimestampFormat) (map[string]json.RawMessage, error) {
+	normalized := *issue
+	normalized.Labels = slices.Clone(issue.Labels)
+	slices.Sort(normalized.Labels)
+
+	data, err := types.MarshalIssueJSONL(&normalized, format)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func printIssueDiff(result *issueDiff) {
+	if len(result.Added) == 0 && len(result.Removed) == 0 && len(result.Modified) == 0 {
+		fmt.Println("No differences")
+		return
+	}
+
+	for _, ref := range result.Added {
+		fmt.Printf("%s %s %s\n", ui.RenderPass("+"), ui.RenderID(ref.ID), ref.Title)
+	}
+	for _, ref := range result.Removed {
+		fmt.Printf("%s %s %s\n", ui.RenderFail("-"), ui.RenderID(ref.ID), ref.Title)
+	}
+	for _, change := range result.Modified {
+		fmt.Printf("%s %s %s %s\n", ui.RenderWarn("~"), ui.RenderID(change.ID), change.Title,
+			ui.RenderMuted("("+strings.Join(change.Fields, ", ")+")"))
+	}
+	fmt.Printf("\n%d added, %d removed, %d modified\n", len(result.Added), len(result.Removed), len(result.Modified))
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDiffIssues(t *testing.T) {
+	oldIssues, err := loadIssuesFromJSONL(filepath.Join("testdata", "diff", "old.jsonl"))
+	if err != nil {
+		t.Fatalf("loading old export: %v", err)
+	}
+	newIssues, err := loadIssuesFromJSONL(filepath.Join("testdata", "diff", "new.jsonl"))
+	if err != nil {
+		t.Fatalf("loading new export: %v", err)
+	}
+
+	got, err := diffIssues(oldIssues, newIssues, types.TimestampRFC3339)
+	if err != nil {
+		t.Fatalf("diffIssues failed: %v", err)
+	}
+	want := &issueDiff{
+		Added:   []issueRef{{ID: "bd-4", Title: "Brand new"}},
+		Removed: []issueRef{{ID: "bd-3", Title: "Removed later"}},
+		Modified: []issueChange{
+			{ID: "bd-2", Title: "Fix login", Fields: []string{"closed_at", "priority", "status", "updated_at"}},
+			{ID: "bd-5", Title: "Needs a label", Fields: []string{"labels"}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffIssues =\n%+v\nwant\n%+v", got, want)
+	}
+
+	same, err := diffIssues(newIssues, newIssues, types.TimestampRFC3339)
+	if err != nil {
+		t.Fatalf("diffIssues failed: %v", err)
+	}
+	if len(same.Added)+len(same.Removed)+len(same.Modified) != 0 {
+		t.Errorf("diffing an export with itself = %+v, want no differences", same)
+	}
+}
+
+func TestDiffIssuesNormalizesTimestamps(t *testing.T) {
+	berlin := time.FixedZone("CEST", 2*60*60)
+	created := time.Date(2024, 6, 1, 12, 0, 0, 123456789, berlin)
+	dbIssue := &types.Issue{ID: "bd-1", Title: "Same", Priority: 2, CreatedAt: created, UpdatedAt: created}
+
+	// As read back from a UTC RFC 3339 export
+	rfcIssue := *dbIssue
+	rfcIssue.CreatedAt = created.UTC()
+	rfcIssue.UpdatedAt = created.UTC()
+	// As read back from a Unix-seconds export
+	unixIssue := *dbIssue
+	unixIssue.CreatedAt = time.Unix(created.Unix(), 0).UTC()
+	unixIssue.UpdatedAt = unixIssue.CreatedAt
+
+	tests := []struct {
+		name   string
+		old    *types.Issue
+		format types.TimestampFormat
+	}{
+		{"rfc3339", &rfcIssue, types.TimestampRFC3339},
+		{"unix", &unixIssue, types.TimestampUnix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffIssues([]*types.Issue{tt.old}, []*types.Issue{dbIssue}, tt.format)
+			if err != nil {
+				t.Fatalf("diffIssues failed: %v", err)
+			}
+			if len(got.Modified) != 0 {
+				t.Errorf("Modified = %+v, want none", got.Modified)
+			}
+		})
+	}
+}
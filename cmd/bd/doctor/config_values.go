@@ -14,6 +14,7 @@ import (
 	_ "github.com/ncruces/go-sqlite3/embed"
 	"github.com/spf13/viper"
 	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 )
 
@@ -81,18 +82,10 @@ func checkYAMLConfigValues(repoPath string) []string {
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		// No config.yaml, check user config dirs
 		configPath = ""
-		if configDir, err := os.UserConfigDir(); err == nil {
-			userConfigPath := filepath.Join(configDir, "bd", "config.yaml")
+		for _, userConfigPath := range config.UserConfigPaths() {
 			if _, err := os.Stat(userConfigPath); err == nil {
 				configPath = userConfigPath
-			}
-		}
-		if configPath == "" {
-			if homeDir, err := os.UserHomeDir(); err == nil {
-				homeConfigPath := filepath.Join(homeDir, ".beads", "config.yaml")
-				if _, err := os.Stat(homeConfigPath); err == nil {
-					configPath = homeConfigPath
-				}
+				break
 			}
 		}
 	}
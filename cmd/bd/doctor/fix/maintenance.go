@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
@@ -120,7 +121,7 @@ func ExpiredTombstones(path string) error {
 	decoder := json.NewDecoder(file)
 	for {
 		var issue types.Issue
-		if err := decoder.Decode(&issue); err != nil {
+		if err := types.DecodeIssueJSONL(decoder, &issue); err != nil {
 			break
 		}
 		issue.SetDefaults()
@@ -153,9 +154,9 @@ func ExpiredTombstones(path string) error {
 	}
 	tempPath := tempFile.Name()
 
-	encoder := json.NewEncoder(tempFile)
+	format := config.TimestampFormat()
 	for _, issue := range kept {
-		if err := encoder.Encode(issue); err != nil {
+		if err := types.EncodeIssueJSONL(tempFile, issue, format); err != nil {
 			_ = tempFile.Close()
 			_ = os.Remove(tempPath)
 			return fmt.Errorf("failed to write issue %s: %w", issue.ID, err)
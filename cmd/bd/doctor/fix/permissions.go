@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+
+	"github.com/steveyegge/beads/internal/config"
 )
 
 // Permissions fixes file permission issues in the .beads directory
@@ -45,11 +47,11 @@ func Permissions(path string) error {
 			return nil
 		}
 
-		// Ensure database has exactly 0600 permissions (owner rw only)
-		expectedFileMode := os.FileMode(0600)
+		// Ensure database has exactly 0600 permissions (owner rw only),
+		// or the configured file-mode
+		expectedFileMode := config.FileMode(0600)
 		currentPerms := dbInfo.Mode().Perm()
 
-		// Check if permissions are not exactly 0600
 		if currentPerms != expectedFileMode {
 			if err := os.Chmod(dbPath, expectedFileMode); err != nil {
 				return fmt.Errorf("failed to fix database permissions: %w", err)
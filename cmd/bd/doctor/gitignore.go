@@ -19,6 +19,7 @@ daemon.lock
 daemon.log
 daemon.pid
 bd.sock
+*.jsonl.lock
 
 # Local version tracking (prevents upgrade notification spam after git ops)
 .local_version
@@ -128,7 +128,7 @@ func CheckExpiredTombstones(path string) DoctorCheck {
 
 	for {
 		var issue types.Issue
-		if err := decoder.Decode(&issue); err != nil {
+		if err := types.DecodeIssueJSONL(decoder, &issue); err != nil {
 			break
 		}
 		issue.SetDefaults()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -81,11 +82,11 @@ func runDuplicate(cmd *cobra.Command, args []string) error {
 		}
 	} else {
 		var err error
-		duplicateID, err = utils.ResolvePartialID(ctx, store, args[0])
+		duplicateID, err = utils.ResolveIssueID(ctx, store, args[0])
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", args[0], err)
 		}
-		canonicalID, err = utils.ResolvePartialID(ctx, store, duplicateOf)
+		canonicalID, err = utils.ResolveIssueID(ctx, store, duplicateOf)
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", duplicateOf, err)
 		}
@@ -100,7 +101,7 @@ func runDuplicate(cmd *cobra.Command, args []string) error {
 	if daemonClient != nil {
 		resp, err := daemonClient.Show(&rpc.ShowArgs{ID: canonicalID})
 		if err != nil {
-			return fmt.Errorf("canonical issue not found: %s", canonicalID)
+			return fmt.Errorf("canonical issue %s %w", canonicalID, storage.ErrNotFound)
 		}
 		if err := json.Unmarshal(resp.Data, &canonical); err != nil {
 			return fmt.Errorf("parsing response: %w", err)
@@ -109,7 +110,7 @@ func runDuplicate(cmd *cobra.Command, args []string) error {
 		var err error
 		canonical, err = store.GetIssue(ctx, canonicalID)
 		if err != nil || canonical == nil {
-			return fmt.Errorf("canonical issue not found: %s", canonicalID)
+			return fmt.Errorf("canonical issue %s %w", canonicalID, storage.ErrNotFound)
 		}
 	}
 
@@ -179,11 +180,11 @@ func runSupersede(cmd *cobra.Command, args []string) error {
 		}
 	} else {
 		var err error
-		oldID, err = utils.ResolvePartialID(ctx, store, args[0])
+		oldID, err = utils.ResolveIssueID(ctx, store, args[0])
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", args[0], err)
 		}
-		newID, err = utils.ResolvePartialID(ctx, store, supersededWith)
+		newID, err = utils.ResolveIssueID(ctx, store, supersededWith)
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", supersededWith, err)
 		}
@@ -198,7 +199,7 @@ func runSupersede(cmd *cobra.Command, args []string) error {
 	if daemonClient != nil {
 		resp, err := daemonClient.Show(&rpc.ShowArgs{ID: newID})
 		if err != nil {
-			return fmt.Errorf("replacement issue not found: %s", newID)
+			return fmt.Errorf("replacement issue %s %w", newID, storage.ErrNotFound)
 		}
 		if err := json.Unmarshal(resp.Data, &newIssue); err != nil {
 			return fmt.Errorf("parsing response: %w", err)
@@ -207,7 +208,7 @@ func runSupersede(cmd *cobra.Command, args []string) error {
 		var err error
 		newIssue, err = store.GetIssue(ctx, newID)
 		if err != nil || newIssue == nil {
-			return fmt.Errorf("replacement issue not found: %s", newID)
+			return fmt.Errorf("replacement issue %s %w", newID, storage.ErrNotFound)
 		}
 	}
 
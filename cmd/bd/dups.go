@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var dupsCmd = &cobra.Command{
+	Use:     "dups",
+	GroupID: "views",
+	Short:   "Report open issues with similar titles",
+	Long: `Report groups of open issues that are likely duplicates, judged by title.
+
+Titles are compared after lowercasing and dropping punctuation, so
+"Fix login timeout" and "fix login-timeout!" are grouped. With --threshold,
+issues are also grouped when their titles share at least that fraction of
+their words (e.g. 0.6). This command only reports; use 'bd duplicate <id> --of
+<canonical>' to mark a duplicate, or 'bd duplicates' for exact content matches.
+
+Examples:
+  bd dups                     # Same title after normalization
+  bd dups --threshold 0.6     # Also titles sharing 60% of their words
+  bd dups --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+
+		if err := ensureDirectMode("dups requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("dups requires SQLite storage")
+		}
+
+		groups, err := sqliteStore.FindDuplicates(rootCtx, threshold)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			if groups == nil {
+				groups = [][]*types.Issue{}
+			}
+			outputJSON(groups)
+			return
+		}
+
+		if len(groups) == 0 {
+			fmt.Printf("\n%s No likely duplicates found\n\n", ui.RenderPass("✨"))
+			return
+		}
+		fmt.Printf("\n%s %d group(s) of likely duplicates:\n\n", ui.RenderWarn("🔍"), len(groups))
+		for i, group := range groups {
+			fmt.Printf("Group %d:\n", i+1)
+			for _, issue := range group {
+				fmt.Printf("  %s [%s] %s\n", ui.RenderID(issue.ID), ui.RenderStatus(string(issue.Status)), issue.Title)
+			}
+			fmt.Println()
+		}
+	},
+}
+
+func init() {
+	dupsCmd.Flags().Float64("threshold", 0, "Also group titles sharing at least this fraction of words, between 0 and 1 (0: exact matches only)")
+	rootCmd.AddCommand(dupsCmd)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// errEmptyEdit is returned when the user saves an empty buffer, which aborts
+// the operation rather than storing an empty field.
+var errEmptyEdit = errors.New("empty description, aborting")
+
+// findEditor returns the editor command to run: the "editor" config key,
+// then $EDITOR, then $VISUAL, then the first common editor on PATH. The
+// result may include arguments (e.g. "code --wait").
+func findEditor() (string, error) {
+	if editor := config.GetString("editor"); editor != "" {
+		return editor, nil
+	}
+	if editor := os.Getenv("EDITOR"); editor != "" {
+		return editor, nil
+	}
+	if editor := os.Getenv("VISUAL"); editor != "" {
+		return editor, nil
+	}
+	for _, defaultEditor := range []string{"vim", "vi", "nano", "emacs"} {
+		if _, err := exec.LookPath(defaultEditor); err == nil {
+			return defaultEditor, nil
+		}
+	}
+	return "", errors.New("no editor found. Set the editor config key, $EDITOR or $VISUAL")
+}
+
+// editInEditor writes initial to a temp file named after pattern (see
+// os.CreateTemp), opens it in the user's editor, and returns what was saved.
+// An editor that exits non-zero is treated as an abort.
+func editInEditor(initial, pattern string) (string, error) {
+	editor, err := findEditor()
+	if err != nil {
+		return "", err
+	}
+
+	tmpFile, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmpFile.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	if _, err := tmpFile.WriteString(initial); err != nil {
+		_ = tmpFile.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	parts := strings.Fields(editor)
+	// #nosec G204 -- the editor is chosen by the user
+	editorCmd := exec.Command(parts[0], append(parts[1:], tmpPath)...)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		return "", fmt.Errorf("editor %q failed, aborting: %w", editor, err)
+	}
+
+	// #nosec G304 -- tmpPath was created above
+	edited, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return string(edited), nil
+}
+
+// editIssueBody opens initial in the editor for bd create --edit and returns
+// the body the user wrote, without trailing whitespace. Saving an empty
+// buffer returns errEmptyEdit.
+func editIssueBody(initial string) (string, error) {
+	if initial != "" && !strings.HasSuffix(initial, "\n") {
+		initial += "\n"
+	}
+	body, err := editInEditor(initial, "bd-create-*.md")
+	if err != nil {
+		return "", err
+	}
+	body = strings.TrimRight(body, " \t\r\n")
+	if strings.TrimSpace(body) == "" {
+		return "", errEmptyEdit
+	}
+	return body, nil
+}
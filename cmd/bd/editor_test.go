@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+)
+
+func TestEditIssueBody(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("editor stubs are shell scripts")
+	}
+	// Initialize outside the repo so its .beads/config.yaml doesn't leak into later tests
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize failed: %v", err)
+	}
+	t.Cleanup(func() { config.Set("editor", "") })
+	dir := t.TempDir()
+	seen := filepath.Join(dir, "seen.md")
+
+	// useEditor points the editor config key at a script with the given body;
+	// the script gets the file to edit as $1.
+	useEditor := func(t *testing.T, body string) {
+		t.Helper()
+		path := filepath.Join(dir, "editor.sh")
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		config.Set("editor", path)
+	}
+
+	t.Run("stores what the user wrote", func(t *testing.T) {
+		useEditor(t, `cp "$1" `+seen+`; printf '## Summary\nWritten in the editor\n\n' > "$1"`)
+		got, err := editIssueBody("## Summary\n{placeholder}\n")
+		if err != nil {
+			t.Fatalf("editIssueBody failed: %v", err)
+		}
+		if want := "## Summary\nWritten in the editor"; got != want {
+			t.Errorf("body = %q, want %q", got, want)
+		}
+		initial, err := os.ReadFile(seen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(initial) != "## Summary\n{placeholder}\n" {
+			t.Errorf("editor opened with %q, want the template", initial)
+		}
+	})
+
+	t.Run("editor config key beats $EDITOR", func(t *testing.T) {
+		t.Setenv("EDITOR", "false")
+		useEditor(t, `echo from-config > "$1"`)
+		if got, err := editIssueBody(""); err != nil || got != "from-config" {
+			t.Errorf("editIssueBody = %q, %v; want %q", got, err, "from-config")
+		}
+	})
+
+	t.Run("editor arguments", func(t *testing.T) {
+		useEditor(t, `echo "$1" > "$2"`)
+		config.Set("editor", config.GetString("editor")+" --wait")
+		if got, err := editIssueBody(""); err != nil || got != "--wait" {
+			t.Errorf("editIssueBody = %q, %v; want %q", got, err, "--wait")
+		}
+	})
+
+	t.Run("non-zero exit aborts", func(t *testing.T) {
+		useEditor(t, `echo "half written" > "$1"; exit 1`)
+		if _, err := editIssueBody("template"); err == nil || !strings.Contains(err.Error(), "aborting") {
+			t.Errorf("editIssueBody error = %v, want abort", err)
+		}
+	})
+
+	t.Run("empty body aborts", func(t *testing.T) {
+		useEditor(t, `printf '  \n\n' > "$1"`)
+		if _, err := editIssueBody("template"); !errors.Is(err, errEmptyEdit) {
+			t.Errorf("editIssueBody error = %v, want errEmptyEdit", err)
+		}
+	})
+}
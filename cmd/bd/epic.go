@@ -5,8 +5,11 @@ import (
 	"os"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
 )
 var epicCmd = &cobra.Command{
 	Use:     "epic",
@@ -14,9 +17,18 @@ var epicCmd = &cobra.Command{
 	Short:   "Epic management commands",
 }
 var epicStatusCmd = &cobra.Command{
-	Use:   "status",
+	Use:   "status [issue-id]",
 	Short: "Show epic completion status",
+	Long: `Show completion status of open epics, counting their direct children.
+
+With an issue ID, show the progress of that issue's whole hierarchy instead:
+children, grandchildren and so on. Deleted issues are not counted.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 1 {
+			showEpicProgress(args[0])
+			return
+		}
 		eligibleOnly, _ := cmd.Flags().GetBool("eligible-only")
 		// Use global jsonOutput set by PersistentPreRun
 		var epics []*types.EpicStatus
@@ -92,6 +104,46 @@ var epicStatusCmd = &cobra.Command{
 		}
 	},
 }
+// showEpicProgress prints the transitive progress of one parent issue.
+func showEpicProgress(issueID string) {
+	if err := ensureDirectMode("epic status <id> requires direct database access"); err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+	if !ok {
+		FatalErrorRespectJSON("epic status <id> requires SQLite storage")
+	}
+	ctx := rootCtx
+	fullID, err := utils.ResolveIssueID(ctx, store, issueID)
+	if err != nil {
+		FatalErrorRespectJSON("resolving %s: %v", issueID, err)
+	}
+	issue, err := store.GetIssue(ctx, fullID)
+	if err != nil || issue == nil {
+		FatalErrorRespectJSON("%v", fmt.Errorf("issue %s %w", fullID, storage.ErrNotFound))
+	}
+	done, total, err := sqliteStore.EpicProgress(ctx, fullID)
+	if err != nil {
+		FatalErrorRespectJSON("getting progress of %s: %v", fullID, err)
+	}
+
+	if jsonOutput {
+		outputJSON(map[string]interface{}{
+			"id":    fullID,
+			"title": issue.Title,
+			"done":  done,
+			"total": total,
+		})
+		return
+	}
+	percentage := 0
+	if total > 0 {
+		percentage = (done * 100) / total
+	}
+	fmt.Printf("%s %s\n", ui.RenderAccent(fullID), ui.RenderBold(issue.Title))
+	fmt.Printf("   Progress: %d/%d descendants done (%d%%)\n", done, total, percentage)
+}
+
 var closeEligibleEpicsCmd = &cobra.Command{
 	Use:   "close-eligible",
 	Short: "Close epics where all children are complete",
@@ -117,7 +117,7 @@ func TestEpicCommandInit(t *testing.T) {
 	// Check that subcommands exist
 	var hasStatusCmd bool
 	for _, cmd := range epicCmd.Commands() {
-		if cmd.Use == "status" {
+		if cmd.Name() == "status" {
 			hasStatusCmd = true
 		}
 	}
@@ -2,11 +2,82 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+)
+
+// Exit codes, so scripts can tell failure categories apart (e.g. retry on
+// ExitBusy). Documented in docs/CLI_REFERENCE.md.
+const (
+	ExitSuccess  = 0 // Command completed
+	ExitError    = 1 // Any other failure
+	ExitUsage    = 2 // Bad arguments, flags or unknown command
+	ExitNotFound = 3 // Issue or database not found
+	ExitBusy     = 4 // Database locked by another process
+	ExitConfig   = 5 // Invalid configuration
 )
 
-// FatalError writes an error message to stderr and exits with code 1.
+// usageError marks command-line usage mistakes so main exits with ExitUsage.
+type usageError struct{ err error }
+
+func (e usageError) Error() string { return e.err.Error() }
+func (e usageError) Unwrap() error { return e.err }
+
+// exitCodeFor picks the exit code for err.
+func exitCodeFor(err error) int {
+	var usage usageError
+	switch {
+	case err == nil:
+		return ExitSuccess
+	case errors.As(err, &usage), strings.HasPrefix(err.Error(), "unknown command"):
+		return ExitUsage
+	case errors.Is(err, storage.ErrLocked), sqlite.IsBusyError(err):
+		return ExitBusy
+	case errors.Is(err, storage.ErrNotFound):
+		return ExitNotFound
+	default:
+		return ExitError
+	}
+}
+
+// exitCodeForArgs returns the exit code for the first error among the
+// arguments of a Fatal* call, or ExitError if there is none.
+func exitCodeForArgs(args []interface{}) int {
+	for _, arg := range args {
+		if err, ok := arg.(error); ok {
+			return exitCodeFor(err)
+		}
+	}
+	return ExitError
+}
+
+// markUsageErrors wraps the argument validators of cmd and its subcommands
+// so their errors exit with ExitUsage.
+func markUsageErrors(cmd *cobra.Command) {
+	if validate := cmd.Args; validate != nil {
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			if err := validate(cmd, args); err != nil {
+				return usageError{err}
+			}
+			return nil
+		}
+	}
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return usageError{err}
+	})
+	for _, sub := range cmd.Commands() {
+		markUsageErrors(sub)
+	}
+}
+
+// FatalError writes an error message to stderr and exits. The exit code
+// comes from the first error argument (see exitCodeFor), or is ExitError.
 // Use this for fatal errors that prevent the command from completing.
 //
 // Pattern A from ERROR_HANDLING.md:
@@ -21,10 +92,17 @@ import (
 //	}
 func FatalError(format string, args ...interface{}) {
 	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
-	os.Exit(1)
+	os.Exit(exitCodeForArgs(args))
 }
 
-// FatalErrorRespectJSON writes an error message and exits with code 1.
+// FatalErrorCode is FatalError with an explicit exit code.
+func FatalErrorCode(code int, format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
+	os.Exit(code)
+}
+
+// FatalErrorRespectJSON writes an error message and exits with the same code
+// FatalError would.
 // If --json flag is set, outputs structured JSON to stdout.
 // Otherwise, outputs plain text to stderr.
 //
@@ -43,7 +121,7 @@ func FatalErrorRespectJSON(format string, args ...interface{}) {
 	} else {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", msg)
 	}
-	os.Exit(1)
+	os.Exit(exitCodeForArgs(args))
 }
 
 // FatalErrorWithHint writes an error message with a hint to stderr and exits.
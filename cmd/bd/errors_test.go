@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitSuccess},
+		{"generic", errors.New("boom"), ExitError},
+		{"usage", usageError{errors.New("accepts 1 arg(s), received 0")}, ExitUsage},
+		{"unknown command", errors.New(`unknown command "frob" for "bd"`), ExitUsage},
+		{"busy", errors.New("database is locked"), ExitBusy},
+		{"not found sentinel", fmt.Errorf("get issue: %w", sqlite.ErrNotFound), ExitNotFound},
+		{"storage not found sentinel", fmt.Errorf("update issue: %w", storage.ErrNotFound), ExitNotFound},
+		{"locked sentinel", fmt.Errorf("begin transaction: %w", storage.ErrLocked), ExitBusy},
+		{"corrupt sentinel", fmt.Errorf("open database: %w", storage.ErrCorrupt), ExitError},
+		{"no issue found", fmt.Errorf("resolving: %w", &utils.NoMatchError{Input: "bd-zz"}), ExitNotFound},
+		{"other not found", errors.New("git not found in PATH"), ExitError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCodeFor(tt.err); got != tt.want {
+				t.Errorf("exitCodeFor(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// exitCodeArgsEnv makes the test binary act as bd with the given arguments
+// (separated by newlines), so tests can observe real exit codes and the
+// effects of a whole command run, such as the flush at exit.
+const exitCodeArgsEnv = "BD_EXIT_CODE_TEST_ARGS"
+
+func TestExitCodes(t *testing.T) {
+	if args := os.Getenv(exitCodeArgsEnv); args != "" {
+		rootCmd.SetArgs(strings.Split(args, "\n"))
+		main()
+		os.Exit(ExitSuccess)
+	}
+	if testing.Short() {
+		t.Skip("skipping subprocess test in short mode")
+	}
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, ".beads", "beads.db")
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	store := newTestStore(t, dbPath)
+	store.Close()
+
+	emptyDir := t.TempDir()
+
+	run := func(t *testing.T, dir string, env []string, args ...string) int {
+		t.Helper()
+		cmd := exec.Command(os.Args[0], "-test.run=^TestExitCodes$")
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			exitCodeArgsEnv+"="+strings.Join(append([]string{"--no-daemon", "--no-auto-flush", "--no-auto-import"}, args...), "\n"),
+			"HOME="+dir,
+			"BEADS_DIR=",
+			"BEADS_DB=",
+		)
+		cmd.Env = append(cmd.Env, env...)
+		out, err := cmd.CombinedOutput()
+		var exitErr *exec.ExitError
+		switch {
+		case err == nil:
+			return ExitSuccess
+		case errors.As(err, &exitErr):
+			return exitErr.ExitCode()
+		default:
+			t.Fatalf("running bd %v: %v\n%s", args, err, out)
+			return -1
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		if got := run(t, dir, nil, "--db", dbPath, "list"); got != ExitSuccess {
+			t.Errorf("exit code = %d, want %d", got, ExitSuccess)
+		}
+	})
+	t.Run("usage", func(t *testing.T) {
+		if got := run(t, dir, nil, "--db", dbPath, "list", "--no-such-flag"); got != ExitUsage {
+			t.Errorf("unknown flag: exit code = %d, want %d", got, ExitUsage)
+		}
+		if got := run(t, dir, nil, "--db", dbPath, "show"); got != ExitUsage {
+			t.Errorf("missing argument: exit code = %d, want %d", got, ExitUsage)
+		}
+		if got := run(t, dir, nil, "--db", dbPath, "list", "--where", "bogus=1"); got != ExitUsage {
+			t.Errorf("bad --where: exit code = %d, want %d", got, ExitUsage)
+		}
+		if got := run(t, dir, nil, "--db", dbPath, "list", "--where", "status=open and ("); got != ExitUsage {
+			t.Errorf("malformed --where: exit code = %d, want %d", got, ExitUsage)
+		}
+	})
+	t.Run("issue not found", func(t *testing.T) {
+		if got := run(t, dir, nil, "--db", dbPath, "show", "bd-nope"); got != ExitNotFound {
+			t.Errorf("exit code = %d, want %d", got, ExitNotFound)
+		}
+		if got := run(t, dir, nil, "--db", dbPath, "delete", "bd-nope", "--force"); got != ExitNotFound {
+			t.Errorf("delete: exit code = %d, want %d", got, ExitNotFound)
+		}
+		if got := run(t, dir, nil, "--db", dbPath, "graph", "bd-nope"); got != ExitNotFound {
+			t.Errorf("graph: exit code = %d, want %d", got, ExitNotFound)
+		}
+		for _, args := range [][]string{
+			{"update", "bd-nope", "--status", "in_progress"},
+			{"close", "bd-nope"},
+			{"reopen", "bd-nope"},
+			{"defer", "bd-nope"},
+			{"undefer", "bd-nope"},
+			{"pin", "bd-nope"},
+			{"unpin", "bd-nope"},
+			{"label", "add", "bd-nope", "urgent"},
+			{"label", "remove", "bd-nope", "urgent"},
+		} {
+			if got := run(t, dir, nil, append([]string{"--db", dbPath}, args...)...); got != ExitNotFound {
+				t.Errorf("%s: exit code = %d, want %d", strings.Join(args, " "), got, ExitNotFound)
+			}
+		}
+	})
+	t.Run("database not found", func(t *testing.T) {
+		if got := run(t, emptyDir, nil, "list"); got != ExitNotFound {
+			t.Errorf("exit code = %d, want %d", got, ExitNotFound)
+		}
+	})
+	t.Run("busy", func(t *testing.T) {
+		ctx := context.Background()
+		holder, err := sqlite.New(ctx, dbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer holder.Close()
+		conn, err := holder.UnderlyingDB().Conn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		if _, err := conn.ExecContext(ctx, "BEGIN IMMEDIATE"); err != nil {
+			t.Fatal(err)
+		}
+		defer conn.ExecContext(ctx, "ROLLBACK")
+
+		if got := run(t, dir, nil, "--db", dbPath, "--lock-timeout", "0", "create", "blocked write"); got != ExitBusy {
+			t.Errorf("exit code = %d, want %d", got, ExitBusy)
+		}
+	})
+	t.Run("config", func(t *testing.T) {
+		if got := run(t, dir, []string{"BD_ID_SEPARATOR=??"}, "--db", dbPath, "list"); got != ExitConfig {
+			t.Errorf("exit code = %d, want %d", got, ExitConfig)
+		}
+	})
+}
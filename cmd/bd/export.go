@@ -2,6 +2,7 @@ package main
 
 import (
 	"cmp"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -10,9 +11,12 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/util"
@@ -36,7 +40,7 @@ func countIssuesInJSONL(path string) (int, error) {
 	decoder := json.NewDecoder(file)
 	for {
 		var issue types.Issue
-		if err := decoder.Decode(&issue); err != nil {
+		if err := types.DecodeIssueJSONL(decoder, &issue); err != nil {
 			if err.Error() == "EOF" {
 				break
 			}
@@ -66,7 +70,7 @@ func getIssueIDsFromJSONL(path string) (map[string]bool, error) {
 	lineNum := 0
 	for {
 		var issue types.Issue
-		if err := decoder.Decode(&issue); err != nil {
+		if err := types.DecodeIssueJSONL(decoder, &issue); err != nil {
 			if err.Error() == "EOF" {
 				break
 			}
@@ -111,6 +115,63 @@ func validateExportPath(path string) error {
 	return nil
 }
 
+// exportFilterFlags are the export flags that select a subset of issues.
+var exportFilterFlags = []string{
+	"status", "priority", "assignee", "type", "label", "label-any",
+	"priority-min", "priority-max", "created-after", "created-before",
+	"updated-after", "updated-before", "since",
+}
+
+// loadIssuesForExport returns the issues matching filter, sorted by ID, with
+// labels, dependencies and references populated. Wisps are never exported (bd-687g).
+//
+// Dependencies are kept even when their target is outside the filter, so a
+// subset export still records which issues it depends on by ID.
+func loadIssuesForExport(ctx context.Context, s storage.Storage, filter types.IssueFilter) ([]*types.Issue, error) {
+	issues, err := s.SearchIssues(ctx, "", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wisps exist only in SQLite and are shared via .beads/redirect, not JSONL.
+	filtered := make([]*types.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if !issue.Wisp {
+			filtered = append(filtered, issue)
+		}
+	}
+	issues = filtered
+
+	// Sort by ID for consistent output
+	slices.SortFunc(issues, func(a, b *types.Issue) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	// Populate dependencies for all issues in one query (avoids N+1 problem)
+	allDeps, err := s.GetAllDependencyRecords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dependencies: %w", err)
+	}
+	for _, issue := range issues {
+		issue.Dependencies = allDeps[issue.ID]
+	}
+
+	// Populate labels for all issues
+	for _, issue := range issues {
+		labels, err := s.GetLabels(ctx, issue.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting labels for %s: %w", issue.ID, err)
+		}
+		issue.Labels = labels
+	}
+
+	if err := populateReferences(ctx, s, issues); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
 var exportCmd = &cobra.Command{
 	Use:     "export",
 	GroupID: "sync",
@@ -123,7 +184,11 @@ Output to stdout by default, or use -o flag for file output.
 Examples:
   bd export --status open -o open-issues.jsonl
   bd export --type bug --priority-max 1
-  bd export --created-after 2025-01-01 --assignee alice`,
+  bd export --created-after 2025-01-01 --assignee alice
+  bd export --label backend -o .beads/backend.jsonl
+
+With any filter, the export is a subset: dependencies on issues outside the
+filter are kept as IDs, and the subset cannot be written over the main JSONL.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		format, _ := cmd.Flags().GetString("format")
 		output, _ := cmd.Flags().GetString("output")
@@ -141,6 +206,7 @@ Examples:
 		createdBefore, _ := cmd.Flags().GetString("created-before")
 		updatedAfter, _ := cmd.Flags().GetString("updated-after")
 		updatedBefore, _ := cmd.Flags().GetString("updated-before")
+		since, _ := cmd.Flags().GetString("since")
 
 		debug.Logf("Debug: export flags - output=%q, force=%v\n", output, force)
 
@@ -271,10 +337,34 @@ Examples:
 			}
 			filter.UpdatedBefore = &t
 		}
+		if since != "" {
+			t, err := parseSinceFlag(since, time.Now())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error parsing --since: %v\n", err)
+				os.Exit(1)
+			}
+			filter.UpdatedSince = &t
+		}
+
+		// A filtered export is a partial view of the database. It must not
+		// replace the main JSONL or mark anything as flushed.
+		partial := false
+		for _, name := range exportFilterFlags {
+			if cmd.Flags().Changed(name) {
+				partial = true
+				break
+			}
+		}
+		if partial && output != "" && output == findJSONLPath() {
+			fmt.Fprintf(os.Stderr, "Error: refusing to write a filtered export over %s\n", output)
+			fmt.Fprintf(os.Stderr, "  Issues outside the filter would be dropped from the synced JSONL.\n")
+			fmt.Fprintf(os.Stderr, "Hint: write the subset to a different file with -o\n")
+			os.Exit(1)
+		}
 
 		// Get all issues
 		ctx := rootCtx
-		issues, err := store.SearchIssues(ctx, "", filter)
+		issues, err := loadIssuesForExport(ctx, store, filter)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
@@ -310,9 +400,19 @@ Examples:
 			}
 			
 			if err == nil && len(jsonlIDs) > 0 {
-				// Build set of DB issue IDs
+				// Build set of DB issue IDs. For a filtered export, an issue
+				// that no longer matches the filter is not lost, so compare
+				// against the whole database.
 				dbIDs := make(map[string]bool)
-				for _, issue := range issues {
+				dbIssues := issues
+				if partial {
+					dbIssues, err = store.SearchIssues(ctx, "", types.IssueFilter{IncludeTombstones: true})
+					if err != nil {
+						fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+						os.Exit(1)
+					}
+				}
+				for _, issue := range dbIssues {
 					dbIDs[issue.ID] = true
 				}
 				
@@ -358,41 +458,6 @@ Examples:
 			}
 		}
 
-		// Filter out wisps - they should never be exported to JSONL (bd-687g)
-		// Wisps exist only in SQLite and are shared via .beads/redirect, not JSONL.
-		filtered := make([]*types.Issue, 0, len(issues))
-		for _, issue := range issues {
-			if !issue.Wisp {
-				filtered = append(filtered, issue)
-			}
-		}
-		issues = filtered
-
-		// Sort by ID for consistent output
-		slices.SortFunc(issues, func(a, b *types.Issue) int {
-			return cmp.Compare(a.ID, b.ID)
-		})
-
-		// Populate dependencies for all issues in one query (avoids N+1 problem)
-		allDeps, err := store.GetAllDependencyRecords(ctx)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error getting dependencies: %v\n", err)
-			os.Exit(1)
-		}
-		for _, issue := range issues {
-			issue.Dependencies = allDeps[issue.ID]
-		}
-
-		// Populate labels for all issues
-		for _, issue := range issues {
-			labels, err := store.GetLabels(ctx, issue.ID)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error getting labels for %s: %v\n", issue.ID, err)
-				os.Exit(1)
-			}
-			issue.Labels = labels
-		}
-
 		// Open output
 		out := os.Stdout
 		var tempFile *os.File
@@ -405,6 +470,16 @@ Examples:
 				os.Exit(1)
 			}
 
+			// Serialize with auto-flush and daemon exports of the project JSONL (see lockJSONL)
+			if output == findJSONLPath() {
+				unlock, err := lockJSONL(output)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					os.Exit(1)
+				}
+				defer unlock()
+			}
+
 			// Create temporary file in same directory for atomic rename
 			dir := filepath.Dir(output)
 			base := filepath.Base(output)
@@ -429,11 +504,17 @@ Examples:
 		}
 
 		// Write JSONL (timestamp-only deduplication DISABLED due to bd-160)
-		encoder := json.NewEncoder(out)
+		timestampFormat := config.TimestampFormat()
 		exportedIDs := make([]string, 0, len(issues))
 		skippedCount := 0
 		for _, issue := range issues {
-			if err := encoder.Encode(issue); err != nil {
+			// Stop before the temp file replaces the output, if interrupted
+			if err := ctx.Err(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: export interrupted: %v\n", err)
+				os.Exit(1)
+			}
+			issue.SchemaVersion = types.JSONLSchemaVersion
+			if err := types.EncodeIssueJSONL(out, issue, timestampFormat); err != nil {
 			 fmt.Fprintf(os.Stderr, "Error encoding issue %s: %v\n", issue.ID, err)
 			 os.Exit(1)
 			}
@@ -448,7 +529,7 @@ Examples:
 
 		// Only clear dirty issues and auto-flush state if exporting to the default JSONL path
 		// This prevents clearing dirty flags when exporting to custom paths (e.g., bd export -o backup.jsonl)
-		if output == "" || output == findJSONLPath() {
+		if !partial && (output == "" || output == findJSONLPath()) {
 			// Clear only the issues that were actually exported (fixes bd-52 race condition)
 			if err := store.ClearDirtyIssuesByID(ctx, exportedIDs); err != nil {
 				fmt.Fprintf(os.Stderr, "Warning: failed to clear dirty issues: %v\n", err)
@@ -486,11 +567,11 @@ Examples:
 				os.Exit(1)
 			}
 
-			// Set appropriate file permissions (0600: rw-------)
+			// Set appropriate file permissions (0600: rw------- unless file-mode is configured)
 			// Skip chmod for symlinks - os.Chmod follows symlinks and would change the target's
 			// permissions, which may be in a read-only location (e.g., /nix/store on NixOS).
 			if info, err := os.Lstat(finalPath); err == nil && info.Mode()&os.ModeSymlink == 0 {
-				if err := os.Chmod(finalPath, 0600); err != nil {
+				if err := os.Chmod(finalPath, config.FileMode(0600)); err != nil {
 					fmt.Fprintf(os.Stderr, "Warning: failed to set file permissions: %v\n", err)
 				}
 			}
@@ -562,6 +643,7 @@ func init() {
 	exportCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD or RFC3339)")
 	exportCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD or RFC3339)")
 	exportCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD or RFC3339)")
+	exportCmd.Flags().String("since", "", "Export only issues updated at or after a time (24h, 7d, YYYY-MM-DD, or RFC3339)")
 
 	rootCmd.AddCommand(exportCmd)
 }
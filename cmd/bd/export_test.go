@@ -383,3 +383,41 @@ func TestExportCommand(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadIssuesForExportFiltered(t *testing.T) {
+	tmpDir := t.TempDir()
+	s := newTestStore(t, filepath.Join(tmpDir, "test.db"))
+	defer s.Close()
+	ctx := context.Background()
+
+	backend := &types.Issue{ID: "test-1", Title: "API", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Assignee: "alice"}
+	frontend := &types.Issue{ID: "test-2", Title: "UI", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Assignee: "alice"}
+	otherOwner := &types.Issue{ID: "test-3", Title: "DB", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, Assignee: "bob"}
+	for _, issue := range []*types.Issue{backend, frontend, otherOwner} {
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+	for _, id := range []string{backend.ID, otherOwner.ID} {
+		if err := s.AddLabel(ctx, id, "backend", "test"); err != nil {
+			t.Fatalf("AddLabel failed: %v", err)
+		}
+	}
+	// The backend issue depends on the frontend one, which the filter excludes
+	if err := s.AddDependency(ctx, &types.Dependency{IssueID: backend.ID, DependsOnID: frontend.ID, Type: types.DepBlocks}, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+
+	assignee := "alice"
+	issues, err := loadIssuesForExport(ctx, s, types.IssueFilter{Labels: []string{"backend"}, Assignee: &assignee})
+	if err != nil {
+		t.Fatalf("loadIssuesForExport failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].ID != backend.ID {
+		t.Fatalf("exported %d issues, want only %s", len(issues), backend.ID)
+	}
+	deps := issues[0].Dependencies
+	if len(deps) != 1 || deps[0].DependsOnID != frontend.ID {
+		t.Errorf("dependencies = %v, want reference to filtered-out %s", deps, frontend.ID)
+	}
+}
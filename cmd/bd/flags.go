@@ -6,6 +6,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/validation"
 )
 
 // registerCommonIssueFlags registers flags common to create and update commands.
@@ -134,3 +136,17 @@ func readBodyFile(filePath string) (string, error) {
 func registerPriorityFlag(cmd *cobra.Command, defaultVal string) {
 	cmd.Flags().StringP("priority", "p", defaultVal, "Priority (0-4 or P0-P4, 0=highest)")
 }
+
+// newIssuePriority returns the priority for an issue being created: --priority
+// if given, otherwise the default-priority config. Both accept 0-4 or P0-P4.
+func newIssuePriority(cmd *cobra.Command) (int, error) {
+	if cmd.Flags().Changed("priority") {
+		priorityStr, _ := cmd.Flags().GetString("priority")
+		return validation.ValidatePriority(priorityStr)
+	}
+	priority, err := validation.ValidatePriority(config.GetString("default-priority"))
+	if err != nil {
+		return -1, fmt.Errorf("invalid default-priority config: %w", err)
+	}
+	return priority, nil
+}
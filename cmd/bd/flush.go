@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// lastFlushKey is the metadata key of the flush watermark: when the last
+// export of the database to the JSONL started. Issues updated after it are
+// not in the JSONL yet.
+const lastFlushKey = "last_flush_time"
+
+var flushCmd = &cobra.Command{
+	Use:     "flush",
+	GroupID: "sync",
+	Short:   "Export the database to the JSONL now, without waiting for flush-debounce",
+	Long: `Write the database to the JSONL immediately instead of waiting for the
+flush-debounce delay, e.g. before committing. The export runs in this process
+whether or not a daemon is running; a running daemon is then told to drop its
+pending export, since the JSONL is already current.
+
+With no-auto-flush set, bd flush refuses unless --force is given.
+
+Examples:
+  bd flush
+  bd flush && git add .beads/issues.jsonl
+  bd flush --force --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("flush")
+		force, _ := cmd.Flags().GetBool("force")
+		if noDb {
+			FatalErrorRespectJSON("nothing to flush in --no-db mode: the JSONL is written after every command")
+		}
+		if !autoFlushEnabled && !force {
+			FatalErrorRespectJSON("auto-flush is disabled (no-auto-flush); pass --force to flush anyway")
+		}
+
+		notifyDaemon := daemonClient != nil
+		if err := ensureDirectMode("flush writes the JSONL from the database directly"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		jsonlPath, count, err := flushJSONL()
+		if err != nil {
+			FatalErrorRespectJSON("flush failed: %v", err)
+		}
+		if notifyDaemon {
+			notifyDaemonFlushed()
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"path": jsonlPath, "issues": count})
+			return
+		}
+		fmt.Printf("%s Flushed %d issue(s) to %s\n", ui.RenderPass("✓"), count, jsonlPath)
+	},
+}
+
+// flushJSONL does a full export of the store to the JSONL right away and
+// returns the JSONL's path and the number of issues in it.
+func flushJSONL() (string, int, error) {
+	flushMutex.Lock()
+	lastFlushError = nil
+	flushMutex.Unlock()
+
+	flushToJSONLWithState(flushState{forceDirty: true, forceFullExport: true})
+
+	flushMutex.Lock()
+	err := lastFlushError
+	flushMutex.Unlock()
+	if err != nil {
+		return "", 0, err
+	}
+	jsonlPath := findJSONLPath()
+	count, err := countIssuesInJSONL(jsonlPath)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read %s: %w", jsonlPath, err)
+	}
+	return jsonlPath, count, nil
+}
+
+// notifyDaemonFlushed tells the daemon the JSONL was just flushed so it resets
+// its export debounce. A daemon that can't be reached, or predates the
+// request, will simply export again later, so failures are only logged.
+func notifyDaemonFlushed() {
+	client, err := rpc.TryConnect(getSocketPath())
+	if err != nil || client == nil {
+		debug.Logf("flush: daemon not reachable: %v", err)
+		return
+	}
+	defer func() { _ = client.Close() }()
+	if err := client.NotifyFlushed(); err != nil {
+		debug.Logf("flush: failed to notify daemon: %v", err)
+	}
+}
+
+// setFlushWatermark records that the database was exported to the JSONL as
+// of at, which should be taken before the export read the issues.
+func setFlushWatermark(ctx context.Context, s storage.Storage, at time.Time) error {
+	if err := s.SetMetadata(ctx, lastFlushKey, at.UTC().Format(time.RFC3339Nano)); err != nil {
+		return fmt.Errorf("failed to update %s: %w", lastFlushKey, err)
+	}
+	return nil
+}
+
+// flushStatus says whether the JSONL has the database's latest changes.
+type flushStatus struct {
+	LastFlush *time.Time `json:"last_flush,omitempty"`
+	Pending   int        `json:"pending"` // Issues created, updated or deleted since the last flush
+	UpToDate  bool       `json:"up_to_date"`
+}
+
+// getFlushStatus counts the issues changed since the database and the JSONL
+// were last in sync: the flush watermark or, if later, the last import, which
+// brings in issues updated elsewhere. With neither, every issue is pending.
+func getFlushStatus(ctx context.Context, s storage.Storage) (*flushStatus, error) {
+	status := &flushStatus{}
+	for _, key := range []string{lastFlushKey, "last_import_time"} {
+		value, err := s.GetMetadata(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil && (status.LastFlush == nil || t.After(*status.LastFlush)) {
+			status.LastFlush = &t
+		}
+	}
+
+	issues, err := s.SearchIssues(ctx, "", types.IssueFilter{IncludeTombstones: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+	for _, issue := range issues {
+		if status.LastFlush == nil || issue.UpdatedAt.After(*status.LastFlush) {
+			status.Pending++
+		}
+	}
+	status.UpToDate = status.Pending == 0
+	return status, nil
+}
+
+func init() {
+	flushCmd.Flags().Bool("force", false, "Flush even if no-auto-flush is set")
+	rootCmd.AddCommand(flushCmd)
+}
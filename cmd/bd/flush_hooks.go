@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/hooks"
+)
+
+// flushHookTimeout bounds how long a flush hook may run.
+const flushHookTimeout = 30 * time.Second
+
+// runFlushHook runs the executable named by the config key hookKey (such as
+// "pre-flush-hook") from the project root, passing jsonlPath as its only
+// argument and env as extra environment variables. Relative paths are
+// resolved against the project root; bare names are looked up in PATH. The
+// hook's output goes to logf.
+//
+// A failing hook returns an error unless "<hookKey>-optional" is set, in which
+// case the failure is only logged.
+func runFlushHook(hookKey, jsonlPath string, env []string, logf func(format string, args ...interface{})) error {
+	hook := config.GetString(hookKey)
+	if hook == "" {
+		return nil
+	}
+
+	projectDir := filepath.Dir(filepath.Dir(jsonlPath))
+	path := hook
+	if !filepath.IsAbs(path) && strings.ContainsAny(path, `/\`) {
+		path = filepath.Join(projectDir, path)
+	}
+
+	output, err := hooks.RunCommand(path, projectDir, env, flushHookTimeout, jsonlPath)
+	if out := strings.TrimRight(string(output), "\n"); out != "" {
+		logf("%s output:\n%s", hookKey, out)
+	}
+	if err == nil {
+		return nil
+	}
+	if config.GetBool(hookKey + "-optional") {
+		logf("%s %s failed (optional, continuing): %v", hookKey, hook, err)
+		return nil
+	}
+	return fmt.Errorf("%s %s failed: %w", hookKey, hook, err)
+}
+
+// postFlushHookEnv describes a completed flush to the post-flush hook:
+// BD_FLUSH_ISSUE_COUNT is the number of issues in the JSONL file and
+// BD_FLUSH_EXPORTED_COUNT the number written by this flush.
+func postFlushHookEnv(issueCount, exportedCount int) []string {
+	return []string{
+		fmt.Sprintf("BD_FLUSH_ISSUE_COUNT=%d", issueCount),
+		fmt.Sprintf("BD_FLUSH_EXPORTED_COUNT=%d", exportedCount),
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// writeHookScript writes an executable shell script to dir/name.
+func writeHookScript(t *testing.T, dir, name, body string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0755); err != nil {
+		t.Fatalf("failed to write hook: %v", err)
+	}
+	return path
+}
+
+// setFlushHookConfig sets a flush hook config key for the duration of the test.
+func setFlushHookConfig(t *testing.T, key string, value interface{}) {
+	t.Helper()
+	old := config.GetString(key)
+	config.Set(key, value)
+	t.Cleanup(func() { config.Set(key, old) })
+}
+
+// initFlushHookConfig resets config and skips where shell hooks can't run.
+func initFlushHookConfig(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("hook scripts are shell scripts")
+	}
+	// Initialize outside the repo so its .beads/config.yaml doesn't leak into later tests
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize failed: %v", err)
+	}
+}
+
+func TestRunFlushHook(t *testing.T) {
+	initFlushHookConfig(t)
+	projectDir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	beadsDir := filepath.Join(projectDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+
+	var logged []string
+	logf := func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}
+
+	t.Run("no hook configured", func(t *testing.T) {
+		setFlushHookConfig(t, "pre-flush-hook", "")
+		if err := runFlushHook("pre-flush-hook", jsonlPath, nil, logf); err != nil {
+			t.Errorf("runFlushHook() = %v, want nil", err)
+		}
+	})
+
+	t.Run("runs from the project root", func(t *testing.T) {
+		logged = nil
+		marker := filepath.Join(projectDir, "ran")
+		writeHookScript(t, beadsDir, "pre-flush", `pwd > ran; echo "$1" >> ran; echo formatted`)
+		setFlushHookConfig(t, "pre-flush-hook", ".beads/pre-flush")
+
+		if err := runFlushHook("pre-flush-hook", jsonlPath, nil, logf); err != nil {
+			t.Fatalf("runFlushHook() = %v", err)
+		}
+		data, err := os.ReadFile(marker)
+		if err != nil {
+			t.Fatalf("hook did not run: %v", err)
+		}
+		if want := projectDir + "\n" + jsonlPath + "\n"; string(data) != want {
+			t.Errorf("hook saw %q, want cwd and JSONL path %q", data, want)
+		}
+		if len(logged) != 1 || !strings.Contains(logged[0], "formatted") {
+			t.Errorf("hook output not logged: %q", logged)
+		}
+	})
+
+	t.Run("failure aborts", func(t *testing.T) {
+		hook := writeHookScript(t, beadsDir, "fail", "echo nope >&2; exit 3")
+		setFlushHookConfig(t, "pre-flush-hook", hook)
+		setFlushHookConfig(t, "pre-flush-hook-optional", false)
+
+		err := runFlushHook("pre-flush-hook", jsonlPath, nil, logf)
+		if err == nil || !strings.Contains(err.Error(), "pre-flush-hook") {
+			t.Errorf("runFlushHook() = %v, want a pre-flush-hook error", err)
+		}
+	})
+
+	t.Run("optional failure continues", func(t *testing.T) {
+		logged = nil
+		hook := writeHookScript(t, beadsDir, "fail", "exit 1")
+		setFlushHookConfig(t, "pre-flush-hook", hook)
+		setFlushHookConfig(t, "pre-flush-hook-optional", true)
+
+		if err := runFlushHook("pre-flush-hook", jsonlPath, nil, logf); err != nil {
+			t.Errorf("runFlushHook() = %v, want nil for an optional hook", err)
+		}
+		if len(logged) == 0 {
+			t.Error("expected the optional failure to be logged")
+		}
+	})
+}
+
+// setupFlushHookStore points the global store at a fresh database holding
+// one issue and returns the JSONL path a flush will write.
+func setupFlushHookStore(t *testing.T) string {
+	t.Helper()
+	ctx := context.Background()
+	oldRootCtx, oldDBPath, oldStore := rootCtx, dbPath, store
+	t.Cleanup(func() {
+		rootCtx, dbPath, store = oldRootCtx, oldDBPath, oldStore
+		storeMutex.Lock()
+		storeActive = false
+		storeMutex.Unlock()
+		flushMutex.Lock()
+		flushFailureCount = 0
+		lastFlushError = nil
+		flushMutex.Unlock()
+	})
+	rootCtx = ctx
+
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	dbPath = filepath.Join(beadsDir, "beads.db")
+	testStore := newTestStore(t, dbPath)
+	store = testStore
+	storeMutex.Lock()
+	storeActive = true
+	storeMutex.Unlock()
+
+	issue := &types.Issue{Title: "Flushed", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := testStore.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	return filepath.Join(beadsDir, "issues.jsonl")
+}
+
+func TestPreFlushHookAbortsFlush(t *testing.T) {
+	initFlushHookConfig(t)
+	jsonlPath := setupFlushHookStore(t)
+
+	setFlushHookConfig(t, "pre-flush-hook", writeHookScript(t, t.TempDir(), "fail", "exit 1"))
+	setFlushHookConfig(t, "pre-flush-hook-optional", false)
+	flushToJSONLWithState(flushState{forceDirty: true, forceFullExport: true})
+	if _, err := os.Stat(jsonlPath); !os.IsNotExist(err) {
+		t.Fatalf("JSONL written despite failing pre-flush hook (err=%v)", err)
+	}
+	flushMutex.Lock()
+	failed := lastFlushError != nil
+	flushMutex.Unlock()
+	if !failed {
+		t.Error("expected the aborted flush to be recorded as a failure")
+	}
+
+	setFlushHookConfig(t, "pre-flush-hook", writeHookScript(t, t.TempDir(), "ok", "exit 0"))
+	flushToJSONLWithState(flushState{forceDirty: true, forceFullExport: true})
+	if _, err := os.Stat(jsonlPath); err != nil {
+		t.Fatalf("JSONL not written after passing pre-flush hook: %v", err)
+	}
+}
+
+func TestPostFlushHook(t *testing.T) {
+	initFlushHookConfig(t)
+	jsonlPath := setupFlushHookStore(t)
+
+	hookDir := t.TempDir()
+	marker := filepath.Join(hookDir, "seen")
+	hook := writeHookScript(t, hookDir, "post-flush",
+		`test -f "$1" && echo "$1 $BD_FLUSH_ISSUE_COUNT $BD_FLUSH_EXPORTED_COUNT" > `+marker)
+	setFlushHookConfig(t, "post-flush-hook", hook)
+
+	flushToJSONLWithState(flushState{forceDirty: true, forceFullExport: true})
+	data, err := os.ReadFile(marker)
+	if err != nil {
+		t.Fatalf("post-flush hook did not run after the JSONL was written: %v", err)
+	}
+	if want := jsonlPath + " 1 1\n"; string(data) != want {
+		t.Errorf("hook saw %q, want %q", data, want)
+	}
+
+	t.Run("failure does not fail the flush", func(t *testing.T) {
+		setFlushHookConfig(t, "post-flush-hook", writeHookScript(t, hookDir, "fail", "exit 1"))
+		flushToJSONLWithState(flushState{forceDirty: true, forceFullExport: true})
+		flushMutex.Lock()
+		failErr := lastFlushError
+		flushMutex.Unlock()
+		if failErr != nil {
+			t.Errorf("failing post-flush hook recorded a flush failure: %v", failErr)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFlushJSONL_WritesImmediately(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	oldRootCtx, oldDBPath, oldStore := rootCtx, dbPath, store
+	defer func() {
+		rootCtx, dbPath, store = oldRootCtx, oldDBPath, oldStore
+		storeMutex.Lock()
+		storeActive = false
+		storeMutex.Unlock()
+	}()
+
+	tmpDir := t.TempDir()
+	t.Chdir(tmpDir)
+	rootCtx = ctx
+	dbPath = filepath.Join(tmpDir, ".beads", "beads.db")
+	store = newTestStore(t, dbPath)
+	storeMutex.Lock()
+	storeActive = true
+	storeMutex.Unlock()
+
+	// A long debounce that would never fire during the test
+	oldFlushManager := flushManager
+	flushManager = NewFlushManager(true, time.Hour)
+	defer func() {
+		_ = flushManager.Shutdown()
+		flushManager = oldFlushManager
+	}()
+
+	issue := &types.Issue{Title: "Flush me", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	markDirtyAndScheduleFlush()
+
+	jsonlPath, count, err := flushJSONL()
+	if err != nil {
+		t.Fatalf("flushJSONL() error = %v", err)
+	}
+	if want := filepath.Join(tmpDir, ".beads", "issues.jsonl"); jsonlPath != want {
+		t.Errorf("flushJSONL() path = %q, want %q", jsonlPath, want)
+	}
+	if count != 1 {
+		t.Errorf("flushJSONL() count = %d, want 1", count)
+	}
+	data, err := os.ReadFile(jsonlPath)
+	if err != nil {
+		t.Fatalf("JSONL not written: %v", err)
+	}
+	if !strings.Contains(string(data), issue.ID) {
+		t.Errorf("JSONL lacks %s:\n%s", issue.ID, data)
+	}
+	if dirty, _ := store.GetDirtyIssues(ctx); len(dirty) != 0 {
+		t.Errorf("dirty issues after flush = %v, want none", dirty)
+	}
+}
+
+func TestFlushStatus_PendingThenUpToDate(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	oldRootCtx, oldDBPath, oldStore := rootCtx, dbPath, store
+	defer func() {
+		rootCtx, dbPath, store = oldRootCtx, oldDBPath, oldStore
+		storeMutex.Lock()
+		storeActive = false
+		storeMutex.Unlock()
+	}()
+
+	tmpDir := t.TempDir()
+	t.Chdir(tmpDir)
+	rootCtx = ctx
+	dbPath = filepath.Join(tmpDir, ".beads", "beads.db")
+	store = newTestStore(t, dbPath)
+	storeMutex.Lock()
+	storeActive = true
+	storeMutex.Unlock()
+
+	issue := &types.Issue{Title: "Pending", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	status, err := getFlushStatus(ctx, store)
+	if err != nil {
+		t.Fatalf("getFlushStatus() error = %v", err)
+	}
+	if status.UpToDate || status.Pending != 1 || status.LastFlush != nil {
+		t.Errorf("before any flush: %+v, want 1 pending and no last flush", status)
+	}
+
+	if _, _, err := flushJSONL(); err != nil {
+		t.Fatalf("flushJSONL() error = %v", err)
+	}
+	status, err = getFlushStatus(ctx, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.UpToDate || status.Pending != 0 || status.LastFlush == nil {
+		t.Errorf("after flush: %+v, want up to date", status)
+	}
+
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": "Changed"}, "test"); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	if status, _ = getFlushStatus(ctx, store); status.UpToDate || status.Pending != 1 {
+		t.Errorf("after update: %+v, want 1 pending", status)
+	}
+}
@@ -233,7 +233,7 @@ var gateShowCmd = &cobra.Command{
 				FatalError("failed to parse gate: %v", err)
 			}
 		} else if store != nil {
-			gateID, err := utils.ResolvePartialID(ctx, store, args[0])
+			gateID, err := utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
@@ -246,7 +246,7 @@ var gateShowCmd = &cobra.Command{
 			}
 			if gate == nil {
 				fmt.Fprintf(os.Stderr, "Error: gate %s not found\n", gateID)
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 			if gate.IssueType != types.TypeGate {
 				fmt.Fprintf(os.Stderr, "Error: %s is not a gate (type: %s)\n", gateID, gate.IssueType)
@@ -391,7 +391,7 @@ var gateCloseCmd = &cobra.Command{
 			gateID = closedGate.ID
 		} else if store != nil {
 			var err error
-			gateID, err = utils.ResolvePartialID(ctx, store, args[0])
+			gateID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
@@ -405,7 +405,7 @@ var gateCloseCmd = &cobra.Command{
 			}
 			if gate == nil {
 				fmt.Fprintf(os.Stderr, "Error: gate %s not found\n", gateID)
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 			if gate.IssueType != types.TypeGate {
 				fmt.Fprintf(os.Stderr, "Error: %s is not a gate (type: %s)\n", gateID, gate.IssueType)
@@ -472,7 +472,7 @@ var gateWaitCmd = &cobra.Command{
 			newWaiters = nil
 		} else if store != nil {
 			var err error
-			gateID, err = utils.ResolvePartialID(ctx, store, args[0])
+			gateID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
@@ -486,7 +486,7 @@ var gateWaitCmd = &cobra.Command{
 			}
 			if gate == nil {
 				fmt.Fprintf(os.Stderr, "Error: gate %s not found\n", gateID)
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 			if gate.IssueType != types.TypeGate {
 				fmt.Fprintf(os.Stderr, "Error: %s is not a gate (type: %s)\n", gateID, gate.IssueType)
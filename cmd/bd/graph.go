@@ -62,7 +62,7 @@ Colors indicate status:
 			resp, err := daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: issue '%s' not found\n", args[0])
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 			if err := json.Unmarshal(resp.Data, &issueID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -70,10 +70,10 @@ Colors indicate status:
 			}
 		} else if store != nil {
 			var err error
-			issueID, err = utils.ResolvePartialID(ctx, store, args[0])
+			issueID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: issue '%s' not found\n", args[0])
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 		} else {
 			fmt.Fprintf(os.Stderr, "Error: no database connection\n")
@@ -121,7 +121,7 @@ func loadGraphSubgraph(ctx context.Context, s storage.Storage, issueID string) (
 		return nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 	if root == nil {
-		return nil, fmt.Errorf("issue %s not found", issueID)
+		return nil, fmt.Errorf("issue %s %w", issueID, storage.ErrNotFound)
 	}
 
 	subgraph := &TemplateSubgraph{
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"bytes"
 	"cmp"
-	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -14,6 +13,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
@@ -35,6 +35,10 @@ Behavior:
   - Collisions (same ID, different content) are detected and reported
   - Use --dedupe-after to find and merge content duplicates after import
   - Use --dry-run to preview changes without applying them
+  - Use --import-prefix (or the import-prefix config key) when importing from
+    GitHub or another beads project: every imported issue gets a new ID under
+    that prefix, references to the old IDs are rewritten, and the mapping is
+    remembered so importing the same issues again updates them
 
 NOTE: Import requires direct database access and does not work with daemon mode.
       The command automatically uses --no-daemon when executed.`,
@@ -94,6 +98,10 @@ NOTE: Import requires direct database access and does not work with daemon mode.
 		strict, _ := cmd.Flags().GetBool("strict")
 		dryRun, _ := cmd.Flags().GetBool("dry-run")
 		renameOnImport, _ := cmd.Flags().GetBool("rename-on-import")
+		importPrefix := config.GetString("import-prefix")
+		if cmd.Flags().Changed("import-prefix") {
+			importPrefix, _ = cmd.Flags().GetString("import-prefix")
+		}
 		dedupeAfter, _ := cmd.Flags().GetBool("dedupe-after")
 		clearDuplicateExternalRefs, _ := cmd.Flags().GetBool("clear-duplicate-external-refs")
 		orphanHandling, _ := cmd.Flags().GetString("orphan-handling")
@@ -203,7 +211,7 @@ NOTE: Import requires direct database access and does not work with daemon mode.
 
 			// Parse JSON
 			var issue types.Issue
-			if err := json.Unmarshal([]byte(line), &issue); err != nil {
+			if err := types.UnmarshalJSONLRecord([]byte(line), &issue); err != nil {
 				fmt.Fprintf(os.Stderr, "Error parsing line %d: %v\n", lineNum, err)
 				os.Exit(1)
 			}
@@ -258,6 +266,7 @@ NOTE: Import requires direct database access and does not work with daemon mode.
 			SkipUpdate:                 skipUpdate,
 			Strict:                     strict,
 			RenameOnImport:             renameOnImport,
+			ImportPrefix:               importPrefix,
 			ClearDuplicateExternalRefs: clearDuplicateExternalRefs,
 			OrphanHandling:             orphanHandling,
 		}
@@ -778,6 +787,7 @@ func init() {
 	importCmd.Flags().Bool("dedupe-after", false, "Detect and report content duplicates after import")
 	importCmd.Flags().Bool("dry-run", false, "Preview collision detection without making changes")
 	importCmd.Flags().Bool("rename-on-import", false, "Rename imported issues to match database prefix (updates all references)")
+	importCmd.Flags().String("import-prefix", "", "Give imported issues new IDs under this prefix, e.g. gh (default: import-prefix config)")
 	importCmd.Flags().Bool("clear-duplicate-external-refs", false, "Clear duplicate external_ref values (keeps first occurrence)")
 	importCmd.Flags().String("orphan-handling", "", "How to handle missing parent issues: strict/resurrect/skip/allow (default: use config or 'allow')")
 	importCmd.Flags().Bool("force", false, "Force metadata update even when database is already in sync with JSONL")
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// taskItemRegex matches a GFM task list item: "- [ ] text" or "* [x] text".
+var taskItemRegex = regexp.MustCompile(`^([ \t]*)(?:[-*+]|\d+[.)])\s+\[([ xX])\]\s+(.+?)\s*$`)
+
+// checklistMarkerPrefix prefixes the external_ref that ties an issue to its
+// checklist item, so re-importing the same file updates instead of duplicating.
+const checklistMarkerPrefix = "md:"
+
+var importMarkdownCmd = &cobra.Command{
+	Use:   "md <file>",
+	Short: "Import issues from a Markdown checklist",
+	Long: `Create issues from the GFM task list items in a Markdown file, such as an
+existing TODO.md. Other lines are ignored.
+
+  - [ ] Unchecked items become open issues
+  - [x] Checked items become closed issues
+    - [ ] Nested items become children of the item above them
+
+New issues get IDs with the configured prefix; nested items get child IDs
+and a parent-child link. Each issue records a marker in external_ref derived
+from its text and the text of its parents, so importing the file again
+updates the existing issues: checking or unchecking an item closes or
+reopens its issue. Editing an item's text makes it a new issue.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("import md")
+		if err := ensureDirectMode("import md requires direct database access"); err != nil {
+			FatalError("%v", err)
+		}
+
+		path, err := validateMarkdownPath(args[0])
+		if err != nil {
+			FatalError("%v", err)
+		}
+		// #nosec G304 - path validated by validateMarkdownPath
+		f, err := os.Open(path)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		items, err := parseMarkdownChecklist(f)
+		_ = f.Close()
+		if err != nil {
+			FatalError("parsing %s: %v", path, err)
+		}
+		if len(items) == 0 {
+			FatalError("no task list items (- [ ] ...) found in %s", path)
+		}
+
+		result, err := importChecklist(rootCtx, store, items, filepath.Base(path), actor)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		if result.Created+result.Closed+result.Reopened > 0 {
+			markDirtyAndScheduleFlush()
+		}
+
+		if jsonOutput {
+			outputJSON(result)
+			return
+		}
+		fmt.Printf("%s Imported %d checklist items from %s: %d created, %d closed, %d reopened, %d unchanged\n",
+			ui.RenderPass("✓"), len(items), path, result.Created, result.Closed, result.Reopened, result.Unchanged)
+	},
+}
+
+// checklistItem is one task list item from a Markdown checklist.
+type checklistItem struct {
+	Title   string
+	Checked bool
+	Parent  int    // Index of the enclosing item, or -1 at top level
+	Marker  string // Stable external_ref identifying the item across imports
+}
+
+// parseMarkdownChecklist extracts the task list items from r in document
+// order, so every parent comes before its children. An item is nested under
+// the closest preceding item with less indentation (a tab counts as four
+// spaces).
+func parseMarkdownChecklist(r io.Reader) ([]*checklistItem, error) {
+	type open struct{ indent, index int }
+	var (
+		items []*checklistItem
+		stack []open
+		paths []string // Title path of each item, for its marker
+		seen  = map[string]int{}
+	)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		m := taskItemRegex.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		indent := len(strings.ReplaceAll(m[1], "\t", "    "))
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		item := &checklistItem{Title: m[3], Checked: m[2] != " ", Parent: -1}
+		path := item.Title
+		if len(stack) > 0 {
+			item.Parent = stack[len(stack)-1].index
+			path = paths[item.Parent] + "\n" + path
+		}
+
+		// Identical items under the same parent are told apart by position
+		sum := sha256.Sum256([]byte(path))
+		item.Marker = checklistMarkerPrefix + hex.EncodeToString(sum[:6])
+		seen[item.Marker]++
+		if n := seen[item.Marker]; n > 1 {
+			item.Marker = fmt.Sprintf("%s-%d", item.Marker, n)
+		}
+
+		stack = append(stack, open{indent: indent, index: len(items)})
+		paths = append(paths, path)
+		items = append(items, item)
+	}
+	return items, scanner.Err()
+}
+
+// checklistImportResult summarizes an import md run.
+type checklistImportResult struct {
+	Created   int      `json:"created"`
+	Closed    int      `json:"closed"`
+	Reopened  int      `json:"reopened"`
+	Unchanged int      `json:"unchanged"`
+	IDs       []string `json:"ids"` // Issue ID for each item, in document order
+}
+
+// importChecklist creates or updates one issue per checklist item, matching
+// items to issues by marker. source names the file in close reasons.
+func importChecklist(ctx context.Context, s storage.Storage, items []*checklistItem, source, actor string) (*checklistImportResult, error) {
+	result := &checklistImportResult{IDs: make([]string, len(items))}
+	for i, item := range items {
+		existing, err := s.GetIssueByExternalRef(ctx, item.Marker)
+		if err != nil {
+			return nil, fmt.Errorf("looking up %q: %w", item.Title, err)
+		}
+
+		if existing == nil {
+			id, err := createChecklistIssue(ctx, s, item, result.IDs, actor)
+			if err != nil {
+				return nil, err
+			}
+			result.IDs[i] = id
+			result.Created++
+			if item.Checked {
+				if err := s.CloseIssue(ctx, id, "Checked in "+source, actor); err != nil {
+					return nil, fmt.Errorf("closing %s: %w", id, err)
+				}
+			}
+			continue
+		}
+
+		result.IDs[i] = existing.ID
+		switch {
+		case existing.Status == types.StatusTombstone:
+			// Deleted on purpose; don't bring it back
+			result.Unchanged++
+		case item.Checked && existing.Status != types.StatusClosed:
+			if err := s.CloseIssue(ctx, existing.ID, "Checked in "+source, actor); err != nil {
+				return nil, fmt.Errorf("closing %s: %w", existing.ID, err)
+			}
+			result.Closed++
+		case !item.Checked && existing.Status == types.StatusClosed:
+			updates := map[string]interface{}{"status": string(types.StatusOpen)}
+			if err := s.UpdateIssue(ctx, existing.ID, updates, actor); err != nil {
+				return nil, fmt.Errorf("reopening %s: %w", existing.ID, err)
+			}
+			result.Reopened++
+		default:
+			result.Unchanged++
+		}
+	}
+	return result, nil
+}
+
+// createChecklistIssue creates the open issue for item. Nested items get a
+// child ID of their parent where the hierarchy depth allows, and always a
+// parent-child link.
+func createChecklistIssue(ctx context.Context, s storage.Storage, item *checklistItem, ids []string, actor string) (string, error) {
+	marker := item.Marker
+	issue := &types.Issue{
+		Title:       item.Title,
+		Status:      types.StatusOpen,
+		Priority:    2,
+		IssueType:   types.TypeTask,
+		ExternalRef: &marker,
+	}
+	parentID := ""
+	if item.Parent >= 0 {
+		parentID = ids[item.Parent]
+		if childID, err := s.GetNextChildID(ctx, parentID); err == nil {
+			issue.ID = childID
+		}
+	}
+	if err := s.CreateIssue(ctx, issue, actor); err != nil {
+		return "", fmt.Errorf("creating %q: %w", item.Title, err)
+	}
+	if parentID != "" {
+		dep := &types.Dependency{IssueID: issue.ID, DependsOnID: parentID, Type: types.DepParentChild}
+		if err := s.AddDependency(ctx, dep, actor); err != nil {
+			return "", fmt.Errorf("linking %s to parent %s: %w", issue.ID, parentID, err)
+		}
+	}
+	return issue.ID, nil
+}
+
+func init() {
+	importCmd.AddCommand(importMarkdownCmd)
+}
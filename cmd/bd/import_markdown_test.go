@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func loadChecklistFixture(t *testing.T, replace ...string) []*checklistItem {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "checklist", "TODO.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := strings.NewReplacer(replace...).Replace(string(data))
+	items, err := parseMarkdownChecklist(strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("parseMarkdownChecklist failed: %v", err)
+	}
+	return items
+}
+
+func TestParseMarkdownChecklist(t *testing.T) {
+	items := loadChecklistFixture(t)
+
+	type parsed struct {
+		Title   string
+		Checked bool
+		Parent  int
+	}
+	var got []parsed
+	for _, item := range items {
+		got = append(got, parsed{item.Title, item.Checked, item.Parent})
+	}
+	want := []parsed{
+		{"Ship the website", false, -1},
+		{"Pick a domain", true, 0},
+		{"Write landing page copy", false, 0},
+		{"Draft hero section", false, 2},
+		{"Set up CI", true, -1},
+		{"Write docs", false, -1},
+		{"Write docs", false, -1},
+		{"Announce on the blog", false, -1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parsed items =\n%+v\nwant\n%+v", got, want)
+	}
+
+	markers := map[string]bool{}
+	for _, item := range items {
+		if markers[item.Marker] {
+			t.Errorf("duplicate marker %q for %q", item.Marker, item.Title)
+		}
+		markers[item.Marker] = true
+	}
+	// Editing one item leaves the others' markers alone
+	edited := loadChecklistFixture(t, "Announce on the blog", "Announce on the forum")
+	if edited[4].Marker != items[4].Marker {
+		t.Error("editing an unrelated item changed a marker")
+	}
+	if edited[7].Marker == items[7].Marker {
+		t.Error("editing an item kept its marker")
+	}
+}
+
+func TestImportChecklist(t *testing.T) {
+	ctx := context.Background()
+	testStore := newTestStore(t, filepath.Join(t.TempDir(), ".beads", "beads.db"))
+
+	items := loadChecklistFixture(t)
+	result, err := importChecklist(ctx, testStore, items, "TODO.md", "test")
+	if err != nil {
+		t.Fatalf("importChecklist failed: %v", err)
+	}
+	if result.Created != len(items) || result.Unchanged != 0 {
+		t.Fatalf("first import = %+v, want %d created", result, len(items))
+	}
+
+	issue := func(i int) *types.Issue {
+		t.Helper()
+		got, err := testStore.GetIssue(ctx, result.IDs[i])
+		if err != nil || got == nil {
+			t.Fatalf("GetIssue(%s) = %v, %v", result.IDs[i], got, err)
+		}
+		return got
+	}
+	prefix, err := testStore.GetConfig(ctx, "issue_prefix")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(result.IDs[0], prefix+"-") {
+		t.Errorf("root ID %s doesn't use the configured prefix %q", result.IDs[0], prefix)
+	}
+	if issue(1).Status != types.StatusClosed || issue(4).Status != types.StatusClosed {
+		t.Error("checked items should be closed")
+	}
+	if issue(0).Status != types.StatusOpen {
+		t.Error("unchecked items should be open")
+	}
+	if want := result.IDs[2] + ".1"; result.IDs[3] != want {
+		t.Errorf("nested item ID = %s, want child ID %s", result.IDs[3], want)
+	}
+	deps, err := testStore.GetDependencyRecords(ctx, result.IDs[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps) != 1 || deps[0].DependsOnID != result.IDs[2] || deps[0].Type != types.DepParentChild {
+		t.Errorf("nested item deps = %+v, want parent-child to %s", deps, result.IDs[2])
+	}
+
+	// Re-importing the same file changes nothing
+	again, err := importChecklist(ctx, testStore, items, "TODO.md", "test")
+	if err != nil {
+		t.Fatalf("re-import failed: %v", err)
+	}
+	if again.Created != 0 || again.Unchanged != len(items) || !reflect.DeepEqual(again.IDs, result.IDs) {
+		t.Errorf("re-import = %+v, want all %d unchanged with the same IDs", again, len(items))
+	}
+
+	// Toggling checkboxes closes and reopens the matching issues
+	toggled := loadChecklistFixture(t,
+		"- [ ] Write landing page copy", "- [x] Write landing page copy",
+		"- [X] Set up CI", "- [ ] Set up CI")
+	changed, err := importChecklist(ctx, testStore, toggled, "TODO.md", "test")
+	if err != nil {
+		t.Fatalf("import after toggling failed: %v", err)
+	}
+	if changed.Created != 0 || changed.Closed != 1 || changed.Reopened != 1 {
+		t.Errorf("import after toggling = %+v, want 1 closed and 1 reopened", changed)
+	}
+	if issue(2).Status != types.StatusClosed || issue(4).Status != types.StatusOpen {
+		t.Error("toggled items did not change status")
+	}
+}
@@ -134,6 +134,8 @@ func (fc *fieldComparator) checkFieldChanged(key string, existing *types.Issue,
 		return !fc.equalStr(existing.Assignee, newVal)
 	case "external_ref":
 		return !fc.equalPtrStr(existing.ExternalRef, newVal)
+	case "due_date":
+		return !fc.equalStr(existing.DueDate, newVal)
 	default:
 		// Unknown field - treat as changed to be conservative
 		// This prevents skipping updates when new fields are added
@@ -162,6 +164,7 @@ type ImportOptions struct {
 	SkipUpdate                 bool              // Skip updating existing issues (create-only mode)
 	Strict                     bool              // Fail on any error (dependencies, labels, etc.)
 	RenameOnImport             bool              // Rename imported issues to match database prefix
+	ImportPrefix               string            // Give imported issues new IDs under this prefix
 	SkipPrefixValidation       bool              // Skip prefix validation (for auto-import)
 	ClearDuplicateExternalRefs bool              // Clear duplicate external_ref values instead of erroring
 	OrphanHandling             string            // Orphan handling mode: strict/resurrect/skip/allow (empty = use config)
@@ -217,6 +220,7 @@ func importIssuesCore(ctx context.Context, dbPath string, store storage.Storage,
 		SkipUpdate:                 opts.SkipUpdate,
 		Strict:                     opts.Strict,
 		RenameOnImport:             opts.RenameOnImport,
+		ImportPrefix:               opts.ImportPrefix,
 		SkipPrefixValidation:       opts.SkipPrefixValidation,
 		ClearDuplicateExternalRefs: opts.ClearDuplicateExternalRefs,
 		OrphanHandling:             importer.OrphanHandling(orphanHandling),
@@ -22,6 +22,8 @@ import (
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
+	"github.com/steveyegge/beads/internal/validation"
+	"golang.org/x/term"
 )
 
 var initCmd = &cobra.Command{
@@ -48,10 +50,13 @@ With --stealth: configures per-repository git settings for invisible beads usage
 		skipHooks, _ := cmd.Flags().GetBool("skip-hooks")
 		force, _ := cmd.Flags().GetBool("force")
 
-		// Initialize config (PersistentPreRun doesn't run for init command)
-		if err := config.Initialize(); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to initialize config: %v\n", err)
-			// Non-fatal - continue with defaults
+		// Initialize config (PersistentPreRun doesn't run for init command),
+		// unless it was already read from stdin with --config -
+		if configSource == "" {
+			if err := config.Initialize(); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to initialize config: %v\n", err)
+				// Non-fatal - continue with defaults
+			}
 		}
 
 		// Safety guard: check for existing JSONL with issues (bd-emg)
@@ -84,7 +89,7 @@ With --stealth: configures per-repository git settings for invisible beads usage
 			}
 		}
 
-		// Determine prefix with precedence: flag > config > auto-detect from git > auto-detect from directory name
+		// Determine prefix with precedence: flag > config > auto-detect from git history > git remote or directory name
 		if prefix == "" {
 			// Try to get from config file
 			prefix = config.GetString("issue-prefix")
@@ -101,15 +106,25 @@ With --stealth: configures per-repository git settings for invisible beads usage
 			}
 		}
 
-		// auto-detect prefix from directory name
+		// auto-detect prefix from git remote or directory name
 		if prefix == "" {
-			// Auto-detect from directory name
 			cwd, err := os.Getwd()
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: failed to get current directory: %v\n", err)
 				os.Exit(1)
 			}
-			prefix = filepath.Base(cwd)
+			suggested := beads.SuggestPrefix(cwd)
+			switch {
+			case !quiet && term.IsTerminal(int(os.Stdin.Fd())):
+				if suggested == "" {
+					suggested = filepath.Base(cwd)
+				}
+				prefix = promptForPrefix(bufio.NewReader(os.Stdin), suggested)
+			case suggested != "" && config.GetBool("init.prefix-from-remote"):
+				prefix = suggested
+			default:
+				prefix = filepath.Base(cwd)
+			}
 		}
 
 		// Normalize prefix: strip trailing hyphens
@@ -480,7 +495,7 @@ With --stealth: configures per-repository git settings for invisible beads usage
 }
 
 func init() {
-	initCmd.Flags().StringP("prefix", "p", "", "Issue prefix (default: current directory name)")
+	initCmd.Flags().StringP("prefix", "p", "", "Issue prefix (default: prompt with a suggestion from the git remote, or the current directory name)")
 	initCmd.Flags().BoolP("quiet", "q", false, "Suppress output (quiet mode)")
 	initCmd.Flags().StringP("branch", "b", "", "Git branch for beads commits (default: current branch)")
 	initCmd.Flags().Bool("contributor", false, "Run OSS contributor setup wizard")
@@ -1108,7 +1123,8 @@ func migrateOldDatabases(targetPath string, quiet bool) error {
 	return nil
 }
 
-// createConfigYaml creates the config.yaml template in the specified directory
+// createConfigYaml creates a config.yaml documenting every setting in the
+// specified directory
 func createConfigYaml(beadsDir string, noDbMode bool) error {
 	configYamlPath := filepath.Join(beadsDir, "config.yaml")
 
@@ -1117,78 +1133,21 @@ func createConfigYaml(beadsDir string, noDbMode bool) error {
 		return nil
 	}
 
-	noDbLine := "# no-db: false"
+	var settings []byte
 	if noDbMode {
-		noDbLine = "no-db: true  # JSONL-only mode, no SQLite database"
+		settings = []byte("no-db: true\n")
+	}
+	configYaml, err := config.GenerateConfigYaml(settings)
+	if err != nil {
+		return err
 	}
 
-	configYamlTemplate := fmt.Sprintf(`# Beads Configuration File
-# This file configures default behavior for all bd commands in this repository
-# All settings can also be set via environment variables (BD_* prefix)
-# or overridden with command-line flags
-
-# Issue prefix for this repository (used by bd init)
-# If not set, bd init will auto-detect from directory name
-# Example: issue-prefix: "myproject" creates issues like "myproject-1", "myproject-2", etc.
-# issue-prefix: ""
-
-# Use no-db mode: load from JSONL, no SQLite, write back after each command
-# When true, bd will use .beads/issues.jsonl as the source of truth
-# instead of SQLite database
-%s
-
-# Disable daemon for RPC communication (forces direct database access)
-# no-daemon: false
-
-# Disable auto-flush of database to JSONL after mutations
-# no-auto-flush: false
-
-# Disable auto-import from JSONL when it's newer than database
-# no-auto-import: false
-
-# Enable JSON output by default
-# json: false
-
-# Default actor for audit trails (overridden by BD_ACTOR or --actor)
-# actor: ""
-
-# Path to database (overridden by BEADS_DB or --db)
-# db: ""
-
-# Auto-start daemon if not running (can also use BEADS_AUTO_START_DAEMON)
-# auto-start-daemon: true
-
-# Debounce interval for auto-flush (can also use BEADS_FLUSH_DEBOUNCE)
-# flush-debounce: "5s"
-
-# Git branch for beads commits (bd sync will commit to this branch)
-# IMPORTANT: Set this for team projects so all clones use the same sync branch.
-# This setting persists across clones (unlike database config which is gitignored).
-# Can also use BEADS_SYNC_BRANCH env var for local override.
-# If not set, bd sync will require you to run 'bd config set sync.branch <branch>'.
-# sync-branch: "beads-sync"
-
-# Multi-repo configuration (experimental - bd-307)
-# Allows hydrating from multiple repositories and routing writes to the correct JSONL
-# repos:
-#   primary: "."  # Primary repo (where this database lives)
-#   additional:   # Additional repos to hydrate from (read-only)
-#     - ~/beads-planning  # Personal planning repo
-#     - ~/work-planning   # Work planning repo
-
-# Integration settings (access with 'bd config get/set')
-# These are stored in the database, not in this file:
-# - jira.url
-# - jira.project
-# - linear.url
-# - linear.api-key
-# - github.org
-# - github.repo
-`, noDbLine)
-
-	if err := os.WriteFile(configYamlPath, []byte(configYamlTemplate), 0600); err != nil {
+	if err := os.WriteFile(configYamlPath, configYaml, 0600); err != nil {
 		return fmt.Errorf("failed to write config.yaml: %w", err)
 	}
+	if err := config.ApplyFileMode(configYamlPath); err != nil {
+		return fmt.Errorf("failed to set config.yaml permissions: %w", err)
+	}
 
 	return nil
 }
@@ -1315,7 +1274,7 @@ func readFirstIssueFromJSONL(path string) (*types.Issue, error) {
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err == nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err == nil {
 			return &issue, nil
 		} else {
 			// Skip malformed lines with warning
@@ -1348,7 +1307,7 @@ func readFirstIssueFromGit(jsonlPath, gitRef string) (*types.Issue, error) {
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err == nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err == nil {
 			return &issue, nil
 		}
 		// Skip malformed lines silently (called during auto-detection)
@@ -1583,6 +1542,31 @@ func setupGlobalGitIgnore(homeDir string, projectPath string, verbose bool) erro
 //
 // For worktrees, checks the main repository root instead of current directory
 // since worktrees should share the database with the main repository.
+// promptForPrefix asks for the issue prefix, offering suggested as the
+// default, until the answer passes validation.ValidateIssuePrefix. An empty
+// answer or end of input accepts the default.
+func promptForPrefix(reader *bufio.Reader, suggested string) string {
+	for {
+		fmt.Printf("Issue prefix [%s]: ", suggested)
+		line, err := reader.ReadString('\n')
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			if err != nil {
+				fmt.Println()
+			}
+			return suggested
+		}
+		if verr := validation.ValidateIssuePrefix(answer); verr != nil {
+			fmt.Printf("%s %v\n", ui.RenderWarn("⚠"), verr)
+			if err != nil {
+				return suggested
+			}
+			continue
+		}
+		return answer
+	}
+}
+
 func checkExistingBeadsData(prefix string) error {
 	cwd, err := os.Getwd()
 	if err != nil {
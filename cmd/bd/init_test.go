@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"os"
@@ -1136,3 +1137,29 @@ func captureStdout(t *testing.T, fn func() error) string {
 	}
 	return buf.String()
 }
+
+func TestPromptForPrefix(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"enter accepts suggestion", "\n", "btm"},
+		{"end of input accepts suggestion", "", "btm"},
+		{"override", "tasks\n", "tasks"},
+		{"invalid answer asks again", "Not Valid\nwork\n", "work"},
+		{"invalid answer then end of input", "Not Valid", "btm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			captureStdout(t, func() error {
+				got = promptForPrefix(bufio.NewReader(strings.NewReader(tt.input)), "btm")
+				return nil
+			})
+			if got != tt.want {
+				t.Errorf("promptForPrefix(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -7,13 +7,13 @@ import (
 	"crypto/sha256"
 	"database/sql"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -463,11 +463,17 @@ func computeDBHash(ctx context.Context, store storage.Storage) (string, error) {
 		issue.Comments = comments
 	}
 
+	// Populate references for all issues
+	if err := populateReferences(ctx, store, issues); err != nil {
+		return "", err
+	}
+
 	// Serialize to JSON and hash
 	var buf bytes.Buffer
-	encoder := json.NewEncoder(&buf)
+	format := config.TimestampFormat()
 	for _, issue := range issues {
-		if err := encoder.Encode(issue); err != nil {
+		issue.SchemaVersion = types.JSONLSchemaVersion
+		if err := types.EncodeIssueJSONL(&buf, issue, format); err != nil {
 			return "", fmt.Errorf("failed to encode issue %s: %w", issue.ID, err)
 		}
 	}
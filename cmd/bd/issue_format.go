@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/steveyegge/beads/internal/idgen"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+// issueFormatPresets are the named --format templates for list and show.
+var issueFormatPresets = map[string]string{
+	"id-only": "{{.ID}}",
+	"oneline": "{{.ID}} [{{priority .Priority}}] [{{.IssueType}}] {{status .Status}} - {{.Title}}",
+}
+
+// issueTemplateFuncs are the helpers available to --format templates, e.g.
+// '{{shortid .ID}} {{status .Status}} {{join .Labels ","}}'.
+var issueTemplateFuncs = template.FuncMap{
+	"status":   func(s types.Status) string { return ui.RenderStatus(string(s)) },
+	"priority": ui.RenderPriority,
+	"shortid":  shortIssueID,
+	"join":     strings.Join,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+}
+
+// parseIssueFormat resolves format, a preset name or a Go text/template
+// executed once per issue, into a template.
+func parseIssueFormat(format string) (*template.Template, error) {
+	text, ok := issueFormatPresets[format]
+	if !ok {
+		text = format
+	}
+	tmpl, err := template.New("format").Funcs(issueTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// writeIssueFormat writes one line per issue using tmpl.
+func writeIssueFormat(w io.Writer, tmpl *template.Template, issues []*types.Issue) error {
+	for _, issue := range issues {
+		if err := tmpl.Execute(w, issue); err != nil {
+			return fmt.Errorf("template execution error: %w", err)
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shortIssueID strips the prefix and separator from an issue ID, so bd-a1b2.1
+// becomes a1b2.1. IDs without a recognizable prefix are returned unchanged.
+func shortIssueID(id string) string {
+	prefix := utils.ExtractIssuePrefix(id)
+	if prefix == "" {
+		return id
+	}
+	return strings.TrimPrefix(id[len(prefix):], idgen.Separator())
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestWriteIssueFormat(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "bd-a1b2", Title: "First", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeBug},
+		{ID: "bd-c3d4.1", Title: "Second", Status: types.StatusClosed, Priority: 3, IssueType: types.TypeTask,
+			Labels: []string{"backend", "urgent"}},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"{{.ID}}: {{.Title}}", "bd-a1b2: First\nbd-c3d4.1: Second\n"},
+		{"id-only", "bd-a1b2\nbd-c3d4.1\n"},
+		{"oneline", "bd-a1b2 [P1] [bug] open - First\nbd-c3d4.1 [P3] [task] closed - Second\n"},
+		{"{{shortid .ID}} {{upper .Title}} {{join .Labels \",\"}}", "a1b2 FIRST \nc3d4.1 SECOND backend,urgent\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			tmpl, err := parseIssueFormat(tt.format)
+			if err != nil {
+				t.Fatalf("parseIssueFormat(%q) failed: %v", tt.format, err)
+			}
+			var buf bytes.Buffer
+			if err := writeIssueFormat(&buf, tmpl, issues); err != nil {
+				t.Fatalf("writeIssueFormat failed: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := parseIssueFormat("{{.ID"); err == nil {
+		t.Error("expected error for invalid template")
+	}
+	tmpl, _ := parseIssueFormat("{{.NoSuchField}}")
+	if err := writeIssueFormat(&bytes.Buffer{}, tmpl, issues); err == nil {
+		t.Error("expected error for unknown field")
+	}
+}
@@ -428,7 +428,7 @@ func doPullFromJira(ctx context.Context, dryRun bool, state string) (*PullStats,
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err != nil {
 			return stats, fmt.Errorf("failed to parse issue JSON: %w", err)
 		}
 		issues = append(issues, &issue)
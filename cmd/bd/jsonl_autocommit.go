@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/syncbranch"
+)
+
+// defaultCommitMessageTemplate is used when commit-message-template is unset.
+const defaultCommitMessageTemplate = "beads: update issues"
+
+// commitMessageData is available to commit-message-template, e.g.
+// "beads: {{.ExportedCount}} issue(s) changed".
+type commitMessageData struct {
+	File          string // JSONL path relative to the repository root
+	IssueCount    int    // Issues in the JSONL file
+	ExportedCount int    // Issues written by this flush
+}
+
+// autoCommitJSONL commits jsonlPath to the current branch when the
+// auto-commit-jsonl config key is set. Only the JSONL file is committed, so
+// other staged changes are left alone. It does nothing when the file has no
+// changes, outside a git repository, or when a sync branch is configured
+// (bd sync commits the JSONL there instead).
+func autoCommitJSONL(ctx context.Context, jsonlPath string, issueCount, exportedCount int) error {
+	if !config.GetBool("auto-commit-jsonl") {
+		return nil
+	}
+	if syncbranch.IsConfigured() {
+		debug.Logf("auto-commit-jsonl: skipped, sync branch configured")
+		return nil
+	}
+
+	rootOut, err := exec.CommandContext(ctx, "git", "-C", filepath.Dir(jsonlPath), "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		debug.Logf("auto-commit-jsonl: skipped, %s is not in a git repository", jsonlPath)
+		return nil
+	}
+	repoRoot := strings.TrimSpace(string(rootOut))
+	relPath, err := filepath.Rel(repoRoot, jsonlPath)
+	if err != nil {
+		relPath = jsonlPath
+	}
+
+	status, err := exec.CommandContext(ctx, "git", "-C", repoRoot, "status", "--porcelain", "--", relPath).Output()
+	if err != nil {
+		return fmt.Errorf("git status failed: %w", err)
+	}
+	if len(bytes.TrimSpace(status)) == 0 {
+		return nil
+	}
+
+	message, err := renderCommitMessage(commitMessageData{File: relPath, IssueCount: issueCount, ExportedCount: exportedCount})
+	if err != nil {
+		return err
+	}
+	if output, err := exec.CommandContext(ctx, "git", "-C", repoRoot, "add", "--", relPath).CombinedOutput(); err != nil {
+		return fmt.Errorf("git add failed: %w\n%s", err, output)
+	}
+	commitArgs := buildGitCommitArgs(repoRoot, message, "--", relPath)
+	if output, err := exec.CommandContext(ctx, "git", commitArgs...).CombinedOutput(); err != nil {
+		return fmt.Errorf("git commit failed: %w\n%s", err, output)
+	}
+	debug.Logf("auto-commit-jsonl: committed %s", relPath)
+	return nil
+}
+
+// renderCommitMessage expands commit-message-template with data.
+func renderCommitMessage(data commitMessageData) (string, error) {
+	text := config.GetString("commit-message-template")
+	if text == "" {
+		text = defaultCommitMessageTemplate
+	}
+	tmpl, err := template.New("commit-message-template").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid commit-message-template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("invalid commit-message-template: %w", err)
+	}
+	return buf.String(), nil
+}
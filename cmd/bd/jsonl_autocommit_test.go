@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/syncbranch"
+)
+
+func TestAutoCommitJSONL(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	// Initialize outside the repo so its .beads/config.yaml doesn't leak into later tests
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize failed: %v", err)
+	}
+	t.Setenv(syncbranch.EnvVar, "")
+	config.Set(syncbranch.ConfigYAMLKey, "")
+	config.Set("git.author", "")
+	config.Set("git.no-gpg-sign", true)
+	defer config.Set("git.no-gpg-sign", false)
+	ctx := context.Background()
+
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	git("init")
+	git("config", "user.email", "test@test.com")
+	git("config", "user.name", "Test User")
+	git("commit", "--allow-empty", "-m", "initial")
+
+	jsonlPath := filepath.Join(repo, ".beads", "issues.jsonl")
+	if err := os.MkdirAll(filepath.Dir(jsonlPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeJSONL := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(jsonlPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	commitCount := func() string { return git("rev-list", "--count", "HEAD") }
+
+	t.Run("disabled by default", func(t *testing.T) {
+		config.Set("auto-commit-jsonl", false)
+		writeJSONL(`{"id":"bd-1"}` + "\n")
+		if err := autoCommitJSONL(ctx, jsonlPath, 1, 1); err != nil {
+			t.Fatalf("autoCommitJSONL failed: %v", err)
+		}
+		if got := commitCount(); got != "1" {
+			t.Errorf("commit count = %s, want 1", got)
+		}
+	})
+
+	config.Set("auto-commit-jsonl", true)
+	defer config.Set("auto-commit-jsonl", false)
+
+	t.Run("commits only the JSONL with the templated message", func(t *testing.T) {
+		config.Set("commit-message-template", "beads: {{.ExportedCount}} of {{.IssueCount}} in {{.File}}")
+		defer config.Set("commit-message-template", "")
+		other := filepath.Join(repo, "other.txt")
+		if err := os.WriteFile(other, []byte("unrelated"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		git("add", "other.txt")
+
+		if err := autoCommitJSONL(ctx, jsonlPath, 3, 1); err != nil {
+			t.Fatalf("autoCommitJSONL failed: %v", err)
+		}
+		if got := commitCount(); got != "2" {
+			t.Fatalf("commit count = %s, want 2", got)
+		}
+		if got, want := git("log", "-1", "--format=%s"), "beads: 1 of 3 in .beads/issues.jsonl"; got != want {
+			t.Errorf("commit message = %q, want %q", got, want)
+		}
+		if files := git("show", "--name-only", "--format=", "HEAD"); files != ".beads/issues.jsonl" {
+			t.Errorf("committed files = %q, want only the JSONL", files)
+		}
+		if staged := git("diff", "--cached", "--name-only"); staged != "other.txt" {
+			t.Errorf("staged files = %q, want other.txt left staged", staged)
+		}
+	})
+
+	t.Run("no-op without changes", func(t *testing.T) {
+		if err := autoCommitJSONL(ctx, jsonlPath, 1, 0); err != nil {
+			t.Fatalf("autoCommitJSONL failed: %v", err)
+		}
+		if got := commitCount(); got != "2" {
+			t.Errorf("commit count = %s, want 2", got)
+		}
+	})
+
+	t.Run("default message", func(t *testing.T) {
+		writeJSONL(`{"id":"bd-1"}` + "\n" + `{"id":"bd-2"}` + "\n")
+		if err := autoCommitJSONL(ctx, jsonlPath, 2, 1); err != nil {
+			t.Fatalf("autoCommitJSONL failed: %v", err)
+		}
+		if got := git("log", "-1", "--format=%s"); got != defaultCommitMessageTemplate {
+			t.Errorf("commit message = %q, want %q", got, defaultCommitMessageTemplate)
+		}
+	})
+
+	t.Run("skips outside a git repository", func(t *testing.T) {
+		outside := filepath.Join(t.TempDir(), "issues.jsonl")
+		if err := os.WriteFile(outside, []byte("{}\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := autoCommitJSONL(ctx, outside, 1, 1); err != nil {
+			t.Errorf("autoCommitJSONL outside git = %v, want nil", err)
+		}
+	})
+}
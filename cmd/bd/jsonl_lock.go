@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/steveyegge/beads/internal/lockfile"
+)
+
+// lockJSONL takes an exclusive lock on jsonlPath, blocking while another
+// process or goroutine is writing it. Every JSONL writer holds this lock
+// from reading the old file until the new one has been renamed into place,
+// so concurrent flushes can't interleave or drop each other's changes.
+//
+// The lock is an flock on a "<jsonl>.lock" sidecar rather than the JSONL
+// itself, because writers replace the JSONL by renaming a temp file over it.
+// The returned unlock function is safe to call more than once.
+func lockJSONL(jsonlPath string) (unlock func(), err error) {
+	lockPath := jsonlPath + ".lock"
+	// #nosec G304 - lock file next to the controlled JSONL path
+	f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open JSONL lock: %w", err)
+	}
+	if err := lockfile.FlockExclusiveBlocking(f); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to lock %s: %w", jsonlPath, err)
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			_ = lockfile.FlockUnlock(f)
+			_ = f.Close()
+		})
+	}, nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestLockJSONLBlocksSecondWriter(t *testing.T) {
+	jsonlPath := filepath.Join(t.TempDir(), "issues.jsonl")
+
+	unlock, err := lockJSONL(jsonlPath)
+	if err != nil {
+		t.Fatalf("lockJSONL failed: %v", err)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		unlock2, err := lockJSONL(jsonlPath)
+		if err != nil {
+			t.Errorf("second lockJSONL failed: %v", err)
+			close(acquired)
+			return
+		}
+		close(acquired)
+		unlock2()
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("second writer got the lock while the first held it")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	unlock() // Safe to call twice
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("second writer never got the lock after unlock")
+	}
+}
+
+func TestConcurrentJSONLExports(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	jsonlPath := filepath.Join(tmpDir, "issues.jsonl")
+	testStore := newTestStore(t, filepath.Join(tmpDir, "beads.db"))
+
+	const issueCount = 40
+	for i := 0; i < issueCount; i++ {
+		issue := &types.Issue{
+			Title:       fmt.Sprintf("Issue %d", i),
+			Description: strings.Repeat("long enough to need several writes ", 50),
+			Status:      types.StatusOpen,
+			Priority:    2,
+			IssueType:   types.TypeTask,
+		}
+		if err := testStore.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+	// checkJSONL returns an error unless path holds exactly issueCount valid lines
+	checkJSONL := func(path string) error {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 64*1024), 2*1024*1024)
+		lines := 0
+		for scanner.Scan() {
+			var issue types.Issue
+			if err := json.Unmarshal(scanner.Bytes(), &issue); err != nil {
+				return fmt.Errorf("line %d is not valid JSON: %w", lines+1, err)
+			}
+			lines++
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+		if lines != issueCount {
+			return fmt.Errorf("got %d lines, want %d", lines, issueCount)
+		}
+		return nil
+	}
+
+	var writers sync.WaitGroup
+	var writing atomic.Bool
+	writing.Store(true)
+
+	// Daemon-style full exports
+	for w := 0; w < 2; w++ {
+		writers.Add(1)
+		go func() {
+			defer writers.Done()
+			for i := 0; i < 10; i++ {
+				if err := exportToJSONLWithStore(ctx, testStore, jsonlPath); err != nil {
+					t.Errorf("exportToJSONLWithStore failed: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	// Auto-flush-style writes
+	for w := 0; w < 2; w++ {
+		writers.Add(1)
+		go func() {
+			defer writers.Done()
+			for i := 0; i < 10; i++ {
+				// Each flush loads its own issues, as the real flush does
+				issues, err := testStore.SearchIssues(ctx, "", types.IssueFilter{})
+				if err != nil {
+					t.Errorf("SearchIssues failed: %v", err)
+					return
+				}
+				unlock, err := lockJSONL(jsonlPath)
+				if err != nil {
+					t.Errorf("lockJSONL failed: %v", err)
+					return
+				}
+				_, err = writeJSONLAtomic(jsonlPath, issues)
+				unlock()
+				if err != nil {
+					t.Errorf("writeJSONLAtomic failed: %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	// Readers must never see a partial file
+	readerDone := make(chan struct{})
+	go func() {
+		defer close(readerDone)
+		for writing.Load() {
+			if err := checkJSONL(jsonlPath); err != nil && !os.IsNotExist(err) {
+				t.Errorf("reader saw a bad JSONL: %v", err)
+				return
+			}
+		}
+	}()
+
+	writers.Wait()
+	writing.Store(false)
+	<-readerDone
+
+	if err := checkJSONL(jsonlPath); err != nil {
+		t.Fatalf("final JSONL invalid: %v", err)
+	}
+	if leftovers, _ := filepath.Glob(jsonlPath + ".tmp*"); len(leftovers) > 0 {
+		t.Errorf("temp files left behind: %v", leftovers)
+	}
+}
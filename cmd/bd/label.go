@@ -9,6 +9,7 @@ import (
 	"strings"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -18,11 +19,13 @@ var labelCmd = &cobra.Command{
 	GroupID: "issues",
 	Short:   "Manage issue labels",
 }
-// Helper function to process label operations for multiple issues
+// Helper function to process label operations for multiple issues.
+// It returns the last error, after reporting every failure.
 func processBatchLabelOperation(issueIDs []string, label string, operation string, jsonOut bool,
-	daemonFunc func(string, string) error, storeFunc func(context.Context, string, string, string) error) {
+	daemonFunc func(string, string) error, storeFunc func(context.Context, string, string, string) error) error {
 	ctx := rootCtx
 	results := []map[string]interface{}{}
+	var failure error
 	for _, issueID := range issueIDs {
 		var err error
 		if daemonClient != nil {
@@ -32,6 +35,7 @@ func processBatchLabelOperation(issueIDs []string, label string, operation strin
 		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error %s label %s %s: %v\n", operation, operation, issueID, err)
+			failure = err
 			continue
 		}
 		if jsonOut {
@@ -56,6 +60,7 @@ func processBatchLabelOperation(issueIDs []string, label string, operation strin
 	if jsonOut && len(results) > 0 {
 		outputJSON(results)
 	}
+	return failure
 }
 func parseLabelArgs(args []string) (issueIDs []string, label string) {
 	label = args[len(args)-1]
@@ -74,6 +79,7 @@ var labelAddCmd = &cobra.Command{
 		// Resolve partial IDs
 		ctx := rootCtx
 		resolvedIDs := make([]string, 0, len(issueIDs))
+		var failure error
 		for _, id := range issueIDs {
 			var fullID string
 			var err error
@@ -82,16 +88,19 @@ var labelAddCmd = &cobra.Command{
 				resp, err := daemonClient.ResolveID(resolveArgs)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
+					failure = err
 					continue
 				}
 				if err := json.Unmarshal(resp.Data, &fullID); err != nil {
 					fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
+					failure = err
 					continue
 				}
 			} else {
-				fullID, err = utils.ResolvePartialID(ctx, store, id)
+				fullID, err = utils.ResolveIssueID(ctx, store, id)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
+					failure = err
 					continue
 				}
 			}
@@ -107,14 +116,19 @@ var labelAddCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		processBatchLabelOperation(issueIDs, label, "added", jsonOutput,
+		if err := processBatchLabelOperation(issueIDs, label, "added", jsonOutput,
 			func(issueID, lbl string) error {
 				_, err := daemonClient.AddLabel(&rpc.LabelAddArgs{ID: issueID, Label: lbl})
 				return err
 			},
 			func(ctx context.Context, issueID, lbl, act string) error {
 				return store.AddLabel(ctx, issueID, lbl, act)
-			})
+			}); err != nil {
+			failure = err
+		}
+		if failure != nil {
+			os.Exit(exitCodeFor(failure))
+		}
 	},
 }
 //nolint:dupl // labelRemoveCmd and labelAddCmd are similar but serve different operations
@@ -129,6 +143,7 @@ var labelRemoveCmd = &cobra.Command{
 		// Resolve partial IDs
 		ctx := rootCtx
 		resolvedIDs := make([]string, 0, len(issueIDs))
+		var failure error
 		for _, id := range issueIDs {
 			var fullID string
 			var err error
@@ -137,30 +152,38 @@ var labelRemoveCmd = &cobra.Command{
 				resp, err := daemonClient.ResolveID(resolveArgs)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
+					failure = err
 					continue
 				}
 				if err := json.Unmarshal(resp.Data, &fullID); err != nil {
 					fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
+					failure = err
 					continue
 				}
 			} else {
-				fullID, err = utils.ResolvePartialID(ctx, store, id)
+				fullID, err = utils.ResolveIssueID(ctx, store, id)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
+					failure = err
 					continue
 				}
 			}
 			resolvedIDs = append(resolvedIDs, fullID)
 		}
 		issueIDs = resolvedIDs
-		processBatchLabelOperation(issueIDs, label, "removed", jsonOutput,
+		if err := processBatchLabelOperation(issueIDs, label, "removed", jsonOutput,
 			func(issueID, lbl string) error {
 				_, err := daemonClient.RemoveLabel(&rpc.LabelRemoveArgs{ID: issueID, Label: lbl})
 				return err
 			},
 			func(ctx context.Context, issueID, lbl, act string) error {
 				return store.RemoveLabel(ctx, issueID, lbl, act)
-			})
+			}); err != nil {
+			failure = err
+		}
+		if failure != nil {
+			os.Exit(exitCodeFor(failure))
+		}
 	},
 }
 var labelListCmd = &cobra.Command{
@@ -177,7 +200,7 @@ var labelListCmd = &cobra.Command{
 			resp, err := daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving issue ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			if err := json.Unmarshal(resp.Data, &issueID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error unmarshaling resolved ID: %v\n", err)
@@ -185,10 +208,10 @@ var labelListCmd = &cobra.Command{
 			}
 		} else {
 			var err error
-			issueID, err = utils.ResolvePartialID(ctx, store, args[0])
+			issueID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		}
 		var labels []string
@@ -239,37 +262,39 @@ var labelListAllCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		// Use global jsonOutput set by PersistentPreRun
 		ctx := rootCtx
-		var issues []*types.Issue
-		var err error
-		// Use daemon if available
+		labelCounts := make(map[string]int)
 		if daemonClient != nil {
+			// Use daemon if available; labels come back with the issues
 			resp, err := daemonClient.List(&rpc.ListArgs{})
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
 			}
+			var issues []*types.Issue
 			if err := json.Unmarshal(resp.Data, &issues); err != nil {
 				fmt.Fprintf(os.Stderr, "Error parsing response: %v\n", err)
 				os.Exit(1)
 			}
+			for _, issue := range issues {
+				for _, label := range issue.Labels {
+					labelCounts[label]++
+				}
+			}
+		} else if sqliteStore, ok := store.(*sqlite.SQLiteStorage); ok {
+			// Direct mode - count in a single query
+			counts, err := sqliteStore.CountByLabel(ctx)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			labelCounts = counts
 		} else {
-			// Direct mode
-			issues, err = store.SearchIssues(ctx, "", types.IssueFilter{})
+			issues, err := store.SearchIssues(ctx, "", types.IssueFilter{})
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
 			}
-		}
-		// Collect unique labels with counts
-		labelCounts := make(map[string]int)
-		for _, issue := range issues {
-			if daemonClient != nil {
-				// Labels are already in the issue from daemon
-				for _, label := range issue.Labels {
-					labelCounts[label]++
-				}
-			} else {
-				// Direct mode - need to fetch labels
+			for _, issue := range issues {
 				labels, err := store.GetLabels(ctx, issue.ID)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error getting labels for %s: %v\n", issue.ID, err)
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bytes"
 	"cmp"
 	"context"
 	"encoding/json"
@@ -12,7 +11,6 @@ import (
 	"slices"
 	"strings"
 	"syscall"
-	"text/template"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -20,6 +18,7 @@ import (
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/rpc"
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/util"
@@ -44,6 +43,18 @@ func parseTimeFlag(s string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("unable to parse time %q (try formats: 2006-01-02, 2006-01-02T15:04:05, or RFC3339)", s)
 }
 
+// parseSinceFlag parses a --since value: either a duration relative to now
+// ("24h", "7d") or an absolute time accepted by parseTimeFlag.
+func parseSinceFlag(s string, now time.Time) (time.Time, error) {
+	if d, err := parseDurationString(s); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := parseTimeFlag(s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("unable to parse %q as a duration (24h, 7d) or time (2006-01-02 or RFC3339)", s)
+}
+
 // pinIndicator returns a pushpin emoji prefix for pinned issues (bd-18b, bd-7h5)
 func pinIndicator(issue *types.Issue) string {
 	if issue.Pinned {
@@ -306,6 +317,163 @@ func sortIssues(issues []*types.Issue, sortBy string, reverse bool) {
 	})
 }
 
+// listArchivedIssues prints issues moved out of the issues table by 'bd archive'.
+func listArchivedIssues(limit int, sortBy string, reverse, longFormat bool) {
+	if err := ensureDirectMode("listing archived issues requires direct database access"); err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+	if !ok {
+		FatalErrorRespectJSON("archived issues require SQLite storage")
+	}
+
+	issues, err := sqliteStore.ListArchivedIssues(rootCtx)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	sortIssues(issues, sortBy, reverse)
+	if limit > 0 && len(issues) > limit {
+		issues = issues[:limit]
+	}
+
+	if jsonOutput {
+		if issues == nil {
+			issues = []*types.Issue{}
+		}
+		outputJSON(issues)
+		return
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("No archived issues")
+		return
+	}
+
+	if longFormat {
+		fmt.Printf("\nFound %d archived issues:\n\n", len(issues))
+	}
+	for _, issue := range issues {
+		closed := ""
+		if issue.ClosedAt != nil {
+			closed = " closed " + issue.ClosedAt.Format("2006-01-02")
+		}
+		line := fmt.Sprintf("%s [P%d] [%s] archived%s - %s",
+			issue.ID, issue.Priority, issue.IssueType, closed, issue.Title)
+		fmt.Println(ui.RenderClosedLine(line))
+		if longFormat {
+			if len(issue.Labels) > 0 {
+				fmt.Printf("  Labels: %v\n", issue.Labels)
+			}
+			fmt.Println()
+		}
+	}
+}
+
+// listIssuePage prints one keyset-paginated page of issues in creation order,
+// followed by the cursor for the next page.
+func listIssuePage(filter types.IssueFilter, page types.PageRequest) {
+	if err := ensureDirectMode("pagination requires direct database access"); err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	ctx := rootCtx
+	if err := ensureDatabaseFresh(ctx); err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+
+	result, err := store.ListIssues(ctx, filter, page)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+
+	issueIDs := make([]string, len(result.Issues))
+	for i, issue := range result.Issues {
+		issueIDs[i] = issue.ID
+	}
+	labelsMap, _ := store.GetLabelsForIssues(ctx, issueIDs)
+	for _, issue := range result.Issues {
+		issue.Labels = labelsMap[issue.ID]
+	}
+
+	if jsonOutput {
+		if result.Issues == nil {
+			result.Issues = []*types.Issue{}
+		}
+		outputJSON(result)
+		return
+	}
+
+	for _, issue := range result.Issues {
+		labelsStr := ""
+		if len(issue.Labels) > 0 {
+			labelsStr = fmt.Sprintf(" %v", issue.Labels)
+		}
+		assigneeStr := ""
+		if issue.Assignee != "" {
+			assigneeStr = fmt.Sprintf(" @%s", issue.Assignee)
+		}
+		status := string(issue.Status)
+		if status == "closed" {
+			line := fmt.Sprintf("%s%s [P%d] [%s] %s%s%s - %s",
+				pinIndicator(issue), issue.ID, issue.Priority,
+				issue.IssueType, status, assigneeStr, labelsStr, issue.Title)
+			fmt.Println(ui.RenderClosedLine(line))
+		} else {
+			fmt.Printf("%s%s [%s] [%s] %s%s%s - %s\n",
+				pinIndicator(issue),
+				ui.RenderID(issue.ID),
+				ui.RenderPriority(issue.Priority),
+				ui.RenderType(string(issue.IssueType)),
+				ui.RenderStatus(status),
+				assigneeStr, labelsStr, issue.Title)
+		}
+	}
+
+	if result.NextCursor != "" {
+		fmt.Printf("\nNext page: bd list --limit %d --cursor %s\n", page.Limit, result.NextCursor)
+	}
+}
+
+// paginationConflicts are the list flags that change the order or layout of
+// the output, which a page in creation order cannot honor.
+var paginationConflicts = []string{"sort", "reverse", "pretty", "watch", "format", "long"}
+
+// checkPaginationFlags rejects flags that cannot be combined with --paginate
+// or --cursor.
+func checkPaginationFlags(cmd *cobra.Command) error {
+	for _, name := range paginationConflicts {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("--%s cannot be combined with --paginate or --cursor, which list in creation order", name)
+		}
+	}
+	return nil
+}
+
+// listDefaultLimit returns how many issues bd list prints when neither --limit
+// nor --all is given, from the list-default-limit config (0 means no limit).
+func listDefaultLimit(cmd *cobra.Command) int {
+	if all, _ := cmd.Flags().GetBool("all"); all || cmd.Flags().Changed("limit") {
+		return 0
+	}
+	return config.GetInt("list-default-limit")
+}
+
+// truncateIssueList cuts issues down to limit, if it is positive, and returns
+// how many there were before.
+func truncateIssueList(issues []*types.Issue, limit int) ([]*types.Issue, int) {
+	total := len(issues)
+	if limit > 0 && total > limit {
+		issues = issues[:limit]
+	}
+	return issues, total
+}
+
+// printTruncatedFooter tells the user the list was cut by list-default-limit.
+func printTruncatedFooter(shown, total int) {
+	if shown < total {
+		fmt.Printf("\nShowing %d of %d issues; use --all to see all, or --limit N\n", shown, total)
+	}
+}
+
 var listCmd = &cobra.Command{
 	Use:     "list",
 	GroupID: "issues",
@@ -334,6 +502,7 @@ var listCmd = &cobra.Command{
 		createdBefore, _ := cmd.Flags().GetString("created-before")
 		updatedAfter, _ := cmd.Flags().GetString("updated-after")
 		updatedBefore, _ := cmd.Flags().GetString("updated-before")
+		since, _ := cmd.Flags().GetString("since")
 		closedAfter, _ := cmd.Flags().GetString("closed-after")
 		closedBefore, _ := cmd.Flags().GetString("closed-before")
 		
@@ -356,6 +525,13 @@ var listCmd = &cobra.Command{
 		// Parent filtering (bd-yqhh)
 		parentID, _ := cmd.Flags().GetString("parent")
 
+		// Expression filtering
+		whereExpr, _ := cmd.Flags().GetString("where")
+		savedName, _ := cmd.Flags().GetString("saved")
+
+		// Archive browsing
+		archived, _ := cmd.Flags().GetBool("archived")
+
 		// Pretty and watch flags (GH#654)
 		prettyFormat, _ := cmd.Flags().GetBool("pretty")
 		watchMode, _ := cmd.Flags().GetBool("watch")
@@ -462,6 +638,14 @@ var listCmd = &cobra.Command{
 			}
 			filter.UpdatedBefore = &t
 		}
+		if since != "" {
+			t, err := parseSinceFlag(since, time.Now())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error parsing --since: %v\n", err)
+				os.Exit(1)
+			}
+			filter.UpdatedSince = &t
+		}
 		if closedAfter != "" {
 			t, err := parseTimeFlag(closedAfter)
 			if err != nil {
@@ -533,6 +717,36 @@ var listCmd = &cobra.Command{
 			filter.ParentID = &parentID
 		}
 
+		// Expression filtering: resolve here so a typo fails before any query.
+		// Saved queries live in the database config.
+		if savedName != "" {
+			if err := ensureDirectMode("--saved requires direct database access"); err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
+		}
+		whereExpr, err := resolveWhere(rootCtx, store, savedName, whereExpr, actor)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitCodeFor(err))
+		}
+		filter.Where = whereExpr
+
+		// Archived issues live in their own table and are only readable directly
+		if archived {
+			listArchivedIssues(limit, sortBy, reverse, longFormat)
+			return
+		}
+
+		// Keyset pagination lists in creation order so pages stay stable
+		if paginate, _ := cmd.Flags().GetBool("paginate"); paginate || cmd.Flags().Changed("cursor") {
+			if err := checkPaginationFlags(cmd); err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
+			cursor, _ := cmd.Flags().GetString("cursor")
+			listIssuePage(filter, types.PageRequest{Limit: limit, Cursor: cursor})
+			return
+		}
+
 		// Check database freshness before reading (bd-2q6d, bd-c4rq)
 		// Skip check when using daemon (daemon auto-imports on staleness)
 		ctx := rootCtx
@@ -592,6 +806,9 @@ var listCmd = &cobra.Command{
 			if filter.UpdatedBefore != nil {
 				listArgs.UpdatedBefore = filter.UpdatedBefore.Format(time.RFC3339)
 			}
+			if filter.UpdatedSince != nil {
+				listArgs.UpdatedSince = filter.UpdatedSince.Format(time.RFC3339Nano)
+			}
 			if filter.ClosedAfter != nil {
 				listArgs.ClosedAfter = filter.ClosedAfter.Format(time.RFC3339)
 			}
@@ -617,6 +834,9 @@ var listCmd = &cobra.Command{
 			// Parent filtering (bd-yqhh)
 			listArgs.ParentID = parentID
 
+			// Expression filtering
+			listArgs.Where = whereExpr
+
 			 resp, err := daemonClient.List(listArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -646,6 +866,22 @@ var listCmd = &cobra.Command{
 			// Apply sorting
 			sortIssues(issues, sortBy, reverse)
 
+			if formatStr != "" {
+				if formatStr == "dot" || formatStr == "digraph" {
+					FatalError("--format %s requires direct database access (use --no-daemon)", formatStr)
+				}
+				tmpl, err := parseIssueFormat(formatStr)
+				if err == nil {
+					err = writeIssueFormat(os.Stdout, tmpl, issues)
+				}
+				if err != nil {
+					FatalError("%v", err)
+				}
+				return
+			}
+
+			issues, total := truncateIssueList(issues, listDefaultLimit(cmd))
+
 			if longFormat {
 				// Long format: multi-line with details
 				fmt.Printf("\nFound %d issues:\n\n", len(issues))
@@ -703,6 +939,7 @@ var listCmd = &cobra.Command{
 					}
 				}
 			}
+			printTruncatedFooter(len(issues), total)
 			return
 		}
 
@@ -735,9 +972,17 @@ var listCmd = &cobra.Command{
 			return
 		}
 
+		// list-default-limit keeps terminals readable; JSON and --format
+		// output stay complete for scripts
+		total := len(issues)
+		if !jsonOutput && formatStr == "" {
+			issues, total = truncateIssueList(issues, listDefaultLimit(cmd))
+		}
+
 		// Handle pretty format (GH#654)
 		if prettyFormat {
 			displayPrettyList(issues, false)
+			printTruncatedFooter(len(issues), total)
 			return
 		}
 
@@ -852,6 +1097,7 @@ var listCmd = &cobra.Command{
 				}
 			}
 		}
+		printTruncatedFooter(len(issues), total)
 
 		// Show tip after successful list (direct mode only)
 		maybeShowTip(store)
@@ -868,8 +1114,10 @@ func init() {
 	listCmd.Flags().String("title", "", "Filter by title text (case-insensitive substring match)")
 	listCmd.Flags().String("id", "", "Filter by specific issue IDs (comma-separated, e.g., bd-1,bd-5,bd-10)")
 	listCmd.Flags().IntP("limit", "n", 0, "Limit results")
-	listCmd.Flags().String("format", "", "Output format: 'digraph' (for golang.org/x/tools/cmd/digraph), 'dot' (Graphviz), or Go template")
-	listCmd.Flags().Bool("all", false, "Show all issues (default behavior; flag provided for CLI familiarity)")
+	listCmd.Flags().Bool("paginate", false, "Page through issues in creation order, --limit at a time; prints the --cursor for the next page (not combinable with --sort, --reverse, --pretty, --watch, --format or --long)")
+	listCmd.Flags().String("cursor", "", "Continue --paginate from the cursor printed by the previous page")
+	listCmd.Flags().String("format", "", "Output format: 'digraph' (for golang.org/x/tools/cmd/digraph), 'dot' (Graphviz), 'id-only', 'oneline', or a Go template run per issue, e.g. '{{.ID}}: {{.Title}}'")
+	listCmd.Flags().Bool("all", false, "Show all issues, ignoring list-default-limit")
 	listCmd.Flags().Bool("long", false, "Show detailed multi-line output for each issue")
 	listCmd.Flags().String("sort", "", "Sort by field: priority, created, updated, closed, status, id, title, type, assignee")
 	listCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
@@ -884,6 +1132,7 @@ func init() {
 	listCmd.Flags().String("created-before", "", "Filter issues created before date (YYYY-MM-DD or RFC3339)")
 	listCmd.Flags().String("updated-after", "", "Filter issues updated after date (YYYY-MM-DD or RFC3339)")
 	listCmd.Flags().String("updated-before", "", "Filter issues updated before date (YYYY-MM-DD or RFC3339)")
+	listCmd.Flags().String("since", "", "Filter issues updated at or after a time (24h, 7d, YYYY-MM-DD, or RFC3339)")
 	listCmd.Flags().String("closed-after", "", "Filter issues closed after date (YYYY-MM-DD or RFC3339)")
 	listCmd.Flags().String("closed-before", "", "Filter issues closed before date (YYYY-MM-DD or RFC3339)")
 	
@@ -906,7 +1155,13 @@ func init() {
 	// Parent filtering (bd-yqhh): filter children by parent issue
 	listCmd.Flags().String("parent", "", "Filter by parent issue ID (shows children of specified issue)")
 
+	// Expression filtering: fields are id, title, status, type, assignee,
+	// priority, label, created, updated, closed and due
+	listCmd.Flags().String("where", "", "Filter by expression, e.g. 'status=open and (label=backend or priority<=1)'")
+	listCmd.Flags().String("saved", "", "Filter by a saved query (see 'bd query'); combines with --where")
+
 	// Pretty and watch flags (GH#654)
+	listCmd.Flags().Bool("archived", false, "List archived issues (see 'bd archive')")
 	listCmd.Flags().Bool("pretty", false, "Display issues in a tree format with status/priority symbols")
 	listCmd.Flags().BoolP("watch", "w", false, "Watch for changes and auto-update display (implies --pretty)")
 
@@ -991,37 +1246,45 @@ func outputDotFormat(ctx context.Context, store storage.Storage, issues []*types
 	return nil
 }
 
-// outputFormattedList outputs issues in a custom format (preset or Go template)
+// outputFormattedList outputs issues in a custom format: 'dot', the
+// 'digraph' dependency edge list, or a per-issue preset or Go template (see
+// parseIssueFormat)
 func outputFormattedList(ctx context.Context, store storage.Storage, issues []*types.Issue, formatStr string) error {
-	// Handle special 'dot' format (Graphviz output)
-	if formatStr == "dot" {
+	switch formatStr {
+	case "dot":
+		// Graphviz output
 		return outputDotFormat(ctx, store, issues)
+	case "digraph":
+		return outputDigraphFormat(ctx, store, issues)
 	}
 
-	// Built-in format presets
-	presets := map[string]string{
-		"digraph": "{{.IssueID}} {{.DependsOnID}}",
+	tmpl, err := parseIssueFormat(formatStr)
+	if err != nil {
+		return err
 	}
 
-	// Check if it's a preset
-	templateStr, isPreset := presets[formatStr]
-	if !isPreset {
-		templateStr = formatStr
+	// Load labels in bulk so templates can use {{.Labels}}
+	issueIDs := make([]string, len(issues))
+	for i, issue := range issues {
+		issueIDs[i] = issue.ID
 	}
-
-	// Parse template
-	tmpl, err := template.New("format").Parse(templateStr)
-	if err != nil {
-		return fmt.Errorf("invalid format template: %w", err)
+	labelsMap, _ := store.GetLabelsForIssues(ctx, issueIDs)
+	for _, issue := range issues {
+		issue.Labels = labelsMap[issue.ID]
 	}
 
+	return writeIssueFormat(os.Stdout, tmpl, issues)
+}
+
+// outputDigraphFormat prints one "issue dependency" line per dependency edge
+// between the listed issues, for golang.org/x/tools/cmd/digraph
+func outputDigraphFormat(ctx context.Context, store storage.Storage, issues []*types.Issue) error {
 	// Build map of all issues for quick lookup
 	issueMap := make(map[string]bool)
 	for _, issue := range issues {
 		issueMap[issue.ID] = true
 	}
 
-	// For each issue, output its dependencies using the template
 	for _, issue := range issues {
 		deps, err := store.GetDependencyRecords(ctx, issue.ID)
 		if err != nil {
@@ -1030,20 +1293,7 @@ func outputFormattedList(ctx context.Context, store storage.Storage, issues []*t
 		for _, dep := range deps {
 			// Only output edges where both nodes are in the filtered list
 			if issueMap[dep.DependsOnID] {
-				// Template data includes both issue and dependency info
-				data := map[string]interface{}{
-					"IssueID":     issue.ID,
-					"DependsOnID": dep.DependsOnID,
-					"Type":        dep.Type,
-					"Issue":       issue,
-					"Dependency":  dep,
-				}
-
-				var buf bytes.Buffer
-				if err := tmpl.Execute(&buf, data); err != nil {
-					return fmt.Errorf("template execution error: %w", err)
-				}
-				fmt.Println(buf.String())
+				fmt.Printf("%s %s\n", issue.ID, dep.DependsOnID)
 			}
 		}
 	}
@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/util"
@@ -479,3 +482,188 @@ func TestParseTimeFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSinceFlag(t *testing.T) {
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"hours", "24h", now.Add(-24 * time.Hour), false},
+		{"days", "7d", now.AddDate(0, 0, -7), false},
+		{"RFC3339", "2025-06-01T08:30:00Z", time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC), false},
+		{"date only", "2025-06-01", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), false},
+		{"invalid", "last tuesday", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSinceFlag(tt.input, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSinceFlag(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseSinceFlag(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListUpdatedSince(t *testing.T) {
+	tmpDir := t.TempDir()
+	s := newTestStore(t, filepath.Join(tmpDir, ".beads", "beads.db"))
+	defer s.Close()
+	ctx := context.Background()
+
+	boundary := time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC)
+	for id, updatedAt := range map[string]time.Time{
+		"test-before": boundary.Add(-time.Second),
+		"test-equal":  boundary,
+		"test-after":  boundary.Add(time.Hour),
+	} {
+		issue := &types.Issue{
+			ID:        id,
+			Title:     id,
+			Status:    types.StatusOpen,
+			Priority:  2,
+			IssueType: types.TypeTask,
+			CreatedAt: boundary.Add(-24 * time.Hour),
+			UpdatedAt: updatedAt,
+		}
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+
+	since, err := parseSinceFlag("2025-06-01T08:30:00Z", time.Now())
+	if err != nil {
+		t.Fatalf("parseSinceFlag failed: %v", err)
+	}
+	results, err := s.SearchIssues(ctx, "", types.IssueFilter{UpdatedSince: &since})
+	if err != nil {
+		t.Fatalf("SearchIssues failed: %v", err)
+	}
+	var ids []string
+	for _, issue := range results {
+		ids = append(ids, issue.ID)
+	}
+	if got := strings.Join(ids, ","); got != "test-after,test-equal" && got != "test-equal,test-after" {
+		t.Errorf("--since matched %v, want test-equal and test-after", ids)
+	}
+}
+
+func TestListSortByPriority(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "test-p3", Priority: 3},
+		{ID: "test-p0", Priority: 0},
+		{ID: "test-p4", Priority: 4},
+		{ID: "test-p1", Priority: 1},
+	}
+	ids := func() string {
+		var ids []string
+		for _, issue := range issues {
+			ids = append(ids, issue.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	sortIssues(issues, "priority", false)
+	if got := ids(); got != "test-p0,test-p1,test-p3,test-p4" {
+		t.Errorf("--sort priority = %s, want highest priority (P0) first", got)
+	}
+	sortIssues(issues, "priority", true)
+	if got := ids(); got != "test-p4,test-p3,test-p1,test-p0" {
+		t.Errorf("--sort priority --reverse = %s, want lowest priority first", got)
+	}
+}
+
+func TestListDefaultLimit(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	t.Cleanup(func() { config.Set("list-default-limit", 50) })
+
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().IntP("limit", "n", 0, "")
+		cmd.Flags().Bool("all", false, "")
+		if err := cmd.Flags().Parse(args); err != nil {
+			t.Fatalf("Parse(%v) failed: %v", args, err)
+		}
+		return cmd
+	}
+
+	issues := make([]*types.Issue, 60)
+	for i := range issues {
+		issues[i] = &types.Issue{ID: fmt.Sprintf("test-%d", i)}
+	}
+
+	// The default applies when neither --limit nor --all is given
+	shown, total := truncateIssueList(issues, listDefaultLimit(newCmd()))
+	if len(shown) != 50 || total != 60 {
+		t.Errorf("default limit showed %d of %d, want 50 of 60", len(shown), total)
+	}
+
+	// An explicit --limit is applied by the query itself, so no default on top
+	if got := listDefaultLimit(newCmd("--limit", "5")); got != 0 {
+		t.Errorf("listDefaultLimit() with --limit = %d, want 0", got)
+	}
+
+	tests := []struct {
+		name  string
+		args  []string
+		limit int
+		want  int
+	}{
+		{"explicit zero limit", []string{"--limit", "0"}, 50, 60},
+		{"all", []string{"--all"}, 50, 60},
+		{"config lowered", nil, 10, 10},
+		{"config raised", nil, 100, 60},
+		{"config disabled", nil, 0, 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Set("list-default-limit", tt.limit)
+			shown, total := truncateIssueList(issues, listDefaultLimit(newCmd(tt.args...)))
+			if len(shown) != tt.want || total != 60 {
+				t.Errorf("showed %d of %d, want %d of 60", len(shown), total, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPaginationFlags(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().Bool("paginate", false, "")
+		cmd.Flags().String("cursor", "", "")
+		cmd.Flags().IntP("limit", "n", 0, "")
+		cmd.Flags().String("sort", "", "")
+		cmd.Flags().BoolP("reverse", "r", false, "")
+		cmd.Flags().Bool("pretty", false, "")
+		cmd.Flags().BoolP("watch", "w", false, "")
+		cmd.Flags().String("format", "", "")
+		cmd.Flags().Bool("long", false, "")
+		if err := cmd.Flags().Parse(args); err != nil {
+			t.Fatalf("Parse(%v) failed: %v", args, err)
+		}
+		return cmd
+	}
+
+	if err := checkPaginationFlags(newCmd("--paginate", "--limit", "10")); err != nil {
+		t.Errorf("--paginate --limit rejected: %v", err)
+	}
+	for _, args := range [][]string{
+		{"--paginate", "--sort", "priority"},
+		{"--cursor", "abc", "--reverse"},
+		{"--paginate", "--pretty"},
+		{"--paginate", "--format", "id-only"},
+	} {
+		if err := checkPaginationFlags(newCmd(args...)); err == nil {
+			t.Errorf("checkPaginationFlags(%v) = nil, want error", args)
+		}
+	}
+}
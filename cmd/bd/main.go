@@ -9,7 +9,6 @@ import (
 	"regexp"
 	"runtime/pprof"
 	"runtime/trace"
-	"slices"
 	"strings"
 	"sync"
 	"syscall"
@@ -20,6 +19,7 @@ import (
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/hooks"
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/molecules"
 	"github.com/steveyegge/beads/internal/rpc"
 	"github.com/steveyegge/beads/internal/storage"
@@ -108,11 +108,14 @@ var (
 	noDb           bool          // Use --no-db mode: load from JSONL, write back after each command
 	readonlyMode   bool          // Read-only mode: block write operations (for worker sandboxes)
 	lockTimeout    time.Duration // SQLite busy_timeout (default 30s, 0 = fail immediately)
+	commandTimeout time.Duration // Deadline for the whole command (0 = none)
 	profileEnabled bool
 	profileFile    *os.File
 	traceFile      *os.File
 	verboseFlag    bool // Enable verbose/debug output
 	quietFlag      bool // Suppress non-essential output
+	noColor        bool   // Disable colored output
+	configSource   string // --config: "-" reads config YAML from stdin
 )
 
 // Command group IDs for help organization
@@ -135,7 +138,7 @@ func init() {
 
 	// Register persistent flags
 	rootCmd.PersistentFlags().StringVar(&dbPath, "db", "", "Database path (default: auto-discover .beads/*.db)")
-	rootCmd.PersistentFlags().StringVar(&actor, "actor", "", "Actor name for audit trail (default: $BD_ACTOR or $USER)")
+	rootCmd.PersistentFlags().StringVar(&actor, "actor", "", "Actor name for audit trail (default: $BD_ACTOR, config actor, git user.name, or $USER)")
 	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Output in JSON format")
 	rootCmd.PersistentFlags().BoolVar(&noDaemon, "no-daemon", false, "Force direct storage mode, bypass daemon if running")
 	rootCmd.PersistentFlags().BoolVar(&noAutoFlush, "no-auto-flush", false, "Disable automatic JSONL sync after CRUD operations")
@@ -145,9 +148,12 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&noDb, "no-db", false, "Use no-db mode: load from JSONL, no SQLite")
 	rootCmd.PersistentFlags().BoolVar(&readonlyMode, "readonly", false, "Read-only mode: block write operations (for worker sandboxes)")
 	rootCmd.PersistentFlags().DurationVar(&lockTimeout, "lock-timeout", 30*time.Second, "SQLite busy timeout (0 = fail immediately if locked)")
+	rootCmd.PersistentFlags().DurationVar(&commandTimeout, "timeout", 0, "Abort the command if it runs longer than this, e.g. 30s (0 = no timeout)")
 	rootCmd.PersistentFlags().BoolVar(&profileEnabled, "profile", false, "Generate CPU profile for performance analysis")
 	rootCmd.PersistentFlags().BoolVarP(&verboseFlag, "verbose", "v", false, "Enable verbose/debug output")
 	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "Suppress non-essential output (errors only)")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output (same as color: never)")
+	rootCmd.PersistentFlags().StringVar(&configSource, "config", "", "Read config YAML from stdin instead of config.yaml (only '-' is supported)")
 
 	// Add --version flag to root command (same behavior as version subcommand)
 	rootCmd.Flags().BoolP("version", "V", false, "Print version information")
@@ -316,6 +322,17 @@ var rootCmd = &cobra.Command{
 		debug.SetVerbose(verboseFlag)
 		debug.SetQuiet(quietFlag)
 
+		// --config - replaces config.yaml discovery with YAML piped on stdin
+		// (e.g. from a CI secret store)
+		if configSource != "" {
+			if configSource != "-" {
+				FatalErrorCode(ExitUsage, "--config only supports '-' (read YAML from stdin); use .beads/config.yaml for files")
+			}
+			if err := config.InitializeFromReader(os.Stdin); err != nil {
+				FatalErrorCode(ExitConfig, "%v", err)
+			}
+		}
+
 		// Apply viper configuration if flags weren't explicitly set
 		// Priority: flags > viper (config file + env vars) > defaults
 		// Do this BEFORE early-return so init/version/help respect config
@@ -335,6 +352,14 @@ var rootCmd = &cobra.Command{
 				WasSet bool
 			}{jsonOutput, true}
 		}
+		if !cmd.Flags().Changed("quiet") {
+			quietFlag = config.GetBool("quiet")
+		} else {
+			flagOverrides["quiet"] = struct {
+				Value  interface{}
+				WasSet bool
+			}{quietFlag, true}
+		}
 		if !cmd.Flags().Changed("no-daemon") {
 			noDaemon = config.GetBool("no-daemon")
 		} else {
@@ -383,6 +408,16 @@ var rootCmd = &cobra.Command{
 				WasSet bool
 			}{lockTimeout, true}
 		}
+		if !cmd.Flags().Changed("timeout") {
+			commandTimeout = config.GetDuration("command-timeout")
+		} else {
+			flagOverrides["command-timeout"] = struct {
+				Value  interface{}
+				WasSet bool
+			}{commandTimeout, true}
+		}
+		// Bound the whole command, so a locked database can't block it forever
+		rootCtx, rootCancel = withCommandTimeout(rootCtx, rootCancel, commandTimeout)
 		if !cmd.Flags().Changed("db") && dbPath == "" {
 			dbPath = config.GetString("db")
 		} else if cmd.Flags().Changed("db") {
@@ -391,15 +426,41 @@ var rootCmd = &cobra.Command{
 				WasSet bool
 			}{dbPath, true}
 		}
-		if !cmd.Flags().Changed("actor") && actor == "" {
-			actor = config.GetString("actor")
-		} else if cmd.Flags().Changed("actor") {
+		// An explicit path (flag > BD_DB/config.yaml) wins over metadata.json
+		// discovery; make it absolute so later chdirs can't move it.
+		if dbPath != "" {
+			dbPath = beads.ResolveDatabasePath(dbPath, "")
+		}
+		// Actor for the audit trail: --actor > BD_ACTOR > config.yaml > git user.name > $USER
+		if cmd.Flags().Changed("actor") || actor == "" {
+			resolved, err := resolveActor(actor, cmd.Flags().Changed("actor"))
+			if err != nil {
+				FatalErrorCode(ExitUsage, "%v", err)
+			}
+			actor = resolved
+		}
+		if cmd.Flags().Changed("actor") {
 			flagOverrides["actor"] = struct {
 				Value  interface{}
 				WasSet bool
 			}{actor, true}
 		}
 
+		// Quiet mode: only errors (stderr) get through, unless JSON was asked for
+		debug.SetQuiet(quietFlag)
+		if quietFlag && !jsonOutput {
+			silenceStdout()
+		}
+
+		// Color: --no-color > color config (auto/always/never). JSON is never colored.
+		colorMode := config.GetString("color")
+		if noColor || jsonOutput {
+			colorMode = ui.ColorNever
+		}
+		if err := ui.SetColorMode(colorMode); err != nil {
+			FatalErrorCode(ExitConfig, "%v", err)
+		}
+
 		// Check for and log configuration overrides (only in verbose mode)
 		if verboseFlag {
 			overrides := config.CheckOverrides(flagOverrides)
@@ -408,6 +469,21 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		// Separator between prefix and hash in issue IDs ("bd-123", "bd/123")
+		if err := idgen.SetSeparator(config.GetString("id-separator")); err != nil {
+			FatalErrorCode(ExitConfig, "%v", err)
+		}
+
+		// Permissions for created config.yaml, database and export files
+		if err := config.ValidateFileMode(); err != nil {
+			FatalErrorCode(ExitConfig, "%v", err)
+		}
+
+		// Timestamp format for JSONL exports
+		if err := config.ValidateTimestampFormat(); err != nil {
+			FatalErrorCode(ExitConfig, "%v", err)
+		}
+
 		// Protect forks from accidentally committing upstream issue database
 		ensureForkProtection()
 
@@ -428,36 +504,37 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
-		// Skip database initialization for commands that don't need a database
+		// Skip database initialization for commands that don't need a database.
+		// Entries are command paths, so a subcommand sharing a name (db merge)
+		// isn't skipped by accident; a parent covers its subcommands.
 		noDbCommands := []string{
 			cmdDaemon,
-			"bash",
+			cobra.ShellCompRequestCmd, // completion reads the database itself
+			cobra.ShellCompNoDescRequestCmd,
+			"backup",
 			"completion",
+			"config init",
+			"config schema",
+			"db move",   // db move opens the database itself
+			"db verify", // db verify opens each database read-only itself
+			"diff",
 			"doctor",
-			"fish",
 			"help",
 			"hooks",
 			"init",
-			"merge",
+			"merge", // git merge driver
 			"onboard",
-			"powershell",
 			"prime",
 			"quickstart",
+			"rebuild",
+			"schema",
 			"setup",
 			"version",
-			"zsh",
 		}
-		// Check both the command name and parent command name for subcommands
-		cmdName := cmd.Name()
-		if cmd.Parent() != nil {
-			parentName := cmd.Parent().Name()
-			if slices.Contains(noDbCommands, parentName) {
-				return
-			}
-		}
-		if slices.Contains(noDbCommands, cmdName) {
+		if commandMatches(cmd, noDbCommands) {
 			return
 		}
+		cmdName := cmd.Name()
 
 		// Skip for root command with no subcommand (just shows help)
 		if cmd.Parent() == nil && cmdName == "bd" {
@@ -469,6 +546,14 @@ var rootCmd = &cobra.Command{
 			return
 		}
 
+		// Refuse to touch the database with a binary older than the project
+		// allows. bd config stays usable so the setting can be changed.
+		if cmdName != "config" && (cmd.Parent() == nil || cmd.Parent().Name() != "config") {
+			if err := checkMinBdVersion(config.GetString("min-bd-version"), Version); err != nil {
+				FatalErrorCode(ExitConfig, "%v", err)
+			}
+		}
+
 		// Auto-detect sandboxed environment (bd-u3t: Phase 2 for GH #353)
 		// Only auto-enable if user hasn't explicitly set --sandbox or --no-daemon
 		if !cmd.Flags().Changed("sandbox") && !cmd.Flags().Changed("no-daemon") {
@@ -494,6 +579,9 @@ var rootCmd = &cobra.Command{
 		if cmd.Name() == "edit" {
 			noDaemon = true
 		}
+		if edit, _ := cmd.Flags().GetBool("edit"); edit && cmd.Name() == "create" {
+			noDaemon = true
+		}
 
 		// Set auto-flush based on flag (invert no-auto-flush)
 		autoFlushEnabled = !noAutoFlush
@@ -508,17 +596,6 @@ var rootCmd = &cobra.Command{
 				os.Exit(1)
 			}
 
-			// Set actor for audit trail
-			if actor == "" {
-				if bdActor := os.Getenv("BD_ACTOR"); bdActor != "" {
-					actor = bdActor
-				} else if user := os.Getenv("USER"); user != "" {
-					actor = user
-				} else {
-					actor = "unknown"
-				}
-			}
-
 			// Skip daemon and SQLite initialization - we're in memory mode
 			return
 		}
@@ -550,14 +627,6 @@ var rootCmd = &cobra.Command{
 							fmt.Fprintf(os.Stderr, "Error initializing JSONL-only mode: %v\n", err)
 							os.Exit(1)
 						}
-						// Set actor from flag, viper, or env
-						if actor == "" {
-							if user := os.Getenv("USER"); user != "" {
-								actor = user
-							} else {
-								actor = "unknown"
-							}
-						}
 						return
 					}
 				}
@@ -580,7 +649,7 @@ var rootCmd = &cobra.Command{
 							fmt.Fprintf(os.Stderr, "  • Run 'bd init' to create database and import issues\n")
 							fmt.Fprintf(os.Stderr, "  • Use 'bd --no-db %s' for JSONL-only mode\n", cmd.Name())
 							fmt.Fprintf(os.Stderr, "  • Add 'no-db: true' to .beads/config.yaml for permanent JSONL-only mode\n")
-							os.Exit(1)
+							os.Exit(ExitNotFound)
 						}
 					}
 
@@ -588,26 +657,13 @@ var rootCmd = &cobra.Command{
 					fmt.Fprintf(os.Stderr, "Hint: run 'bd init' to create a database in the current directory\n")
 					fmt.Fprintf(os.Stderr, "      or use 'bd --no-db' to work with JSONL only (no SQLite)\n")
 					fmt.Fprintf(os.Stderr, "      or set BEADS_DIR to point to your .beads directory\n")
-					os.Exit(1)
+					os.Exit(ExitNotFound)
 				}
 				// For import/setup commands, set default database path
 				dbPath = filepath.Join(".beads", beads.CanonicalDatabaseName)
 			}
 		}
 
-		// Set actor from flag, viper (env), or default
-		// Priority: --actor flag > viper (config + BD_ACTOR env) > USER env > "unknown"
-		// Note: Viper handles BD_ACTOR automatically via AutomaticEnv()
-		if actor == "" {
-			// Viper already populated from config file or BD_ACTOR env
-			// Fall back to USER env if still empty
-			if user := os.Getenv("USER"); user != "" {
-				actor = user
-			} else {
-				actor = "unknown"
-			}
-		}
-
 		// Track bd version changes (bd-loka)
 		// Best-effort tracking - failures are silent
 		trackBdVersion()
@@ -619,7 +675,7 @@ var rootCmd = &cobra.Command{
 			Connected:        false,
 			Degraded:         true,
 			SocketPath:       socketPath,
-			AutoStartEnabled: shouldAutoStartDaemon(),
+			AutoStartEnabled: shouldAutoStartDaemon() && !skipAutoStartForCommand(cmd),
 			FallbackReason:   FallbackNone,
 		}
 
@@ -806,7 +862,11 @@ var rootCmd = &cobra.Command{
 				os.Exit(1)
 			}
 			fmt.Fprintf(os.Stderr, "Error: failed to open database: %v\n", err)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
+		}
+		if sqliteStore, ok := store.(*sqlite.SQLiteStorage); ok {
+			sqliteStore.SetWriteMaxRetries(config.GetInt("write-max-retries"))
+			checkStrictPrefix(cmd, sqliteStore)
 		}
 
 		// Mark store as active for flush goroutine safety
@@ -866,6 +926,8 @@ var rootCmd = &cobra.Command{
 		}
 	},
 	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		defer restoreStdout()
+
 		// Handle --no-db mode: write memory storage back to JSONL
 		if noDb {
 			if store != nil {
@@ -932,8 +994,13 @@ var rootCmd = &cobra.Command{
 // Defaults to 5 seconds if not set or invalid
 
 func main() {
+	// 'bd foo' runs a bd-foo plugin from PATH when foo isn't built in
+	if path, args, ok := findPlugin(rootCmd, os.Args[1:]); ok {
+		os.Exit(runPlugin(path, args))
+	}
+	markUsageErrors(rootCmd)
 	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(exitCodeFor(err))
 	}
 }
 
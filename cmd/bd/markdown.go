@@ -347,7 +347,6 @@ func createIssuesFromMarkdown(_ *cobra.Command, filepath string) {
 			Description:        template.Description,
 			Design:             template.Design,
 			AcceptanceCriteria: template.AcceptanceCriteria,
-			Status:             types.StatusOpen,
 			Priority:           template.Priority,
 			IssueType:          template.IssueType,
 			Assignee:           template.Assignee,
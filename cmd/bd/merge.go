@@ -17,6 +17,7 @@ var (
 
 var mergeCmd = &cobra.Command{
 	Use:     "merge <output> <base> <left> <right>",
+	Aliases: []string{"merge-driver"},
 	GroupID: "sync",
 	Short:   "Git merge driver for beads JSONL files",
 	Long: `bd merge is a git merge driver for beads issue tracker JSONL files.
@@ -27,6 +28,9 @@ To merge duplicate issues, use 'bd duplicates --auto-merge' instead.
 This tool handles 3-way merges during git pull/merge operations. It intelligently
 merges issues based on identity (id + created_at + created_by), applies field-specific
 merge rules, combines dependencies, and outputs conflict markers for unresolvable conflicts.
+Fields without a specific rule (assignee, labels, design, ...) are merged one by one:
+a field changed on only one side keeps that change, and a field changed on both
+sides takes the value from the side with the later updated_at.
 
 Designed to work as a git merge driver. Configure with:
 
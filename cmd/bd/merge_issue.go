@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var mergeIssueCmd = &cobra.Command{
+	Use:     "merge-issue <keep> <remove>",
+	GroupID: "deps",
+	Short:   "Merge a duplicate issue into another",
+	Long: `Merge <remove> into <keep>: the dependencies, comments, references and
+labels of <remove> move to <keep>, and issues that depended on <remove> now
+depend on <keep>. <remove> is then deleted, leaving a tombstone with the
+reason "merged into <keep>".
+
+Use 'bd dups' or 'bd duplicates' to find candidates.
+
+Examples:
+  bd merge-issue bd-12 bd-34    # Fold bd-34 into bd-12`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("merge-issue")
+		if err := ensureDirectMode("merge-issue requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("merge-issue requires SQLite storage")
+		}
+
+		ctx := rootCtx
+		keep, err := utils.ResolveIssueID(ctx, store, args[0])
+		if err != nil {
+			FatalErrorRespectJSON("resolving %s: %v", args[0], err)
+		}
+		remove, err := utils.ResolveIssueID(ctx, store, args[1])
+		if err != nil {
+			FatalErrorRespectJSON("resolving %s: %v", args[1], err)
+		}
+
+		if err := sqliteStore.MergeIssues(ctx, keep, remove, actor); err != nil {
+			FatalErrorRespectJSON("merging %s into %s: %v", remove, keep, err)
+		}
+		markDirtyAndScheduleFlush()
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"kept":   keep,
+				"merged": remove,
+			})
+			return
+		}
+		fmt.Printf("%s Merged %s into %s\n", ui.RenderPass("✓"), remove, keep)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mergeIssueCmd)
+}
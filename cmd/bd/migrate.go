@@ -3,6 +3,7 @@ package main
 import (
 	"database/sql"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -27,7 +28,7 @@ var migrateCmd = &cobra.Command{
 	Long: `Detect and migrate database files to the current version.
 
 This command:
-- Finds all .db files in .beads/
+- Finds all .db files in .beads/ (and its subdirectories with --recursive)
 - Checks schema versions
 - Migrates old databases to beads.db
 - Updates schema version metadata
@@ -42,6 +43,7 @@ This command:
 		toHashIDs, _ := cmd.Flags().GetBool("to-hash-ids")
 		inspect, _ := cmd.Flags().GetBool("inspect")
 		toSeparateBranch, _ := cmd.Flags().GetString("to-separate-branch")
+		recursive, _ := cmd.Flags().GetBool("recursive")
 
 		// Block writes in readonly mode (migration modifies data, --inspect is read-only)
 		if !dryRun && !inspect {
@@ -71,6 +73,7 @@ This command:
 		if beadsDir == "" {
 		if jsonOutput {
 		outputJSON(map[string]interface{}{
+		"status":  "failure",
 		"error":   "no_beads_directory",
 		"message": "No .beads directory found. Run 'bd init' first.",
 		})
@@ -85,6 +88,7 @@ This command:
 	if err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "config_load_failed",
 				"message": err.Error(),
 			})
@@ -95,10 +99,11 @@ This command:
 	}
 
 	// Detect all database files
-		databases, err := detectDatabases(beadsDir)
+		databases, err := detectDatabases(beadsDir, recursive)
 		if err != nil {
 			if jsonOutput {
 				outputJSON(map[string]interface{}{
+					"status":  "failure",
 					"error":   "detection_failed",
 					"message": err.Error(),
 				})
@@ -138,7 +143,7 @@ This command:
 		if !jsonOutput {
 			fmt.Printf("Database migration status:\n\n")
 			if currentDB != nil {
-				fmt.Printf("  Current database: %s\n", filepath.Base(currentDB.path))
+				fmt.Printf("  Current database: %s\n", currentDB.name())
 				fmt.Printf("  Schema version: %s\n", currentDB.version)
 				if currentDB.version != Version {
 					fmt.Printf("  ⚠ %s\n", ui.RenderWarn(fmt.Sprintf("Version mismatch (current: %s, expected: %s)", currentDB.version, Version)))
@@ -152,7 +157,7 @@ This command:
 			if len(oldDBs) > 0 {
 				fmt.Printf("\n  Old databases found:\n")
 				for _, db := range oldDBs {
-					fmt.Printf("    - %s (version: %s)\n", filepath.Base(db.path), db.version)
+					fmt.Printf("    - %s (version: %s)\n", db.name(), db.version)
 				}
 			}
 			fmt.Println()
@@ -169,6 +174,7 @@ This command:
 			// Multiple old databases - ambiguous
 			if jsonOutput {
 				outputJSON(map[string]interface{}{
+					"status":    "failure",
 					"error":     "ambiguous_migration",
 					"message":   "Multiple old database files found",
 					"databases": formatDBList(oldDBs),
@@ -177,7 +183,7 @@ This command:
 			} else {
 				fmt.Fprintf(os.Stderr, "Error: multiple old database files found:\n")
 				for _, db := range oldDBs {
-					fmt.Fprintf(os.Stderr, "  - %s (version: %s)\n", filepath.Base(db.path), db.version)
+					fmt.Fprintf(os.Stderr, "  - %s (version: %s)\n", db.name(), db.version)
 				}
 				fmt.Fprintf(os.Stderr, "\nPlease manually rename the correct database to %s and remove others.\n", cfg.Database)
 				os.Exit(1)
@@ -187,6 +193,9 @@ This command:
 			needsVersionUpdate = true
 		}
 
+		// Changes made, reported in the final JSON status
+		actions := []migrateAction{}
+
 		// Perform migrations
 		if dryRun {
 			if jsonOutput {
@@ -199,7 +208,7 @@ This command:
 			} else {
 				fmt.Println("Dry run mode - no changes will be made")
 				if needsMigration {
-				fmt.Printf("Would migrate: %s → %s\n", filepath.Base(oldDBs[0].path), cfg.Database)
+				fmt.Printf("Would migrate: %s → %s\n", oldDBs[0].name(), cfg.Database)
 				}
 				if needsVersionUpdate {
 					fmt.Printf("Would update version: %s → %s\n", currentDB.version, Version)
@@ -215,7 +224,7 @@ This command:
 		if needsMigration {
 			oldDB := oldDBs[0]
 			if !jsonOutput {
-				fmt.Printf("Migrating database: %s → %s\n", filepath.Base(oldDB.path), cfg.Database)
+				fmt.Printf("Migrating database: %s → %s\n", oldDB.name(), cfg.Database)
 			}
 
 			// Create backup before migration
@@ -224,6 +233,7 @@ This command:
 				if err := copyFile(oldDB.path, backupPath); err != nil {
 					if jsonOutput {
 						outputJSON(map[string]interface{}{
+							"status":  "failure",
 							"error":   "backup_failed",
 							"message": err.Error(),
 						})
@@ -232,6 +242,7 @@ This command:
 					}
 					os.Exit(1)
 				}
+				actions = append(actions, migrateAction{Action: "backup", From: oldDB.path, To: backupPath})
 				if !jsonOutput {
 					fmt.Printf("%s\n", ui.RenderPass(fmt.Sprintf("✓ Created backup: %s", filepath.Base(backupPath))))
 				}
@@ -240,6 +251,7 @@ This command:
 			if err := os.Rename(oldDB.path, targetPath); err != nil {
 				if jsonOutput {
 					outputJSON(map[string]interface{}{
+						"status":  "failure",
 						"error":   "migration_failed",
 						"message": err.Error(),
 					})
@@ -249,6 +261,8 @@ This command:
 				os.Exit(1)
 			}
 
+			actions = append(actions, migrateAction{Action: "rename", From: oldDB.path, To: targetPath})
+
 			// Clean up orphaned WAL files from old database
 			cleanupWALFiles(oldDB.path)
 
@@ -275,6 +289,7 @@ This command:
 			if err != nil {
 				if jsonOutput {
 					outputJSON(map[string]interface{}{
+						"status":  "failure",
 						"error":   "version_update_failed",
 						"message": err.Error(),
 					})
@@ -298,6 +313,7 @@ This command:
 							_ = store.Close()
 							if jsonOutput {
 								outputJSON(map[string]interface{}{
+									"status":  "failure",
 									"error":   "prefix_detection_failed",
 									"message": err.Error(),
 								})
@@ -306,6 +322,7 @@ This command:
 							}
 							os.Exit(1)
 						}
+						actions = append(actions, migrateAction{Action: "set_prefix", Detail: detectedPrefix})
 						if !jsonOutput {
 							fmt.Printf("%s\n", ui.RenderPass(fmt.Sprintf("✓ Detected and set issue prefix: %s", detectedPrefix)))
 						}
@@ -317,6 +334,7 @@ This command:
 				_ = store.Close()
 				if jsonOutput {
 					outputJSON(map[string]interface{}{
+						"status":  "failure",
 						"error":   "version_update_failed",
 						"message": err.Error(),
 					})
@@ -333,6 +351,7 @@ This command:
 				}
 			}
 
+			actions = append(actions, migrateAction{Action: "version_update", From: currentDB.version, To: Version})
 			if !jsonOutput {
 				fmt.Printf("%s\n\n", ui.RenderPass("✓ Version updated"))
 			}
@@ -349,7 +368,7 @@ This command:
 				if !autoYes && !jsonOutput {
 					fmt.Printf("Found %d old database file(s):\n", len(oldDBs))
 					for _, db := range oldDBs {
-						fmt.Printf("  - %s (version: %s)\n", filepath.Base(db.path), db.version)
+						fmt.Printf("  - %s (version: %s)\n", db.name(), db.version)
 					}
 					fmt.Print("\nRemove these files? [y/N] ")
 					var response string
@@ -363,10 +382,13 @@ This command:
 				for _, db := range oldDBs {
 					if err := os.Remove(db.path); err != nil {
 						if !jsonOutput {
-							fmt.Printf("%s\n", ui.RenderWarn(fmt.Sprintf("Warning: failed to remove %s: %v", filepath.Base(db.path), err)))
+							fmt.Printf("%s\n", ui.RenderWarn(fmt.Sprintf("Warning: failed to remove %s: %v", db.name(), err)))
+						}
+					} else {
+						actions = append(actions, migrateAction{Action: "remove", From: db.path})
+						if !jsonOutput {
+							fmt.Printf("Removed %s\n", db.name())
 						}
-					} else if !jsonOutput {
-						fmt.Printf("Removed %s\n", filepath.Base(db.path))
 					}
 				}
 
@@ -387,6 +409,7 @@ This command:
 			if err != nil {
 				if jsonOutput {
 					outputJSON(map[string]interface{}{
+						"status":  "failure",
 						"error":   "hash_migration_failed",
 						"message": err.Error(),
 					})
@@ -402,6 +425,7 @@ This command:
 			_ = store.Close()
 			if jsonOutput {
 					outputJSON(map[string]interface{}{
+						"status":  "failure",
 						"error":   "hash_migration_failed",
 						"message": err.Error(),
 					})
@@ -419,6 +443,7 @@ This command:
 						_ = store.Close()
 						if jsonOutput {
 							outputJSON(map[string]interface{}{
+								"status":  "failure",
 								"error":   "backup_failed",
 								"message": err.Error(),
 							})
@@ -427,6 +452,7 @@ This command:
 						}
 						os.Exit(1)
 					}
+					actions = append(actions, migrateAction{Action: "backup", From: targetPath, To: backupPath})
 					if !jsonOutput {
 						fmt.Printf("%s\n", ui.RenderPass(fmt.Sprintf("✓ Created backup: %s", filepath.Base(backupPath))))
 					}
@@ -438,6 +464,7 @@ This command:
 				if err != nil {
 					if jsonOutput {
 						outputJSON(map[string]interface{}{
+							"status":  "failure",
 							"error":   "hash_migration_failed",
 							"message": err.Error(),
 						})
@@ -447,6 +474,7 @@ This command:
 					os.Exit(1)
 				}
 				
+				actions = append(actions, migrateAction{Action: "hash_ids", Detail: fmt.Sprintf("%d issues", len(mapping))})
 				if !jsonOutput {
 					if dryRun {
 						fmt.Printf("\nWould migrate %d issues to hash-based IDs\n", len(mapping))
@@ -481,6 +509,8 @@ This command:
 				"migrated":         needsMigration,
 				"version_updated":  needsVersionUpdate,
 				"cleaned_up":       cleanup && len(oldDBs) > 0,
+				"databases":        formatDBList(databases),
+				"actions":          actions,
 			})
 		} else {
 			fmt.Println("\nMigration complete!")
@@ -489,12 +519,33 @@ This command:
 	},
 }
 
+// migrateAction is one change made by bd migrate, listed in its JSON output.
+type migrateAction struct {
+	Action string `json:"action"` // backup, rename, set_prefix, version_update, remove, hash_ids
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
 type dbInfo struct {
 	path    string
+	relPath string // Relative to the .beads directory
 	version string
 }
 
-func detectDatabases(beadsDir string) ([]*dbInfo, error) {
+// name is how the database is shown to the user: its path relative to the
+// .beads directory, which is just the file name unless it is nested.
+func (db *dbInfo) name() string {
+	if db.relPath != "" {
+		return db.relPath
+	}
+	return filepath.Base(db.path)
+}
+
+// detectDatabases finds the database files in beadsDir. With recursive it
+// also walks subdirectories (except backups/), where only databases carrying
+// bd_version metadata count, since other tools' .db files may live there too.
+func detectDatabases(beadsDir string, recursive bool) ([]*dbInfo, error) {
 	pattern := filepath.Join(beadsDir, "*.db")
 	matches, err := filepath.Glob(pattern)
 	if err != nil {
@@ -518,10 +569,56 @@ func detectDatabases(beadsDir string) ([]*dbInfo, error) {
 		version := getDBVersion(match)
 		databases = append(databases, &dbInfo{
 			path:    match,
+			relPath: filepath.Base(match),
 			version: version,
 		})
 	}
 
+	if recursive {
+		nested, err := detectNestedDatabases(beadsDir)
+		if err != nil {
+			return nil, err
+		}
+		databases = append(databases, nested...)
+	}
+
+	return databases, nil
+}
+
+// detectNestedDatabases walks the subdirectories of beadsDir for bd databases.
+func detectNestedDatabases(beadsDir string) ([]*dbInfo, error) {
+	var databases []*dbInfo
+	err := filepath.WalkDir(beadsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == beadsDir {
+				return err
+			}
+			return nil // Unreadable subdirectory: skip it
+		}
+		if d.IsDir() {
+			if path != beadsDir && d.Name() == "backups" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Dir(path) == beadsDir || filepath.Ext(path) != ".db" || strings.HasSuffix(path, ".backup.db") {
+			return nil
+		}
+
+		version := getDBVersion(path)
+		if version == "unknown" || version == "pre-0.17.5" {
+			return nil // Not a bd database
+		}
+		rel, err := filepath.Rel(beadsDir, path)
+		if err != nil {
+			rel = path
+		}
+		databases = append(databases, &dbInfo{path: path, relPath: rel, version: version})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for databases: %w", err)
+	}
 	return databases, nil
 }
 
@@ -573,7 +670,7 @@ func formatDBList(dbs []*dbInfo) []map[string]string {
 	for i, db := range dbs {
 		result[i] = map[string]string{
 			"path":    db.path,
-			"name":    filepath.Base(db.path),
+			"name":    db.name(),
 			"version": db.version,
 		}
 	}
@@ -586,6 +683,7 @@ func handleUpdateRepoID(dryRun bool, autoYes bool) {
 	if foundDB == "" {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "no_database",
 				"message": "No beads database found. Run 'bd init' first.",
 			})
@@ -601,6 +699,7 @@ func handleUpdateRepoID(dryRun bool, autoYes bool) {
 	if err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "compute_failed",
 				"message": err.Error(),
 			})
@@ -615,6 +714,7 @@ func handleUpdateRepoID(dryRun bool, autoYes bool) {
 	if err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "open_failed",
 				"message": err.Error(),
 			})
@@ -631,6 +731,7 @@ func handleUpdateRepoID(dryRun bool, autoYes bool) {
 	if err != nil && err.Error() != "metadata key not found: repo_id" {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "read_failed",
 				"message": err.Error(),
 			})
@@ -679,6 +780,7 @@ func handleUpdateRepoID(dryRun bool, autoYes bool) {
 	if err := store.SetMetadata(ctx, "repo_id", newRepoID); err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "update_failed",
 				"message": err.Error(),
 			})
@@ -712,6 +814,9 @@ func loadOrCreateConfig(beadsDir string) (*configfile.Config, error) {
 	if cfg == nil {
 		cfg = configfile.DefaultConfig()
 	}
+	if cfg.Database == "" {
+		cfg.Database = configfile.DefaultDatabaseName
+	}
 	
 	return cfg, nil
 }
@@ -733,6 +838,7 @@ func handleInspect() {
 	if beadsDir == "" {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "no_beads_directory",
 				"message": "No .beads directory found. Run 'bd init' first.",
 			})
@@ -748,6 +854,7 @@ func handleInspect() {
 	if err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "config_load_failed",
 				"message": err.Error(),
 			})
@@ -766,6 +873,7 @@ func handleInspect() {
 		// Stat error (not just "doesn't exist")
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "database_stat_failed",
 				"message": err.Error(),
 			})
@@ -806,6 +914,7 @@ func handleInspect() {
 	if err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "database_open_failed",
 				"message": err.Error(),
 			})
@@ -916,6 +1025,7 @@ func handleToSeparateBranch(branch string, dryRun bool) {
 	if b == "" || strings.ContainsAny(b, " \t\n") {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "invalid_branch",
 				"message": "Branch name cannot be empty or contain whitespace",
 			})
@@ -931,6 +1041,7 @@ func handleToSeparateBranch(branch string, dryRun bool) {
 	if beadsDir == "" {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "no_beads_directory",
 				"message": "No .beads directory found. Run 'bd init' first.",
 			})
@@ -946,6 +1057,7 @@ func handleToSeparateBranch(branch string, dryRun bool) {
 	if err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "config_load_failed",
 				"message": err.Error(),
 			})
@@ -960,6 +1072,7 @@ func handleToSeparateBranch(branch string, dryRun bool) {
 	if _, err := os.Stat(targetPath); os.IsNotExist(err) {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "database_missing",
 				"message": "Database not found. Run 'bd init' first.",
 			})
@@ -975,6 +1088,7 @@ func handleToSeparateBranch(branch string, dryRun bool) {
 	if err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "database_open_failed",
 				"message": err.Error(),
 			})
@@ -1028,6 +1142,7 @@ func handleToSeparateBranch(branch string, dryRun bool) {
 	if err := store.SetConfig(ctx, "sync.branch", b); err != nil {
 		if jsonOutput {
 			outputJSON(map[string]interface{}{
+				"status":  "failure",
 				"error":   "config_update_failed",
 				"message": err.Error(),
 			})
@@ -1066,6 +1181,7 @@ func init() {
 	migrateCmd.Flags().Bool("to-hash-ids", false, "Migrate sequential IDs to hash-based IDs")
 	migrateCmd.Flags().Bool("inspect", false, "Show migration plan and database state for AI agent analysis")
 	migrateCmd.Flags().String("to-separate-branch", "", "Enable separate branch workflow (e.g., 'beads-metadata')")
+	migrateCmd.Flags().Bool("recursive", false, "Also find databases in subdirectories of .beads/ (except backups/)")
 	migrateCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output migration statistics in JSON format")
 	rootCmd.AddCommand(migrateCmd)
 }
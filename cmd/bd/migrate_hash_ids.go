@@ -16,6 +16,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
@@ -323,7 +324,7 @@ func generateHashIDForIssue(prefix string, issue *types.Issue) string {
 	hash := sha256Hash(content)
 	shortHash := hash[:8] // First 8 hex chars
 	
-	return fmt.Sprintf("%s-%s", prefix, shortHash)
+	return idgen.FormatID(prefix, shortHash)
 }
 
 // sha256Hash computes SHA256 hash and returns first 8 hex chars
@@ -335,7 +336,7 @@ func sha256Hash(content string) string {
 // replaceIDReferences replaces all old ID references with new hash IDs
 func replaceIDReferences(text string, mapping map[string]string) string {
 	// Match patterns like "bd-123" or "bd-123.4"
-	re := regexp.MustCompile(`\bbd-\d+(?:\.\d+)*\b`)
+	re := regexp.MustCompile(`\bbd` + regexp.QuoteMeta(idgen.Separator()) + `\d+(?:\.\d+)*\b`)
 	
 	return re.ReplaceAllStringFunc(text, func(match string) string {
 		if newID, ok := mapping[match]; ok {
@@ -349,12 +350,13 @@ func replaceIDReferences(text string, mapping map[string]string) string {
 func isHashID(id string) bool {
 	// Hash IDs contain hex letters (a-f), sequential IDs are only digits
 	// May have hierarchical suffix like .1 or .1.2
-	lastSeperatorIndex := strings.LastIndex(id, "-")
+	sep := idgen.Separator()
+	lastSeperatorIndex := strings.LastIndex(id, sep)
 	if lastSeperatorIndex == -1 {
 		return false
 	}
 	
-	suffix := id[lastSeperatorIndex+1:]
+	suffix := id[lastSeperatorIndex+len(sep):]
 	// Strip hierarchical suffix like .1 or .1.2
 	baseSuffix := strings.Split(suffix, ".")[0]
 	
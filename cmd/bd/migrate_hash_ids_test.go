@@ -4,8 +4,10 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -296,6 +298,25 @@ func TestIsHashID(t *testing.T) {
 	}
 }
 
+func TestHashIDsWithSeparator(t *testing.T) {
+	if err := idgen.SetSeparator("/"); err != nil {
+		t.Fatalf("SetSeparator failed: %v", err)
+	}
+	t.Cleanup(func() { _ = idgen.SetSeparator(idgen.DefaultSeparator) })
+
+	if !isHashID("bd/a3f8e9a2") || isHashID("bd/123") {
+		t.Errorf("isHashID does not split on the configured separator")
+	}
+	issue := &types.Issue{Title: "Title", Description: "Desc"}
+	if id := generateHashIDForIssue("bd", issue); !strings.HasPrefix(id, "bd/") {
+		t.Errorf("generateHashIDForIssue() = %q, want bd/ prefix", id)
+	}
+	got := replaceIDReferences("see bd/12 and bd-12", map[string]string{"bd/12": "bd/a3f8e9a2"})
+	if got != "see bd/a3f8e9a2 and bd-12" {
+		t.Errorf("replaceIDReferences() = %q", got)
+	}
+}
+
 func TestCopyFile(t *testing.T) {
 	tmpDir := t.TempDir()
 	src := filepath.Join(tmpDir, "source.txt")
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -18,7 +19,7 @@ func TestMigrateCommand(t *testing.T) {
 	}
 
 	t.Run("no databases", func(t *testing.T) {
-		databases, err := detectDatabases(beadsDir)
+		databases, err := detectDatabases(beadsDir, false)
 		if err != nil {
 			t.Fatalf("detectDatabases failed: %v", err)
 		}
@@ -43,7 +44,7 @@ func TestMigrateCommand(t *testing.T) {
 		_ = store.Close()
 
 		// Detect databases
-		databases, err := detectDatabases(beadsDir)
+		databases, err := detectDatabases(beadsDir, false)
 		if err != nil {
 			t.Fatalf("detectDatabases failed: %v", err)
 		}
@@ -61,7 +62,7 @@ func TestMigrateCommand(t *testing.T) {
 		}
 
 		// Verify migration
-		databases, err = detectDatabases(beadsDir)
+		databases, err = detectDatabases(beadsDir, false)
 		if err != nil {
 			t.Fatalf("detectDatabases failed after migration: %v", err)
 		}
@@ -100,6 +101,60 @@ func TestMigrateCommand(t *testing.T) {
 	})
 }
 
+func TestDetectDatabasesRecursive(t *testing.T) {
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	ctx := context.Background()
+
+	createDB := func(rel, version string) {
+		t.Helper()
+		path := filepath.Join(beadsDir, rel)
+		store, err := sqlite.New(ctx, path)
+		if err != nil {
+			t.Fatalf("Failed to create %s: %v", rel, err)
+		}
+		if err := store.SetMetadata(ctx, "bd_version", version); err != nil {
+			t.Fatalf("Failed to set version on %s: %v", rel, err)
+		}
+		_ = store.Close()
+	}
+	createDB("beads.db", Version)
+	createDB(filepath.Join("areas", "frontend", "fe.db"), "0.16.0")
+	createDB(filepath.Join("backups", "old.db"), "0.15.0")
+	// A .db file that isn't a bd database
+	if err := os.WriteFile(filepath.Join(beadsDir, "areas", "cache.db"), []byte("not sqlite"), 0600); err != nil {
+		t.Fatalf("Failed to write cache.db: %v", err)
+	}
+
+	databases, err := detectDatabases(beadsDir, false)
+	if err != nil {
+		t.Fatalf("detectDatabases failed: %v", err)
+	}
+	if len(databases) != 1 {
+		t.Fatalf("Expected only beads.db without --recursive, got %d databases", len(databases))
+	}
+
+	databases, err = detectDatabases(beadsDir, true)
+	if err != nil {
+		t.Fatalf("detectDatabases(recursive) failed: %v", err)
+	}
+	var names []string
+	for _, db := range databases {
+		names = append(names, db.name())
+	}
+	nested := filepath.Join("areas", "frontend", "fe.db")
+	if len(databases) != 2 || names[0] != "beads.db" || names[1] != nested {
+		t.Fatalf("Expected [beads.db %s], got %v", nested, names)
+	}
+	if databases[1].version != "0.16.0" {
+		t.Errorf("Expected nested version 0.16.0, got %s", databases[1].version)
+	}
+
+	list := formatDBList(databases)
+	if list[1]["name"] != nested || list[1]["path"] != filepath.Join(beadsDir, nested) {
+		t.Errorf("formatDBList() = %v, want name %s with its full path", list[1], nested)
+	}
+}
+
 func TestFormatDBList(t *testing.T) {
 	dbs := []*dbInfo{
 		{path: "/tmp/.beads/beads.db", version: "0.17.5"},
@@ -175,3 +230,60 @@ func TestMigrateRespectsConfigJSON(t *testing.T) {
 		t.Errorf("Database does not exist at custom path: %s", actualPath)
 	}
 }
+
+func TestMigrateJSONOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0750); err != nil {
+		t.Fatalf("Failed to create .beads directory: %v", err)
+	}
+	ctx := context.Background()
+
+	oldDBPath := filepath.Join(beadsDir, "issues.db")
+	store, err := sqlite.New(ctx, oldDBPath)
+	if err != nil {
+		t.Fatalf("Failed to create old database: %v", err)
+	}
+	if err := store.SetMetadata(ctx, "bd_version", "0.16.0"); err != nil {
+		t.Fatalf("Failed to set old version: %v", err)
+	}
+	_ = store.Close()
+
+	t.Setenv("BEADS_DIR", beadsDir)
+	oldJSON, oldCtx := jsonOutput, rootCtx
+	jsonOutput, rootCtx = true, ctx
+	defer func() { jsonOutput, rootCtx = oldJSON, oldCtx }()
+
+	output := captureStdout(t, func() error {
+		migrateCmd.Run(migrateCmd, nil)
+		return nil
+	})
+
+	var result struct {
+		Status    string              `json:"status"`
+		Databases []map[string]string `json:"databases"`
+		Actions   []migrateAction     `json:"actions"`
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("output is not a single JSON object: %v\n%s", err, output)
+	}
+	if result.Status != "success" {
+		t.Errorf("status = %q, want success", result.Status)
+	}
+	if len(result.Databases) != 1 || result.Databases[0]["version"] != "0.16.0" {
+		t.Errorf("databases = %v, want issues.db at 0.16.0", result.Databases)
+	}
+	var actions []string
+	for _, action := range result.Actions {
+		actions = append(actions, action.Action)
+	}
+	for _, want := range []string{"backup", "rename", "version_update"} {
+		found := false
+		for _, action := range actions {
+			found = found || action == want
+		}
+		if !found {
+			t.Errorf("actions = %v, missing %q", actions, want)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 )
@@ -176,7 +177,7 @@ Examples:
 			decoder := json.NewDecoder(file)
 			for {
 				var issue types.Issue
-				if err := decoder.Decode(&issue); err != nil {
+				if err := types.DecodeIssueJSONL(decoder, &issue); err != nil {
 					if err.Error() == "EOF" {
 						break
 					}
@@ -264,11 +265,11 @@ Examples:
 		}
 		defer file.Close()
 
-		encoder := json.NewEncoder(file)
+		format := config.TimestampFormat()
 		var migratedIDs []string
 		for _, record := range toMigrate {
 			tombstone := convertLegacyDeletionRecordToTombstone(record)
-			if err := encoder.Encode(tombstone); err != nil {
+			if err := types.EncodeIssueJSONL(file, tombstone, format); err != nil {
 				if jsonOutput {
 					outputJSON(map[string]interface{}{
 						"error":    "write_tombstone_failed",
@@ -128,15 +128,15 @@ func runMolBond(cmd *cobra.Command, args []string) {
 	}
 
 	// Resolve both IDs
-	idA, err := utils.ResolvePartialID(ctx, store, args[0])
+	idA, err := utils.ResolveIssueID(ctx, store, args[0])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: '%s' not found\n", args[0])
-		os.Exit(1)
+		os.Exit(ExitNotFound)
 	}
-	idB, err := utils.ResolvePartialID(ctx, store, args[1])
+	idB, err := utils.ResolveIssueID(ctx, store, args[1])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: '%s' not found\n", args[1])
-		os.Exit(1)
+		os.Exit(ExitNotFound)
 	}
 
 	// Load both issues
@@ -67,10 +67,10 @@ func runMolBurn(cmd *cobra.Command, args []string) {
 	moleculeID := args[0]
 
 	// Resolve molecule ID in main store
-	resolvedID, err := utils.ResolvePartialID(ctx, store, moleculeID)
+	resolvedID, err := utils.ResolveIssueID(ctx, store, moleculeID)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error resolving molecule ID %s: %v\n", moleculeID, err)
-		os.Exit(1)
+		os.Exit(exitCodeFor(err))
 	}
 
 	// Load the molecule
@@ -74,10 +74,10 @@ The output shows all steps with status indicators:
 
 		if len(args) == 1 {
 			// Explicit molecule ID given
-			moleculeID, err := utils.ResolvePartialID(ctx, store, args[0])
+			moleculeID, err := utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: molecule '%s' not found\n", args[0])
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 
 			progress, err := getMoleculeProgress(ctx, store, moleculeID)
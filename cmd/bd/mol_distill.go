@@ -115,10 +115,10 @@ func runMolDistill(cmd *cobra.Command, args []string) {
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 
 	// Resolve epic ID
-	epicID, err := utils.ResolvePartialID(ctx, store, args[0])
+	epicID, err := utils.ResolveIssueID(ctx, store, args[0])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: '%s' not found\n", args[0])
-		os.Exit(1)
+		os.Exit(ExitNotFound)
 	}
 
 	// Load the epic subgraph (needed for smart var detection)
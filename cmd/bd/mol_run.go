@@ -98,10 +98,10 @@ func runMolRun(cmd *cobra.Command, args []string) {
 	}
 
 	// Resolve molecule ID from template store
-	moleculeID, err := utils.ResolvePartialID(ctx, templateStore, args[0])
+	moleculeID, err := utils.ResolveIssueID(ctx, templateStore, args[0])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error resolving molecule ID %s: %v\n", args[0], err)
-		os.Exit(1)
+		os.Exit(exitCodeFor(err))
 	}
 
 	// Load the molecule subgraph from template store
@@ -43,10 +43,10 @@ Example:
 			os.Exit(1)
 		}
 
-		moleculeID, err := utils.ResolvePartialID(ctx, store, args[0])
+		moleculeID, err := utils.ResolveIssueID(ctx, store, args[0])
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: molecule '%s' not found\n", args[0])
-			os.Exit(1)
+			os.Exit(ExitNotFound)
 		}
 
 		subgraph, err := loadTemplateSubgraph(ctx, store, moleculeID)
@@ -82,10 +82,10 @@ func runMolSquash(cmd *cobra.Command, args []string) {
 	summary, _ := cmd.Flags().GetString("summary")
 
 	// Resolve molecule ID in main store
-	moleculeID, err := utils.ResolvePartialID(ctx, store, args[0])
+	moleculeID, err := utils.ResolveIssueID(ctx, store, args[0])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error resolving molecule ID %s: %v\n", args[0], err)
-		os.Exit(1)
+		os.Exit(exitCodeFor(err))
 	}
 
 	// Load the molecule subgraph from main store
@@ -2,8 +2,8 @@ package main
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -86,8 +86,13 @@ func loadIssuesFromJSONL(path string) ([]*types.Issue, error) {
 	}
 	defer file.Close()
 
+	return parseIssuesJSONL(file)
+}
+
+// parseIssuesJSONL reads all issues from JSONL content
+func parseIssuesJSONL(r io.Reader) ([]*types.Issue, error) {
 	var issues []*types.Issue
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 
 	lineNum := 0
 	for scanner.Scan() {
@@ -100,7 +105,7 @@ func loadIssuesFromJSONL(path string) ([]*types.Issue, error) {
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err != nil {
 			return nil, fmt.Errorf("line %d: %w", lineNum, err)
 		}
 		issue.SetDefaults() // Apply defaults for omitted fields (beads-399)
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// defaultNotesTemplate is used when notes-template is unset.
+const defaultNotesTemplate = `## Changes since {{.Since}}
+{{range .Groups}}
+### {{.Title}}
+
+{{range .Issues}}- {{.Title}} ({{.ID}})
+{{end}}{{end}}`
+
+// notesSections are the sections of bd notes, in order. An issue goes in the
+// first section whose label it has, or else whose issue type it is; the rest
+// go under "Other".
+var notesSections = []struct {
+	Title string
+	Label string
+}{
+	{"Features", "feature"},
+	{"Bug fixes", "bug"},
+}
+
+// notesData is available to notes-template, e.g.
+// "{{range .Issues}}* {{.Title}} ({{date .ClosedAt}})\n{{end}}".
+type notesData struct {
+	Since  string         `json:"since"`  // The --since value
+	From   time.Time      `json:"from"`   // Start of the range
+	Issues []*types.Issue `json:"issues"` // Every issue closed in the range, oldest first
+	Groups []notesGroup   `json:"groups"` // Non-empty sections
+}
+
+type notesGroup struct {
+	Title  string         `json:"title"`
+	Issues []*types.Issue `json:"issues"`
+}
+
+// notesTemplateFuncs are the helpers available to notes-template.
+var notesTemplateFuncs = template.FuncMap{
+	"shortid": shortIssueID,
+	"join":    strings.Join,
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+	"date": func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("2006-01-02")
+	},
+}
+
+var notesCmd = &cobra.Command{
+	Use:     "notes",
+	GroupID: "views",
+	Short:   "Generate Markdown release notes from closed issues",
+	Long: `Print the issues closed since a point in time as Markdown release notes,
+grouped into Features (label or type "feature"), Bug fixes (label or type
+"bug"), and Other.
+
+--since takes a duration (7d, 24h), a date (YYYY-MM-DD or RFC3339), or a git
+ref such as a release tag, meaning the time of that commit.
+
+The output is a Go text/template, configurable with the notes-template
+config key. Templates get .Since, .From, .Issues (all closed issues, oldest
+first) and .Groups (each with .Title and .Issues), and the helpers shortid,
+join, upper, lower, and date.
+
+Examples:
+  bd notes --since v1.2.0 > CHANGELOG-next.md
+  bd notes --since 14d
+  bd notes --since 2025-06-01 --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		sinceStr, _ := cmd.Flags().GetString("since")
+		if sinceStr == "" {
+			FatalErrorRespectJSON("--since is required (a duration, date, or git ref)")
+		}
+		from, err := parseNotesSince(sinceStr, time.Now())
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if err := ensureDirectMode("notes requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		closed := types.StatusClosed
+		issues, err := loadIssuesForExport(rootCtx, store, types.IssueFilter{Status: &closed, ClosedAfter: &from})
+		if err != nil {
+			FatalErrorRespectJSON("loading closed issues: %v", err)
+		}
+
+		data := buildNotesData(sinceStr, from, issues)
+		if jsonOutput {
+			outputJSON(data)
+			return
+		}
+		if len(data.Issues) == 0 {
+			fmt.Fprintf(os.Stderr, "No issues closed since %s\n", sinceStr)
+		}
+		if err := writeReleaseNotes(os.Stdout, config.GetString("notes-template"), data); err != nil {
+			FatalErrorCode(ExitConfig, "%v", err)
+		}
+	},
+}
+
+// parseNotesSince resolves --since: a duration or time as accepted by
+// parseSinceFlag, or else a git ref, standing for its commit time.
+func parseNotesSince(s string, now time.Time) (time.Time, error) {
+	if t, err := parseSinceFlag(s, now); err == nil {
+		return t, nil
+	}
+	if !strings.HasPrefix(s, "-") {
+		out, err := exec.Command("git", "show", "-s", "--format=%cI", s+"^{commit}").Output() // #nosec G204 - git command with safe args
+		if err == nil {
+			if t, err := time.Parse(time.RFC3339, strings.TrimSpace(string(out))); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("--since %q is not a duration (7d), date (2006-01-02 or RFC3339), or git ref", s)
+}
+
+// buildNotesData sorts issues by close time and groups them into sections.
+func buildNotesData(since string, from time.Time, issues []*types.Issue) *notesData {
+	issues = slices.Clone(issues)
+	slices.SortStableFunc(issues, func(a, b *types.Issue) int {
+		if c := closedAtOrZero(a).Compare(closedAtOrZero(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	groups := make([]notesGroup, len(notesSections)+1)
+	for i, section := range notesSections {
+		groups[i].Title = section.Title
+	}
+	groups[len(notesSections)].Title = "Other"
+	for _, issue := range issues {
+		i := notesSection(issue)
+		groups[i].Issues = append(groups[i].Issues, issue)
+	}
+
+	data := &notesData{Since: since, From: from, Issues: issues, Groups: []notesGroup{}}
+	for _, group := range groups {
+		if len(group.Issues) > 0 {
+			data.Groups = append(data.Groups, group)
+		}
+	}
+	if data.Issues == nil {
+		data.Issues = []*types.Issue{}
+	}
+	return data
+}
+
+// notesSection returns the index in notesSections of issue's section, or
+// len(notesSections) for Other. Labels take precedence over issue types.
+func notesSection(issue *types.Issue) int {
+	for i, section := range notesSections {
+		if slices.Contains(issue.Labels, section.Label) {
+			return i
+		}
+	}
+	for i, section := range notesSections {
+		if string(issue.IssueType) == section.Label {
+			return i
+		}
+	}
+	return len(notesSections)
+}
+
+func closedAtOrZero(issue *types.Issue) time.Time {
+	if issue.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *issue.ClosedAt
+}
+
+// writeReleaseNotes expands text, or defaultNotesTemplate if empty, with data.
+func writeReleaseNotes(w io.Writer, text string, data *notesData) error {
+	if text == "" {
+		text = defaultNotesTemplate
+	}
+	tmpl, err := template.New("notes-template").Funcs(notesTemplateFuncs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid notes-template: %w", err)
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		return fmt.Errorf("invalid notes-template: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	notesCmd.Flags().String("since", "", "Start of the range: a duration (7d), date (YYYY-MM-DD or RFC3339), or git ref")
+	rootCmd.AddCommand(notesCmd)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestWriteReleaseNotes(t *testing.T) {
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	closedAt := func(days int) *time.Time {
+		t := base.AddDate(0, 0, days)
+		return &t
+	}
+	issues := []*types.Issue{
+		{ID: "bd-4", Title: "Fix crash on empty JSONL", IssueType: types.TypeTask, Labels: []string{"bug"}, ClosedAt: closedAt(3)},
+		{ID: "bd-1", Title: "Add bd notes", IssueType: types.TypeTask, Labels: []string{"cli", "feature"}, ClosedAt: closedAt(2)},
+		{ID: "bd-3", Title: "Typo in help", IssueType: types.TypeBug, ClosedAt: closedAt(1)},
+		{ID: "bd-2", Title: "Bump dependencies", IssueType: types.TypeChore, ClosedAt: closedAt(4)},
+		{ID: "bd-5", Title: "Faster ready", IssueType: types.TypeFeature, Labels: []string{"bug"}, ClosedAt: closedAt(5)},
+	}
+	data := buildNotesData("v1.2.0", base, issues)
+
+	var buf bytes.Buffer
+	if err := writeReleaseNotes(&buf, "", data); err != nil {
+		t.Fatalf("writeReleaseNotes() error = %v", err)
+	}
+	want := `## Changes since v1.2.0
+
+### Features
+
+- Add bd notes (bd-1)
+
+### Bug fixes
+
+- Typo in help (bd-3)
+- Fix crash on empty JSONL (bd-4)
+- Faster ready (bd-5)
+
+### Other
+
+- Bump dependencies (bd-2)
+`
+	if got := buf.String(); got != want {
+		t.Errorf("default template output:\n%s\nwant:\n%s", got, want)
+	}
+
+	buf.Reset()
+	custom := `{{range .Issues}}* {{upper .ID}} {{.Title}} [{{join .Labels ","}}] {{date .ClosedAt}}
+{{end}}`
+	if err := writeReleaseNotes(&buf, custom, data); err != nil {
+		t.Fatalf("writeReleaseNotes(custom) error = %v", err)
+	}
+	want = `* BD-3 Typo in help [] 2025-06-02
+* BD-1 Add bd notes [cli,feature] 2025-06-03
+* BD-4 Fix crash on empty JSONL [bug] 2025-06-04
+* BD-2 Bump dependencies [] 2025-06-05
+* BD-5 Faster ready [bug] 2025-06-06
+`
+	if got := buf.String(); got != want {
+		t.Errorf("custom template output:\n%s\nwant:\n%s", got, want)
+	}
+
+	if err := writeReleaseNotes(&buf, "{{.Nope", data); err == nil {
+		t.Error("expected an error for an invalid template")
+	}
+	if err := writeReleaseNotes(&buf, "{{.Nope}}", data); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestBuildNotesDataEmpty(t *testing.T) {
+	data := buildNotesData("7d", time.Now(), nil)
+	if data.Issues == nil || data.Groups == nil || len(data.Groups) != 0 {
+		t.Errorf("buildNotesData(nil) = %+v, want empty non-nil lists", data)
+	}
+	var buf bytes.Buffer
+	if err := writeReleaseNotes(&buf, "", data); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "## Changes since 7d\n" {
+		t.Errorf("empty notes = %q", got)
+	}
+}
+
+func TestParseNotesSince(t *testing.T) {
+	now := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	if got, err := parseNotesSince("2025-06-01", now); err != nil || got.Format("2006-01-02") != "2025-06-01" {
+		t.Errorf("parseNotesSince(date) = %v, %v", got, err)
+	}
+	if got, err := parseNotesSince("2d", now); err != nil || !got.Equal(now.AddDate(0, 0, -2)) {
+		t.Errorf("parseNotesSince(2d) = %v, %v", got, err)
+	}
+	t.Chdir(t.TempDir())
+	if _, err := parseNotesSince("no-such-ref", now); err == nil {
+		t.Error("expected an error for an unknown ref outside a git repository")
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/util"
+)
+
+var overdueCmd = &cobra.Command{
+	Use:     "overdue",
+	GroupID: "views",
+	Short:   "Show open issues past their due date",
+	Long: `Show issues that are not closed and whose due date has passed, earliest
+due date first. An issue is overdue from the day after its due date.
+
+"Today" is taken in the timezone set by the "timezone" config key, or in
+local time when it isn't set. Set due dates with 'bd update <id> --due'.
+With business-days-only set, days overdue skip weekends.
+
+Examples:
+  bd update bd-123 --due 2024-12-01
+  bd overdue
+  bd overdue --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := ensureDirectMode("overdue requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("overdue requires SQLite storage")
+		}
+
+		loc, err := projectLocation()
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		today := dueDateToday(time.Now(), loc)
+
+		issues, err := sqliteStore.GetOverdueIssues(rootCtx, today)
+		if err != nil {
+			FatalErrorRespectJSON("listing overdue issues: %v", err)
+		}
+
+		if jsonOutput {
+			if issues == nil {
+				issues = []*types.Issue{}
+			}
+			outputJSON(issues)
+			return
+		}
+		displayOverdueIssues(issues, today, config.GetBool("business-days-only"))
+	},
+}
+
+// dueDateToday returns the date of now in loc, in the due date layout.
+func dueDateToday(now time.Time, loc *time.Location) string {
+	return now.In(loc).Format(types.DueDateLayout)
+}
+
+// overdueSuffix returns " (overdue)" for an overdue issue, for 'bd show'.
+func overdueSuffix(issue *types.Issue) string {
+	loc, err := projectLocation()
+	if err != nil {
+		loc = time.Local
+	}
+	if issue.IsOverdue(dueDateToday(time.Now(), loc)) {
+		return " " + ui.RenderWarn("(overdue)")
+	}
+	return ""
+}
+
+// daysOverdue returns how many days past dueDate today is, counting only
+// weekdays when businessDaysOnly is set.
+func daysOverdue(dueDate, today string, businessDaysOnly bool) int {
+	due, err := time.Parse(types.DueDateLayout, dueDate)
+	if err != nil {
+		return 0
+	}
+	now, err := time.Parse(types.DueDateLayout, today)
+	if err != nil {
+		return 0
+	}
+	if businessDaysOnly {
+		// Count the days after the due date, up to and including today
+		return int(util.BusinessDuration(due.AddDate(0, 0, 1), now.AddDate(0, 0, 1)).Hours() / 24)
+	}
+	return int(now.Sub(due).Hours() / 24)
+}
+
+func displayOverdueIssues(issues []*types.Issue, today string, businessDaysOnly bool) {
+	if len(issues) == 0 {
+		fmt.Printf("\n%s No overdue issues\n\n", ui.RenderPass("✨"))
+		return
+	}
+	unit := "days"
+	if businessDaysOnly {
+		unit = "business days"
+	}
+	fmt.Printf("\n%s Overdue issues (%d):\n\n", ui.RenderWarn("⏰"), len(issues))
+	for i, issue := range issues {
+		fmt.Printf("%d. [%s] %s: %s\n", i+1, ui.RenderPriority(issue.Priority), ui.RenderID(issue.ID), issue.Title)
+		fmt.Printf("   Status: %s, Due: %s (%d %s overdue)\n", ui.RenderStatus(string(issue.Status)), issue.DueDate, daysOverdue(issue.DueDate, today, businessDaysOnly), unit)
+		if issue.Assignee != "" {
+			fmt.Printf("   Assignee: %s\n", issue.Assignee)
+		}
+		fmt.Println()
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(overdueCmd)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDueDateToday(t *testing.T) {
+	// 23:30 UTC on Dec 1 is already Dec 2 in UTC+9 and still Dec 1 in UTC-5
+	now := time.Date(2024, 12, 1, 23, 30, 0, 0, time.UTC)
+	tests := []struct {
+		loc  *time.Location
+		want string
+	}{
+		{time.UTC, "2024-12-01"},
+		{time.FixedZone("UTC+9", 9*60*60), "2024-12-02"},
+		{time.FixedZone("UTC-5", -5*60*60), "2024-12-01"},
+	}
+	for _, tt := range tests {
+		if got := dueDateToday(now, tt.loc); got != tt.want {
+			t.Errorf("dueDateToday(%s) = %s, want %s", tt.loc, got, tt.want)
+		}
+	}
+}
+
+func TestDaysOverdue(t *testing.T) {
+	// Due Thursday, today is the following Monday
+	if got := daysOverdue("2024-11-28", "2024-12-02", false); got != 4 {
+		t.Errorf("daysOverdue() = %d, want 4", got)
+	}
+	if got := daysOverdue("2024-11-28", "2024-12-02", true); got != 2 {
+		t.Errorf("daysOverdue(business days) = %d, want 2 (Friday and Monday)", got)
+	}
+	if got := daysOverdue("not a date", "2024-12-02", false); got != 0 {
+		t.Errorf("daysOverdue(invalid) = %d, want 0", got)
+	}
+}
+
+func TestDueDateExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	oldRootCtx, oldDBPath, oldStore := rootCtx, dbPath, store
+	defer func() {
+		rootCtx, dbPath, store = oldRootCtx, oldDBPath, oldStore
+		storeMutex.Lock()
+		storeActive = false
+		storeMutex.Unlock()
+	}()
+
+	tmpDir := t.TempDir()
+	t.Chdir(tmpDir)
+	rootCtx = ctx
+	dbPath = filepath.Join(tmpDir, ".beads", "beads.db")
+	store = newTestStore(t, dbPath)
+	storeMutex.Lock()
+	storeActive = true
+	storeMutex.Unlock()
+
+	issue := &types.Issue{Title: "Ship it", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"due_date": "2024-12-01"}, "test"); err != nil {
+		t.Fatalf("UpdateIssue(due_date) error = %v", err)
+	}
+
+	jsonlPath := filepath.Join(tmpDir, ".beads", "issues.jsonl")
+	if err := exportToJSONL(ctx, jsonlPath); err != nil {
+		t.Fatalf("exportToJSONL() error = %v", err)
+	}
+	issues, err := loadIssuesFromJSONL(jsonlPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 || issues[0].DueDate != "2024-12-01" {
+		t.Fatalf("exported issues = %+v, want 1 issue due 2024-12-01", issues)
+	}
+
+	importedDB := filepath.Join(tmpDir, "imported", "beads.db")
+	imported := newTestStore(t, importedDB)
+	if _, err := importIssuesCore(ctx, importedDB, imported, issues, ImportOptions{}); err != nil {
+		t.Fatalf("importIssuesCore() error = %v", err)
+	}
+	got, err := imported.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.DueDate != "2024-12-01" {
+		t.Errorf("imported due date = %q, want 2024-12-01", got.DueDate)
+	}
+}
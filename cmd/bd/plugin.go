@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+)
+
+// pluginPrefix names external subcommands: 'bd foo' runs bd-foo from PATH.
+const pluginPrefix = "bd-"
+
+// findPlugin returns the path of the bd-<name> executable for args, and the
+// args to pass it, when the first non-flag argument names no built-in command.
+// Built-in commands, including aliases, always take precedence over plugins.
+func findPlugin(root *cobra.Command, args []string) (string, []string, bool) {
+	if cmd, _, err := root.Find(args); err == nil && cmd != root {
+		return "", nil, false
+	}
+	i := firstNonFlagArg(root, args)
+	if i < 0 || strings.ContainsAny(args[i], `/\`) {
+		return "", nil, false
+	}
+	name := args[i]
+	// Commands cobra adds when it executes
+	switch name {
+	case "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return "", nil, false
+	}
+	path, err := exec.LookPath(pluginPrefix + name)
+	if err != nil {
+		return "", nil, false
+	}
+	return path, args[i+1:], true
+}
+
+// firstNonFlagArg returns the index of the first argument that is neither one
+// of root's flags nor a flag's value, or -1 if there is none.
+func firstNonFlagArg(root *cobra.Command, args []string) int {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			return -1
+		case !strings.HasPrefix(arg, "-") || arg == "-":
+			return i
+		case strings.Contains(arg, "="):
+			continue
+		}
+		if name, ok := strings.CutPrefix(arg, "--"); ok {
+			if flag := root.Flag(name); flag != nil && flag.NoOptDefVal == "" {
+				i++ // skip the flag's value
+			}
+		} else if len(arg) == 2 {
+			flag := root.Flags().ShorthandLookup(arg[1:])
+			if flag == nil {
+				flag = root.PersistentFlags().ShorthandLookup(arg[1:])
+			}
+			if flag != nil && flag.NoOptDefVal == "" {
+				i++
+			}
+		}
+	}
+	return -1
+}
+
+// runPlugin runs the plugin at path with args, passing through stdio, and
+// returns its exit code. BD_PROJECT_DIR is set to the directory holding the
+// project's .beads directory, when there is one.
+func runPlugin(path string, args []string) int {
+	cmd := exec.Command(path, args...) // #nosec G204 - runs the bd-* plugin the user invoked
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	if beadsDir := beads.FindBeadsDir(); beadsDir != "" {
+		cmd.Env = append(cmd.Env, "BD_PROJECT_DIR="+filepath.Dir(beadsDir))
+	}
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return ExitSuccess
+	case errors.As(err, &exitErr) && exitErr.ExitCode() >= 0:
+		return exitErr.ExitCode()
+	default:
+		fmt.Fprintf(os.Stderr, "Error: running %s: %v\n", filepath.Base(path), err)
+		return ExitError
+	}
+}
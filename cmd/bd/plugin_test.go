@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// writePlugin puts an executable bd-<name> script in dir.
+func writePlugin(t *testing.T, dir, name, script string) {
+	t.Helper()
+	path := filepath.Join(dir, pluginPrefix+name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+		t.Fatalf("writing plugin: %v", err)
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts need a POSIX shell")
+	}
+
+	projectDir := t.TempDir()
+	newTestStore(t, filepath.Join(projectDir, ".beads", "beads.db"))
+	binDir := t.TempDir()
+	outFile := filepath.Join(t.TempDir(), "out")
+	writePlugin(t, binDir, "foo", `printf '%s\n' "$BD_PROJECT_DIR" "$@" > "$BD_PLUGIN_OUT"
+exit 3
+`)
+	writePlugin(t, binDir, "list", "exit 0\n")
+
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("BD_PLUGIN_OUT", outFile)
+	t.Setenv("BEADS_DIR", "")
+	t.Chdir(filepath.Join(projectDir, ".beads"))
+
+	t.Run("runs plugin with args and project dir", func(t *testing.T) {
+		path, args, ok := findPlugin(rootCmd, []string{"foo", "bar", "--baz"})
+		if !ok {
+			t.Fatal("findPlugin(foo) found no plugin")
+		}
+		if code := runPlugin(path, args); code != 3 {
+			t.Errorf("runPlugin() exit code = %d, want the plugin's 3", code)
+		}
+
+		out, err := os.ReadFile(outFile)
+		if err != nil {
+			t.Fatalf("plugin wrote no output: %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+		if len(lines) != 3 || lines[1] != "bar" || lines[2] != "--baz" {
+			t.Fatalf("plugin got %q, want project dir then bar, --baz", lines)
+		}
+		wantDir, _ := filepath.EvalSymlinks(projectDir)
+		gotDir, _ := filepath.EvalSymlinks(lines[0])
+		if gotDir != wantDir {
+			t.Errorf("BD_PROJECT_DIR = %q, want %q", lines[0], projectDir)
+		}
+	})
+
+	t.Run("plugin after global flags", func(t *testing.T) {
+		for _, args := range [][]string{{"--no-daemon", "foo", "bar"}, {"--json", "foo", "bar"}, {"--db", "x.db", "-q", "foo", "bar"}, {"--actor=me", "foo", "bar"}} {
+			path, pluginArgs, ok := findPlugin(rootCmd, args)
+			if !ok || filepath.Base(path) != pluginPrefix+"foo" {
+				t.Errorf("findPlugin(%q) = %s, %v, want bd-foo", args, path, ok)
+				continue
+			}
+			if strings.Join(pluginArgs, " ") != "bar" {
+				t.Errorf("findPlugin(%q) plugin args = %q, want [bar]", args, pluginArgs)
+			}
+		}
+	})
+
+	t.Run("built-in commands take precedence", func(t *testing.T) {
+		for _, args := range [][]string{{"list"}, {"help"}, {"--json", "list"}, {"--db", "foo", "list"}, {"--", "foo"}} {
+			if path, _, ok := findPlugin(rootCmd, args); ok {
+				t.Errorf("findPlugin(%q) = %s, want no plugin", args, path)
+			}
+		}
+	})
+
+	t.Run("unknown command without plugin", func(t *testing.T) {
+		if path, _, ok := findPlugin(rootCmd, []string{"nosuchplugin"}); ok {
+			t.Errorf("findPlugin(nosuchplugin) = %s, want no plugin", path)
+		}
+	})
+}
@@ -73,10 +73,10 @@ func runPour(cmd *cobra.Command, args []string) {
 	}
 
 	// Resolve proto ID
-	protoID, err := utils.ResolvePartialID(ctx, store, args[0])
+	protoID, err := utils.ResolveIssueID(ctx, store, args[0])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error resolving proto ID %s: %v\n", args[0], err)
-		os.Exit(1)
+		os.Exit(exitCodeFor(err))
 	}
 
 	// Verify it's a proto
@@ -105,10 +105,10 @@ func runPour(cmd *cobra.Command, args []string) {
 	}
 	var attachments []attachmentInfo
 	for _, attachArg := range attachFlags {
-		attachID, err := utils.ResolvePartialID(ctx, store, attachArg)
+		attachID, err := utils.ResolveIssueID(ctx, store, attachArg)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error resolving attachment ID %s: %v\n", attachArg, err)
-			os.Exit(1)
+			os.Exit(exitCodeFor(err))
 		}
 		attachIssue, err := store.GetIssue(ctx, attachID)
 		if err != nil {
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/validation"
+)
+
+var prefixCmd = &cobra.Command{
+	Use:     "prefix",
+	GroupID: "setup",
+	Short:   "Show or set the issue ID prefix",
+	Long: `Print the issue ID prefix new issues get, e.g. "bd" for bd-a1b2.
+
+Exits with status 5 if no prefix is configured, so scripts can branch on it:
+
+  prefix=$(bd prefix) || echo "not initialized"
+  bd prefix --json    # {"prefix": "bd"}
+
+Use 'bd prefix set' to change the prefix for new issues, or 'bd rename-prefix'
+to also rename the existing ones.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := ensureDirectMode("prefix requires direct database access"); err != nil {
+			FatalError("%v", err)
+		}
+
+		prefix, err := issuePrefix(rootCtx, store)
+		if err != nil {
+			FatalError("failed to read prefix: %v", err)
+		}
+		if prefix == "" {
+			FatalErrorCode(ExitConfig, "no issue prefix set (run 'bd prefix set <prefix>')")
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]string{"prefix": prefix})
+			return
+		}
+		fmt.Println(prefix)
+	},
+}
+
+var prefixSetCmd = &cobra.Command{
+	Use:   "set <prefix>",
+	Short: "Set the issue ID prefix for new issues",
+	Long: `Set the prefix used for the IDs of new issues. Existing issues keep their IDs.
+
+If the database already has issues with a different prefix this is refused,
+since mixed prefixes confuse import and sync; use 'bd rename-prefix' to rename
+them too, or --force to set the prefix anyway.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("prefix set")
+		force, _ := cmd.Flags().GetBool("force")
+
+		if err := ensureDirectMode("prefix set requires direct database access"); err != nil {
+			FatalError("%v", err)
+		}
+
+		oldPrefix, _ := store.GetConfig(rootCtx, "issue_prefix")
+		prefix, err := setIssuePrefix(rootCtx, store, args[0], force)
+		if err != nil {
+			FatalErrorCode(ExitConfig, "%v", err)
+		}
+
+		// In no-db mode the prefix comes from config.yaml on every run
+		if noDb {
+			if err := config.SetYamlConfig("issue-prefix", prefix); err != nil {
+				FatalError("failed to update config.yaml: %v", err)
+			}
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]string{"prefix": prefix, "old_prefix": oldPrefix})
+			return
+		}
+		if oldPrefix == prefix {
+			fmt.Printf("Prefix is already %s\n", ui.RenderAccent(prefix))
+			return
+		}
+		fmt.Printf("%s Issue prefix set to %s\n", ui.RenderPass("✓"), ui.RenderAccent(prefix))
+	},
+}
+
+// issuePrefix returns the issue prefix the way create resolves it: the
+// store's issue_prefix (which --no-db mode seeds from config.yaml), or else
+// config.yaml's issue-prefix. It is empty if neither is set.
+func issuePrefix(ctx context.Context, s storage.Storage) (string, error) {
+	prefix, err := s.GetConfig(ctx, "issue_prefix")
+	if err != nil {
+		return "", err
+	}
+	if prefix == "" {
+		prefix = config.GetString("issue-prefix")
+	}
+	return strings.TrimRight(prefix, "-"), nil
+}
+
+// setIssuePrefix validates prefix and stores it (without its trailing hyphen)
+// as the issue_prefix config. Unless force is set it refuses to leave live
+// issues with a different prefix behind.
+func setIssuePrefix(ctx context.Context, s storage.Storage, prefix string, force bool) (string, error) {
+	if err := validation.ValidateIssuePrefix(prefix); err != nil {
+		return "", err
+	}
+	prefix = strings.TrimRight(prefix, "-")
+
+	if !force {
+		issues, err := s.SearchIssues(ctx, "", types.IssueFilter{})
+		if err != nil {
+			return "", fmt.Errorf("failed to list issues: %w", err)
+		}
+		var others []string
+		for p, count := range detectPrefixes(issues) {
+			if p != prefix {
+				others = append(others, fmt.Sprintf("%s (%d)", p, count))
+			}
+		}
+		if len(others) > 0 {
+			sort.Strings(others)
+			return "", fmt.Errorf("existing issues use prefix %s; run 'bd rename-prefix %s' to rename them, or pass --force",
+				strings.Join(others, ", "), prefix)
+		}
+	}
+
+	if err := s.SetConfig(ctx, "issue_prefix", prefix); err != nil {
+		return "", fmt.Errorf("failed to set prefix: %w", err)
+	}
+	return prefix, nil
+}
+
+// strictPrefixExempt lists the commands that still run when strict-prefix
+// finds a mismatch, because they are how it gets fixed.
+var strictPrefixExempt = map[string]bool{
+	"config":        true,
+	"prefix":        true,
+	"rename-prefix": true,
+}
+
+// checkStrictPrefix exits when the strict-prefix config key is set and most
+// issues in the database use a prefix other than issue_prefix, so new issues
+// don't silently end up under a different prefix than the old ones.
+func checkStrictPrefix(cmd *cobra.Command, s *sqlite.SQLiteStorage) {
+	if !config.GetBool("strict-prefix") {
+		return
+	}
+	top := cmd
+	for top.HasParent() && top.Parent().HasParent() {
+		top = top.Parent()
+	}
+	if strictPrefixExempt[top.Name()] {
+		return
+	}
+
+	err := s.CheckIssuePrefix(rootCtx)
+	if errors.Is(err, sqlite.ErrPrefixMismatch) {
+		FatalErrorCode(ExitConfig, "%v\nHint: run 'bd rename-prefix <prefix>' to rename the issues, or 'bd prefix set <prefix>' to switch back (strict-prefix is enabled)", err)
+	}
+	if err != nil {
+		FatalError("%v", err)
+	}
+}
+
+func init() {
+	prefixSetCmd.Flags().Bool("force", false, "Set the prefix even if existing issues use another one")
+	prefixCmd.AddCommand(prefixSetCmd)
+	rootCmd.AddCommand(prefixCmd)
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"golang.org/x/term"
+)
+
+var prefixFixCmd = &cobra.Command{
+	Use:   "fix",
+	Short: "Make the configured prefix and the issues' prefix agree",
+	Long: `Check that the configured issue prefix (issue-prefix in config.yaml, or else
+the database's issue_prefix) is the prefix the issues actually use, and fix
+it if not. This typically happens after a migration or a hand-edited config.
+
+There are two ways to fix a mismatch:
+
+  --to-config   Rename the issues to the configured prefix (like 'bd rename-prefix')
+  --to-issues   Set the prefix, in the database and config.yaml, to the one
+                most issues use (like 'bd prefix set')
+
+Without either flag bd asks which to do. With --json and no flag it only
+reports, exiting with status 5 on a mismatch.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		toConfig, _ := cmd.Flags().GetBool("to-config")
+		toIssues, _ := cmd.Flags().GetBool("to-issues")
+		if toConfig && toIssues {
+			FatalErrorCode(ExitUsage, "--to-config and --to-issues are mutually exclusive")
+		}
+
+		if err := ensureDirectMode("prefix fix requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		ctx := rootCtx
+
+		agreement, issues, err := checkPrefixAgreement(ctx, store, config.GetString("issue-prefix"))
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if agreement.Agree {
+			if jsonOutput {
+				outputJSON(agreement)
+				return
+			}
+			fmt.Printf("%s Issue prefix %s matches the issues\n", ui.RenderPass("✓"), ui.RenderAccent(agreement.Configured))
+			return
+		}
+
+		if !toConfig && !toIssues {
+			if jsonOutput {
+				outputJSON(agreement)
+				os.Exit(ExitConfig)
+			}
+			printPrefixMismatch(agreement)
+			if !term.IsTerminal(int(os.Stdin.Fd())) {
+				FatalErrorCode(ExitConfig, "prefix mismatch; rerun with --to-config or --to-issues")
+			}
+			switch promptPrefixFix(bufio.NewReader(os.Stdin), agreement) {
+			case "c":
+				toConfig = true
+			case "i":
+				toIssues = true
+			default:
+				fmt.Println("No changes made.")
+				return
+			}
+		}
+
+		CheckReadonly("prefix fix")
+		var prefix string
+		if toConfig {
+			prefix = agreement.Configured
+			err = fixPrefixToConfig(ctx, agreement, issues)
+		} else {
+			prefix, err = fixPrefixToIssues(ctx, store, agreement)
+		}
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		markDirtyAndScheduleFullExport()
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"prefix": prefix, "renamed_issues": toConfig})
+			return
+		}
+		fmt.Printf("%s Issue prefix and issues now agree on %s\n", ui.RenderPass("✓"), ui.RenderAccent(prefix))
+	},
+}
+
+// prefixAgreement compares the configured issue prefix with the prefixes the
+// issues use.
+type prefixAgreement struct {
+	Configured     string         `json:"configured"`      // config.yaml's issue-prefix, else the database's
+	Source         string         `json:"source"`          // "config.yaml" or "database"
+	DatabasePrefix string         `json:"database_prefix"` // issue_prefix stored in the database
+	IssuePrefixes  map[string]int `json:"issue_prefixes"`  // Prefix -> number of issues using it
+	Dominant       string         `json:"dominant"`        // The prefix most issues use ("" with no issues)
+	Agree          bool           `json:"agree"`
+}
+
+// checkPrefixAgreement reports whether yamlPrefix (issue-prefix from
+// config.yaml, possibly empty) or else the database's issue_prefix is the one
+// prefix all issues in s use, and that the two settings don't disagree. It
+// also returns the issues, for fixPrefixToConfig.
+func checkPrefixAgreement(ctx context.Context, s storage.Storage, yamlPrefix string) (*prefixAgreement, []*types.Issue, error) {
+	dbPrefix, err := s.GetConfig(ctx, "issue_prefix")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read prefix: %w", err)
+	}
+	issues, err := s.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+
+	a := &prefixAgreement{
+		Configured:     dbPrefix,
+		Source:         "database",
+		DatabasePrefix: dbPrefix,
+		IssuePrefixes:  detectPrefixes(issues),
+	}
+	if yamlPrefix = strings.TrimRight(yamlPrefix, "-"); yamlPrefix != "" {
+		a.Configured, a.Source = yamlPrefix, "config.yaml"
+	}
+
+	// Most issues win; on a tie the configured prefix does
+	prefixes := make([]string, 0, len(a.IssuePrefixes))
+	for prefix := range a.IssuePrefixes {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	best := 0
+	if n := a.IssuePrefixes[a.Configured]; n > 0 {
+		a.Dominant, best = a.Configured, n
+	}
+	for _, prefix := range prefixes {
+		if a.IssuePrefixes[prefix] > best {
+			a.Dominant, best = prefix, a.IssuePrefixes[prefix]
+		}
+	}
+
+	if a.Configured == "" {
+		a.Agree = len(issues) == 0
+	} else {
+		a.Agree = a.DatabasePrefix == a.Configured &&
+			(len(a.IssuePrefixes) == 0 || len(a.IssuePrefixes) == 1 && a.Dominant == a.Configured)
+	}
+	return a, issues, nil
+}
+
+// fixPrefixToIssues sets the database's issue_prefix, and config.yaml's
+// issue-prefix if that is where the configured prefix came from, to the prefix
+// most issues use. Issues with other prefixes keep them.
+func fixPrefixToIssues(ctx context.Context, s storage.Storage, a *prefixAgreement) (string, error) {
+	if a.Dominant == "" {
+		return "", fmt.Errorf("there are no issues to take a prefix from")
+	}
+	prefix, err := setIssuePrefix(ctx, s, a.Dominant, true)
+	if err != nil {
+		return "", err
+	}
+	if a.Source == "config.yaml" {
+		if err := config.SetYamlConfig("issue-prefix", prefix); err != nil {
+			return "", fmt.Errorf("failed to update config.yaml: %w", err)
+		}
+	}
+	if len(a.IssuePrefixes) > 1 {
+		fmt.Fprintf(os.Stderr, "Note: some issues use other prefixes; run 'bd rename-prefix %s --repair' to consolidate them\n", prefix)
+	}
+	return prefix, nil
+}
+
+// fixPrefixToConfig renames the issues to the configured prefix and stores it
+// as the database's issue_prefix. Like renamePrefixInDB it works on the global
+// store.
+func fixPrefixToConfig(ctx context.Context, a *prefixAgreement, issues []*types.Issue) error {
+	if a.Configured == "" {
+		return fmt.Errorf("no issue prefix is configured; use --to-issues, or set one with 'bd prefix set'")
+	}
+	switch {
+	case len(a.IssuePrefixes) > 1:
+		return repairPrefixes(ctx, store, actor, a.Configured, issues, a.IssuePrefixes, false)
+	case len(a.IssuePrefixes) == 1 && a.Dominant != a.Configured:
+		return renamePrefixInDB(ctx, a.Dominant, a.Configured, issues)
+	default:
+		if err := store.SetConfig(ctx, "issue_prefix", a.Configured); err != nil {
+			return fmt.Errorf("failed to set prefix: %w", err)
+		}
+		return nil
+	}
+}
+
+func printPrefixMismatch(a *prefixAgreement) {
+	fmt.Printf("%s Issue prefix mismatch\n", ui.RenderFail("✗"))
+	if a.Source == "config.yaml" {
+		fmt.Printf("  config.yaml issue-prefix: %s\n", ui.RenderAccent(a.Configured))
+	}
+	fmt.Printf("  database issue_prefix:    %s\n", ui.RenderAccent(a.DatabasePrefix))
+	var counts []string
+	for prefix, n := range a.IssuePrefixes {
+		counts = append(counts, fmt.Sprintf("%s (%d)", prefix, n))
+	}
+	sort.Strings(counts)
+	fmt.Printf("  issues use:               %s\n\n", strings.Join(counts, ", "))
+}
+
+// promptPrefixFix asks how to fix a mismatch and returns "c" (rename the
+// issues to the config), "i" (set the config to the issues) or "q".
+func promptPrefixFix(reader *bufio.Reader, a *prefixAgreement) string {
+	var options []string
+	if a.Configured != "" {
+		fmt.Printf("  [c] Rename the issues to %s (match the config)\n", ui.RenderAccent(a.Configured))
+		options = append(options, "c")
+	}
+	if a.Dominant != "" {
+		fmt.Printf("  [i] Set the prefix to %s (match the issues)\n", ui.RenderAccent(a.Dominant))
+		options = append(options, "i")
+	}
+	fmt.Printf("  [q] Quit without changes\n\nChoice [%s/q]: ", strings.Join(options, "/"))
+
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		return "q"
+	}
+	response = strings.ToLower(strings.TrimSpace(response))
+	for _, option := range options {
+		if response == option {
+			return option
+		}
+	}
+	return "q"
+}
+
+func init() {
+	prefixFixCmd.Flags().Bool("to-config", false, "Rename the issues to the configured prefix")
+	prefixFixCmd.Flags().Bool("to-issues", false, "Set the configured prefix to the one the issues use")
+	prefixCmd.AddCommand(prefixFixCmd)
+}
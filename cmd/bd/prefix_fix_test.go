@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// newPrefixMismatchStore returns a store whose issues old-1 and old-2 use
+// prefix "old" while its issue_prefix is dbPrefix.
+func newPrefixMismatchStore(t *testing.T, dir, dbPrefix string) *sqlite.SQLiteStorage {
+	t.Helper()
+	ctx := context.Background()
+	s := newTestStore(t, filepath.Join(dir, ".beads", "beads.db"))
+	if err := s.SetConfig(ctx, "issue_prefix", "old"); err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range []*types.Issue{
+		{ID: "old-1", Title: "First", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "old-2", Title: "Blocked by old-1", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", issue.ID, err)
+		}
+	}
+	if err := s.SetConfig(ctx, "issue_prefix", dbPrefix); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestPrefixFix_ToConfig(t *testing.T) {
+	ctx := context.Background()
+	s := newPrefixMismatchStore(t, t.TempDir(), "old")
+	store, actor = s, "test"
+	defer func() { store, actor = nil, "" }()
+
+	// config.yaml says "new", the database and issues say "old"
+	a, issues, err := checkPrefixAgreement(ctx, s, "new")
+	if err != nil {
+		t.Fatalf("checkPrefixAgreement() error = %v", err)
+	}
+	if a.Agree || a.Configured != "new" || a.Source != "config.yaml" || a.Dominant != "old" || a.IssuePrefixes["old"] != 2 {
+		t.Fatalf("checkPrefixAgreement() = %+v, want a mismatch between new and old", a)
+	}
+
+	if err := fixPrefixToConfig(ctx, a, issues); err != nil {
+		t.Fatalf("fixPrefixToConfig() error = %v", err)
+	}
+	renamed, err := s.GetIssue(ctx, "new-2")
+	if err != nil || renamed == nil {
+		t.Fatalf("GetIssue(new-2) = %v, %v; want the renamed issue", renamed, err)
+	}
+	if renamed.Title != "Blocked by new-1" {
+		t.Errorf("references not renamed: title = %q", renamed.Title)
+	}
+	if prefix, _ := s.GetConfig(ctx, "issue_prefix"); prefix != "new" {
+		t.Errorf("issue_prefix = %q, want new", prefix)
+	}
+	if a, _, _ := checkPrefixAgreement(ctx, s, "new"); !a.Agree {
+		t.Errorf("after fix, checkPrefixAgreement() = %+v, want agreement", a)
+	}
+}
+
+func TestPrefixFix_ToIssues(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	s := newPrefixMismatchStore(t, tmpDir, "new")
+	configPath := filepath.Join(tmpDir, ".beads", "config.yaml")
+	if err := os.WriteFile(configPath, []byte("issue-prefix: new\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(tmpDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize() error = %v", err)
+	}
+
+	a, _, err := checkPrefixAgreement(ctx, s, config.GetString("issue-prefix"))
+	if err != nil {
+		t.Fatalf("checkPrefixAgreement() error = %v", err)
+	}
+	if a.Agree || a.Configured != "new" || a.Dominant != "old" {
+		t.Fatalf("checkPrefixAgreement() = %+v, want a mismatch between new and old", a)
+	}
+
+	prefix, err := fixPrefixToIssues(ctx, s, a)
+	if err != nil {
+		t.Fatalf("fixPrefixToIssues() error = %v", err)
+	}
+	if prefix != "old" {
+		t.Errorf("fixPrefixToIssues() = %q, want old", prefix)
+	}
+	if stored, _ := s.GetConfig(ctx, "issue_prefix"); stored != "old" {
+		t.Errorf("issue_prefix = %q, want old", stored)
+	}
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), `issue-prefix: "old"`) {
+		t.Errorf("config.yaml not updated:\n%s", content)
+	}
+	if issue, _ := s.GetIssue(ctx, "old-1"); issue == nil {
+		t.Error("fixPrefixToIssues should not rename issues")
+	}
+	if a, _, _ := checkPrefixAgreement(ctx, s, "old"); !a.Agree {
+		t.Errorf("after fix, checkPrefixAgreement() = %+v, want agreement", a)
+	}
+}
+
+func TestCheckPrefixAgreement_NoIssues(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t, filepath.Join(t.TempDir(), ".beads", "beads.db"))
+	a, _, err := checkPrefixAgreement(ctx, s, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.Agree || a.Dominant != "" {
+		t.Errorf("checkPrefixAgreement() = %+v, want agreement with no issues", a)
+	}
+	if _, err := fixPrefixToIssues(ctx, s, &prefixAgreement{Configured: "x"}); err == nil {
+		t.Error("fixPrefixToIssues() with no issues should fail")
+	}
+}
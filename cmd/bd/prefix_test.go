@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestSetIssuePrefix(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t, filepath.Join(t.TempDir(), ".beads", "beads.db"))
+
+	// No issues yet: any valid prefix goes, trailing hyphen dropped
+	got, err := setIssuePrefix(ctx, s, "api-", false)
+	if err != nil {
+		t.Fatalf("setIssuePrefix() error = %v", err)
+	}
+	if got != "api" {
+		t.Errorf("setIssuePrefix() = %q, want %q", got, "api")
+	}
+	if stored, _ := s.GetConfig(ctx, "issue_prefix"); stored != "api" {
+		t.Errorf("issue_prefix = %q, want %q", stored, "api")
+	}
+
+	if _, err := setIssuePrefix(ctx, s, "Bad Prefix", false); err == nil {
+		t.Error("expected invalid prefix to be rejected")
+	}
+
+	issue := &types.Issue{Title: "First", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	// Setting the prefix the issues already use is fine
+	if _, err := setIssuePrefix(ctx, s, "api", false); err != nil {
+		t.Errorf("setIssuePrefix(same) error = %v", err)
+	}
+
+	// A different prefix would orphan the existing issue's prefix
+	_, err = setIssuePrefix(ctx, s, "web", false)
+	if err == nil || !strings.Contains(err.Error(), "rename-prefix") {
+		t.Fatalf("expected rename-prefix hint, got %v", err)
+	}
+	if stored, _ := s.GetConfig(ctx, "issue_prefix"); stored != "api" {
+		t.Errorf("refused set changed issue_prefix to %q", stored)
+	}
+
+	if _, err := setIssuePrefix(ctx, s, "web", true); err != nil {
+		t.Fatalf("setIssuePrefix(force) error = %v", err)
+	}
+	if stored, _ := s.GetConfig(ctx, "issue_prefix"); stored != "web" {
+		t.Errorf("issue_prefix = %q, want %q", stored, "web")
+	}
+}
+
+func TestIssuePrefix(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize config: %v", err)
+	}
+	ctx := context.Background()
+	s := newTestStore(t, filepath.Join(t.TempDir(), ".beads", "beads.db"))
+	t.Cleanup(func() { config.Set("issue-prefix", "") })
+
+	// The database's prefix is the one create uses
+	config.Set("issue-prefix", "yaml")
+	if got, err := issuePrefix(ctx, s); err != nil || got != "test" {
+		t.Errorf("issuePrefix() = %q, %v; want %q", got, err, "test")
+	}
+
+	// Without one, config.yaml's issue-prefix applies
+	if err := s.DeleteConfig(ctx, "issue_prefix"); err != nil {
+		t.Fatal(err)
+	}
+	config.Set("issue-prefix", "yaml-")
+	if got, err := issuePrefix(ctx, s); err != nil || got != "yaml" {
+		t.Errorf("issuePrefix() = %q, %v; want %q", got, err, "yaml")
+	}
+
+	config.Set("issue-prefix", "")
+	if got, err := issuePrefix(ctx, s); err != nil || got != "" {
+		t.Errorf("issuePrefix() = %q, %v; want no prefix", got, err)
+	}
+}
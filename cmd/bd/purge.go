@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var purgeCmd = &cobra.Command{
+	Use:     "purge",
+	GroupID: "maint",
+	Short:   "Permanently remove old deleted issues",
+	Long: `Permanently remove deleted issues (tombstones) older than a cutoff.
+
+Deleting an issue leaves a tombstone so other clones learn about the deletion
+and 'bd restore' can bring it back. Purging removes the tombstone with its
+labels, dependencies, comments and events, and drops it from the JSONL.
+
+Only purge tombstones every clone has already synced: a purged ID is no longer
+protected from being resurrected by an old JSONL copy.
+
+Examples:
+  bd purge                     # Purge issues deleted more than 30 days ago
+  bd purge --older-than 90     # Purge issues deleted more than 90 days ago
+  bd purge --dry-run           # List what would be purged, change nothing`,
+	Run: func(cmd *cobra.Command, args []string) {
+		olderThanDays, _ := cmd.Flags().GetInt("older-than")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !dryRun {
+			CheckReadonly("purge")
+		}
+		if olderThanDays < 0 {
+			FatalErrorRespectJSON("--older-than must be non-negative")
+		}
+
+		if err := ensureDirectMode("purge requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("purge requires SQLite storage")
+		}
+
+		cutoff := time.Now().AddDate(0, 0, -olderThanDays)
+		ids, err := sqliteStore.Purge(rootCtx, cutoff, dryRun)
+		if err != nil {
+			FatalErrorRespectJSON("purge failed: %v", err)
+		}
+		count := len(ids)
+
+		// Purged tombstones must disappear from the JSONL, so rewrite it in full
+		if count > 0 && !dryRun {
+			markDirtyAndScheduleFullExport()
+		}
+
+		if jsonOutput {
+			if ids == nil {
+				ids = []string{}
+			}
+			outputJSON(map[string]interface{}{
+				"purged_count": count,
+				"purged_ids":   ids,
+				"older_than":   olderThanDays,
+				"dry_run":      dryRun,
+			})
+			return
+		}
+
+		if count == 0 {
+			fmt.Printf("No issues deleted more than %d days ago to purge\n", olderThanDays)
+			return
+		}
+		if dryRun {
+			fmt.Printf("Would purge %d issue(s) deleted more than %d days ago:\n", count, olderThanDays)
+			for _, id := range ids {
+				fmt.Printf("  %s\n", id)
+			}
+			fmt.Println("(dry run, nothing purged)")
+			return
+		}
+		fmt.Printf("%s Purged %d issue(s) deleted more than %d days ago\n", ui.RenderPass("✓"), count, olderThanDays)
+	},
+}
+
+func init() {
+	purgeCmd.Flags().Int("older-than", 30, "Purge issues deleted more than N days ago")
+	purgeCmd.Flags().Bool("dry-run", false, "List the issues that would be purged without purging them")
+	rootCmd.AddCommand(purgeCmd)
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/where"
+)
+
+// savedQueryPrefix namespaces saved queries in the database config table:
+// the query "my-backlog" is stored under "saved-queries.my-backlog".
+const savedQueryPrefix = "saved-queries."
+
+var savedQueryNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+var queryCmd = &cobra.Command{
+	Use:     "query",
+	GroupID: "views",
+	Short:   "Manage saved --where queries",
+	Long: `Manage saved queries: named 'bd list --where' expressions, run with
+'bd list --saved <name>'.
+
+In a saved query (or any --where expression) the bare value me in an
+assignee comparison stands for the current actor (--actor, BD_ACTOR, or
+the actor config), so one query works for everyone on the team.
+
+Examples:
+  bd query save my-backlog 'status=open and assignee=me'
+  bd list --saved my-backlog
+  bd query list
+  bd query delete my-backlog`,
+}
+
+var querySaveCmd = &cobra.Command{
+	Use:   "save <name> <expression>",
+	Short: "Save a named query, replacing any query with that name",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("query save")
+		if err := ensureDirectMode("query save requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		name, expr := args[0], args[1]
+		if err := saveQuery(rootCtx, store, name, expr); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]string{"name": name, "where": expr})
+			return
+		}
+		fmt.Printf("Saved query %s: %s\n", name, expr)
+	},
+}
+
+var queryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved queries",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := ensureDirectMode("query list requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		queries, err := listSavedQueries(rootCtx, store)
+		if err != nil {
+			FatalErrorRespectJSON("listing saved queries: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(queries)
+			return
+		}
+		if len(queries) == 0 {
+			fmt.Println("No saved queries (add one with 'bd query save <name> <expression>')")
+			return
+		}
+		names := make([]string, 0, len(queries))
+		for name := range queries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("%s: %s\n", name, queries[name])
+		}
+	},
+}
+
+var queryDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a saved query",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("query delete")
+		if err := ensureDirectMode("query delete requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		name := args[0]
+		if _, err := loadSavedQuery(rootCtx, store, name); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if err := store.DeleteConfig(rootCtx, savedQueryPrefix+name); err != nil {
+			FatalErrorRespectJSON("deleting saved query: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"name": name, "deleted": true})
+			return
+		}
+		fmt.Printf("Deleted saved query %s\n", name)
+	},
+}
+
+// saveQuery validates and stores a named --where expression.
+func saveQuery(ctx context.Context, s storage.Storage, name, expr string) error {
+	if !savedQueryNameRe.MatchString(name) {
+		return fmt.Errorf("invalid query name %q (use letters, digits, - and _)", name)
+	}
+	if _, err := where.Parse(expr); err != nil {
+		return fmt.Errorf("invalid expression: %w", err)
+	}
+	if err := s.SetConfig(ctx, savedQueryPrefix+name, expr); err != nil {
+		return fmt.Errorf("saving query: %w", err)
+	}
+	return nil
+}
+
+// loadSavedQuery returns the expression saved as name.
+func loadSavedQuery(ctx context.Context, s storage.Storage, name string) (string, error) {
+	expr, err := s.GetConfig(ctx, savedQueryPrefix+name)
+	if err != nil {
+		return "", fmt.Errorf("reading saved query: %w", err)
+	}
+	if expr == "" {
+		return "", fmt.Errorf("no saved query named %q (see 'bd query list')", name)
+	}
+	return expr, nil
+}
+
+// listSavedQueries returns every saved query, by name.
+func listSavedQueries(ctx context.Context, s storage.Storage) (map[string]string, error) {
+	all, err := s.GetAllConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := make(map[string]string)
+	for key, value := range all {
+		if name, ok := strings.CutPrefix(key, savedQueryPrefix); ok {
+			queries[name] = value
+		}
+	}
+	return queries, nil
+}
+
+// resolveWhere builds the expression bd list filters by from --saved and
+// --where, which must both match when given together, with me resolved to
+// actor. It returns "" when neither is set.
+func resolveWhere(ctx context.Context, s storage.Storage, savedName, whereExpr, actor string) (string, error) {
+	var e where.Expr
+	if savedName != "" {
+		expr, err := loadSavedQuery(ctx, s, savedName)
+		if err != nil {
+			return "", err
+		}
+		if e, err = where.Parse(expr); err != nil {
+			return "", fmt.Errorf("saved query %s: %w", savedName, err)
+		}
+	}
+	if whereExpr != "" {
+		parsed, err := where.Parse(whereExpr)
+		if err != nil {
+			return "", usageError{fmt.Errorf("parsing --where: %w", err)}
+		}
+		if e == nil {
+			e = parsed
+		} else {
+			e = &where.And{Left: e, Right: parsed}
+		}
+	}
+	if e == nil {
+		return "", nil
+	}
+	where.ResolveMe(e, actor)
+	return e.String(), nil
+}
+
+func init() {
+	queryCmd.AddCommand(querySaveCmd)
+	queryCmd.AddCommand(queryListCmd)
+	queryCmd.AddCommand(queryDeleteCmd)
+	rootCmd.AddCommand(queryCmd)
+}
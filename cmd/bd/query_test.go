@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestSavedQueries(t *testing.T) {
+	s := newTestStore(t, filepath.Join(t.TempDir(), ".beads", "beads.db"))
+	ctx := context.Background()
+
+	create := func(title, assignee string, priority int) string {
+		t.Helper()
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: priority, IssueType: types.TypeTask, Assignee: assignee}
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", title, err)
+		}
+		return issue.ID
+	}
+	aliceUrgent := create("alice urgent", "alice", 0)
+	aliceLater := create("alice later", "alice", 3)
+	create("bob urgent", "bob", 0)
+	create("named me", "me", 0)
+
+	if err := saveQuery(ctx, s, "my-backlog", "status=open and assignee=me"); err != nil {
+		t.Fatalf("saveQuery() error = %v", err)
+	}
+	if err := saveQuery(ctx, s, "bad name", "status=open"); err == nil {
+		t.Error("saveQuery with a space in the name succeeded, want error")
+	}
+	if err := saveQuery(ctx, s, "broken", "status=open and"); err == nil {
+		t.Error("saveQuery with an invalid expression succeeded, want error")
+	}
+
+	queries, err := listSavedQueries(ctx, s)
+	if err != nil {
+		t.Fatalf("listSavedQueries() error = %v", err)
+	}
+	if len(queries) != 1 || queries["my-backlog"] != "status=open and assignee=me" {
+		t.Errorf("listSavedQueries() = %v, want only my-backlog", queries)
+	}
+
+	run := func(savedName, whereExpr string) []string {
+		t.Helper()
+		expr, err := resolveWhere(ctx, s, savedName, whereExpr, "alice")
+		if err != nil {
+			t.Fatalf("resolveWhere(%q, %q) error = %v", savedName, whereExpr, err)
+		}
+		issues, err := s.SearchIssues(ctx, "", types.IssueFilter{Where: expr})
+		if err != nil {
+			t.Fatalf("SearchIssues(%q) error = %v", expr, err)
+		}
+		var ids []string
+		for _, issue := range issues {
+			ids = append(ids, issue.ID)
+		}
+		return ids
+	}
+
+	// me resolves to the actor, not to the assignee named "me"
+	if got := run("my-backlog", ""); len(got) != 2 || !slices.Contains(got, aliceUrgent) || !slices.Contains(got, aliceLater) {
+		t.Errorf("--saved my-backlog = %v, want %s and %s", got, aliceUrgent, aliceLater)
+	}
+	// --where narrows a saved query
+	if got := run("my-backlog", "priority<=1"); len(got) != 1 || got[0] != aliceUrgent {
+		t.Errorf("--saved my-backlog --where priority<=1 = %v, want %s", got, aliceUrgent)
+	}
+
+	if _, err := resolveWhere(ctx, s, "missing", "", "alice"); err == nil {
+		t.Error("resolveWhere with an unknown saved query succeeded, want error")
+	}
+
+	if err := s.DeleteConfig(ctx, savedQueryPrefix+"my-backlog"); err != nil {
+		t.Fatalf("DeleteConfig() error = %v", err)
+	}
+	if _, err := loadSavedQuery(ctx, s, "my-backlog"); err == nil {
+		t.Error("loadSavedQuery after delete succeeded, want error")
+	}
+}
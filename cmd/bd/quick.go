@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/rpc"
 	"github.com/steveyegge/beads/internal/types"
-	"github.com/steveyegge/beads/internal/validation"
 )
 
 var quickCmd = &cobra.Command{
@@ -29,12 +28,11 @@ Example:
 		title := strings.Join(args, " ")
 
 		// Get optional flags
-		priorityStr, _ := cmd.Flags().GetString("priority")
 		issueType, _ := cmd.Flags().GetString("type")
 		labels, _ := cmd.Flags().GetStringSlice("labels")
 
 		// Parse priority
-		priority, err := validation.ValidatePriority(priorityStr)
+		priority, err := newIssuePriority(cmd)
 		if err != nil {
 			FatalError("%v", err)
 		}
@@ -64,7 +62,6 @@ Example:
 		// Direct mode
 		issue := &types.Issue{
 			Title:     title,
-			Status:    types.StatusOpen,
 			Priority:  priority,
 			IssueType: types.IssueType(issueType),
 		}
@@ -88,7 +85,7 @@ Example:
 }
 
 func init() {
-	quickCmd.Flags().StringP("priority", "p", "2", "Priority (0-4 or P0-P4)")
+	quickCmd.Flags().StringP("priority", "p", "2", "Priority (0-4 or P0-P4, default: default-priority config)")
 	quickCmd.Flags().StringP("type", "t", "task", "Issue type")
 	quickCmd.Flags().StringSliceP("labels", "l", []string{}, "Labels")
 	rootCmd.AddCommand(quickCmd)
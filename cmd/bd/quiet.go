@@ -0,0 +1,31 @@
+package main
+
+import "os"
+
+// quietRealStdout is the stdout replaced by silenceStdout, if any.
+var quietRealStdout *os.File
+
+// silenceStdout points os.Stdout at the null device for --quiet, so every
+// command's informational output is dropped without each one checking the
+// flag. Errors and warnings go to stderr and are unaffected.
+func silenceStdout() {
+	if quietRealStdout != nil {
+		return
+	}
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return
+	}
+	quietRealStdout = os.Stdout
+	os.Stdout = devNull
+}
+
+// restoreStdout undoes silenceStdout.
+func restoreStdout() {
+	if quietRealStdout == nil {
+		return
+	}
+	_ = os.Stdout.Close()
+	os.Stdout = quietRealStdout
+	quietRealStdout = nil
+}
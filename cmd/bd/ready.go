@@ -283,10 +283,10 @@ func runMoleculeReady(_ *cobra.Command, molIDArg string) {
 	}
 
 	// Resolve molecule ID
-	moleculeID, err := utils.ResolvePartialID(ctx, store, molIDArg)
+	moleculeID, err := utils.ResolveIssueID(ctx, store, molIDArg)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: molecule '%s' not found\n", molIDArg)
-		os.Exit(1)
+		os.Exit(ExitNotFound)
 	}
 
 	// Load molecule subgraph
@@ -405,7 +405,7 @@ func init() {
 	readyCmd.Flags().IntP("priority", "p", 0, "Filter by priority")
 	readyCmd.Flags().StringP("assignee", "a", "", "Filter by assignee")
 	readyCmd.Flags().BoolP("unassigned", "u", false, "Show only unassigned issues")
-	readyCmd.Flags().StringP("sort", "s", "hybrid", "Sort policy: hybrid (default), priority, oldest")
+	readyCmd.Flags().StringP("sort", "s", "priority", "Sort policy: priority (then dependency order), hybrid, oldest")
 	readyCmd.Flags().StringSliceP("label", "l", []string{}, "Filter by labels (AND: must have ALL). Can combine with --label-any")
 	readyCmd.Flags().StringSlice("label-any", []string{}, "Filter by labels (OR: must have AT LEAST ONE). Can combine with --label")
 	readyCmd.Flags().StringP("type", "t", "", "Filter by issue type (task, bug, feature, epic, merge-request)")
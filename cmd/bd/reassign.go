@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var reassignCmd = &cobra.Command{
+	Use:     "reassign <from> <to>",
+	GroupID: "issues",
+	Short:   "Reassign all open issues from one assignee to another",
+	Long: `Reassign every open issue assigned to <from> over to <to>, in a single
+transaction. Closed issues keep their assignee.
+
+Pass "" as <from> to assign all unassigned open issues, or "" as <to> to
+unassign them.
+
+Examples:
+  bd reassign alice bob             # Hand alice's open work to bob
+  bd reassign "" bob                # Give bob everything unassigned
+  bd reassign alice bob --dry-run   # Count what would move, change nothing`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		from, to := args[0], args[1]
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !dryRun {
+			CheckReadonly("reassign")
+		}
+
+		if err := ensureDirectMode("reassign requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("reassign requires SQLite storage")
+		}
+
+		count, err := sqliteStore.Reassign(rootCtx, from, to, actor, dryRun)
+		if err != nil {
+			FatalErrorRespectJSON("reassign failed: %v", err)
+		}
+		if count > 0 && !dryRun {
+			markDirtyAndScheduleFlush()
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"from":    from,
+				"to":      to,
+				"count":   count,
+				"dry_run": dryRun,
+			})
+			return
+		}
+
+		if count == 0 {
+			fmt.Printf("No open issues assigned to %s\n", displayAssignee(from))
+			return
+		}
+		if dryRun {
+			fmt.Printf("Would reassign %d issue(s) from %s to %s (dry run, nothing changed)\n", count, displayAssignee(from), displayAssignee(to))
+			return
+		}
+		fmt.Printf("%s Reassigned %d issue(s) from %s to %s\n", ui.RenderPass("✓"), count, displayAssignee(from), displayAssignee(to))
+	},
+}
+
+// displayAssignee renders an assignee for messages, naming the empty one.
+func displayAssignee(assignee string) string {
+	if assignee == "" {
+		return "(unassigned)"
+	}
+	return assignee
+}
+
+func init() {
+	reassignCmd.Flags().Bool("dry-run", false, "Count the issues that would be reassigned without changing them")
+	rootCmd.AddCommand(reassignCmd)
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var rebuildCmd = &cobra.Command{
+	Use:     "rebuild",
+	GroupID: "maint",
+	Short:   "Recreate the database from the JSONL export",
+	Long: `Recreate the database purely from the JSONL file named in metadata.json
+(jsonl_export, normally issues.jsonl). Use this when the database is corrupt or
+lost but the committed JSONL is fine.
+
+The new database is built next to the old one and its issue count is checked
+against the JSONL before anything is replaced. The old database, if any, is
+then moved aside as <name>.backup-pre-rebuild-<timestamp>.db.
+
+Config stored in the old database (issue prefix, sync branch, ...) is carried
+over when the old database can still be opened. Otherwise the issue prefix is
+taken from config.yaml or from the issues in the JSONL.
+
+Stop the daemon before rebuilding.
+
+Examples:
+  bd rebuild
+  bd rebuild --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("rebuild")
+
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			FatalErrorWithHint("no .beads directory found", "run 'bd init' to initialize bd")
+		}
+		cfg, err := loadOrCreateConfig(beadsDir)
+		if err != nil {
+			FatalErrorRespectJSON("failed to load config: %v", err)
+		}
+
+		targetPath := dbPath
+		if targetPath == "" {
+			targetPath = cfg.DatabasePath(beadsDir)
+		}
+		jsonlPath := cfg.JSONLPath(beadsDir)
+
+		pidFile := filepath.Join(beadsDir, "daemon.pid")
+		if isRunning, pid := isDaemonRunning(pidFile); isRunning {
+			FatalErrorRespectJSON("daemon is running (PID %d); run 'bd daemon --stop' first", pid)
+		}
+
+		result, err := rebuildDatabase(rootCtx, targetPath, jsonlPath)
+		if err != nil {
+			FatalErrorRespectJSON("rebuild failed: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(result)
+			return
+		}
+
+		fmt.Printf("%s Rebuilt %s from %s (%d issues)\n", ui.RenderPass("✓"), result.DBPath, result.JSONLPath, result.Issues)
+		if result.BackupPath != "" {
+			fmt.Printf("  Previous database saved as %s\n", result.BackupPath)
+		}
+	},
+}
+
+// rebuildResult describes a completed rebuild.
+type rebuildResult struct {
+	DBPath     string `json:"db_path"`
+	JSONLPath  string `json:"jsonl_path"`
+	BackupPath string `json:"backup_path,omitempty"` // Empty if there was no database to replace
+	Issues     int    `json:"issues"`
+}
+
+// rebuildDatabase recreates the database at dbPath from jsonlPath. The new
+// database is built in a temporary file and only swapped into place once its
+// issue count matches the JSONL, so a failed rebuild leaves dbPath untouched.
+func rebuildDatabase(ctx context.Context, dbPath, jsonlPath string) (*rebuildResult, error) {
+	issues, err := loadIssuesFromJSONL(jsonlPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", jsonlPath, err)
+	}
+	wantIDs := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		wantIDs[issue.ID] = true
+	}
+
+	tmpPath := dbPath + ".rebuild"
+	removeDatabaseFiles(tmpPath)
+
+	if err := buildDatabaseFromIssues(ctx, tmpPath, jsonlPath, readOldDatabaseConfig(ctx, dbPath), issues, len(wantIDs)); err != nil {
+		removeDatabaseFiles(tmpPath)
+		return nil, err
+	}
+
+	result := &rebuildResult{DBPath: dbPath, JSONLPath: jsonlPath, Issues: len(wantIDs)}
+	if _, err := os.Stat(dbPath); err == nil {
+		result.BackupPath = strings.TrimSuffix(dbPath, ".db") + ".backup-pre-rebuild-" + time.Now().Format("20060102-150405") + ".db"
+		if err := os.Rename(dbPath, result.BackupPath); err != nil {
+			removeDatabaseFiles(tmpPath)
+			return nil, fmt.Errorf("failed to back up existing database: %w", err)
+		}
+		// Keep the old WAL with its database; replaying it over the new one would corrupt it
+		for _, suffix := range []string{"-wal", "-shm"} {
+			if _, err := os.Stat(dbPath + suffix); err == nil {
+				_ = os.Rename(dbPath+suffix, result.BackupPath+suffix)
+			}
+		}
+	}
+
+	if err := os.Rename(tmpPath, dbPath); err != nil {
+		return nil, fmt.Errorf("failed to move rebuilt database into place: %w", err)
+	}
+	removeDatabaseFiles(tmpPath)
+
+	// The new database is in sync with the JSONL
+	if err := TouchDatabaseFile(dbPath, jsonlPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to update database mtime: %v\n", err)
+	}
+	return result, nil
+}
+
+// buildDatabaseFromIssues creates a fresh database at path holding issues and
+// checks that it ends up with wantCount issues.
+func buildDatabaseFromIssues(ctx context.Context, path, jsonlPath string, oldConfig map[string]string, issues []*types.Issue, wantCount int) error {
+	newStore, err := sqlite.New(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to create database: %w", err)
+	}
+	defer func() { _ = newStore.Close() }()
+
+	for key, value := range oldConfig {
+		if err := newStore.SetConfig(ctx, key, value); err != nil {
+			return fmt.Errorf("failed to copy config %s: %w", key, err)
+		}
+	}
+	if oldConfig["issue_prefix"] == "" {
+		prefix := config.GetString("issue-prefix")
+		if prefix == "" && len(issues) > 0 {
+			prefix = utils.ExtractIssuePrefix(issues[0].ID)
+		}
+		if prefix == "" {
+			prefix = "bd"
+		}
+		if err := newStore.SetConfig(ctx, "issue_prefix", strings.TrimRight(prefix, "-")); err != nil {
+			return fmt.Errorf("failed to set issue prefix: %w", err)
+		}
+	}
+
+	opts := ImportOptions{
+		SkipPrefixValidation: true,
+		OrphanHandling:       string(sqlite.OrphanAllow),
+	}
+	if _, err := importIssuesCore(ctx, path, newStore, issues, opts); err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+
+	var gotCount int
+	if err := newStore.UnderlyingDB().QueryRowContext(ctx, `SELECT COUNT(*) FROM issues`).Scan(&gotCount); err != nil {
+		return fmt.Errorf("failed to count rebuilt issues: %w", err)
+	}
+	if gotCount != wantCount {
+		return fmt.Errorf("rebuilt database has %d issues but %s has %d; existing database left in place", gotCount, jsonlPath, wantCount)
+	}
+
+	if err := newStore.SetMetadata(ctx, "bd_version", Version); err != nil {
+		return fmt.Errorf("failed to store version metadata: %w", err)
+	}
+	if hash, err := computeJSONLHash(jsonlPath); err == nil {
+		if err := newStore.SetMetadata(ctx, "jsonl_content_hash", hash); err != nil {
+			return fmt.Errorf("failed to store JSONL hash: %w", err)
+		}
+	}
+	if err := newStore.SetMetadata(ctx, "last_import_time", time.Now().Format(time.RFC3339Nano)); err != nil {
+		return fmt.Errorf("failed to store import time: %w", err)
+	}
+
+	// Fold the WAL into the .db file so the rename below moves everything
+	return newStore.CheckpointWAL(ctx)
+}
+
+// readOldDatabaseConfig returns the config table of the database being
+// replaced, or nil if it is missing or too damaged to open.
+func readOldDatabaseConfig(ctx context.Context, path string) map[string]string {
+	if _, err := os.Stat(path); err != nil {
+		return nil // sqlite.New would create it
+	}
+	oldStore, err := sqlite.New(ctx, path)
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = oldStore.Close() }()
+	cfg, err := oldStore.GetAllConfig(ctx)
+	if err != nil {
+		return nil
+	}
+	return cfg
+}
+
+// removeDatabaseFiles deletes a database file and its WAL/SHM sidecars.
+func removeDatabaseFiles(path string) {
+	_ = os.Remove(path)
+	cleanupWALFiles(path)
+}
+
+func init() {
+	rootCmd.AddCommand(rebuildCmd)
+}
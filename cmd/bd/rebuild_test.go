@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestRebuildDatabase(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, ".beads", "beads.db")
+	jsonlPath := filepath.Join(tmpDir, ".beads", "issues.jsonl")
+	ctx := context.Background()
+
+	s := newTestStore(t, dbPath)
+	parent := &types.Issue{ID: "test-1", Title: "Parent", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeEpic}
+	child := &types.Issue{ID: "test-2", Title: "Child", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	for _, issue := range []*types.Issue{parent, child} {
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+	if err := s.AddLabel(ctx, child.ID, "backend", "test"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	if err := s.AddDependency(ctx, &types.Dependency{IssueID: child.ID, DependsOnID: parent.ID, Type: types.DepBlocks}, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+	if err := exportToJSONLWithStore(ctx, s, jsonlPath); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	t.Run("replaces a damaged database and keeps a backup", func(t *testing.T) {
+		result, err := rebuildDatabase(ctx, dbPath, jsonlPath)
+		if err != nil {
+			t.Fatalf("rebuildDatabase failed: %v", err)
+		}
+		if result.Issues != 2 {
+			t.Errorf("Issues = %d, want 2", result.Issues)
+		}
+		if result.BackupPath == "" {
+			t.Fatal("expected the existing database to be backed up")
+		}
+		if _, err := os.Stat(result.BackupPath); err != nil {
+			t.Errorf("backup missing: %v", err)
+		}
+	})
+	s.Close()
+
+	t.Run("recreates a deleted database", func(t *testing.T) {
+		removeDatabaseFiles(dbPath)
+
+		result, err := rebuildDatabase(ctx, dbPath, jsonlPath)
+		if err != nil {
+			t.Fatalf("rebuildDatabase failed: %v", err)
+		}
+		if result.BackupPath != "" {
+			t.Errorf("BackupPath = %q, want none", result.BackupPath)
+		}
+		if _, err := os.Stat(dbPath + ".rebuild"); !os.IsNotExist(err) {
+			t.Error("temporary rebuild database left behind")
+		}
+
+		rebuilt, err := sqlite.New(ctx, dbPath)
+		if err != nil {
+			t.Fatalf("failed to open rebuilt database: %v", err)
+		}
+		defer rebuilt.Close()
+
+		if prefix, err := rebuilt.GetConfig(ctx, "issue_prefix"); err != nil || prefix == "" {
+			t.Errorf("issue_prefix not set after rebuild (%v)", err)
+		}
+		got, err := rebuilt.GetIssue(ctx, child.ID)
+		if err != nil || got == nil || got.Title != "Child" {
+			t.Fatalf("GetIssue(%s) = %+v, %v", child.ID, got, err)
+		}
+		labels, err := rebuilt.GetLabels(ctx, child.ID)
+		if err != nil || len(labels) != 1 || labels[0] != "backend" {
+			t.Errorf("labels = %v (%v), want [backend]", labels, err)
+		}
+		deps, err := rebuilt.GetDependencies(ctx, child.ID)
+		if err != nil || len(deps) != 1 || deps[0].ID != parent.ID {
+			t.Errorf("dependencies = %v (%v), want [%s]", deps, err, parent.ID)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var refCmd = &cobra.Command{
+	Use:     "ref",
+	GroupID: "issues",
+	Short:   "Manage links from issues to external URLs",
+	Long: `Manage references: links from an issue to an external URL such as a pull
+request, a design doc or a ticket in another tracker.
+
+Unlike dependencies, which connect issues to each other, references point
+outside beads. They are shown by 'bd show' and included in the JSONL export.
+
+Examples:
+  bd ref add bd-123 https://github.com/org/repo/pull/42 --label "fix PR"
+  bd ref list bd-123
+  bd ref remove bd-123 https://github.com/org/repo/pull/42`,
+}
+
+var refAddCmd = &cobra.Command{
+	Use:   "add [issue-id] [url]",
+	Short: "Link an issue to an external URL",
+	Long: `Link an issue to an external URL. The URL must be absolute, with a scheme
+and a host. Adding a URL the issue already links to updates its label.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("ref add")
+		label, _ := cmd.Flags().GetString("label")
+		if err := ensureDirectMode("ref add requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		ctx := rootCtx
+		issueID, err := utils.ResolveIssueID(ctx, store, args[0])
+		if err != nil {
+			FatalErrorRespectJSON("resolving %s: %v", args[0], err)
+		}
+		if err := store.AddReference(ctx, issueID, args[1], label, actor); err != nil {
+			FatalErrorRespectJSON("adding reference: %v", err)
+		}
+		markDirtyAndScheduleFlush()
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"issue_id": issueID,
+				"url":      args[1],
+				"label":    label,
+			})
+			return
+		}
+		fmt.Printf("Added reference to %s: %s\n", issueID, args[1])
+	},
+}
+
+var refRemoveCmd = &cobra.Command{
+	Use:   "remove [issue-id] [url]",
+	Short: "Remove an issue's link to an external URL",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("ref remove")
+		if err := ensureDirectMode("ref remove requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		ctx := rootCtx
+		issueID, err := utils.ResolveIssueID(ctx, store, args[0])
+		if err != nil {
+			FatalErrorRespectJSON("resolving %s: %v", args[0], err)
+		}
+		if err := store.RemoveReference(ctx, issueID, args[1], actor); err != nil {
+			FatalErrorRespectJSON("removing reference: %v", err)
+		}
+		markDirtyAndScheduleFlush()
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"issue_id": issueID,
+				"url":      args[1],
+				"removed":  true,
+			})
+			return
+		}
+		fmt.Printf("Removed reference from %s: %s\n", issueID, args[1])
+	},
+}
+
+var refListCmd = &cobra.Command{
+	Use:   "list [issue-id]",
+	Short: "List an issue's references",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := ensureDirectMode("ref list requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		ctx := rootCtx
+		issueID, err := utils.ResolveIssueID(ctx, store, args[0])
+		if err != nil {
+			FatalErrorRespectJSON("resolving %s: %v", args[0], err)
+		}
+		refs, err := store.GetReferences(ctx, issueID)
+		if err != nil {
+			FatalErrorRespectJSON("listing references: %v", err)
+		}
+
+		if jsonOutput {
+			if refs == nil {
+				refs = []*types.Reference{}
+			}
+			outputJSON(refs)
+			return
+		}
+		if len(refs) == 0 {
+			fmt.Printf("No references on %s\n", issueID)
+			return
+		}
+		printReferences(refs)
+	},
+}
+
+// printReferences prints the References section of 'bd show', if there are any.
+func printReferences(refs []*types.Reference) {
+	if len(refs) == 0 {
+		return
+	}
+	fmt.Printf("\nReferences (%d):\n", len(refs))
+	for _, ref := range refs {
+		if ref.Label != "" {
+			fmt.Printf("  %s: %s\n", ref.Label, ref.URL)
+		} else {
+			fmt.Printf("  %s\n", ref.URL)
+		}
+	}
+}
+
+// populateReferences sets References on each issue for export, in one query.
+func populateReferences(ctx context.Context, s storage.Storage, issues []*types.Issue) error {
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	refs, err := s.GetReferencesForIssues(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get references: %w", err)
+	}
+	for _, issue := range issues {
+		issue.References = refs[issue.ID]
+	}
+	return nil
+}
+
+func init() {
+	refAddCmd.Flags().StringP("label", "l", "", "Short description of the link (e.g. \"fix PR\")")
+	refCmd.AddCommand(refAddCmd)
+	refCmd.AddCommand(refRemoveCmd)
+	refCmd.AddCommand(refListCmd)
+	rootCmd.AddCommand(refCmd)
+}
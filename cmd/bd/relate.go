@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -71,11 +72,11 @@ func runRelate(cmd *cobra.Command, args []string) error {
 		}
 	} else {
 		var err error
-		id1, err = utils.ResolvePartialID(ctx, store, args[0])
+		id1, err = utils.ResolveIssueID(ctx, store, args[0])
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", args[0], err)
 		}
-		id2, err = utils.ResolvePartialID(ctx, store, args[1])
+		id2, err = utils.ResolveIssueID(ctx, store, args[1])
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", args[1], err)
 		}
@@ -115,10 +116,10 @@ func runRelate(cmd *cobra.Command, args []string) error {
 	}
 
 	if issue1 == nil {
-		return fmt.Errorf("issue not found: %s", id1)
+		return fmt.Errorf("issue %s %w", id1, storage.ErrNotFound)
 	}
 	if issue2 == nil {
-		return fmt.Errorf("issue not found: %s", id2)
+		return fmt.Errorf("issue %s %w", id2, storage.ErrNotFound)
 	}
 
 	// Add relates-to dependency: id1 -> id2 (bidirectional, so also id2 -> id1)
@@ -207,11 +208,11 @@ func runUnrelate(cmd *cobra.Command, args []string) error {
 		}
 	} else {
 		var err error
-		id1, err = utils.ResolvePartialID(ctx, store, args[0])
+		id1, err = utils.ResolveIssueID(ctx, store, args[0])
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", args[0], err)
 		}
-		id2, err = utils.ResolvePartialID(ctx, store, args[1])
+		id2, err = utils.ResolveIssueID(ctx, store, args[1])
 		if err != nil {
 			return fmt.Errorf("failed to resolve %s: %w", args[1], err)
 		}
@@ -247,10 +248,10 @@ func runUnrelate(cmd *cobra.Command, args []string) error {
 	}
 
 	if issue1 == nil {
-		return fmt.Errorf("issue not found: %s", id1)
+		return fmt.Errorf("issue %s %w", id1, storage.ErrNotFound)
 	}
 	if issue2 == nil {
-		return fmt.Errorf("issue not found: %s", id2)
+		return fmt.Errorf("issue %s %w", id2, storage.ErrNotFound)
 	}
 
 	// Remove relates-to dependency in both directions
@@ -13,11 +13,13 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
+	"github.com/steveyegge/beads/internal/validation"
 )
 
 var renamePrefixCmd = &cobra.Command{
@@ -77,7 +79,7 @@ NOTE: This is a rare operation. Most users never need this command.`,
 			}
 		}
 
-		if err := validatePrefix(newPrefix); err != nil {
+		if err := validation.ValidateIssuePrefix(newPrefix); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
@@ -88,7 +90,7 @@ NOTE: This is a rare operation. Most users never need this command.`,
 			os.Exit(1)
 		}
 
-		newPrefix = strings.TrimRight(newPrefix, "-")
+		newPrefix = strings.TrimRight(newPrefix, idgen.Separator())
 
 		// Check for multiple prefixes first
 		issues, err := store.SearchIssues(ctx, "", types.IssueFilter{})
@@ -143,13 +145,15 @@ NOTE: This is a rare operation. Most users never need this command.`,
 		if dryRun {
 				fmt.Printf("DRY RUN: Would rename %d issues from prefix '%s' to '%s'\n\n", len(issues), oldPrefix, newPrefix)
 			fmt.Printf("Sample changes:\n")
+			sep := idgen.Separator()
 			for i, issue := range issues {
 				if i >= 5 {
 					fmt.Printf("... and %d more issues\n", len(issues)-5)
 					break
 				}
-				oldID := fmt.Sprintf("%s-%s", oldPrefix, strings.TrimPrefix(issue.ID, oldPrefix+"-"))
-				newID := fmt.Sprintf("%s-%s", newPrefix, strings.TrimPrefix(issue.ID, oldPrefix+"-"))
+				suffix := strings.TrimPrefix(issue.ID, oldPrefix+sep)
+				oldID := idgen.FormatID(oldPrefix, suffix)
+				newID := idgen.FormatID(newPrefix, suffix)
 				fmt.Printf("  %s -> %s\n", ui.RenderAccent(oldID), ui.RenderAccent(newID))
 			}
 			return
@@ -181,29 +185,6 @@ NOTE: This is a rare operation. Most users never need this command.`,
 	},
 }
 
-func validatePrefix(prefix string) error {
-	prefix = strings.TrimRight(prefix, "-")
-
-	if prefix == "" {
-		return fmt.Errorf("prefix cannot be empty")
-	}
-
-	if len(prefix) > 8 {
-		return fmt.Errorf("prefix too long (max 8 characters): %s", prefix)
-	}
-
-	matched, _ := regexp.MatchString(`^[a-z][a-z0-9-]*$`, prefix)
-	if !matched {
-		return fmt.Errorf("prefix must start with a lowercase letter and contain only lowercase letters, numbers, and hyphens: %s", prefix)
-	}
-
-	if strings.HasPrefix(prefix, "-") || strings.HasSuffix(prefix, "--") {
-		return fmt.Errorf("prefix has invalid hyphen placement: %s", prefix)
-	}
-
-	return nil
-}
-
 // detectPrefixes analyzes all issues and returns a map of prefix -> count
 func detectPrefixes(issues []*types.Issue) map[string]int {
 	prefixes := make(map[string]int)
@@ -396,16 +377,17 @@ func renamePrefixInDB(ctx context.Context, oldPrefix, newPrefix string, issues [
 	// For production use, consider implementing a single atomic RenamePrefix() method
 	// in the storage layer that wraps all updates in one transaction.
 
-	oldPrefixPattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(oldPrefix) + `-(\d+)\b`)
+	sep := idgen.Separator()
+	oldPrefixPattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(oldPrefix+sep) + `(\d+)\b`)
 
 	replaceFunc := func(match string) string {
-		return strings.Replace(match, oldPrefix+"-", newPrefix+"-", 1)
+		return strings.Replace(match, oldPrefix+sep, newPrefix+sep, 1)
 	}
 
 	for _, issue := range issues {
 		oldID := issue.ID
-		numPart := strings.TrimPrefix(oldID, oldPrefix+"-")
-		newID := fmt.Sprintf("%s-%s", newPrefix, numPart)
+		numPart := strings.TrimPrefix(oldID, oldPrefix+sep)
+		newID := idgen.FormatID(newPrefix, numPart)
 
 		issue.ID = newID
 
@@ -1,10 +1,13 @@
 package main
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 	"github.com/steveyegge/beads/internal/utils"
@@ -13,7 +16,12 @@ var reopenCmd = &cobra.Command{
 	Use:     "reopen [id...]",
 	GroupID: "issues",
 	Short:   "Reopen one or more closed issues",
-	Long: `Reopen closed issues by setting status to 'open' and clearing the closed_at timestamp.
+	Long: `Reopen closed issues, clearing the closed_at timestamp and close reason.
+Each issue goes back to the status it had before it was closed (e.g.
+in_progress), or to the default-status ('open' unless configured) if that
+isn't known. Issues in one of the closed-statuses count as closed. Issues that
+aren't closed are an error.
+
 This is more explicit than 'bd update --status open' and emits a Reopened event.`,
 	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -29,7 +37,7 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 				resp, err := daemonClient.ResolveID(resolveArgs)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving ID %s: %v\n", id, err)
-					os.Exit(1)
+					os.Exit(exitCodeFor(err))
 				}
 				var resolvedID string
 				if err := json.Unmarshal(resp.Data, &resolvedID); err != nil {
@@ -39,103 +47,102 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 				resolvedIDs = append(resolvedIDs, resolvedID)
 			}
 		} else {
+			if store == nil {
+				fmt.Fprintln(os.Stderr, "Error: database not initialized")
+				os.Exit(1)
+			}
 			var err error
-			resolvedIDs, err = utils.ResolvePartialIDs(ctx, store, args)
+			resolvedIDs, err = utils.ResolveIssueIDs(ctx, store, args)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		}
 		reopenedIssues := []*types.Issue{}
-		// If daemon is running, use RPC
-		if daemonClient != nil {
-			for _, id := range resolvedIDs {
-				openStatus := string(types.StatusOpen)
-				updateArgs := &rpc.UpdateArgs{
-					ID:     id,
-					Status: &openStatus,
-				}
-				resp, err := daemonClient.Update(updateArgs)
+		failed := false
+		for _, id := range resolvedIDs {
+			var issue *types.Issue
+			if daemonClient != nil {
+				resp, err := daemonClient.ReopenIssue(&rpc.ReopenArgs{ID: id, Reason: reason})
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error reopening %s: %v\n", id, err)
+					failed = true
 					continue
 				}
-				// Add reason as a comment if provided
-				if reason != "" {
-					commentArgs := &rpc.CommentAddArgs{
-						ID:     id,
-						Author: actor,
-						Text:   reason,
-					}
-					if _, err := daemonClient.AddComment(commentArgs); err != nil {
-						fmt.Fprintf(os.Stderr, "Warning: failed to add comment to %s: %v\n", id, err)
-					}
-				}
-				if jsonOutput {
-					var issue types.Issue
-					if err := json.Unmarshal(resp.Data, &issue); err == nil {
-						reopenedIssues = append(reopenedIssues, &issue)
-					}
-				} else {
-					reasonMsg := ""
-					if reason != "" {
-						reasonMsg = ": " + reason
-					}
-					fmt.Printf("%s Reopened %s%s\n", ui.RenderAccent("↻"), id, reasonMsg)
+				issue = &types.Issue{}
+				if err := json.Unmarshal(resp.Data, issue); err != nil {
+					issue = nil
 				}
-			}
-			if jsonOutput && len(reopenedIssues) > 0 {
-				outputJSON(reopenedIssues)
-			}
-			return
-		}
-		// Fall back to direct storage access
-		if store == nil {
-			fmt.Fprintln(os.Stderr, "Error: database not initialized")
-			os.Exit(1)
-		}
-		for _, id := range args {
-			fullID, err := utils.ResolvePartialID(ctx, store, id)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
-				continue
-			}
-			// UpdateIssue automatically clears closed_at when status changes from closed
-			updates := map[string]interface{}{
-				"status": string(types.StatusOpen),
-			}
-			if err := store.UpdateIssue(ctx, fullID, updates, actor); err != nil {
-				fmt.Fprintf(os.Stderr, "Error reopening %s: %v\n", fullID, err)
-				continue
-			}
-			// Add reason as a comment if provided
-			if reason != "" {
-				if err := store.AddComment(ctx, fullID, actor, reason); err != nil {
-					fmt.Fprintf(os.Stderr, "Warning: failed to add comment to %s: %v\n", fullID, err)
+			} else {
+				if err := reopenIssue(ctx, store, id, reason); err != nil {
+					fmt.Fprintf(os.Stderr, "Error reopening %s: %v\n", id, err)
+					failed = true
+					continue
 				}
+				issue, _ = store.GetIssue(ctx, id)
 			}
+
 			if jsonOutput {
-				issue, _ := store.GetIssue(ctx, fullID)
 				if issue != nil {
 					reopenedIssues = append(reopenedIssues, issue)
 				}
-			} else {
-				reasonMsg := ""
-				if reason != "" {
-					reasonMsg = ": " + reason
-				}
-				fmt.Printf("%s Reopened %s%s\n", ui.RenderAccent("↻"), fullID, reasonMsg)
+				continue
+			}
+			statusMsg := ""
+			if issue != nil && issue.Status != types.StatusOpen {
+				statusMsg = fmt.Sprintf(" (%s)", issue.Status)
+			}
+			reasonMsg := ""
+			if reason != "" {
+				reasonMsg = ": " + reason
 			}
+			fmt.Printf("%s Reopened %s%s%s\n", ui.RenderAccent("↻"), id, statusMsg, reasonMsg)
 		}
+
 		// Schedule auto-flush if any issues were reopened
-		if len(args) > 0 {
+		if daemonClient == nil && len(resolvedIDs) > 0 {
 			markDirtyAndScheduleFlush()
 		}
 		if jsonOutput && len(reopenedIssues) > 0 {
 			outputJSON(reopenedIssues)
 		}
+		if failed {
+			os.Exit(1)
+		}
 	},
 }
+
+// reopenIssue reopens a closed issue in direct mode, restoring the status it
+// had before it was closed, and adds reason, if any, as a comment. Stores
+// that don't keep that history, such as --no-db's, reopen to open.
+func reopenIssue(ctx context.Context, s storage.Storage, id, reason string) error {
+	if sqliteStore, ok := s.(*sqlite.SQLiteStorage); ok {
+		if err := sqliteStore.ReopenIssue(ctx, id, actor); err != nil {
+			return err
+		}
+	} else {
+		issue, err := s.GetIssue(ctx, id)
+		if err != nil {
+			return err
+		}
+		if issue == nil {
+			return fmt.Errorf("issue %s %w", id, storage.ErrNotFound)
+		}
+		if issue.Status != types.StatusClosed {
+			return fmt.Errorf("issue %s is not closed (status: %s)", id, issue.Status)
+		}
+		if err := s.UpdateIssue(ctx, id, map[string]interface{}{"status": string(types.StatusOpen)}, actor); err != nil {
+			return err
+		}
+	}
+	if reason != "" {
+		if err := s.AddComment(ctx, id, actor, reason); err != nil {
+			return fmt.Errorf("reopened, but failed to add the reason as a comment: %w", err)
+		}
+	}
+	return nil
+}
+
 func init() {
 	reopenCmd.Flags().StringP("reason", "r", "", "Reason for reopening")
 	rootCmd.AddCommand(reopenCmd)
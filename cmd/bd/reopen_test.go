@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/beads/internal/storage/sqlite"
@@ -137,6 +138,29 @@ func TestReopenCommand(t *testing.T) {
 		h.assertStatus(issue2.ID, types.StatusOpen)
 	})
 
+	t.Run("reopen restores status before close", func(t *testing.T) {
+		issue := h.createIssue("In Progress", types.TypeTask, 1)
+		if err := s.UpdateIssue(ctx, issue.ID, map[string]interface{}{"status": string(types.StatusInProgress)}, "test-user"); err != nil {
+			t.Fatal(err)
+		}
+		h.closeIssue(issue.ID, "Done")
+		if err := reopenIssue(ctx, s, issue.ID, "Not done after all"); err != nil {
+			t.Fatalf("reopenIssue() error = %v", err)
+		}
+		h.assertStatus(issue.ID, types.StatusInProgress)
+		h.assertClosedAtNil(issue.ID)
+		h.assertCommentEvent(issue.ID, "Not done after all")
+	})
+
+	t.Run("reopen of open issue errors", func(t *testing.T) {
+		issue := h.createIssue("Still Open", types.TypeTask, 1)
+		err := reopenIssue(ctx, s, issue.ID, "")
+		if err == nil || !strings.Contains(err.Error(), "is not closed") {
+			t.Errorf("reopenIssue(open issue) error = %v, want not closed", err)
+		}
+		h.assertStatus(issue.ID, types.StatusOpen)
+	})
+
 	t.Run("reopen already open issue is no-op", func(t *testing.T) {
 		issue := h.createIssue("Already Open", types.TypeTask, 1)
 		h.reopenIssue(issue.ID)
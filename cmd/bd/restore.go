@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -9,6 +10,8 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 )
@@ -16,8 +19,15 @@ import (
 var restoreCmd = &cobra.Command{
 	Use:     "restore <issue-id>",
 	GroupID: "sync",
-	Short:   "Restore full history of a compacted issue from git",
-	Long: `Restore full history of a compacted issue from git version control.
+	Short:   "Undelete or unarchive an issue, or restore full history of a compacted issue from git",
+	Long: `Undelete or unarchive an issue, or restore full history of a compacted issue from git.
+
+If the issue was deleted (it is a tombstone), restore brings it back as an
+open issue with its original type. Other clones that already imported the
+tombstone keep it deleted until they restore it too.
+
+If the issue was archived with 'bd archive', restore moves it back into the
+active issues with its labels, dependencies, comments and references.
 
 When an issue is compacted, the git commit hash is saved. This command:
 1. Reads the compacted_at_commit from the database
@@ -26,12 +36,25 @@ When an issue is compacted, the git commit hash is saved. This command:
 4. Displays the full issue history (description, events, etc.)
 5. Returns to the current git state
 
-This is read-only and does not modify the database or git state.`,
+Restoring compacted history is read-only and does not modify the database or git state.`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		issueID := args[0]
 		ctx := rootCtx
 
+		issue, err := getRestoreIssue(ctx, issueID)
+
+		// Deleted issues are undeleted instead of read back from git
+		if err == nil && issue != nil && issue.Status == types.StatusTombstone {
+			undeleteIssue(issueID)
+			return
+		}
+
+		// Archived issues are no longer in the issues table; move them back
+		if (err != nil || issue == nil) && unarchiveIssue(issueID) {
+			return
+		}
+
 		// Check if we're in a git repository
 		if !isGitRepo() {
 			fmt.Fprintf(os.Stderr, "Error: not in a git repository\n")
@@ -39,11 +62,9 @@ This is read-only and does not modify the database or git state.`,
 			os.Exit(1)
 		}
 
-		// Get the issue
-		issue, err := store.GetIssue(ctx, issueID)
-		if err != nil {
+		if err != nil || issue == nil {
 			fmt.Fprintf(os.Stderr, "Error: issue %s not found: %v\n", issueID, err)
-			os.Exit(1)
+			os.Exit(ExitNotFound)
 		}
 
 		// Check if issue is compacted
@@ -103,7 +124,7 @@ This is read-only and does not modify the database or git state.`,
 
 		if historicalIssue == nil {
 			fmt.Fprintf(os.Stderr, "Error: issue %s not found in JSONL at commit %s\n", issueID, commitHash)
-			os.Exit(1)
+			os.Exit(ExitNotFound)
 		}
 
 		// Display the restored issue
@@ -116,6 +137,78 @@ func init() {
 	rootCmd.AddCommand(restoreCmd)
 }
 
+// getRestoreIssue fetches an issue through the daemon when one is connected.
+func getRestoreIssue(ctx context.Context, issueID string) (*types.Issue, error) {
+	if daemonClient != nil {
+		resp, err := daemonClient.Show(&rpc.ShowArgs{ID: issueID})
+		if err != nil {
+			return nil, err
+		}
+		var issue types.Issue
+		if err := json.Unmarshal(resp.Data, &issue); err != nil {
+			return nil, fmt.Errorf("parsing response: %w", err)
+		}
+		return &issue, nil
+	}
+	return store.GetIssue(ctx, issueID)
+}
+
+// undeleteIssue turns a tombstone back into an open issue
+func undeleteIssue(issueID string) {
+	CheckReadonly("restore")
+	if err := ensureDirectMode("undelete requires direct database access"); err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+	if !ok {
+		FatalErrorRespectJSON("undelete requires SQLite storage")
+	}
+	if err := sqliteStore.Undelete(rootCtx, issueID, actor); err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	markDirtyAndScheduleFlush()
+
+	if jsonOutput {
+		issue, _ := store.GetIssue(rootCtx, issueID)
+		outputJSON(issue)
+		return
+	}
+	fmt.Printf("%s Restored deleted issue %s\n", ui.RenderPass("✓"), issueID)
+}
+
+// unarchiveIssue moves an archived issue back out of the archive. It reports
+// false, changing nothing, if issueID is not archived.
+func unarchiveIssue(issueID string) bool {
+	if err := ensureDirectMode("unarchive requires direct database access"); err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+	if !ok {
+		return false
+	}
+	archived, err := sqliteStore.IsArchived(rootCtx, issueID)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	if !archived {
+		return false
+	}
+
+	CheckReadonly("restore")
+	issue, err := sqliteStore.Unarchive(rootCtx, issueID, actor)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	markDirtyAndScheduleFlush()
+
+	if jsonOutput {
+		outputJSON(issue)
+		return true
+	}
+	fmt.Printf("%s Restored archived issue %s\n", ui.RenderPass("✓"), issueID)
+	return true
+}
+
 // getCurrentGitHead returns the current HEAD reference (branch or commit)
 func getCurrentGitHead() (string, error) {
 	// Try to get symbolic ref (branch name) first
@@ -169,7 +262,7 @@ func readIssueFromJSONL(jsonlPath, issueID string) (*types.Issue, error) {
 
 	for scanner.Scan() {
 		var issue types.Issue
-		if err := json.Unmarshal(scanner.Bytes(), &issue); err != nil {
+		if err := types.UnmarshalJSONL(scanner.Bytes(), &issue); err != nil {
 			continue // Skip malformed lines
 		}
 		if issue.ID == issueID {
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:     "schema",
+	GroupID: "advanced",
+	Short:   "Show file format schemas",
+}
+
+var schemaJSONLCmd = &cobra.Command{
+	Use:   "jsonl",
+	Short: "Print the JSON schema for issues.jsonl records",
+	Long: `Print the JSON Schema (draft 2020-12) describing one line of issues.jsonl.
+
+Every exported record carries a schema_version field. Records without one
+predate versioning and are read as version 1. Importing a record with a
+newer schema_version than this bd supports fails instead of dropping fields.
+
+Use --check to validate an existing JSONL file against the schema.
+
+Examples:
+  bd schema jsonl                              # Print the schema
+  bd schema jsonl --check .beads/issues.jsonl  # Validate a file`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		checkPath, _ := cmd.Flags().GetString("check")
+		if checkPath == "" {
+			fmt.Print(types.JSONLSchema)
+			return
+		}
+
+		records, problems, err := checkJSONLFile(checkPath)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"path":           checkPath,
+				"schema_version": types.JSONLSchemaVersion,
+				"records":        records,
+				"valid":          len(problems) == 0,
+				"errors":         problems,
+			})
+		} else if len(problems) == 0 {
+			fmt.Printf("%s %s: %d record(s) match JSONL schema v%d\n", ui.RenderPass("✓"), checkPath, records, types.JSONLSchemaVersion)
+		} else {
+			for _, problem := range problems {
+				fmt.Fprintln(os.Stderr, problem)
+			}
+			fmt.Fprintf(os.Stderr, "%s: %d of %d record(s) do not match JSONL schema v%d\n", checkPath, len(problems), records, types.JSONLSchemaVersion)
+		}
+		if len(problems) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// checkJSONLFile validates every non-blank line of path against the JSONL
+// schema. It returns the number of records and one message per invalid record.
+func checkJSONLFile(path string) (int, []string, error) {
+	// #nosec G304 - user-specified path
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	records := 0
+	problems := []string{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if strings.TrimSpace(string(line)) == "" {
+			continue
+		}
+		records++
+		if err := types.ValidateJSONLRecord(line); err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", lineNum, err))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return records, problems, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return records, problems, nil
+}
+
+func init() {
+	schemaJSONLCmd.Flags().String("check", "", "Validate a JSONL file against the schema instead of printing it")
+	schemaCmd.AddCommand(schemaJSONLCmd)
+	rootCmd.AddCommand(schemaCmd)
+}
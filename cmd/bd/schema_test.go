@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/importer"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestExportMatchesJSONLSchema(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, ".beads", "beads.db")
+	jsonlPath := filepath.Join(tmpDir, ".beads", "issues.jsonl")
+	store := newTestStore(t, dbPath)
+	defer store.Close()
+	ctx := context.Background()
+
+	estimate := 30
+	ref := "gh-9"
+	parent := &types.Issue{Title: "Parent", Status: types.StatusOpen, Priority: 0, IssueType: types.TypeEpic}
+	child := &types.Issue{
+		Title:            "Child",
+		Description:      "Has every optional field we can set",
+		Status:           types.StatusInProgress,
+		Priority:         2,
+		IssueType:        types.TypeTask,
+		Assignee:         "alice",
+		EstimatedMinutes: &estimate,
+		ExternalRef:      &ref,
+		Pinned:           true,
+	}
+	for _, issue := range []*types.Issue{parent, child} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+	if err := store.AddLabel(ctx, child.ID, "backend", "test"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	dep := &types.Dependency{IssueID: child.ID, DependsOnID: parent.ID, Type: types.DepParentChild}
+	if err := store.AddDependency(ctx, dep, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+	if _, err := store.AddIssueComment(ctx, child.ID, "alice", "looks good"); err != nil {
+		t.Fatalf("AddIssueComment failed: %v", err)
+	}
+	if err := store.AddReference(ctx, child.ID, "https://github.com/org/repo/pull/9", "PR", "test"); err != nil {
+		t.Fatalf("AddReference failed: %v", err)
+	}
+	if err := store.CloseIssue(ctx, parent.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+
+	if err := exportToJSONLWithStore(ctx, store, jsonlPath); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	records, problems, err := checkJSONLFile(jsonlPath)
+	if err != nil {
+		t.Fatalf("checkJSONLFile failed: %v", err)
+	}
+	if records != 2 {
+		t.Errorf("records = %d, want 2", records)
+	}
+	if len(problems) != 0 {
+		t.Fatalf("export does not match schema: %v", problems)
+	}
+
+	data, err := os.ReadFile(jsonlPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if !strings.Contains(line, `"schema_version":1`) {
+			t.Errorf("record missing schema_version: %s", line)
+		}
+	}
+	if !strings.Contains(string(data), `"references":[`) {
+		t.Error("export should include the child's reference")
+	}
+}
+
+func TestUnixTimestampExportMatchesJSONLSchema(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, ".beads", "beads.db")
+	jsonlPath := filepath.Join(tmpDir, ".beads", "issues.jsonl")
+	store := newTestStore(t, dbPath)
+	defer store.Close()
+	ctx := context.Background()
+
+	config.Set("timestamp-format", "unix")
+	t.Cleanup(func() { config.Set("timestamp-format", "rfc3339") })
+
+	issue := &types.Issue{Title: "Closed", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if err := store.CloseIssue(ctx, issue.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+	if err := exportToJSONLWithStore(ctx, store, jsonlPath); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	data, err := os.ReadFile(jsonlPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), `"created_at":"`) {
+		t.Fatalf("expected Unix timestamps in export:\n%s", data)
+	}
+	_, problems, err := checkJSONLFile(jsonlPath)
+	if err != nil {
+		t.Fatalf("checkJSONLFile failed: %v", err)
+	}
+	if len(problems) != 0 {
+		t.Fatalf("unix export does not match schema: %v", problems)
+	}
+}
+
+func TestValidateJSONLRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		wantErr string
+	}{
+		{"legacy record without version", `{"id":"bd-1","title":"t","priority":1,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`, ""},
+		{"missing required field", `{"id":"bd-1","priority":1,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`, `missing required field "title"`},
+		{"unknown field", `{"id":"bd-1","title":"t","priority":1,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","owner":"x"}`, `unknown field "owner"`},
+		{"wrong type", `{"id":"bd-1","title":"t","priority":"high","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`, `field "priority" must be of type integer`},
+		{"not an object", `["bd-1"]`, "not a JSON object"},
+		{"unix timestamps", `{"id":"bd-1","title":"t","priority":1,"created_at":1735689600,"updated_at":1735689600}`, ""},
+		{"timestamp of wrong type", `{"id":"bd-1","title":"t","priority":1,"created_at":true,"updated_at":1735689600}`, `field "created_at" must be of type string or integer`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateJSONLRecord([]byte(tt.line))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONLRecord(t *testing.T) {
+	var issue types.Issue
+	newer := `{"id":"bd-1","title":"t","priority":1,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","schema_version":2,"owner":"x"}`
+	if err := types.UnmarshalJSONLRecord([]byte(newer), &issue); err == nil || !strings.Contains(err.Error(), "upgrade bd") {
+		t.Errorf("newer record error = %v, want the schema version error", err)
+	}
+
+	issue = types.Issue{}
+	unknown := `{"id":"bd-1","title":"t","priority":1,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","owner":"x"}`
+	if err := types.UnmarshalJSONLRecord([]byte(unknown), &issue); err == nil || !strings.Contains(err.Error(), `unknown field "owner"`) {
+		t.Errorf("unknown field error = %v, want a schema error", err)
+	}
+}
+
+func TestImportRejectsNewerSchemaVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, ".beads", "beads.db")
+	store := newTestStore(t, dbPath)
+	defer store.Close()
+
+	issue := &types.Issue{
+		ID:            "test-1",
+		Title:         "From the future",
+		Status:        types.StatusOpen,
+		Priority:      1,
+		IssueType:     types.TypeTask,
+		SchemaVersion: types.JSONLSchemaVersion + 1,
+	}
+	_, err := importer.ImportIssues(context.Background(), dbPath, store, []*types.Issue{issue}, importer.Options{})
+	if err == nil || !strings.Contains(err.Error(), "schema version") {
+		t.Fatalf("expected schema version error, got %v", err)
+	}
+	if got, _ := store.GetIssue(context.Background(), "test-1"); got != nil {
+		t.Error("issue from newer schema version should not be imported")
+	}
+}
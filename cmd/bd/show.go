@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"slices"
 	"strings"
 
@@ -27,6 +26,7 @@ var showCmd = &cobra.Command{
 	Args:    cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		showThread, _ := cmd.Flags().GetBool("thread")
+		formatStr, _ := cmd.Flags().GetString("format")
 		ctx := rootCtx
 
 		// Check database freshness before reading (bd-2q6d, bd-c4rq)
@@ -56,7 +56,7 @@ var showCmd = &cobra.Command{
 		} else {
 			// In direct mode, resolve via storage
 			var err error
-			resolvedIDs, err = utils.ResolvePartialIDs(ctx, store, args)
+			resolvedIDs, err = utils.ResolveIssueIDs(ctx, store, args)
 			if err != nil {
 				FatalErrorRespectJSON("%v", err)
 			}
@@ -68,6 +68,11 @@ var showCmd = &cobra.Command{
 			return
 		}
 
+		if formatStr != "" {
+			showFormatted(ctx, resolvedIDs, formatStr)
+			return
+		}
+
 		// If daemon is running, use RPC
 		if daemonClient != nil {
 			allDetails := []interface{}{}
@@ -139,6 +144,9 @@ var showCmd = &cobra.Command{
 					if issue.EstimatedMinutes != nil {
 						fmt.Printf("Estimated: %d minutes\n", *issue.EstimatedMinutes)
 					}
+					if issue.DueDate != "" {
+						fmt.Printf("Due: %s%s\n", issue.DueDate, overdueSuffix(issue))
+					}
 					fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04"))
 					fmt.Printf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04"))
 
@@ -233,6 +241,8 @@ var showCmd = &cobra.Command{
 						}
 					}
 
+					printReferences(issue.References)
+
 					fmt.Println()
 				}
 			}
@@ -245,6 +255,7 @@ var showCmd = &cobra.Command{
 
 		// Direct mode
 		allDetails := []interface{}{}
+		notFound := false
 		for idx, id := range resolvedIDs {
 			issue, err := store.GetIssue(ctx, id)
 			if err != nil {
@@ -253,38 +264,35 @@ var showCmd = &cobra.Command{
 			}
 			if issue == nil {
 				fmt.Fprintf(os.Stderr, "Issue %s not found\n", id)
+				notFound = true
 				continue
 			}
 
-			if jsonOutput {
-				// Include labels, dependencies (with metadata), dependents (with metadata), and comments in JSON output
-				type IssueDetails struct {
-					*types.Issue
-					Labels       []string                             `json:"labels,omitempty"`
-					Dependencies []*types.IssueWithDependencyMetadata `json:"dependencies,omitempty"`
-					Dependents   []*types.IssueWithDependencyMetadata `json:"dependents,omitempty"`
-					Comments     []*types.Comment                     `json:"comments,omitempty"`
+			if sqliteStore, ok := store.(*sqlite.SQLiteStorage); ok && jsonOutput {
+				detail, err := sqliteStore.GetIssueDetail(ctx, id)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error fetching %s: %v\n", id, err)
+					notFound = notFound || sqlite.IsNotFound(err)
+					continue
 				}
-				details := &IssueDetails{Issue: issue}
+				allDetails = append(allDetails, detail)
+				continue
+			}
+			if jsonOutput {
+				// Other storage backends: regular methods, without dependency metadata
+				details := &types.IssueDetail{Issue: issue}
 				details.Labels, _ = store.GetLabels(ctx, issue.ID)
-
-				// Get dependencies with metadata (dependency_type field)
-				if sqliteStore, ok := store.(*sqlite.SQLiteStorage); ok {
-					details.Dependencies, _ = sqliteStore.GetDependenciesWithMetadata(ctx, issue.ID)
-					details.Dependents, _ = sqliteStore.GetDependentsWithMetadata(ctx, issue.ID)
-				} else {
-					// Fallback to regular methods without metadata for other storage backends
-					deps, _ := store.GetDependencies(ctx, issue.ID)
-					for _, dep := range deps {
-						details.Dependencies = append(details.Dependencies, &types.IssueWithDependencyMetadata{Issue: *dep})
-					}
-					dependents, _ := store.GetDependents(ctx, issue.ID)
-					for _, dependent := range dependents {
-						details.Dependents = append(details.Dependents, &types.IssueWithDependencyMetadata{Issue: *dependent})
-					}
+				deps, _ := store.GetDependencies(ctx, issue.ID)
+				for _, dep := range deps {
+					details.Dependencies = append(details.Dependencies, &types.IssueWithDependencyMetadata{Issue: *dep})
+				}
+				dependents, _ := store.GetDependents(ctx, issue.ID)
+				for _, dependent := range dependents {
+					details.Dependents = append(details.Dependents, &types.IssueWithDependencyMetadata{Issue: *dependent})
 				}
 
 				details.Comments, _ = store.GetIssueComments(ctx, issue.ID)
+				details.References, _ = store.GetReferences(ctx, issue.ID)
 				allDetails = append(allDetails, details)
 				continue
 			}
@@ -318,6 +326,9 @@ var showCmd = &cobra.Command{
 			if issue.EstimatedMinutes != nil {
 				fmt.Printf("Estimated: %d minutes\n", *issue.EstimatedMinutes)
 			}
+			if issue.DueDate != "" {
+				fmt.Printf("Due: %s%s\n", issue.DueDate, overdueSuffix(issue))
+			}
 			fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04"))
 			fmt.Printf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04"))
 
@@ -433,6 +444,10 @@ var showCmd = &cobra.Command{
 				}
 			}
 
+			// Show references
+			refs, _ := store.GetReferences(ctx, issue.ID)
+			printReferences(refs)
+
 			// Show comments
 			comments, _ := store.GetIssueComments(ctx, issue.ID)
 			if len(comments) > 0 {
@@ -451,6 +466,9 @@ var showCmd = &cobra.Command{
 			// Show tip after successful show (non-JSON mode)
 			maybeShowTip(store)
 		}
+		if notFound {
+			os.Exit(ExitNotFound)
+		}
 	},
 }
 
@@ -509,6 +527,15 @@ var updateCmd = &cobra.Command{
 			externalRef, _ := cmd.Flags().GetString("external-ref")
 			updates["external_ref"] = externalRef
 		}
+		if cmd.Flags().Changed("due") {
+			due, _ := cmd.Flags().GetString("due")
+			dueDate, err := types.ParseDueDate(due)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			updates["due_date"] = dueDate
+		}
 		if cmd.Flags().Changed("estimate") {
 			estimate, _ := cmd.Flags().GetInt("estimate")
 			if estimate < 0 {
@@ -563,7 +590,7 @@ var updateCmd = &cobra.Command{
 				resp, err := daemonClient.ResolveID(resolveArgs)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving ID %s: %v\n", id, err)
-					os.Exit(1)
+					os.Exit(exitCodeFor(err))
 				}
 				var resolvedID string
 				if err := json.Unmarshal(resp.Data, &resolvedID); err != nil {
@@ -574,10 +601,10 @@ var updateCmd = &cobra.Command{
 			}
 		} else {
 			var err error
-			resolvedIDs, err = utils.ResolvePartialIDs(ctx, store, args)
+			resolvedIDs, err = utils.ResolveIssueIDs(ctx, store, args)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		}
 
@@ -618,6 +645,9 @@ var updateCmd = &cobra.Command{
 				if estimate, ok := updates["estimated_minutes"].(int); ok {
 					updateArgs.EstimatedMinutes = &estimate
 				}
+				if dueDate, ok := updates["due_date"].(string); ok {
+					updateArgs.DueDate = &dueDate
+				}
 				if issueType, ok := updates["issue_type"].(string); ok {
 					updateArgs.IssueType = &issueType
 				}
@@ -782,10 +812,10 @@ Examples:
 
 		// Resolve partial ID if in direct mode
 		if daemonClient == nil {
-			fullID, err := utils.ResolvePartialID(ctx, store, id)
+			fullID, err := utils.ResolveIssueID(ctx, store, id)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			id = fullID
 		}
@@ -802,22 +832,9 @@ Examples:
 			fieldToEdit = "acceptance_criteria"
 		}
 
-		// Get the editor from environment
-		editor := os.Getenv("EDITOR")
-		if editor == "" {
-			editor = os.Getenv("VISUAL")
-		}
-		if editor == "" {
-			// Try common defaults
-			for _, defaultEditor := range []string{"vim", "vi", "nano", "emacs"} {
-				if _, err := exec.LookPath(defaultEditor); err == nil {
-					editor = defaultEditor
-					break
-				}
-			}
-		}
-		if editor == "" {
-			fmt.Fprintf(os.Stderr, "Error: No editor found. Set $EDITOR or $VISUAL environment variable.\n")
+		// Fail before fetching the issue if there is no editor to open
+		if _, err := findEditor(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
@@ -848,7 +865,7 @@ Examples:
 			}
 			if issue == nil {
 				fmt.Fprintf(os.Stderr, "Issue %s not found\n", id)
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 		}
 
@@ -867,43 +884,12 @@ Examples:
 			currentValue = issue.AcceptanceCriteria
 		}
 
-		// Create a temporary file with the current value
-		tmpFile, err := os.CreateTemp("", fmt.Sprintf("bd-edit-%s-*.txt", fieldToEdit))
+		// Edit the current value in a temporary file
+		newValue, err := editInEditor(currentValue, fmt.Sprintf("bd-edit-%s-*.txt", fieldToEdit))
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error creating temp file: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
-		tmpPath := tmpFile.Name()
-		defer func() { _ = os.Remove(tmpPath) }()
-
-		// Write current value to temp file
-		if _, err := tmpFile.WriteString(currentValue); err != nil {
-			_ = tmpFile.Close()
-			fmt.Fprintf(os.Stderr, "Error writing to temp file: %v\n", err)
-			os.Exit(1)
-		}
-		_ = tmpFile.Close()
-
-		// Open the editor
-		editorCmd := exec.Command(editor, tmpPath)
-		editorCmd.Stdin = os.Stdin
-		editorCmd.Stdout = os.Stdout
-		editorCmd.Stderr = os.Stderr
-
-		if err := editorCmd.Run(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error running editor: %v\n", err)
-			os.Exit(1)
-		}
-
-		// Read the edited content
-		// #nosec G304 -- tmpPath was created earlier in this function
-		editedContent, err := os.ReadFile(tmpPath)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error reading edited file: %v\n", err)
-			os.Exit(1)
-		}
-
-		newValue := string(editedContent)
 
 		// Check if the value changed
 		if newValue == currentValue {
@@ -989,7 +975,7 @@ var closeCmd = &cobra.Command{
 				resp, err := daemonClient.ResolveID(resolveArgs)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving ID %s: %v\n", id, err)
-					os.Exit(1)
+					os.Exit(exitCodeFor(err))
 				}
 				var resolvedID string
 				if err := json.Unmarshal(resp.Data, &resolvedID); err != nil {
@@ -1000,10 +986,10 @@ var closeCmd = &cobra.Command{
 			}
 		} else {
 			var err error
-			resolvedIDs, err = utils.ResolvePartialIDs(ctx, store, args)
+			resolvedIDs, err = utils.ResolveIssueIDs(ctx, store, args)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		}
 
@@ -1167,7 +1153,7 @@ func showMessageThread(ctx context.Context, messageID string, jsonOutput bool) {
 
 	if startMsg == nil {
 		fmt.Fprintf(os.Stderr, "Message %s not found\n", messageID)
-		os.Exit(1)
+		os.Exit(ExitNotFound)
 	}
 
 	// Find the root of the thread by following replies-to dependencies upward
@@ -1375,8 +1361,59 @@ func findReplies(ctx context.Context, issueID string, daemonClient *rpc.Client,
 	return nil
 }
 
+// showFormatted prints each issue with a --format preset or template.
+func showFormatted(ctx context.Context, ids []string, formatStr string) {
+	tmpl, err := parseIssueFormat(formatStr)
+	if err != nil {
+		FatalError("%v", err)
+	}
+
+	var issues []*types.Issue
+	for _, id := range ids {
+		if daemonClient != nil {
+			resp, err := daemonClient.Show(&rpc.ShowArgs{ID: id})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error fetching %s: %v\n", id, err)
+				continue
+			}
+			if string(resp.Data) == "null" || len(resp.Data) == 0 {
+				fmt.Fprintf(os.Stderr, "Issue %s not found\n", id)
+				continue
+			}
+			var details struct {
+				types.Issue
+				Labels []string `json:"labels,omitempty"`
+			}
+			if err := json.Unmarshal(resp.Data, &details); err != nil {
+				FatalError("parsing response: %v", err)
+			}
+			issue := details.Issue
+			issue.Labels = details.Labels
+			issues = append(issues, &issue)
+			continue
+		}
+
+		issue, err := store.GetIssue(ctx, id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching %s: %v\n", id, err)
+			continue
+		}
+		if issue == nil {
+			fmt.Fprintf(os.Stderr, "Issue %s not found\n", id)
+			continue
+		}
+		issue.Labels, _ = store.GetLabels(ctx, issue.ID)
+		issues = append(issues, issue)
+	}
+
+	if err := writeIssueFormat(os.Stdout, tmpl, issues); err != nil {
+		FatalError("%v", err)
+	}
+}
+
 func init() {
 	showCmd.Flags().Bool("thread", false, "Show full conversation thread (for messages)")
+	showCmd.Flags().String("format", "", "Output format: 'id-only', 'oneline', or a Go template run per issue, e.g. '{{.ID}}: {{.Title}}'")
 	rootCmd.AddCommand(showCmd)
 
 	updateCmd.Flags().StringP("status", "s", "", "New status")
@@ -1388,6 +1425,7 @@ func init() {
 	updateCmd.Flags().String("acceptance-criteria", "", "DEPRECATED: use --acceptance")
 	_ = updateCmd.Flags().MarkHidden("acceptance-criteria") // Only fails if flag missing (caught in tests)
 	updateCmd.Flags().IntP("estimate", "e", 0, "Time estimate in minutes (e.g., 60 for 1 hour)")
+	updateCmd.Flags().String("due", "", "Due date as YYYY-MM-DD (\"\" clears it)")
 	updateCmd.Flags().StringSlice("add-label", nil, "Add labels (repeatable)")
 	updateCmd.Flags().StringSlice("remove-label", nil, "Remove labels (repeatable)")
 	updateCmd.Flags().StringSlice("set-labels", nil, "Set labels, replacing all existing (repeatable)")
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -19,28 +21,55 @@ var staleCmd = &cobra.Command{
 This helps identify:
 - In-progress issues with no recent activity (may be abandoned)
 - Open issues that have been forgotten
-- Issues that might be outdated or no longer relevant`,
+- Issues that might be outdated or no longer relevant
+
+Results are sorted oldest first. --older-than accepts a duration such as 30d
+or 12h and takes precedence over --days. --label tags every stale issue with
+the "stale" label so it can be filtered later.
+
+Examples:
+  bd stale                       # Not updated in 30+ days
+  bd stale --older-than 2w       # Not updated in 14+ days
+  bd stale --older-than 30d --label`,
 	Run: func(cmd *cobra.Command, args []string) {
 		days, _ := cmd.Flags().GetInt("days")
 		status, _ := cmd.Flags().GetString("status")
 		limit, _ := cmd.Flags().GetInt("limit")
+		olderThanStr, _ := cmd.Flags().GetString("older-than")
+		addLabel, _ := cmd.Flags().GetBool("label")
+		if addLabel {
+			CheckReadonly("stale --label")
+		}
 		// Use global jsonOutput set by PersistentPreRun
 		// Validate status if provided
 		if status != "" && status != "open" && status != "in_progress" && status != "blocked" && status != "deferred" {
 			fmt.Fprintf(os.Stderr, "Error: invalid status '%s'. Valid values: open, in_progress, blocked, deferred\n", status)
 			os.Exit(1)
 		}
+		var olderThan time.Duration
+		threshold := fmt.Sprintf("%d+ days", days)
+		if olderThanStr != "" {
+			d, err := parseStaleAge(olderThanStr)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: invalid --older-than: %v\n", err)
+				os.Exit(1)
+			}
+			olderThan = d
+			threshold = olderThanStr
+		}
 		filter := types.StaleFilter{
-			Days:   days,
-			Status: status,
-			Limit:  limit,
+			Days:      days,
+			OlderThan: olderThan,
+			Status:    status,
+			Limit:     limit,
 		}
 		// If daemon is running, use RPC
 		if daemonClient != nil {
 			staleArgs := &rpc.StaleArgs{
-				Days:   days,
-				Status: status,
-				Limit:  limit,
+				Days:      days,
+				OlderThan: olderThan,
+				Status:    status,
+				Limit:     limit,
 			}
 			resp, err := daemonClient.Stale(staleArgs)
 			if err != nil {
@@ -52,6 +81,14 @@ This helps identify:
 				fmt.Fprintf(os.Stderr, "Error parsing response: %v\n", err)
 				os.Exit(1)
 			}
+			if addLabel {
+				for _, issue := range issues {
+					if _, err := daemonClient.AddLabel(&rpc.LabelAddArgs{ID: issue.ID, Label: staleLabel}); err != nil {
+						fmt.Fprintf(os.Stderr, "Error labeling %s: %v\n", issue.ID, err)
+						os.Exit(1)
+					}
+				}
+			}
 			if jsonOutput {
 				if issues == nil {
 					issues = []*types.Issue{}
@@ -59,7 +96,7 @@ This helps identify:
 				outputJSON(issues)
 				return
 			}
-			displayStaleIssues(issues, days)
+			displayStaleIssues(issues, threshold, addLabel)
 			return
 		}
 		// Direct mode
@@ -79,6 +116,15 @@ This helps identify:
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
+		if addLabel && len(issues) > 0 {
+			for _, issue := range issues {
+				if err := store.AddLabel(ctx, issue.ID, staleLabel, actor); err != nil {
+					fmt.Fprintf(os.Stderr, "Error labeling %s: %v\n", issue.ID, err)
+					os.Exit(1)
+				}
+			}
+			markDirtyAndScheduleFlush()
+		}
 		if jsonOutput {
 			if issues == nil {
 				issues = []*types.Issue{}
@@ -86,15 +132,39 @@ This helps identify:
 			outputJSON(issues)
 			return
 		}
-		displayStaleIssues(issues, days)
+		displayStaleIssues(issues, threshold, addLabel)
 	},
 }
-func displayStaleIssues(issues []*types.Issue, days int) {
+
+// staleLabel is the label 'bd stale --label' adds to stale issues.
+const staleLabel = "stale"
+
+// parseStaleAge parses an --older-than value: a day count like "30d", a week
+// count like "2w", or any duration parseDurationString accepts.
+func parseStaleAge(s string) (time.Duration, error) {
+	if n, ok := strings.CutSuffix(strings.ToLower(s), "w"); ok {
+		weeks, err := strconv.Atoi(n)
+		if err != nil || weeks < 0 {
+			return 0, fmt.Errorf("invalid duration format: %s (use 30d, 2w or 12h)", s)
+		}
+		return time.Duration(weeks) * 7 * 24 * time.Hour, nil
+	}
+	d, err := parseDurationString(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive: %s", s)
+	}
+	return d, nil
+}
+
+func displayStaleIssues(issues []*types.Issue, threshold string, labeled bool) {
 	if len(issues) == 0 {
 		fmt.Printf("\n%s No stale issues found (all active)\n\n", ui.RenderPass("✨"))
 		return
 	}
-	fmt.Printf("\n%s Stale issues (%d not updated in %d+ days):\n\n", ui.RenderWarn("⏰"), len(issues), days)
+	fmt.Printf("\n%s Stale issues (%d not updated in %s):\n\n", ui.RenderWarn("⏰"), len(issues), threshold)
 	now := time.Now()
 	for i, issue := range issues {
 		daysStale := int(now.Sub(issue.UpdatedAt).Hours() / 24)
@@ -105,11 +175,16 @@ func displayStaleIssues(issues []*types.Issue, days int) {
 		}
 		fmt.Println()
 	}
+	if labeled {
+		fmt.Printf("Labeled %d issue(s) %q\n\n", len(issues), staleLabel)
+	}
 }
 func init() {
 	staleCmd.Flags().IntP("days", "d", 30, "Issues not updated in this many days")
 	staleCmd.Flags().StringP("status", "s", "", "Filter by status (open|in_progress|blocked|deferred)")
 	staleCmd.Flags().IntP("limit", "n", 50, "Maximum issues to show")
+	staleCmd.Flags().String("older-than", "", "Issues not updated in this long, e.g. 30d, 2w, 12h (overrides --days)")
+	staleCmd.Flags().Bool("label", false, "Add the \"stale\" label to every stale issue")
 	// Note: --json flag is defined as a persistent flag in main.go, not here
 	rootCmd.AddCommand(staleCmd)
 }
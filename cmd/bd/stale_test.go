@@ -4,6 +4,7 @@ import (
 	"context"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -379,6 +380,84 @@ func TestStaleIssuesDifferentDaysThreshold(t *testing.T) {
 	}
 }
 
+func TestStaleIssuesOlderThan(t *testing.T) {
+	tmpDir := t.TempDir()
+	testDB := filepath.Join(tmpDir, ".beads", "beads.db")
+	s := newTestStore(t, testDB)
+	ctx := context.Background()
+
+	ages := map[string]string{
+		"test-2-hours": "-2 hours",
+		"test-3-days":  "-3 days",
+		"test-45-days": "-45 days",
+		"test-10-days": "-10 days",
+	}
+	for id := range ages {
+		issue := &types.Issue{ID: id, Title: id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db := s.UnderlyingDB()
+	for id, age := range ages {
+		if _, err := db.ExecContext(ctx, "UPDATE issues SET updated_at = datetime('now', ?) WHERE id = ?", age, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		olderThan string
+		want      []string
+	}{
+		{"1h", []string{"test-45-days", "test-10-days", "test-3-days", "test-2-hours"}},
+		{"1d", []string{"test-45-days", "test-10-days", "test-3-days"}},
+		{"1w", []string{"test-45-days", "test-10-days"}},
+		{"30d", []string{"test-45-days"}},
+		{"60d", nil},
+	}
+	for _, tt := range tests {
+		olderThan, err := parseStaleAge(tt.olderThan)
+		if err != nil {
+			t.Fatalf("parseStaleAge(%q) failed: %v", tt.olderThan, err)
+		}
+		// Days is ignored when OlderThan is set
+		stale, err := s.GetStaleIssues(ctx, types.StaleFilter{Days: 1000, OlderThan: olderThan})
+		if err != nil {
+			t.Fatalf("GetStaleIssues(%s) failed: %v", tt.olderThan, err)
+		}
+		var got []string
+		for _, issue := range stale {
+			got = append(got, issue.ID)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("--older-than %s: got %v, want %v (oldest first)", tt.olderThan, got, tt.want)
+		}
+	}
+}
+
+func TestParseStaleAge(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"30d", 30 * 24 * time.Hour},
+		{"2w", 14 * 24 * time.Hour},
+		{"12h", 12 * time.Hour},
+		{"90m", 90 * time.Minute},
+	}
+	for _, tt := range tests {
+		got, err := parseStaleAge(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("parseStaleAge(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "soon", "xw", "0d"} {
+		if _, err := parseStaleAge(bad); err == nil {
+			t.Errorf("parseStaleAge(%q) succeeded, want error", bad)
+		}
+	}
+}
+
 func TestStaleCommandInit(t *testing.T) {
 	if staleCmd == nil {
 		t.Fatal("staleCmd should be initialized")
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// CycleTimeSummary is the JSON output of bd stats cycle-time
+type CycleTimeSummary struct {
+	Since            time.Time `json:"since"`
+	BusinessDaysOnly bool      `json:"business_days_only"`
+	Count            int       `json:"count"`
+	P50Hours         float64   `json:"p50_hours"`
+	P90Hours         float64   `json:"p90_hours"`
+	DurationsHours   []float64 `json:"durations_hours"`
+}
+
+var statsCycleTimeCmd = &cobra.Command{
+	Use:   "cycle-time",
+	Short: "Show how long issues take from creation to close",
+	Long: `Show cycle time percentiles (p50/p90) for issues closed since a date.
+
+Cycle time is measured from created_at to closed_at. Open issues are excluded.
+JSON output includes the percentiles and every raw duration in hours.
+
+With --business-days (or the business-days-only config key) time on Saturdays
+and Sundays is not counted, so a day is 24 hours of weekday time. Weekends are
+taken in the timezone set by the "timezone" config key.
+
+Examples:
+  bd stats cycle-time                      # Issues closed in the last 90 days
+  bd stats cycle-time --since 2025-01-01
+  bd stats cycle-time --business-days
+  bd stats cycle-time --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		sinceStr, _ := cmd.Flags().GetString("since")
+		businessDays := config.GetBool("business-days-only")
+		if cmd.Flags().Changed("business-days") {
+			businessDays, _ = cmd.Flags().GetBool("business-days")
+		}
+
+		if err := ensureDirectMode("cycle-time requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("cycle-time requires SQLite storage")
+		}
+
+		loc, err := projectLocation()
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		since := time.Now().AddDate(0, 0, -90)
+		if sinceStr != "" {
+			t, err := parseTimeFlag(sinceStr)
+			if err != nil {
+				FatalErrorRespectJSON("parsing --since: %v", err)
+			}
+			since = t
+		}
+		since = since.In(loc)
+
+		durations, err := sqliteStore.CycleTimes(rootCtx, since, businessDays)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		summary := summarizeCycleTimes(since, durations)
+		summary.BusinessDaysOnly = businessDays
+
+		if jsonOutput {
+			outputJSON(summary)
+			return
+		}
+
+		scope := ""
+		if businessDays {
+			scope = " (business days only)"
+		}
+		fmt.Printf("\n%s Cycle time since %s%s\n\n", ui.RenderAccent("⏱"), since.Format("2006-01-02"), scope)
+		if summary.Count == 0 {
+			fmt.Printf("  No issues closed in this period\n\n")
+			return
+		}
+		fmt.Printf("  Closed issues:  %d\n", summary.Count)
+		fmt.Printf("  p50:            %s\n", formatCycleTime(durationPercentile(durations, 50)))
+		fmt.Printf("  p90:            %s\n\n", formatCycleTime(durationPercentile(durations, 90)))
+	},
+}
+
+// summarizeCycleTimes builds the cycle-time report from sorted durations
+func summarizeCycleTimes(since time.Time, durations []time.Duration) *CycleTimeSummary {
+	summary := &CycleTimeSummary{
+		Since:          since,
+		Count:          len(durations),
+		DurationsHours: make([]float64, len(durations)),
+	}
+	for i, d := range durations {
+		summary.DurationsHours[i] = d.Hours()
+	}
+	summary.P50Hours = durationPercentile(durations, 50).Hours()
+	summary.P90Hours = durationPercentile(durations, 90).Hours()
+	return summary
+}
+
+// durationPercentile returns the nearest-rank percentile of sorted durations
+func durationPercentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// formatCycleTime renders a duration in days or hours, whichever reads better
+func formatCycleTime(d time.Duration) string {
+	if d >= 48*time.Hour {
+		return fmt.Sprintf("%.1f days", d.Hours()/24)
+	}
+	return fmt.Sprintf("%.1f hours", d.Hours())
+}
+
+func init() {
+	statsCycleTimeCmd.Flags().String("since", "", "Only include issues closed on or after this date (default: 90 days ago)")
+	statsCycleTimeCmd.Flags().Bool("business-days", false, "Skip weekends when measuring cycle time (default: business-days-only config)")
+	statusCmd.AddCommand(statsCycleTimeCmd)
+}
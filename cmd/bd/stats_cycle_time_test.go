@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeCycleTimes(t *testing.T) {
+	var durations []time.Duration
+	for i := 1; i <= 10; i++ {
+		durations = append(durations, time.Duration(i)*time.Hour)
+	}
+
+	summary := summarizeCycleTimes(time.Time{}, durations)
+	if summary.Count != 10 {
+		t.Errorf("Count = %d, want 10", summary.Count)
+	}
+	if summary.P50Hours != 5 {
+		t.Errorf("P50Hours = %v, want 5", summary.P50Hours)
+	}
+	if summary.P90Hours != 9 {
+		t.Errorf("P90Hours = %v, want 9", summary.P90Hours)
+	}
+	if len(summary.DurationsHours) != 10 || summary.DurationsHours[0] != 1 {
+		t.Errorf("DurationsHours = %v", summary.DurationsHours)
+	}
+
+	empty := summarizeCycleTimes(time.Time{}, nil)
+	if empty.Count != 0 || empty.P50Hours != 0 || empty.DurationsHours == nil {
+		t.Errorf("empty summary = %+v", empty)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/util"
+)
+
+var statsThroughputCmd = &cobra.Command{
+	Use:   "throughput",
+	Short: "Show issues created vs closed per day or week",
+	Long: `Show how many issues were created and closed in each time bucket.
+
+Buckets are daily by default, or weekly with --weekly. Weekly buckets start on
+the day set by the week-start config key (monday or sunday), and --since is
+moved back to the start of its week. Issues closed before closed_at timestamps
+were recorded only count as created.
+
+Examples:
+  bd stats throughput                      # Last 30 days, daily
+  bd stats throughput --weekly             # Last 12 weeks, weekly
+  bd stats throughput --weekly --since 2025-01-01
+  bd stats throughput --weekly --json      # For charting tools`,
+	Run: func(cmd *cobra.Command, args []string) {
+		weekly, _ := cmd.Flags().GetBool("weekly")
+		sinceStr, _ := cmd.Flags().GetString("since")
+
+		if err := ensureDirectMode("throughput requires direct database access"); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		sqliteStore, ok := store.(*sqlite.SQLiteStorage)
+		if !ok {
+			FatalErrorRespectJSON("throughput requires SQLite storage")
+		}
+
+		bucket := 24 * time.Hour
+		label := "Day"
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		since := today.AddDate(0, 0, -29)
+		if sinceStr != "" {
+			t, err := parseTimeFlag(sinceStr)
+			if err != nil {
+				FatalErrorRespectJSON("parsing --since: %v", err)
+			}
+			since = t
+		}
+		if weekly {
+			weekStart, err := projectWeekStart()
+			if err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
+			bucket = 7 * 24 * time.Hour
+			label = "Week of"
+			if sinceStr == "" {
+				since = today.AddDate(0, 0, -7*11)
+			}
+			since = util.StartOfWeek(since, weekStart)
+		}
+
+		buckets, err := sqliteStore.Throughput(rootCtx, bucket, since)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			if buckets == nil {
+				buckets = []types.ThroughputBucket{}
+			}
+			outputJSON(buckets)
+			return
+		}
+
+		fmt.Printf("\n%s Throughput since %s\n\n", ui.RenderAccent("📈"), since.Format("2006-01-02"))
+		fmt.Printf("  %-12s %8s %8s\n", label, "Created", "Closed")
+		totalCreated, totalClosed := 0, 0
+		for _, b := range buckets {
+			fmt.Printf("  %-12s %8d %8d\n", b.Start.Format("2006-01-02"), b.Created, b.Closed)
+			totalCreated += b.Created
+			totalClosed += b.Closed
+		}
+		fmt.Printf("  %-12s %8d %8d\n\n", "Total", totalCreated, totalClosed)
+	},
+}
+
+func init() {
+	statsThroughputCmd.Flags().Bool("weekly", false, "Use weekly buckets (default: daily)")
+	statsThroughputCmd.Flags().String("since", "", "Start of the first bucket (default: 30 days, or 12 weeks with --weekly)")
+	statusCmd.AddCommand(statsThroughputCmd)
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 )
@@ -18,6 +20,7 @@ import (
 type StatusOutput struct {
 	Summary        *types.Statistics      `json:"summary"`
 	RecentActivity *RecentActivitySummary `json:"recent_activity,omitempty"`
+	Flush          *flushStatus           `json:"flush,omitempty"`
 }
 
 // RecentActivitySummary represents activity from git history
@@ -39,8 +42,9 @@ var statusCmd = &cobra.Command{
 	Long: `Show a quick snapshot of the issue database state and statistics.
 
 This command provides a summary of issue counts by state (open, in_progress,
-blocked, closed), ready work, extended statistics (tombstones, pinned issues,
-average lead time), and recent activity over the last 24 hours from git history.
+blocked, closed, plus a per-status breakdown including custom statuses), ready work, extended statistics (tombstones, pinned issues,
+average lead time), recent activity over the last 24 hours from git history,
+and how many changes are still waiting to be flushed to the JSONL.
 
 Similar to how 'git status' shows working tree state, 'bd status' gives you
 a quick overview of your issue database without needing multiple queries.
@@ -119,9 +123,20 @@ Examples:
 			recentActivity = getGitActivity(24)
 		}
 
+		// Pending flush needs the database's metadata, which the daemon doesn't serve
+		var flush *flushStatus
+		if !noDb {
+			if err := ensureDirectMode("status reads the flush watermark directly"); err != nil {
+				debug.Logf("status: skipping flush status: %v", err)
+			} else if flush, err = getFlushStatus(ctx, store); err != nil {
+				debug.Logf("status: skipping flush status: %v", err)
+			}
+		}
+
 		output := &StatusOutput{
 			Summary:        stats,
 			RecentActivity: recentActivity,
+			Flush:          flush,
 		}
 
 		// JSON output
@@ -140,6 +155,13 @@ Examples:
 		fmt.Printf("  Closed:                 %d\n", stats.ClosedIssues)
 		fmt.Printf("  Ready to Work:          %s\n", ui.RenderPass(fmt.Sprintf("%d", stats.ReadyIssues)))
 
+		if len(stats.ByStatus) > 0 {
+			fmt.Printf("\nBy Status:\n")
+			for _, status := range orderedStatusKeys(stats.ByStatus) {
+				fmt.Printf("  %-23s %d\n", status+":", stats.ByStatus[status])
+			}
+		}
+
 		// Extended statistics (only show if non-zero)
 		hasExtended := stats.TombstoneIssues > 0 || stats.PinnedIssues > 0 ||
 			stats.EpicsEligibleForClosure > 0 || stats.AverageLeadTime > 0
@@ -169,6 +191,18 @@ Examples:
 			fmt.Printf("  Issues Updated:         %d\n", recentActivity.IssuesUpdated)
 		}
 
+		if flush != nil {
+			fmt.Printf("\nJSONL:\n")
+			if flush.UpToDate {
+				fmt.Printf("  %s\n", ui.RenderPass("Up to date"))
+			} else {
+				fmt.Printf("  %s\n", ui.RenderWarn(fmt.Sprintf("%d change(s) pending flush", flush.Pending)))
+			}
+			if flush.LastFlush != nil {
+				fmt.Printf("  Last Flushed:           %s\n", flush.LastFlush.Local().Format("2006-01-02 15:04:05"))
+			}
+		}
+
 		// Show hint for more details
 		fmt.Printf("\nFor more details, use 'bd list' to see individual issues.\n")
 		fmt.Println()
@@ -178,6 +212,31 @@ Examples:
 	},
 }
 
+// orderedStatusKeys returns the statuses in counts with built-in statuses
+// first in workflow order, followed by any others alphabetically.
+func orderedStatusKeys(counts map[string]int) []string {
+	builtin := []types.Status{
+		types.StatusOpen, types.StatusInProgress, types.StatusBlocked,
+		types.StatusDeferred, types.StatusPinned, types.StatusClosed, types.StatusTombstone,
+	}
+	var keys []string
+	seen := make(map[string]bool)
+	for _, status := range builtin {
+		if _, ok := counts[string(status)]; ok {
+			keys = append(keys, string(status))
+			seen[string(status)] = true
+		}
+	}
+	var rest []string
+	for status := range counts {
+		if !seen[status] {
+			rest = append(rest, status)
+		}
+	}
+	sort.Strings(rest)
+	return append(keys, rest...)
+}
+
 // getGitActivity calculates activity stats from git log of issues.jsonl
 func getGitActivity(hours int) *RecentActivitySummary {
 	activity := &RecentActivitySummary{
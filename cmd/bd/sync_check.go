@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/syncbranch"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -243,7 +244,7 @@ func checkPrefixMismatch(ctx context.Context, jsonlPath string) (*PrefixMismatch
 		}
 
 		// Check if ID starts with configured prefix
-		if !strings.HasPrefix(issue.ID, prefix+"-") {
+		if !strings.HasPrefix(issue.ID, prefix+idgen.Separator()) {
 			result.MismatchedIDs = append(result.MismatchedIDs, issue.ID)
 		}
 	}
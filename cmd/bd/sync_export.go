@@ -3,13 +3,13 @@ package main
 import (
 	"cmp"
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/rpc"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -36,6 +36,7 @@ func exportToJSONL(ctx context.Context, jsonlPath string) error {
 	if err := ensureStoreActive(); err != nil {
 		return fmt.Errorf("failed to initialize store: %w", err)
 	}
+	exportStart := time.Now()
 
 	// Get all issues including tombstones for sync propagation (bd-rp4o fix)
 	// Tombstones must be exported so they propagate to other clones and prevent resurrection
@@ -104,6 +105,11 @@ func exportToJSONL(ctx context.Context, jsonlPath string) error {
 		issue.Comments = comments
 	}
 
+	// Populate references for all issues
+	if err := populateReferences(ctx, store, issues); err != nil {
+		return err
+	}
+
 	// Create temp file for atomic write
 	dir := filepath.Dir(jsonlPath)
 	base := filepath.Base(jsonlPath)
@@ -118,10 +124,11 @@ func exportToJSONL(ctx context.Context, jsonlPath string) error {
 	}()
 
 	// Write JSONL
-	encoder := json.NewEncoder(tempFile)
+	format := config.TimestampFormat()
 	exportedIDs := make([]string, 0, len(issues))
 	for _, issue := range issues {
-		if err := encoder.Encode(issue); err != nil {
+		issue.SchemaVersion = types.JSONLSchemaVersion
+		if err := types.EncodeIssueJSONL(tempFile, issue, format); err != nil {
 			return fmt.Errorf("failed to encode issue %s: %w", issue.ID, err)
 		}
 		exportedIDs = append(exportedIDs, issue.ID)
@@ -135,8 +142,8 @@ func exportToJSONL(ctx context.Context, jsonlPath string) error {
 		return fmt.Errorf("failed to replace JSONL file: %w", err)
 	}
 
-	// Set appropriate file permissions (0600: rw-------)
-	if err := os.Chmod(jsonlPath, 0600); err != nil {
+	// Set appropriate file permissions (0600: rw------- unless file-mode is configured)
+	if err := os.Chmod(jsonlPath, config.FileMode(0600)); err != nil {
 		// Non-fatal warning
 		fmt.Fprintf(os.Stderr, "Warning: failed to set file permissions: %v\n", err)
 	}
@@ -168,6 +175,9 @@ func exportToJSONL(ctx context.Context, jsonlPath string) error {
 		}
 		// Note: mtime tracking removed in bd-v0y fix (git doesn't preserve mtime)
 	}
+	if err := setFlushWatermark(ctx, store, exportStart); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
 
 	// Update database mtime to be >= JSONL mtime (fixes #278, #301, #321)
 	// This prevents validatePreExport from incorrectly blocking on next export
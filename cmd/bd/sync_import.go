@@ -28,7 +28,8 @@ func importFromJSONL(ctx context.Context, jsonlPath string, renameOnImport bool,
 
 	// Build args for import command
 	// Use --no-daemon to ensure subprocess uses direct mode, avoiding daemon connection issues
-	args := []string{"--no-daemon", "import", "-i", jsonlPath}
+	// The project's own JSONL must never be moved under the import prefix
+	args := []string{"--no-daemon", "import", "-i", jsonlPath, "--import-prefix="}
 	if renameOnImport {
 		args = append(args, "--rename-on-import")
 	}
@@ -151,7 +151,7 @@ var templateShowCmd = &cobra.Command{
 			resp, err := daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: template '%s' not found\n", args[0])
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 			if err := json.Unmarshal(resp.Data, &templateID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -159,10 +159,10 @@ var templateShowCmd = &cobra.Command{
 			}
 		} else if store != nil {
 			var err error
-			templateID, err = utils.ResolvePartialID(ctx, store, args[0])
+			templateID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: template '%s' not found\n", args[0])
-				os.Exit(1)
+				os.Exit(ExitNotFound)
 			}
 		} else {
 			fmt.Fprintf(os.Stderr, "Error: no database connection\n")
@@ -254,7 +254,7 @@ Example:
 			resp, err := daemonClient.ResolveID(resolveArgs)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving template ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 			if err := json.Unmarshal(resp.Data, &templateID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -262,10 +262,10 @@ Example:
 			}
 		} else if store != nil {
 			var err error
-			templateID, err = utils.ResolvePartialID(ctx, store, args[0])
+			templateID, err = utils.ResolveIssueID(ctx, store, args[0])
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving template ID %s: %v\n", args[0], err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		} else {
 			fmt.Fprintf(os.Stderr, "Error: no database connection\n")
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// withCommandTimeout returns ctx with a deadline timeout from now, and a
+// cancel func that also calls cancel. A timeout of 0 or less returns ctx and
+// cancel unchanged.
+func withCommandTimeout(ctx context.Context, cancel context.CancelFunc, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, cancel
+	}
+	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, timeout)
+	return timeoutCtx, func() {
+		timeoutCancel()
+		cancel()
+	}
+}
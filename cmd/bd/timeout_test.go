@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestWithCommandTimeout(t *testing.T) {
+	parent, parentCancel := context.WithCancel(context.Background())
+	defer parentCancel()
+
+	ctx, _ := withCommandTimeout(parent, parentCancel, 0)
+	if ctx != parent {
+		t.Error("a zero timeout should leave the context unchanged")
+	}
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("a zero timeout should not set a deadline")
+	}
+
+	parent, parentCancel = context.WithCancel(context.Background())
+	ctx, cancel := withCommandTimeout(parent, parentCancel, time.Hour)
+	if _, ok := ctx.Deadline(); !ok {
+		t.Fatal("a positive timeout should set a deadline")
+	}
+	cancel()
+	if parent.Err() == nil {
+		t.Error("cancel should also cancel the parent context")
+	}
+}
+
+func TestCommandTimeoutInterruptsLockedDatabase(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), ".beads", "beads.db")
+	holder := newTestStore(t, dbPath)
+	waiter := newTestStore(t, dbPath) // default 30s busy timeout
+
+	issue := &types.Issue{Title: "Task", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := holder.CreateIssue(context.Background(), issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	// Hold the write lock, so the update below would wait out the busy timeout
+	conn, err := holder.UnderlyingConn(context.Background())
+	if err != nil {
+		t.Fatalf("UnderlyingConn() error = %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(context.Background(), "BEGIN IMMEDIATE"); err != nil {
+		t.Fatalf("BEGIN IMMEDIATE error = %v", err)
+	}
+	defer func() { _, _ = conn.ExecContext(context.Background(), "ROLLBACK") }()
+
+	ctx, cancel := withCommandTimeout(context.Background(), func() {}, 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = waiter.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": "Renamed"}, "test")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("UpdateIssue() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("UpdateIssue() took %v to time out, want it to stop near the 50ms timeout", elapsed)
+	}
+}
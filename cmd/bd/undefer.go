@@ -37,7 +37,7 @@ Examples:
 				resp, err := daemonClient.ResolveID(resolveArgs)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Error resolving ID %s: %v\n", id, err)
-					os.Exit(1)
+					os.Exit(exitCodeFor(err))
 				}
 				var resolvedID string
 				if err := json.Unmarshal(resp.Data, &resolvedID); err != nil {
@@ -48,10 +48,10 @@ Examples:
 			}
 		} else {
 			var err error
-			resolvedIDs, err = utils.ResolvePartialIDs(ctx, store, args)
+			resolvedIDs, err = utils.ResolveIssueIDs(ctx, store, args)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCodeFor(err))
 			}
 		}
 
@@ -95,7 +95,7 @@ Examples:
 		}
 
 		for _, id := range args {
-			fullID, err := utils.ResolvePartialID(ctx, store, id)
+			fullID, err := utils.ResolveIssueID(ctx, store, id)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error resolving %s: %v\n", id, err)
 				continue
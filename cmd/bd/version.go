@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"runtime"
 	"runtime/debug"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/beads"
 	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/types"
 )
 
 var (
@@ -25,6 +27,11 @@ var (
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print version information",
+	Long: `Print the bd version, plus the build and git commit it was built from.
+
+With --json, prints version, build, commit ("unknown" if not recorded at
+build time), branch (if known), schema_version (the JSONL record format), and
+go_version, for bug reports.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		checkDaemon, _ := cmd.Flags().GetBool("daemon")
 
@@ -37,9 +44,12 @@ var versionCmd = &cobra.Command{
 		branch := resolveBranch()
 
 		if jsonOutput {
-			result := map[string]string{
-				"version": Version,
-				"build":   Build,
+			result := map[string]interface{}{
+				"version":        Version,
+				"build":          Build,
+				"schema_version": types.JSONLSchemaVersion,
+				"go_version":     runtime.Version(),
+				"commit":         "unknown",
 			}
 			if commit != "" {
 				result["commit"] = commit
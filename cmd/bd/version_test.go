@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"os"
+	"runtime"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 func TestVersionCommand(t *testing.T) {
@@ -59,18 +62,29 @@ func TestVersionCommand(t *testing.T) {
 		output := buf.String()
 
 		// Parse JSON output
-		var result map[string]string
+		var result map[string]interface{}
 		if err := json.Unmarshal([]byte(output), &result); err != nil {
 			t.Fatalf("Failed to parse JSON output: %v", err)
 		}
 
 		// Verify JSON contains version and build
 		if result["version"] != Version {
-			t.Errorf("Expected version %s, got %s", Version, result["version"])
+			t.Errorf("Expected version %s, got %v", Version, result["version"])
 		}
 		if result["build"] == "" {
 			t.Error("Expected build field to be non-empty")
 		}
+
+		// Fields for bug reports are always present
+		if v, ok := result["schema_version"].(float64); !ok || int(v) != types.JSONLSchemaVersion {
+			t.Errorf("Expected schema_version %d, got %v", types.JSONLSchemaVersion, result["schema_version"])
+		}
+		if result["go_version"] != runtime.Version() {
+			t.Errorf("Expected go_version %s, got %v", runtime.Version(), result["go_version"])
+		}
+		if commit, _ := result["commit"].(string); commit == "" {
+			t.Errorf("Expected commit to be set (or \"unknown\"), got %v", result["commit"])
+		}
 	})
 
 	// Restore default
@@ -184,16 +198,16 @@ func TestVersionOutputWithCommitAndBranch(t *testing.T) {
 		buf.ReadFrom(r)
 		output := buf.String()
 
-		var result map[string]string
+		var result map[string]interface{}
 		if err := json.Unmarshal([]byte(output), &result); err != nil {
 			t.Fatalf("Failed to parse JSON output: %v", err)
 		}
 
 		if result["commit"] != Commit {
-			t.Errorf("Expected commit %q, got %q", Commit, result["commit"])
+			t.Errorf("Expected commit %q, got %v", Commit, result["commit"])
 		}
 		if result["branch"] != Branch {
-			t.Errorf("Expected branch %q, got %q", Branch, result["branch"])
+			t.Errorf("Expected branch %q, got %v", Branch, result["branch"])
 		}
 	})
 }
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/steveyegge/beads/cmd/bd/doctor"
 	"github.com/steveyegge/beads/internal/beads"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/debug"
@@ -133,6 +134,24 @@ func getVersionsSince(sinceVersion string) []VersionChange {
 	return result
 }
 
+// checkMinBdVersion returns an error if version, the running binary's version,
+// is older than minVersion (the min-bd-version setting). An empty minVersion
+// means any version may run.
+func checkMinBdVersion(minVersion, version string) error {
+	minVersion = strings.TrimPrefix(strings.TrimSpace(minVersion), "v")
+	if minVersion == "" {
+		return nil
+	}
+	if !doctor.IsValidSemver(minVersion) {
+		return fmt.Errorf("invalid min-bd-version %q: expected a version like 0.36.0", minVersion)
+	}
+	if doctor.CompareVersions(strings.TrimPrefix(version, "v"), minVersion) < 0 {
+		return fmt.Errorf("this project requires bd %s or newer, but this is bd %s\n"+
+			"Upgrade bd (see 'bd upgrade') before using it here; an older binary may corrupt the database", minVersion, version)
+	}
+	return nil
+}
+
 // maybeShowUpgradeNotification displays a one-time upgrade notification if version changed.
 // This is called by commands like 'bd ready' and 'bd list' to inform users of upgrades.
 func maybeShowUpgradeNotification() {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 )
 
@@ -460,3 +462,43 @@ func TestAutoMigrateOnVersionBump_AlreadyMigrated(t *testing.T) {
 		t.Errorf("Database version changed unexpectedly: got %q, want %q", currentVersion, Version)
 	}
 }
+
+func TestCheckMinBdVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, "config.yaml"), []byte("min-bd-version: \"99.0.0\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(tmpDir)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize() error = %v", err)
+	}
+
+	err := checkMinBdVersion(config.GetString("min-bd-version"), Version)
+	if err == nil {
+		t.Fatalf("checkMinBdVersion(99.0.0, %s) should refuse to run", Version)
+	}
+	if !strings.Contains(err.Error(), "requires bd 99.0.0") || !strings.Contains(err.Error(), Version) {
+		t.Errorf("error should name both versions: %v", err)
+	}
+
+	tests := []struct {
+		min, version string
+		wantErr      bool
+	}{
+		{"", "0.36.0", false},
+		{"0.36.0", "0.36.0", false},
+		{"v0.35.2", "0.36.0", false},
+		{"0.36.1", "0.36.0", true},
+		{"0.4", "0.36.0", false},
+		{"latest", "0.36.0", true},
+	}
+	for _, tt := range tests {
+		if err := checkMinBdVersion(tt.min, tt.version); (err != nil) != tt.wantErr {
+			t.Errorf("checkMinBdVersion(%q, %q) error = %v, wantErr %v", tt.min, tt.version, err, tt.wantErr)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/rpc"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
@@ -261,7 +262,7 @@ func resolvePartialIDDirect(ctx context.Context, partial string) (string, error)
 	if len(issues) > 1 {
 		return "", fmt.Errorf("ambiguous ID: %s matches %d issues", partial, len(issues))
 	}
-	return "", fmt.Errorf("not found: %s", partial)
+	return "", fmt.Errorf("%s %w", partial, storage.ErrNotFound)
 }
 
 var wispListCmd = &cobra.Command{
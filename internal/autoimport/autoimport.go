@@ -6,7 +6,6 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -231,7 +230,7 @@ func parseJSONL(jsonlData []byte, _ Notifier) ([]*types.Issue, error) {
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONLRecord([]byte(line), &issue); err != nil {
 			snippet := line
 			if len(snippet) > 80 {
 				snippet = snippet[:80] + "..."
@@ -454,6 +454,19 @@ not valid json`
 		}
 	})
 
+	t.Run("record not matching schema", func(t *testing.T) {
+		data := `{"id":"test-1","title":"Issue 1","status":"open","priority":1,"issue_type":"task","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}
+{"id":"test-2","title":"Issue 2","status":"open","priority":1,"issue_type":"task","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","owner":"bob"}`
+
+		_, err := parseJSONL([]byte(data), notify)
+		if err == nil {
+			t.Fatal("Expected error for a record with an unknown field")
+		}
+		if !strings.Contains(err.Error(), "line 2") || !strings.Contains(err.Error(), `unknown field "owner"`) {
+			t.Errorf("Expected line number and schema error, got: %v", err)
+		}
+	})
+
 	t.Run("closed without closedAt", func(t *testing.T) {
 		data := `{"id":"test-1","title":"Closed Issue","status":"closed","priority":1,"issue_type":"task","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z"}`
 
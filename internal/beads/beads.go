@@ -263,17 +263,43 @@ func NewSQLiteStorage(ctx context.Context, dbPath string) (Storage, error) {
 	return sqlite.New(ctx, dbPath)
 }
 
+// ResolveDatabasePath returns the database path for a .beads directory using
+// bd's precedence: an explicit path (--db flag, BD_DB, or db in config.yaml)
+// wins, then the database named in metadata.json, then beads.db. The result
+// is canonicalized so it does not depend on the working directory later;
+// in-memory and file: URI databases are returned unchanged.
+func ResolveDatabasePath(explicit, beadsDir string) string {
+	if explicit == ":memory:" || strings.HasPrefix(explicit, "file:") {
+		return explicit
+	}
+	if explicit != "" {
+		return utils.CanonicalizePath(explicit)
+	}
+	if cfg, err := configfile.Load(beadsDir); err == nil && cfg != nil {
+		return utils.CanonicalizePath(cfg.DatabasePath(beadsDir))
+	}
+	return utils.CanonicalizePath(filepath.Join(beadsDir, CanonicalDatabaseName))
+}
+
 // FindDatabasePath discovers the bd database path using bd's standard search order:
-//  1. $BEADS_DIR environment variable (points to .beads directory)
-//  2. $BEADS_DB environment variable (points directly to database file, deprecated)
+//  1. $BEADS_DB environment variable (points directly to database file, deprecated)
+//  2. $BEADS_DIR environment variable (points to .beads directory)
 //  3. .beads/*.db in current directory or ancestors
 //
+// An explicit database file beats a directory, so $BEADS_DB wins over the
+// database named in $BEADS_DIR/metadata.json.
+//
 // Redirect files are supported: if a .beads/redirect file exists, its contents
 // are used as the actual .beads directory path.
 //
 // Returns empty string if no database is found.
 func FindDatabasePath() string {
-	// 1. Check BEADS_DIR environment variable (preferred)
+	// 1. Check BEADS_DB environment variable (deprecated but still supported)
+	if envDB := os.Getenv("BEADS_DB"); envDB != "" {
+		return utils.CanonicalizePath(envDB)
+	}
+
+	// 2. Check BEADS_DIR environment variable (preferred)
 	if beadsDir := os.Getenv("BEADS_DIR"); beadsDir != "" {
 		// Canonicalize the path to prevent nested .beads directories
 		absBeadsDir := utils.CanonicalizePath(beadsDir)
@@ -290,11 +316,6 @@ func FindDatabasePath() string {
 		// Return empty string and let the caller handle it
 	}
 
-	// 2. Check BEADS_DB environment variable (deprecated but still supported)
-	if envDB := os.Getenv("BEADS_DB"); envDB != "" {
-		return utils.CanonicalizePath(envDB)
-	}
-
 	// 3. Search for .beads/*.db in current directory and ancestors
 	if foundDB := findDatabaseInTree(); foundDB != "" {
 		return utils.CanonicalizePath(foundDB)
@@ -1252,4 +1252,84 @@ func TestFindDatabasePath_WorktreeNoLocalDB(t *testing.T) {
 	if resultResolved != mainDBPathResolved {
 		t.Errorf("FindDatabasePath() = %q, want main repo shared db %q", result, mainDBPath)
 	}
-}
\ No newline at end of file
+}
+func TestResolveDatabasePath(t *testing.T) {
+	// Resolve symlinks up front (macOS /var -> /private/var) so expectations
+	// match the canonicalized results.
+	tmpDir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	otherDB := filepath.Join(tmpDir, "elsewhere", "other.db")
+	metaAbsDB := filepath.Join(tmpDir, "meta-abs.db")
+
+	tests := []struct {
+		name     string
+		explicit string
+		metadata string // metadata.json contents; "" means no file
+		want     string
+	}{
+		{"default", "", "", filepath.Join(beadsDir, "beads.db")},
+		{"metadata names db", "", `{"database":"custom.db"}`, filepath.Join(beadsDir, "custom.db")},
+		{"metadata empty database", "", `{"database":""}`, filepath.Join(beadsDir, "beads.db")},
+		{"metadata absolute database", "", `{"database":"` + metaAbsDB + `"}`, metaAbsDB},
+		{"explicit beats default", otherDB, "", otherDB},
+		{"explicit beats metadata", otherDB, `{"database":"custom.db"}`, otherDB},
+		{"explicit beats absolute metadata", otherDB, `{"database":"` + metaAbsDB + `"}`, otherDB},
+		{"explicit memory", ":memory:", `{"database":"custom.db"}`, ":memory:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metaPath := filepath.Join(beadsDir, "metadata.json")
+			_ = os.Remove(metaPath)
+			if tt.metadata != "" {
+				if err := os.WriteFile(metaPath, []byte(tt.metadata), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := ResolveDatabasePath(tt.explicit, beadsDir); got != tt.want {
+				t.Errorf("ResolveDatabasePath(%q) = %q, want %q", tt.explicit, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("relative explicit is made absolute", func(t *testing.T) {
+		want, _ := filepath.Abs(filepath.Join("rel", "x.db"))
+		if got := ResolveDatabasePath(filepath.Join("rel", "x.db"), beadsDir); got != want {
+			t.Errorf("ResolveDatabasePath() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestFindDatabasePathBeadsDBBeatsBeadsDir(t *testing.T) {
+	tmpDir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, "metadata.json"), []byte(`{"database":"custom.db"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, "custom.db"), []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+	envDB := filepath.Join(tmpDir, "env.db")
+
+	t.Setenv("BEADS_DIR", beadsDir)
+	t.Setenv("BEADS_DB", "")
+	if got := FindDatabasePath(); got != filepath.Join(beadsDir, "custom.db") {
+		t.Errorf("BEADS_DIR only: got %q, want metadata database", got)
+	}
+
+	t.Setenv("BEADS_DB", envDB)
+	if got := FindDatabasePath(); got != envDB {
+		t.Errorf("BEADS_DB and BEADS_DIR: got %q, want %q", got, envDB)
+	}
+}
@@ -0,0 +1,86 @@
+package beads
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/steveyegge/beads/internal/validation"
+)
+
+// SuggestPrefix proposes an issue prefix for a project in dir. It uses the
+// repository name from the origin remote when there is one, and the
+// directory name otherwise. Multi-word names are abbreviated to their
+// initials ("beads-task-manager" -> "btm"); single words are lowercased and
+// cut to 8 characters. Returns "" if neither yields a valid prefix.
+func SuggestPrefix(dir string) string {
+	var names []string
+	// #nosec G204 - fixed git arguments
+	if out, err := exec.Command("git", "-C", dir, "remote", "get-url", "origin").Output(); err == nil {
+		names = append(names, repoNameFromURL(strings.TrimSpace(string(out))))
+	}
+	if abs, err := filepath.Abs(dir); err == nil {
+		names = append(names, filepath.Base(abs))
+	}
+
+	for _, name := range names {
+		if prefix := prefixFromName(name); validation.ValidateIssuePrefix(prefix) == nil {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// repoNameFromURL extracts the repository name from a git remote URL, e.g.
+// "git@github.com:org/beads-task-manager.git" -> "beads-task-manager".
+func repoNameFromURL(url string) string {
+	url = strings.TrimRight(url, "/")
+	url = strings.TrimSuffix(url, ".git")
+	if i := strings.LastIndexAny(url, "/:"); i >= 0 {
+		url = url[i+1:]
+	}
+	return url
+}
+
+// prefixFromName turns a repository or directory name into a candidate
+// prefix. Words are split on punctuation and camelCase boundaries.
+func prefixFromName(name string) string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && unicode.IsLower(runes[i-1]):
+			flush()
+			word = append(word, r)
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+
+	if len(words) == 0 {
+		return ""
+	}
+	prefix := words[0]
+	if len(words) > 1 {
+		var initials strings.Builder
+		for _, w := range words {
+			initials.WriteRune([]rune(w)[0])
+		}
+		prefix = initials.String()
+	}
+	if len(prefix) > 8 {
+		prefix = prefix[:8]
+	}
+	return prefix
+}
@@ -0,0 +1,76 @@
+package beads
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrefixFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"beads-task-manager", "btm"},
+		{"beads", "beads"},
+		{"Beads", "beads"},
+		{"my_cool.app", "mca"},
+		{"TaskManager", "tm"},
+		{"supercalifragilistic", "supercal"},
+		{"---", ""},
+	}
+	for _, tt := range tests {
+		if got := prefixFromName(tt.name); got != tt.want {
+			t.Errorf("prefixFromName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRepoNameFromURL(t *testing.T) {
+	for _, url := range []string{
+		"git@github.com:Marmalade118/beads-task-manager.git",
+		"https://github.com/Marmalade118/beads-task-manager.git",
+		"https://github.com/Marmalade118/beads-task-manager/",
+		"ssh://git@example.com:2222/team/beads-task-manager",
+		"/srv/git/beads-task-manager.git",
+	} {
+		if got := repoNameFromURL(url); got != "beads-task-manager" {
+			t.Errorf("repoNameFromURL(%q) = %q, want beads-task-manager", url, got)
+		}
+	}
+}
+
+func TestSuggestPrefix(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := filepath.Join(t.TempDir(), "checkout")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q")
+
+	// No remote: fall back to the directory name
+	if got := SuggestPrefix(dir); got != "checkout" {
+		t.Errorf("SuggestPrefix without remote = %q, want %q", got, "checkout")
+	}
+
+	git("remote", "add", "origin", "git@github.com:Marmalade118/beads-task-manager.git")
+	if got := SuggestPrefix(dir); got != "btm" {
+		t.Errorf("SuggestPrefix = %q, want %q", got, "btm")
+	}
+
+	// A remote name that yields no valid prefix falls back to the directory
+	git("remote", "set-url", "origin", "https://example.com/org/123.git")
+	if got := SuggestPrefix(dir); got != "checkout" {
+		t.Errorf("SuggestPrefix with unusable remote = %q, want %q", got, "checkout")
+	}
+}
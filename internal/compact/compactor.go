@@ -158,6 +158,9 @@ func (c *Compactor) CompactTier1Batch(ctx context.Context, issueIDs []string) ([
 	results := make([]*Result, 0, len(issueIDs))
 
 	for _, id := range issueIDs {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
 		eligible, reason, err := c.store.CheckEligibility(ctx, id, 1)
 		if err != nil {
 			results = append(results, &Result{
@@ -234,6 +237,10 @@ func (c *Compactor) CompactTier1Batch(ctx context.Context, issueIDs []string) ([
 		results = append(results, result)
 	}
 
+	// Workers skip the remaining issues once ctx is canceled
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
 	return results, nil
 }
 
@@ -1,10 +1,13 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,16 +17,24 @@ import (
 
 var v *viper.Viper
 
+// readFromStdin is set when the config came from InitializeFromReader.
+var readFromStdin bool
+
+// ErrConfigFromStdin is returned by config.yaml writes when the config was
+// read from stdin, since there is no file to write back to.
+var ErrConfigFromStdin = errors.New("config was read from stdin (--config -); edit the source it was piped from instead")
+
 // Initialize sets up the viper configuration singleton
 // Should be called once at application startup
 func Initialize() error {
 	v = viper.New()
+	readFromStdin = false
 
 	// Set config type to yaml (we only load config.yaml, not config.json)
 	v.SetConfigType("yaml")
 
 	// Explicitly locate config.yaml and use SetConfigFile to avoid picking up config.json
-	// Precedence: project .beads/config.yaml > ~/.config/bd/config.yaml > ~/.beads/config.yaml
+	// Precedence: project .beads/config.yaml > user config (see UserConfigPaths)
 	configFileSet := false
 
 	// 1. Walk up from CWD to find project .beads/config.yaml
@@ -43,28 +54,99 @@ func Initialize() error {
 		}
 	}
 
-	// 2. User config directory (~/.config/bd/config.yaml)
+	// 2. User config ($XDG_CONFIG_HOME/bd, ~/.config/bd, legacy ~/.beads)
 	if !configFileSet {
-		if configDir, err := os.UserConfigDir(); err == nil {
-			configPath := filepath.Join(configDir, "bd", "config.yaml")
+		for _, configPath := range UserConfigPaths() {
 			if _, err := os.Stat(configPath); err == nil {
 				v.SetConfigFile(configPath)
 				configFileSet = true
+				break
 			}
+			debug.Logf("Debug: no user config at %s\n", configPath)
 		}
 	}
 
-	// 3. Home directory (~/.beads/config.yaml)
-	if !configFileSet {
-		if homeDir, err := os.UserHomeDir(); err == nil {
-			configPath := filepath.Join(homeDir, ".beads", "config.yaml")
-			if _, err := os.Stat(configPath); err == nil {
-				v.SetConfigFile(configPath)
-				configFileSet = true
+	setDefaults(v)
+
+	// Read config file if it was found
+	if configFileSet {
+		if err := v.ReadInConfig(); err != nil {
+			return fmt.Errorf("error reading config file: %w", err)
+		}
+		debug.Logf("Debug: loaded config from %s\n", v.ConfigFileUsed())
+	} else {
+		// No config.yaml found - use defaults and environment variables
+		debug.Logf("Debug: no config.yaml found; using defaults and environment variables\n")
+	}
+
+	return nil
+}
+
+// UserConfigPaths returns the user-level config.yaml locations in the order
+// Initialize tries them when no project .beads/config.yaml is found:
+//  1. $XDG_CONFIG_HOME/bd/config.yaml, if XDG_CONFIG_HOME is an absolute path
+//     to an existing directory (otherwise it is skipped, not an error)
+//  2. the platform's user config directory (os.UserConfigDir), e.g.
+//     ~/Library/Application Support/bd/config.yaml on macOS
+//  3. ~/.config/bd/config.yaml
+//  4. ~/.beads/config.yaml (legacy)
+//
+// Duplicates, such as ~/.config on Linux without XDG_CONFIG_HOME, are listed once.
+func UserConfigPaths() []string {
+	var paths []string
+	add := func(dir string) {
+		path := filepath.Join(dir, "config.yaml")
+		for _, p := range paths {
+			if p == path {
+				return
 			}
 		}
+		paths = append(paths, path)
 	}
 
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		if !filepath.IsAbs(xdg) {
+			debug.Logf("Debug: ignoring XDG_CONFIG_HOME=%s: not an absolute path\n", xdg)
+		} else if info, err := os.Stat(xdg); err != nil || !info.IsDir() {
+			debug.Logf("Debug: ignoring XDG_CONFIG_HOME=%s: not a directory\n", xdg)
+		} else {
+			add(filepath.Join(xdg, "bd"))
+		}
+	}
+	// On Linux this is XDG_CONFIG_HOME or ~/.config; it fails when
+	// XDG_CONFIG_HOME is relative, and the home directory fallbacks apply
+	if configDir, err := os.UserConfigDir(); err == nil {
+		if _, err := os.Stat(configDir); err == nil {
+			add(filepath.Join(configDir, "bd"))
+		}
+	}
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		add(filepath.Join(homeDir, ".config", "bd"))
+		add(filepath.Join(homeDir, ".beads"))
+	}
+	return paths
+}
+
+// InitializeFromReader is Initialize with the YAML config read from r (e.g.
+// stdin for --config -) instead of a discovered config.yaml. Environment
+// variables and defaults apply as usual. There is no file behind this config,
+// so writes to config.yaml fail with ErrConfigFromStdin.
+func InitializeFromReader(r io.Reader) error {
+	v = viper.New()
+	v.SetConfigType("yaml")
+	setDefaults(v)
+
+	if err := v.ReadConfig(r); err != nil {
+		return fmt.Errorf("error reading config: %w", err)
+	}
+	readFromStdin = true
+	debug.Logf("Debug: loaded config from stdin\n")
+	return nil
+}
+
+// setDefaults binds environment variables and sets the default for every
+// known key on v. Schema also reads the defaults from it.
+func setDefaults(v *viper.Viper) {
 	// Automatic environment variable binding
 	// Environment variables take precedence over config file
 	// E.g., BD_JSON, BD_NO_DAEMON, BD_ACTOR, BD_DB
@@ -77,6 +159,7 @@ func Initialize() error {
 
 	// Set defaults for all flags
 	v.SetDefault("json", false)
+	v.SetDefault("quiet", false)
 	v.SetDefault("no-daemon", false)
 	v.SetDefault("no-auto-flush", false)
 	v.SetDefault("no-auto-import", false)
@@ -84,7 +167,14 @@ func Initialize() error {
 	v.SetDefault("db", "")
 	v.SetDefault("actor", "")
 	v.SetDefault("issue-prefix", "")
+	v.SetDefault("strict-prefix", false)
+	v.SetDefault("id-separator", "-")
+	v.SetDefault("min-bd-version", "") // refuse to run older bd binaries
+	v.SetDefault("color", "auto")
 	v.SetDefault("lock-timeout", "30s")
+	v.SetDefault("command-timeout", "0s")
+	v.SetDefault("write-max-retries", 5)
+	v.SetDefault("single-file-db", false)
 	
 	// Additional environment variables (not prefixed with BD_)
 	// These are bound explicitly for backward compatibility
@@ -96,6 +186,8 @@ func Initialize() error {
 	// Set defaults for additional settings
 	v.SetDefault("flush-debounce", "30s")
 	v.SetDefault("auto-start-daemon", true)
+	v.SetDefault("no-daemon-commands", []string{})
+	v.SetDefault("daemon-idle-timeout", "0")
 	v.SetDefault("identity", "")
 	v.SetDefault("remote-sync-interval", "30s")
 	
@@ -113,6 +205,32 @@ func Initialize() error {
 
 	// Create command defaults
 	v.SetDefault("create.require-description", false)
+	v.SetDefault("default-template", "")
+	v.SetDefault("default-priority", "2")
+	v.SetDefault("editor", "")
+	v.SetDefault("list-default-limit", 50)
+	v.SetDefault("timezone", "")
+	v.SetDefault("week-start", "monday")
+	v.SetDefault("business-days-only", false)
+	v.SetDefault("import-prefix", "")
+	v.SetDefault("init.prefix-from-remote", false)
+
+	// Flush hook defaults
+	v.SetDefault("pre-flush-hook", "")
+	v.SetDefault("pre-flush-hook-optional", false)
+	v.SetDefault("post-flush-hook", "")
+	v.SetDefault("auto-commit-jsonl", false)
+
+	// Status change webhook (e.g. a Slack or Teams incoming webhook)
+	v.SetDefault("webhooks-enabled", false)
+
+	// Permissions for created config.yaml, database and export files
+	// ("" keeps the built-in 0600/0644 defaults)
+	v.SetDefault("file-mode", "")
+	v.SetDefault("timestamp-format", "rfc3339")
+	v.SetDefault("file-mode-allow-world-writable", false)
+	v.SetDefault("commit-message-template", "beads: update issues")
+	v.SetDefault("notes-template", "") // bd notes output; "" uses the built-in Markdown
 
 	// Git configuration defaults (GH#600)
 	v.SetDefault("git.author", "")        // Override commit author (e.g., "beads-bot <beads@example.com>")
@@ -125,19 +243,6 @@ func Initialize() error {
 	// External projects for cross-project dependency resolution (bd-h807)
 	// Maps project names to paths for resolving external: blocked_by references
 	v.SetDefault("external_projects", map[string]string{})
-
-	// Read config file if it was found
-	if configFileSet {
-		if err := v.ReadInConfig(); err != nil {
-			return fmt.Errorf("error reading config file: %w", err)
-		}
-		debug.Logf("Debug: loaded config from %s\n", v.ConfigFileUsed())
-	} else {
-		// No config.yaml found - use defaults and environment variables
-		debug.Logf("Debug: no config.yaml found; using defaults and environment variables\n")
-	}
-
-	return nil
 }
 
 // ConfigSource represents where a configuration value came from
@@ -337,12 +442,43 @@ func Set(key string, value interface{}) {
 // 	return v.BindPFlag(key, flag)
 // }
 
-// AllSettings returns all configuration settings as a map
+// AllSettings returns all configuration settings as a map, without secret
+// keys (see SecretKeys)
 func AllSettings() map[string]interface{} {
 	if v == nil {
 		return map[string]interface{}{}
 	}
-	return v.AllSettings()
+	settings := v.AllSettings()
+	redactSecrets(settings)
+	return settings
+}
+
+// SearchKeys returns the known config keys containing substr, ignoring case,
+// sorted. Known keys are those with a default, those set in a config file or
+// the environment, YAML-only keys and secret keys.
+func SearchKeys(substr string) []string {
+	seen := make(map[string]bool)
+	if v != nil {
+		for _, key := range v.AllKeys() {
+			seen[key] = true
+		}
+	}
+	for key := range YamlOnlyKeys {
+		seen[key] = true
+	}
+	for key := range secretKeys {
+		seen[key] = true
+	}
+
+	substr = strings.ToLower(strings.TrimSpace(substr))
+	keys := []string{}
+	for key := range seen {
+		if strings.Contains(strings.ToLower(key), substr) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // GetStringSlice retrieves a string slice configuration value
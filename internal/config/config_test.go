@@ -1,8 +1,12 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -145,6 +149,118 @@ flush-debounce: 15s
 	}
 }
 
+func TestConfigFile_XDGConfigHome(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("HOME does not set the home directory on Windows")
+	}
+	home := t.TempDir()
+	xdg := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	// No project .beads/config.yaml here
+	t.Chdir(t.TempDir())
+
+	writeConfig := func(dir, actor string) {
+		t.Helper()
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			t.Fatalf("failed to create %s: %v", dir, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("actor: "+actor+"\n"), 0600); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+	}
+	writeConfig(filepath.Join(xdg, "bd"), "xdguser")
+	writeConfig(filepath.Join(home, ".config", "bd"), "homeuser")
+
+	if paths := UserConfigPaths(); len(paths) == 0 || paths[0] != filepath.Join(xdg, "bd", "config.yaml") {
+		t.Errorf("UserConfigPaths() = %v, want $XDG_CONFIG_HOME/bd/config.yaml first", paths)
+	}
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	if got := GetString("actor"); got != "xdguser" {
+		t.Errorf("GetString(actor) = %q, want config from XDG_CONFIG_HOME", got)
+	}
+
+	// A missing XDG_CONFIG_HOME falls through to ~/.config/bd
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(xdg, "does-not-exist"))
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() with missing XDG_CONFIG_HOME returned error: %v", err)
+	}
+	if got := GetString("actor"); got != "homeuser" {
+		t.Errorf("GetString(actor) = %q, want config from ~/.config/bd", got)
+	}
+}
+
+func TestInitializeFromReader(t *testing.T) {
+	// A project config that stdin config must bypass
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0750); err != nil {
+		t.Fatalf("failed to create .beads directory: %v", err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+	fileContent := "actor: fileuser\nno-push: true\n"
+	if err := os.WriteFile(configPath, []byte(fileContent), 0600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	t.Chdir(tmpDir)
+	t.Cleanup(func() { _ = Initialize() })
+
+	piped := `
+actor: ci-bot
+no-daemon: true
+flush-debounce: 10s
+`
+	if err := InitializeFromReader(strings.NewReader(piped)); err != nil {
+		t.Fatalf("InitializeFromReader() returned error: %v", err)
+	}
+
+	if got := GetString("actor"); got != "ci-bot" {
+		t.Errorf("GetString(actor) = %q, want \"ci-bot\"", got)
+	}
+	if got := GetBool("no-daemon"); !got {
+		t.Errorf("GetBool(no-daemon) = %v, want true", got)
+	}
+	if got := GetDuration("flush-debounce"); got != 10*time.Second {
+		t.Errorf("GetDuration(flush-debounce) = %v, want 10s", got)
+	}
+	// The discovered config.yaml is not read at all
+	if got := GetBool("no-push"); got {
+		t.Errorf("GetBool(no-push) = %v, want default false", got)
+	}
+	// Defaults still apply
+	if got := GetDuration("lock-timeout"); got != 30*time.Second {
+		t.Errorf("GetDuration(lock-timeout) = %v, want 30s", got)
+	}
+
+	// Writes have nowhere to go
+	if err := SetYamlConfig("actor", "someone"); !errors.Is(err, ErrConfigFromStdin) {
+		t.Errorf("SetYamlConfig() error = %v, want ErrConfigFromStdin", err)
+	}
+	if err := SetReposInYAML(configPath, &ReposConfig{Primary: "."}); !errors.Is(err, ErrConfigFromStdin) {
+		t.Errorf("SetReposInYAML() error = %v, want ErrConfigFromStdin", err)
+	}
+	if data, _ := os.ReadFile(configPath); string(data) != fileContent {
+		t.Errorf("config.yaml was modified: %q", data)
+	}
+
+	if err := InitializeFromReader(strings.NewReader("actor: [unclosed")); err == nil {
+		t.Error("InitializeFromReader() with invalid YAML should fail")
+	}
+
+	// Regular initialization goes back to the file and allows writes
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	if got := GetString("actor"); got != "fileuser" {
+		t.Errorf("GetString(actor) = %q, want \"fileuser\"", got)
+	}
+	if err := SetYamlConfig("actor", "someone"); err != nil {
+		t.Errorf("SetYamlConfig() after Initialize() error = %v", err)
+	}
+}
+
 func TestConfigPrecedence(t *testing.T) {
 	// Create a temporary directory for config file
 	tmpDir := t.TempDir()
@@ -749,3 +865,34 @@ func TestConfigSourceConstants(t *testing.T) {
 		t.Errorf("SourceFlag = %q, want \"flag\"", SourceFlag)
 	}
 }
+
+func TestSearchKeys(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+
+	got := SearchKeys("debounce")
+	if len(got) != 1 || got[0] != "flush-debounce" {
+		t.Errorf("SearchKeys(debounce) = %v, want [flush-debounce]", got)
+	}
+	if got := SearchKeys("DEBOUNCE"); len(got) != 1 || got[0] != "flush-debounce" {
+		t.Errorf("SearchKeys is case-sensitive: %v", got)
+	}
+
+	prefix := SearchKeys("prefix")
+	for _, want := range []string{"issue-prefix", "strict-prefix"} {
+		if !slices.Contains(prefix, want) {
+			t.Errorf("SearchKeys(prefix) = %v, missing %s", prefix, want)
+		}
+	}
+	if !slices.IsSorted(prefix) {
+		t.Errorf("SearchKeys(prefix) = %v, want sorted", prefix)
+	}
+	if got := SearchKeys("token"); !slices.Contains(got, "github-token") {
+		t.Errorf("SearchKeys(token) = %v, want the secret key too", got)
+	}
+	if got := SearchKeys("no-such-setting"); len(got) != 0 {
+		t.Errorf("SearchKeys(no-such-setting) = %v, want none", got)
+	}
+}
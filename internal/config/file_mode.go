@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ParseFileMode parses an octal permission string such as "0640" or "640".
+// World-writable modes are rejected unless allowWorldWritable is set.
+func ParseFileMode(s string, allowWorldWritable bool) (os.FileMode, error) {
+	s = strings.TrimSpace(s)
+	n, err := strconv.ParseUint(strings.TrimPrefix(s, "0o"), 8, 32)
+	if err != nil || n > 0o777 {
+		return 0, fmt.Errorf("invalid file-mode %q: must be octal permissions between 0000 and 0777, e.g. 0640", s)
+	}
+	mode := os.FileMode(n)
+	if mode&0o002 != 0 && !allowWorldWritable {
+		return 0, fmt.Errorf("file-mode %q makes files world-writable; set file-mode-allow-world-writable: true to allow it", s)
+	}
+	return mode, nil
+}
+
+// ValidateFileMode checks the file-mode setting, if any.
+func ValidateFileMode() error {
+	s := GetString("file-mode")
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	_, err := ParseFileMode(s, GetBool("file-mode-allow-world-writable"))
+	return err
+}
+
+// FileMode returns the permissions for files bd creates (config.yaml, the
+// database, exports): the file-mode setting if one is configured, otherwise
+// def, the built-in default for that kind of file. An invalid setting also
+// falls back to def; ValidateFileMode reports it at startup.
+func FileMode(def os.FileMode) os.FileMode {
+	mode, ok := configuredFileMode()
+	if !ok {
+		return def
+	}
+	return mode
+}
+
+// ApplyFileMode sets path's permissions to the file-mode setting. It does
+// nothing when file-mode is unset, leaving the mode the file was created with.
+// Files are created subject to the umask, which can strip group bits, so
+// callers apply the mode explicitly after creating a file.
+func ApplyFileMode(path string) error {
+	mode, ok := configuredFileMode()
+	if !ok {
+		return nil
+	}
+	return os.Chmod(path, mode)
+}
+
+func configuredFileMode() (os.FileMode, bool) {
+	s := GetString("file-mode")
+	if strings.TrimSpace(s) == "" {
+		return 0, false
+	}
+	mode, err := ParseFileMode(s, GetBool("file-mode-allow-world-writable"))
+	if err != nil {
+		return 0, false
+	}
+	return mode, true
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestParseFileMode(t *testing.T) {
+	tests := []struct {
+		in        string
+		allowWW   bool
+		want      os.FileMode
+		wantError bool
+	}{
+		{in: "0640", want: 0o640},
+		{in: "640", want: 0o640},
+		{in: "0o600", want: 0o600},
+		{in: " 0660 ", want: 0o660},
+		{in: "0666", wantError: true},
+		{in: "0666", allowWW: true, want: 0o666},
+		{in: "0644x", wantError: true},
+		{in: "0800", wantError: true},
+		{in: "01777", wantError: true},
+		{in: "rw-r--r--", wantError: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFileMode(tt.in, tt.allowWW)
+		if (err != nil) != tt.wantError {
+			t.Errorf("ParseFileMode(%q, %v) error = %v, wantError %v", tt.in, tt.allowWW, err, tt.wantError)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseFileMode(%q, %v) = %o, want %o", tt.in, tt.allowWW, got, tt.want)
+		}
+	}
+}
+
+func TestApplyFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix permissions")
+	}
+	t.Chdir(t.TempDir())
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	t.Cleanup(func() {
+		Set("file-mode", "")
+		Set("file-mode-allow-world-writable", false)
+	})
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("actor: alice\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unset: the built-in default is kept
+	if got := FileMode(0600); got != 0600 {
+		t.Errorf("FileMode(0600) with no file-mode = %o, want 600", got)
+	}
+	if err := ApplyFileMode(path); err != nil {
+		t.Fatalf("ApplyFileMode() error = %v", err)
+	}
+	assertFileMode(t, path, 0600)
+
+	Set("file-mode", "0640")
+	if err := ValidateFileMode(); err != nil {
+		t.Fatalf("ValidateFileMode() error = %v", err)
+	}
+	if got := FileMode(0600); got != 0640 {
+		t.Errorf("FileMode(0600) = %o, want 640", got)
+	}
+	if err := ApplyFileMode(path); err != nil {
+		t.Fatalf("ApplyFileMode() error = %v", err)
+	}
+	assertFileMode(t, path, 0640)
+
+	Set("file-mode", "0666")
+	if err := ValidateFileMode(); err == nil {
+		t.Error("ValidateFileMode() should reject a world-writable mode")
+	}
+	Set("file-mode-allow-world-writable", true)
+	if err := ValidateFileMode(); err != nil {
+		t.Errorf("ValidateFileMode() with file-mode-allow-world-writable error = %v", err)
+	}
+}
+
+func assertFileMode(t *testing.T, path string, want os.FileMode) {
+	t.Helper()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != want {
+		t.Errorf("%s mode = %o, want %o", filepath.Base(path), got, want)
+	}
+}
@@ -86,6 +86,10 @@ func GetReposFromYAML(configPath string) (*ReposConfig, error) {
 // SetReposInYAML writes the repos configuration to config.yaml
 // It preserves other config sections and comments where possible
 func SetReposInYAML(configPath string, repos *ReposConfig) error {
+	if readFromStdin {
+		return ErrConfigFromStdin
+	}
+
 	// Read existing config or create new
 	data, err := os.ReadFile(configPath) // #nosec G304 - config file path from caller
 	if err != nil && !os.IsNotExist(err) {
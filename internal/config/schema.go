@@ -0,0 +1,197 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// KeySchema describes one config.yaml setting: its JSON type, default,
+// allowed values and meaning.
+type KeySchema struct {
+	Key         string      `json:"key"`
+	Type        string      `json:"type"` // JSON Schema type: string, boolean, integer, array or object
+	Default     interface{} `json:"default,omitempty"`
+	Enum        []string    `json:"enum,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+// keyDetails adds what the defaults in setDefaults can't tell about a key.
+// A key listed here without a default needs its Type set.
+var keyDetails = map[string]KeySchema{
+	"json":                 {Description: "Output in JSON format"},
+	"quiet":                {Description: "Print errors only"},
+	"color":                {Enum: []string{"auto", "always", "never"}, Description: "When to color output"},
+	"no-daemon":            {Description: "Force direct mode, bypassing the daemon"},
+	"no-auto-flush":        {Description: "Disable automatic JSONL export"},
+	"no-auto-import":       {Description: "Disable automatic JSONL import"},
+	"no-db":                {Description: "Work from the JSONL only, without SQLite"},
+	"no-push":              {Description: "Skip pushing to the remote in bd sync"},
+	"db":                   {Description: "Database path"},
+	"actor":                {Description: "Actor name for the audit trail"},
+	"identity":             {Description: "Sender name for bd mail"},
+	"issue-prefix":         {Description: "Issue ID prefix, e.g. bd for bd-a1b2"},
+	"strict-prefix":        {Description: "Refuse to run when most issues use another prefix than issue_prefix"},
+	"id-separator":         {Enum: []string{"-", "_", "/", ":", "~", "+"}, Description: "Separator between prefix and hash in issue IDs"},
+	"min-bd-version":       {Description: "Oldest bd version allowed to use the project"},
+	"lock-timeout":         {Description: "How long to wait for the database lock, e.g. 30s"},
+	"command-timeout":      {Description: "Abort commands that run longer than this, e.g. 2m (0 for no limit)"},
+	"write-max-retries":    {Description: "Retries for writes that find the database locked"},
+	"single-file-db":       {Description: "Use DELETE journal mode instead of WAL"},
+	"flush-debounce":       {Description: "Delay before changes are flushed to the JSONL, e.g. 30s"},
+	"auto-start-daemon":    {Description: "Start the daemon automatically if it isn't running"},
+	"no-daemon-commands":   {Description: "Commands that never auto-start the daemon"},
+	"daemon-idle-timeout":  {Description: "Stop the daemon after this long without requests (0 for never)"},
+	"remote-sync-interval": {Description: "How often the daemon syncs with the remote, e.g. 30s"},
+	"routing.mode":         {Enum: []string{"auto", "maintainer", "contributor"}, Description: "How new issues are routed between repositories"},
+	"routing.default":      {Description: "Repository for new issues"},
+	"routing.maintainer":   {Description: "Repository for maintainers' issues"},
+	"routing.contributor":  {Description: "Repository for contributors' issues"},
+	"sync-branch":          {Type: "string", Description: "Git branch bd commits issue updates to"},
+	"sync.require_confirmation_on_mass_delete": {Description: "Ask before bd sync deletes many issues at once"},
+	"create.require-description":               {Description: "Require a description when creating issues"},
+	"default-template":                         {Description: "Template bd create uses when --template isn't given"},
+	"default-priority":                         {Description: "Priority (0-4 or P0-P4) for issues created without --priority"},
+	"editor":                                   {Description: "Editor for bd edit and bd create --edit"},
+	"list-default-limit":                       {Description: "Issues bd list prints by default (0 for all)"},
+	"timezone":                                 {Description: "IANA timezone due dates are interpreted in, e.g. Europe/Berlin (empty for local time)"},
+	"week-start":                               {Enum: []string{"monday", "sunday"}, Description: "First day of the week for weekly reports"},
+	"business-days-only":                       {Description: "Skip weekends when reporting cycle times and days overdue"},
+	"import-prefix":                            {Description: "Prefix bd import gives issues imported from elsewhere, e.g. gh (empty keeps their IDs)"},
+	"init.prefix-from-remote":                  {Description: "Derive bd init's prefix from the origin remote's name"},
+	"pre-flush-hook":                           {Description: "Executable run before each JSONL flush"},
+	"pre-flush-hook-optional":                  {Description: "Flush even if pre-flush-hook fails"},
+	"post-flush-hook":                          {Description: "Executable run after each JSONL flush"},
+	"auto-commit-jsonl":                        {Description: "Commit the JSONL after each flush"},
+	"commit-message-template":                  {Description: "Go template for auto-commit-jsonl commit messages"},
+	"notes-template":                           {Description: "Go template for bd notes"},
+	"webhooks-enabled":                         {Description: "POST to webhook-url when an issue's status changes"},
+	"file-mode":                                {Description: "Octal permissions for files bd creates, e.g. 0640"},
+	"file-mode-allow-world-writable":           {Description: "Accept a world-writable file-mode"},
+	"timestamp-format":                         {Enum: []string{"rfc3339", "unix"}, Description: "How JSONL exports write issue timestamps (always UTC)"},
+	"git.author":                               {Description: "Commit author for beads commits"},
+	"git.no-gpg-sign":                          {Description: "Disable GPG signing for beads commits"},
+	"directory.labels":                         {Description: "Map of directories to labels for automatic filtering"},
+	"external_projects":                        {Description: "Map of project names to paths for cross-project dependencies"},
+}
+
+// Schema returns every known config.yaml setting, sorted by key: those with a
+// default, typed after it, and those in keyDetails. Secret keys are left out
+// since they never belong in config.yaml.
+func Schema() []KeySchema {
+	defaults := viper.New()
+	setDefaults(defaults)
+
+	byKey := make(map[string]KeySchema)
+	for _, key := range defaults.AllKeys() {
+		value := defaults.Get(key)
+		byKey[key] = KeySchema{Key: key, Type: jsonSchemaType(value), Default: value}
+	}
+	for key, details := range keyDetails {
+		schema := byKey[key]
+		schema.Key = key
+		if details.Type != "" {
+			schema.Type = details.Type
+		}
+		schema.Enum = details.Enum
+		schema.Description = details.Description
+		byKey[key] = schema
+	}
+
+	keys := make([]KeySchema, 0, len(byKey))
+	for key, schema := range byKey {
+		if IsSecretKey(key) {
+			continue
+		}
+		if schema.Type == "" {
+			schema.Type = "string"
+		}
+		keys = append(keys, schema)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	return keys
+}
+
+// JSONSchema returns a JSON Schema (draft-07) for config.yaml, built from
+// Schema, for editors to validate and complete the file. Dotted keys become
+// nested objects. Unknown keys are allowed, since integrations add their own.
+func JSONSchema() map[string]interface{} {
+	root := map[string]interface{}{
+		"$schema":              "http://json-schema.org/draft-07/schema#",
+		"title":                "bd config.yaml",
+		"description":          "Settings for beads (bd), read from .beads/config.yaml",
+		"type":                 "object",
+		"properties":           map[string]interface{}{},
+		"additionalProperties": true,
+	}
+	for _, key := range Schema() {
+		parent := root
+		parts := strings.Split(key.Key, ".")
+		for _, part := range parts[:len(parts)-1] {
+			properties := parent["properties"].(map[string]interface{})
+			child, ok := properties[part].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{
+					"type":                 "object",
+					"properties":           map[string]interface{}{},
+					"additionalProperties": true,
+				}
+				properties[part] = child
+			}
+			parent = child
+		}
+
+		property := map[string]interface{}{"type": key.Type}
+		if key.Default != nil && !isEmptyDefault(key.Default) {
+			property["default"] = key.Default
+		}
+		if len(key.Enum) > 0 {
+			property["enum"] = key.Enum
+		}
+		if key.Description != "" {
+			property["description"] = key.Description
+		}
+		if key.Type == "array" {
+			property["items"] = map[string]interface{}{"type": "string"}
+		}
+		if key.Type == "object" {
+			property["additionalProperties"] = map[string]interface{}{"type": "string"}
+		}
+		parent["properties"].(map[string]interface{})[parts[len(parts)-1]] = property
+	}
+	return root
+}
+
+// jsonSchemaType returns the JSON Schema type of a default value.
+func jsonSchemaType(value interface{}) string {
+	switch value.(type) {
+	case bool:
+		return "boolean"
+	case int, int64:
+		return "integer"
+	case []string, []interface{}:
+		return "array"
+	case map[string]string, map[string]interface{}:
+		return "object"
+	case string:
+		return "string"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// isEmptyDefault reports whether a default is an empty string, list or map,
+// which says nothing useful in a schema.
+func isEmptyDefault(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case []string:
+		return len(v) == 0
+	case map[string]string:
+		return len(v) == 0
+	}
+	return false
+}
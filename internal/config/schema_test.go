@@ -0,0 +1,56 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONSchema(t *testing.T) {
+	data, err := json.Marshal(JSONSchema())
+	if err != nil {
+		t.Fatalf("json.Marshal(JSONSchema()) error = %v", err)
+	}
+	var schema struct {
+		Schema     string `json:"$schema"`
+		Type       string `json:"type"`
+		Properties map[string]struct {
+			Type       string          `json:"type"`
+			Default    interface{}     `json:"default"`
+			Enum       []string        `json:"enum"`
+			Properties map[string]struct {
+				Type string   `json:"type"`
+				Enum []string `json:"enum"`
+			} `json:"properties"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v\n%s", err, data)
+	}
+	if schema.Schema == "" || schema.Type != "object" {
+		t.Errorf("schema header = %q, %q; want a draft-07 object schema", schema.Schema, schema.Type)
+	}
+
+	color, ok := schema.Properties["color"]
+	if !ok || color.Type != "string" || color.Default != "auto" || len(color.Enum) != 3 {
+		t.Errorf("color = %+v, want a string enum defaulting to auto", color)
+	}
+	if p := schema.Properties["no-daemon"]; p.Type != "boolean" {
+		t.Errorf("no-daemon type = %q, want boolean", p.Type)
+	}
+	if p := schema.Properties["list-default-limit"]; p.Type != "integer" || p.Default != float64(50) {
+		t.Errorf("list-default-limit = %+v, want integer defaulting to 50", p)
+	}
+	if p := schema.Properties["no-daemon-commands"]; p.Type != "array" {
+		t.Errorf("no-daemon-commands type = %q, want array", p.Type)
+	}
+	if _, ok := schema.Properties["flush-debounce"]; !ok {
+		t.Error("schema is missing flush-debounce")
+	}
+	routing := schema.Properties["routing"]
+	if routing.Type != "object" || len(routing.Properties["mode"].Enum) != 3 {
+		t.Errorf("routing = %+v, want an object with an enum mode", routing)
+	}
+	if _, ok := schema.Properties["github-token"]; ok {
+		t.Error("secret keys don't belong in config.yaml's schema")
+	}
+}
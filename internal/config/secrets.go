@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// secretKeys are settings holding credentials, such as API tokens for
+// integrations. They are only ever read from the environment: bd never writes
+// them to config.yaml, ignores them there, and leaves them out of
+// AllSettings and 'bd config export', so a token can't end up in a
+// committed file.
+var secretKeys = map[string]bool{
+	"github-token": true,
+	"webhook-url":  true, // Incoming webhook URLs usually embed a token
+}
+
+// SecretKeys returns the names of the environment-only settings, sorted.
+func SecretKeys() []string {
+	keys := make([]string, 0, len(secretKeys))
+	for key := range secretKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsSecretKey reports whether key is an environment-only setting.
+func IsSecretKey(key string) bool {
+	return secretKeys[strings.TrimSpace(key)]
+}
+
+// SecretEnvVar returns the environment variable a secret key is read from,
+// e.g. BD_GITHUB_TOKEN for github-token.
+func SecretEnvVar(key string) string {
+	return "BD_" + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+}
+
+// GetSecret returns the value of a secret key from its environment variable.
+// Values in config.yaml are ignored.
+func GetSecret(key string) string {
+	return os.Getenv(SecretEnvVar(key))
+}
+
+// secretKeyError is returned when something tries to store a secret key.
+func secretKeyError(key string) error {
+	return fmt.Errorf("%s is a secret and is never stored in config; set the %s environment variable instead", key, SecretEnvVar(key))
+}
+
+// redactSecrets removes secret keys from a nested settings map, as returned
+// by viper or read from config.yaml.
+func redactSecrets(settings map[string]interface{}) {
+	for key := range secretKeys {
+		parts := strings.Split(key, ".")
+		m := settings
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := m[part].(map[string]interface{})
+			if !ok {
+				m = nil
+				break
+			}
+			m = next
+		}
+		if m != nil {
+			delete(m, parts[len(parts)-1])
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSecretKeysNeverStored(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create .beads dir: %v", err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+	// A token someone pasted into config.yaml by hand
+	initialConfig := "actor: alice\ngithub-token: from-yaml\nwebhook-url: https://hooks.example.com/T0/secret\n"
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("Failed to write config.yaml: %v", err)
+	}
+	t.Chdir(tmpDir)
+	t.Setenv("BD_GITHUB_TOKEN", "from-env")
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+
+	if !IsSecretKey("github-token") || !IsSecretKey("webhook-url") || IsSecretKey("actor") {
+		t.Errorf("IsSecretKey: github-token and webhook-url should be secret, actor should not")
+	}
+	if got := SecretEnvVar("github-token"); got != "BD_GITHUB_TOKEN" {
+		t.Errorf("SecretEnvVar() = %q, want BD_GITHUB_TOKEN", got)
+	}
+	if got := GetSecret("github-token"); got != "from-env" {
+		t.Errorf("GetSecret() = %q, want the environment value", got)
+	}
+
+	err := SetYamlConfig("github-token", "ghp_123")
+	if err == nil || !strings.Contains(err.Error(), "BD_GITHUB_TOKEN") {
+		t.Errorf("SetYamlConfig(github-token) error = %v, want refusal naming BD_GITHUB_TOKEN", err)
+	}
+	if _, err := ImportYamlConfig([]byte("no-push: true\ngithub-token: ghp_123\n")); err == nil {
+		t.Error("ImportYamlConfig should refuse a secret key")
+	}
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config.yaml: %v", err)
+	}
+	if string(content) != initialConfig {
+		t.Errorf("config.yaml changed by refused writes:\n%s", content)
+	}
+
+	for _, key := range []string{"github-token", "webhook-url"} {
+		if _, ok := AllSettings()[key]; ok {
+			t.Errorf("AllSettings() should not include secret key %s", key)
+		}
+	}
+	if got := GetSecret("webhook-url"); got != "" {
+		t.Errorf("GetSecret(webhook-url) = %q, want the config.yaml value ignored", got)
+	}
+	settings, err := ExportYamlConfig()
+	if err != nil {
+		t.Fatalf("ExportYamlConfig() error = %v", err)
+	}
+	_, hasWebhook := settings["webhook-url"]
+	if _, ok := settings["github-token"]; ok || hasWebhook || settings["actor"] != "alice" {
+		t.Errorf("ExportYamlConfig() = %v, want actor without the secrets", settings)
+	}
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const configYamlHeader = `# Beads Configuration File
+# This file configures default behavior for all bd commands in this repository
+# All settings can also be set via environment variables (BD_* prefix)
+# or overridden with command-line flags
+#
+# Every known setting is listed below with its description and default.
+# Commented-out settings use their default; uncomment one (or run
+# 'bd config set <key> <value>') to change it. 'bd config schema' prints
+# the same information as a JSON Schema.
+`
+
+const configYamlFooter = `# Multi-repo configuration (experimental - bd-307)
+# Allows hydrating from multiple repositories and routing writes to the correct JSONL
+# repos:
+#   primary: "."  # Primary repo (where this database lives)
+#   additional:   # Additional repos to hydrate from (read-only)
+#     - ~/beads-planning  # Personal planning repo
+#     - ~/work-planning   # Work planning repo
+
+# Integration settings (access with 'bd config get/set')
+# These are stored in the database, not in this file:
+# - jira.url
+# - jira.project
+# - linear.url
+# - linear.api-key
+# - github.org
+# - github.repo
+`
+
+// GenerateConfigYaml returns a self-documenting config.yaml: every setting in
+// Schema, preceded by a comment with its description and default. Settings
+// present in the YAML document settings (as flat dotted keys or nested) are
+// written out with their values; the others are commented out so their
+// defaults apply. Settings outside the schema, such as repos, are kept at the
+// end. Each commented-out setting sits on a "# key: value" line, which
+// SetYamlConfig uncomments in place rather than adding the key again.
+func GenerateConfigYaml(settings []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(settings, &doc); err != nil {
+		return nil, fmt.Errorf("invalid config.yaml: %w", err)
+	}
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	if len(doc.Content) > 0 {
+		if doc.Content[0].Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("invalid config.yaml: top level is not a mapping")
+		}
+		root = doc.Content[0]
+	}
+
+	var out bytes.Buffer
+	out.WriteString(configYamlHeader)
+	for _, key := range Schema() {
+		out.WriteString("\n")
+		if comment := describeKey(key); comment != "" {
+			out.WriteString("# " + comment + "\n")
+		}
+		if value, ok := takeYamlSetting(root, key.Key); ok {
+			text, err := encodeYamlSetting(key.Key, value)
+			if err != nil {
+				return nil, err
+			}
+			out.WriteString(text)
+			continue
+		}
+		text, err := encodeYamlSetting(key.Key, defaultYamlNode(key))
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+			out.WriteString("# " + line + "\n")
+		}
+	}
+
+	if len(root.Content) > 0 {
+		rest, err := encodeYamlNode(root)
+		if err != nil {
+			return nil, err
+		}
+		out.WriteString("\n# Other settings\n" + rest)
+	}
+	out.WriteString("\n" + configYamlFooter)
+	return out.Bytes(), nil
+}
+
+// describeKey returns the comment line for a setting, e.g.
+// "When to color output: auto, always or never (default: auto)".
+func describeKey(key KeySchema) string {
+	comment := key.Description
+	if len(key.Enum) > 1 {
+		comment += ": " + strings.Join(key.Enum[:len(key.Enum)-1], ", ") + " or " + key.Enum[len(key.Enum)-1]
+	}
+	if key.Default != nil && !isEmptyDefault(key.Default) {
+		comment += fmt.Sprintf(" (default: %v)", key.Default)
+	}
+	return strings.TrimSpace(comment)
+}
+
+// defaultYamlNode returns a setting's default as a YAML node; settings
+// without one get the empty value of their type.
+func defaultYamlNode(key KeySchema) *yaml.Node {
+	value := key.Default
+	if value == nil {
+		switch key.Type {
+		case "array":
+			value = []string{}
+		case "object":
+			value = map[string]string{}
+		default:
+			value = ""
+		}
+	}
+	var node yaml.Node
+	if err := node.Encode(value); err != nil {
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: fmt.Sprint(value)}
+	}
+	return &node
+}
+
+// encodeYamlSetting renders one "key: value" entry.
+func encodeYamlSetting(key string, value *yaml.Node) (string, error) {
+	return encodeYamlNode(&yaml.Node{
+		Kind:    yaml.MappingNode,
+		Content: []*yaml.Node{{Kind: yaml.ScalarNode, Value: key}, value},
+	})
+}
+
+func encodeYamlNode(node *yaml.Node) (string, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return "", fmt.Errorf("failed to encode config.yaml: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode config.yaml: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// takeYamlSetting removes the dotted key from the mapping node m, where it
+// may be written flat ("routing.mode: auto") or nested, and returns its value.
+// Mappings left empty by the removal are removed too.
+func takeYamlSetting(m *yaml.Node, key string) (*yaml.Node, bool) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			value := m.Content[i+1]
+			m.Content = append(m.Content[:i], m.Content[i+2:]...)
+			return value, true
+		}
+	}
+	first, rest, ok := strings.Cut(key, ".")
+	if !ok {
+		return nil, false
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		child := m.Content[i+1]
+		if m.Content[i].Value != first || child.Kind != yaml.MappingNode {
+			continue
+		}
+		value, found := takeYamlSetting(child, rest)
+		if found && len(child.Content) == 0 {
+			m.Content = append(m.Content[:i], m.Content[i+2:]...)
+		}
+		if found {
+			return value, true
+		}
+	}
+	return nil, false
+}
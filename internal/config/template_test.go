@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestGenerateConfigYaml(t *testing.T) {
+	settings := `no-db: true
+routing:
+  mode: maintainer
+  custom: kept
+repos:
+  primary: "."
+`
+	content, err := GenerateConfigYaml([]byte(settings))
+	if err != nil {
+		t.Fatalf("GenerateConfigYaml() error = %v", err)
+	}
+	text := string(content)
+
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		t.Fatalf("generated config.yaml does not parse: %v\n%s", err, text)
+	}
+	if parsed["no-db"] != true || parsed["routing.mode"] != "maintainer" {
+		t.Errorf("settings not written out: %v", parsed)
+	}
+	if _, ok := parsed["json"]; ok {
+		t.Error("unset settings should be commented out")
+	}
+	for _, key := range Schema() {
+		if key.Description != "" && !strings.Contains(text, "# "+key.Description) {
+			t.Errorf("missing description of %s", key.Key)
+		}
+	}
+	for _, want := range []string{
+		"# When to color output: auto, always or never (default: auto)\n# color: auto\n",
+		"# flush-debounce: 30s\n",
+		"routing:\n  custom: kept\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("generated config.yaml lacks %q:\n%s", want, text)
+		}
+	}
+
+	// The file loads, and setting a key replaces its commented-out line
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+	if err := os.WriteFile(configPath, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(tmpDir)
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	if !GetBool("no-db") || GetString("routing.mode") != "maintainer" || GetString("routing.custom") != "kept" {
+		t.Errorf("loaded no-db=%v routing.mode=%q routing.custom=%q", GetBool("no-db"), GetString("routing.mode"), GetString("routing.custom"))
+	}
+	if err := SetYamlConfig("flush-debounce", "10s"); err != nil {
+		t.Fatalf("SetYamlConfig() error = %v", err)
+	}
+	updated, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(updated), "flush-debounce:"); n != 1 {
+		t.Errorf("flush-debounce appears %d times after set, want 1:\n%s", n, updated)
+	}
+	if n := strings.Count(string(updated), "# Delay before changes are flushed"); n != 1 {
+		t.Errorf("flush-debounce description appears %d times after set, want 1", n)
+	}
+
+	// Regenerating keeps the settings and adds nothing twice
+	again, err := GenerateConfigYaml(updated)
+	if err != nil {
+		t.Fatalf("GenerateConfigYaml(regenerate) error = %v", err)
+	}
+	if !strings.Contains(string(again), "\nflush-debounce: 10s\n") || strings.Count(string(again), "# Multi-repo configuration") != 1 {
+		t.Errorf("regenerated config.yaml:\n%s", again)
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+import "github.com/steveyegge/beads/internal/types"
+
+// ValidateTimestampFormat checks the timestamp-format setting.
+func ValidateTimestampFormat() error {
+	_, err := types.ParseTimestampFormat(GetString("timestamp-format"))
+	return err
+}
+
+// TimestampFormat returns how JSONL exports write issue timestamps. An
+// invalid setting falls back to rfc3339; ValidateTimestampFormat reports it
+// at startup.
+func TimestampFormat() types.TimestampFormat {
+	format, err := types.ParseTimestampFormat(GetString("timestamp-format"))
+	if err != nil {
+		return types.TimestampRFC3339
+	}
+	return format
+}
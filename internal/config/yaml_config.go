@@ -23,17 +23,25 @@ var YamlOnlyKeys = map[string]bool{
 	"no-auto-flush":  true,
 	"no-auto-import": true,
 	"json":           true,
+	"quiet":          true,
+	"color":          true,
 	"auto-start-daemon": true,
+	"no-daemon-commands": true,
 
 	// Database and identity
 	"db":     true,
 	"actor":  true,
 	"identity": true,
+	"strict-prefix": true,
+	"single-file-db": true,
 
 	// Timing settings
 	"flush-debounce":       true,
 	"lock-timeout":         true,
+	"command-timeout":      true,
 	"remote-sync-interval": true,
+	"daemon-idle-timeout":  true,
+	"write-max-retries":    true,
 
 	// Git settings
 	"git.author":       true,
@@ -53,6 +61,40 @@ var YamlOnlyKeys = map[string]bool{
 
 	// Create command settings
 	"create.require-description": true,
+	"default-template":           true,
+	"default-priority":           true,
+	"editor":                     true,
+	"init.prefix-from-remote":    true,
+	"timezone":                   true,
+	"week-start":                 true,
+	"business-days-only":         true,
+	"import-prefix":              true,
+
+	// Flush hooks (run local executables, so never read from the database)
+	"pre-flush-hook":          true,
+	"pre-flush-hook-optional": true,
+	"post-flush-hook":         true,
+
+	// Commit the JSONL after each flush
+	"auto-commit-jsonl":       true,
+	"commit-message-template": true,
+
+	// Go template for bd notes (multi-line, so it lives in config.yaml)
+	"notes-template": true,
+
+	// Status change webhook (read when the database is opened); its URL
+	// is a secret
+	"webhooks-enabled": true,
+
+	// Permissions for created files (needed before the database is opened)
+	"file-mode":                      true,
+	"file-mode-allow-world-writable": true,
+
+	// JSONL timestamp format (read by every export, including the daemon's)
+	"timestamp-format": true,
+
+	// Version pin, checked before the database is opened
+	"min-bd-version": true,
 }
 
 // IsYamlOnlyKey returns true if the given key should be stored in config.yaml
@@ -93,7 +135,14 @@ func normalizeYamlKey(key string) string {
 // SetYamlConfig sets a configuration value in the project's config.yaml file.
 // It handles both adding new keys and updating existing (possibly commented) keys.
 // Keys are normalized to their canonical yaml format (e.g., sync.branch -> sync-branch).
+// Secret keys (see SecretKeys) are refused.
 func SetYamlConfig(key, value string) error {
+	if readFromStdin {
+		return ErrConfigFromStdin
+	}
+	if IsSecretKey(key) {
+		return secretKeyError(key)
+	}
 	configPath, err := findProjectConfigYaml()
 	if err != nil {
 		return err
@@ -122,6 +171,172 @@ func SetYamlConfig(key, value string) error {
 	return nil
 }
 
+// UnsetYamlConfig removes key from the project's config.yaml, so that its
+// default, or environment variable, applies again. Nested keys are dotted
+// ("routing.mode"); a section left empty by the removal goes too. Comments are
+// kept. It reports whether config.yaml had the key.
+func UnsetYamlConfig(key string) (bool, error) {
+	if readFromStdin {
+		return false, ErrConfigFromStdin
+	}
+	configPath, err := findProjectConfigYaml()
+	if err != nil {
+		return false, err
+	}
+	content, err := os.ReadFile(configPath) //nolint:gosec // configPath is from findProjectConfigYaml
+	if err != nil {
+		return false, fmt.Errorf("failed to read config.yaml: %w", err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	lines, removed := removeYamlKey(lines, 0, len(lines), strings.Split(normalizeYamlKey(key), "."))
+	if !removed {
+		return false, nil
+	}
+	if err := writeYamlLines(configPath, lines, string(content)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ResetYamlConfig removes every setting from the project's config.yaml,
+// leaving only its comments, and returns the top-level keys it removed.
+func ResetYamlConfig() ([]string, error) {
+	if readFromStdin {
+		return nil, ErrConfigFromStdin
+	}
+	configPath, err := findProjectConfigYaml()
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(configPath) //nolint:gosec // configPath is from findProjectConfigYaml
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config.yaml: %w", err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	var keys []string
+	for i := 0; i < len(lines); {
+		m := yamlKeyLine.FindStringSubmatch(strings.TrimRight(lines[i], "\r"))
+		if m == nil || m[1] != "" {
+			i++
+			continue
+		}
+		keys = append(keys, m[2])
+		lines = append(lines[:i], lines[yamlBlockEnd(lines, i, len(lines)):]...)
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	if err := writeYamlLines(configPath, lines, string(content)); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// yamlKeyLine matches a "key:" line, capturing its indentation and key.
+var yamlKeyLine = regexp.MustCompile(`^(\s*)([^\s#:-][^:]*?)\s*:(?:\s|$)`)
+
+// removeYamlKey removes the setting at path (a dotted key split on dots) from
+// the mapping in lines[lo:hi], returning the new lines and whether it was
+// found. A key may also be written with its dots, as in "sync.branch: x".
+func removeYamlKey(lines []string, lo, hi int, path []string) ([]string, bool) {
+	for n := len(path); n >= 1; n-- {
+		name := strings.Join(path[:n], ".")
+		i, ok := findYamlKey(lines, lo, hi, name)
+		if !ok {
+			continue
+		}
+		end := yamlBlockEnd(lines, i, hi)
+		if n == len(path) {
+			return append(lines[:i], lines[end:]...), true
+		}
+		newLines, removed := removeYamlKey(lines, i+1, end, path[n:])
+		if !removed {
+			continue
+		}
+		// Drop the section too if nothing but comments is left in it
+		end -= len(lines) - len(newLines)
+		empty := true
+		for _, line := range newLines[i+1 : end] {
+			if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			newLines = append(newLines[:i], newLines[i+1:]...)
+		}
+		return newLines, true
+	}
+	return lines, false
+}
+
+// findYamlKey returns the index of the line setting name at the top level of
+// the mapping in lines[lo:hi], whose indentation is that of its first key.
+func findYamlKey(lines []string, lo, hi int, name string) (int, bool) {
+	indent := -1
+	for i := lo; i < hi; i++ {
+		m := yamlKeyLine.FindStringSubmatch(strings.TrimRight(lines[i], "\r"))
+		if m == nil {
+			continue
+		}
+		if indent < 0 {
+			indent = len(m[1])
+		}
+		if len(m[1]) == indent && strings.Trim(m[2], `"'`) == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// yamlBlockEnd returns the index just past the value of the key on line i:
+// the following lines indented deeper, or list items at the same depth, and
+// the comments among them.
+func yamlBlockEnd(lines []string, i, hi int) int {
+	indent := len(lines[i]) - len(strings.TrimLeft(lines[i], " \t"))
+	end := i + 1
+	for j := i + 1; j < hi; j++ {
+		trimmed := strings.TrimSpace(lines[j])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		lineIndent := len(lines[j]) - len(strings.TrimLeft(lines[j], " \t"))
+		if lineIndent > indent || lineIndent == indent && strings.HasPrefix(trimmed, "- ") {
+			end = j + 1
+			continue
+		}
+		break
+	}
+	return end
+}
+
+// writeYamlLines writes lines to configPath with the line endings of the
+// original content and a single final newline, then reloads the config.
+func writeYamlLines(configPath string, lines []string, original string) error {
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], "\r")
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	eol := detectLineEnding(original)
+	content := strings.Join(lines, eol)
+	if content != "" {
+		content += eol
+	}
+	if err := os.WriteFile(configPath, []byte(content), 0600); err != nil { //nolint:gosec // configPath is validated
+		return fmt.Errorf("failed to write config.yaml: %w", err)
+	}
+
+	// Reload viper config so the defaults take effect immediately
+	if v != nil {
+		_ = v.ReadInConfig()
+	}
+	return nil
+}
+
 // GetYamlConfig gets a configuration value from config.yaml.
 // Returns empty string if key is not found or is commented out.
 func GetYamlConfig(key string) string {
@@ -152,6 +367,9 @@ func findProjectConfigYaml() (string, error) {
 // updateYamlKey updates a key in yaml content, handling commented-out keys.
 // If the key exists (commented or not), it updates it in place.
 // If the key doesn't exist, it appends it at the end.
+// The result always ends with exactly one newline, whatever the input had, so
+// repeated writes are stable and only the changed line shows up in a diff.
+// Line endings follow the input: a mostly-CRLF file stays CRLF.
 //
 //nolint:unparam // error return kept for future validation
 func updateYamlKey(content, key, value string) (string, error) {
@@ -161,7 +379,7 @@ func updateYamlKey(content, key, value string) (string, error) {
 
 	// Build regex to match the key (commented or not)
 	// Matches: "key: value" or "# key: value" with optional leading whitespace
-	keyPattern := regexp.MustCompile(`^(\s*)(#\s*)?` + regexp.QuoteMeta(key) + `\s*:`)
+	keyPattern := regexp.MustCompile(`^(\s*)(?:#([ \t]*))?` + regexp.QuoteMeta(key) + `\s*:`)
 
 	found := false
 	var result []string
@@ -171,12 +389,10 @@ func updateYamlKey(content, key, value string) (string, error) {
 		line := scanner.Text()
 		if keyPattern.MatchString(line) {
 			// Found the key - replace with new value (uncommented)
-			// Preserve leading whitespace
+			// Preserve leading whitespace. In a commented-out nested key
+			// ("#   key:") the nesting is after the "# ", so keep that too.
 			matches := keyPattern.FindStringSubmatch(line)
-			indent := ""
-			if len(matches) > 1 {
-				indent = matches[1]
-			}
+			indent := matches[1] + strings.TrimPrefix(matches[2], " ")
 			result = append(result, indent+newLine)
 			found = true
 		} else {
@@ -184,6 +400,11 @@ func updateYamlKey(content, key, value string) (string, error) {
 		}
 	}
 
+	// Drop trailing blank lines; the final newline is added back below
+	for len(result) > 0 && strings.TrimSpace(result[len(result)-1]) == "" {
+		result = result[:len(result)-1]
+	}
+
 	if !found {
 		// Key not found - append at end
 		// Add blank line before if content doesn't end with one
@@ -193,7 +414,19 @@ func updateYamlKey(content, key, value string) (string, error) {
 		result = append(result, newLine)
 	}
 
-	return strings.Join(result, "\n"), nil
+	eol := detectLineEnding(content)
+	return strings.Join(result, eol) + eol, nil
+}
+
+// detectLineEnding returns "\r\n" if most lines in content end with CRLF,
+// otherwise "\n". The scanner in updateYamlKey strips the "\r", so the
+// original ending has to be put back explicitly.
+func detectLineEnding(content string) string {
+	crlf := strings.Count(content, "\r\n")
+	if crlf > 0 && crlf*2 >= strings.Count(content, "\n") {
+		return "\r\n"
+	}
+	return "\n"
 }
 
 // formatYamlValue formats a value appropriately for YAML.
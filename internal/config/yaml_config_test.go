@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 func TestIsYamlOnlyKey(t *testing.T) {
@@ -64,49 +67,70 @@ func TestUpdateYamlKey(t *testing.T) {
 			content:  "# no-db: false\nother: value",
 			key:      "no-db",
 			value:    "true",
-			expected: "no-db: true\nother: value",
+			expected: "no-db: true\nother: value\n",
 		},
 		{
 			name:     "update existing key",
 			content:  "no-db: false\nother: value",
 			key:      "no-db",
 			value:    "true",
-			expected: "no-db: true\nother: value",
+			expected: "no-db: true\nother: value\n",
 		},
 		{
 			name:     "add new key",
 			content:  "other: value",
 			key:      "no-db",
 			value:    "true",
-			expected: "other: value\n\nno-db: true",
+			expected: "other: value\n\nno-db: true\n",
 		},
 		{
 			name:     "preserve indentation",
 			content:  "  # no-db: false\nother: value",
 			key:      "no-db",
 			value:    "true",
-			expected: "  no-db: true\nother: value",
+			expected: "  no-db: true\nother: value\n",
+		},
+		{
+			name:     "preserve indentation under section",
+			content:  "sync:\n  issue-prefix: \"bd\"\n  branch: main\nother: value\n",
+			key:      "issue-prefix",
+			value:    "api",
+			expected: "sync:\n  issue-prefix: \"api\"\n  branch: main\nother: value\n",
+		},
+		{
+			name:     "preserve tab indentation",
+			content:  "sync:\n\tissue-prefix: bd\n",
+			key:      "issue-prefix",
+			value:    "api",
+			expected: "sync:\n\tissue-prefix: \"api\"\n",
+		},
+		{
+			name:     "preserve indentation of commented nested key",
+			content:  "# repos:\n#   primary: \".\"\nother: value\n",
+			key:      "primary",
+			value:    "main",
+			expected: "# repos:\n  primary: \"main\"\nother: value\n",
 		},
 		{
 			name:     "handle string value",
 			content:  "# actor: \"\"\nother: value",
 			key:      "actor",
 			value:    "steve",
-			expected: "actor: \"steve\"\nother: value",
+			expected: "actor: \"steve\"\nother: value\n",
 		},
 		{
 			name:     "handle duration value",
 			content:  "# flush-debounce: \"5s\"",
 			key:      "flush-debounce",
 			value:    "30s",
-			expected: "flush-debounce: 30s",
+			expected: "flush-debounce: 30s\n",
 		},
 		{
 			name:     "quote special characters",
 			content:  "other: value",
 			key:      "actor",
 			value:    "user: name",
-			expected: "other: value\n\nactor: \"user: name\"",
+			expected: "other: value\n\nactor: \"user: name\"\n",
 		},
 	}
 
@@ -275,3 +299,253 @@ other-setting: value
 		t.Errorf("config.yaml should preserve other settings, got:\n%s", contentStr)
 	}
 }
+
+func TestSetYamlConfig_TrailingNewline(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial string
+	}{
+		{"with trailing newline", "# Beads Config\n# no-db: false\nother-setting: value\n"},
+		{"without trailing newline", "# Beads Config\n# no-db: false\nother-setting: value"},
+		{"with extra blank lines", "# Beads Config\n# no-db: false\nother-setting: value\n\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			beadsDir := filepath.Join(tmpDir, ".beads")
+			if err := os.MkdirAll(beadsDir, 0755); err != nil {
+				t.Fatalf("Failed to create .beads dir: %v", err)
+			}
+			configPath := filepath.Join(beadsDir, "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.initial), 0644); err != nil {
+				t.Fatalf("Failed to write config.yaml: %v", err)
+			}
+			t.Chdir(tmpDir)
+
+			// Updating an existing key changes only that line
+			if err := SetYamlConfig("no-db", "true"); err != nil {
+				t.Fatalf("SetYamlConfig() error = %v", err)
+			}
+			content, err := os.ReadFile(configPath)
+			if err != nil {
+				t.Fatalf("Failed to read config.yaml: %v", err)
+			}
+			want := "# Beads Config\nno-db: true\nother-setting: value\n"
+			if string(content) != want {
+				t.Errorf("after update got %q, want %q", content, want)
+			}
+
+			// Writing the same value again leaves the file untouched
+			if err := SetYamlConfig("no-db", "true"); err != nil {
+				t.Fatalf("SetYamlConfig() error = %v", err)
+			}
+			again, err := os.ReadFile(configPath)
+			if err != nil {
+				t.Fatalf("Failed to read config.yaml: %v", err)
+			}
+			if string(again) != string(content) {
+				t.Errorf("second write changed file: %q -> %q", content, again)
+			}
+
+			// Appending a new key adds it after one blank line
+			if err := SetYamlConfig("actor", "alice"); err != nil {
+				t.Fatalf("SetYamlConfig() error = %v", err)
+			}
+			appended, err := os.ReadFile(configPath)
+			if err != nil {
+				t.Fatalf("Failed to read config.yaml: %v", err)
+			}
+			want += "\nactor: \"alice\"\n"
+			if string(appended) != want {
+				t.Errorf("after append got %q, want %q", appended, want)
+			}
+		})
+	}
+}
+
+func TestUpdateYamlKey_NestedStructureSurvives(t *testing.T) {
+	content := `# Beads Config
+sync:
+  issue-prefix: "bd"
+  branch: main
+other-setting: value
+`
+	got, err := updateYamlKey(content, "issue-prefix", "api")
+	if err != nil {
+		t.Fatalf("updateYamlKey() error = %v", err)
+	}
+
+	var parsed struct {
+		Sync struct {
+			IssuePrefix string `yaml:"issue-prefix"`
+			Branch      string `yaml:"branch"`
+		} `yaml:"sync"`
+		IssuePrefix  string `yaml:"issue-prefix"`
+		OtherSetting string `yaml:"other-setting"`
+	}
+	if err := yaml.Unmarshal([]byte(got), &parsed); err != nil {
+		t.Fatalf("updated config is not valid YAML: %v\n%s", err, got)
+	}
+	if parsed.Sync.IssuePrefix != "api" {
+		t.Errorf("sync.issue-prefix = %q, want %q", parsed.Sync.IssuePrefix, "api")
+	}
+	if parsed.Sync.Branch != "main" {
+		t.Errorf("sync.branch = %q, want %q", parsed.Sync.Branch, "main")
+	}
+	if parsed.IssuePrefix != "" {
+		t.Errorf("issue-prefix leaked to top level: %q", parsed.IssuePrefix)
+	}
+	if parsed.OtherSetting != "value" {
+		t.Errorf("other-setting = %q, want %q", parsed.OtherSetting, "value")
+	}
+}
+
+func TestUpdateYamlKey_CRLF(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		key      string
+		value    string
+		expected string
+	}{
+		{
+			name:     "update keeps CRLF",
+			content:  "# Beads Config\r\n# no-db: false\r\nother: value\r\n",
+			key:      "no-db",
+			value:    "true",
+			expected: "# Beads Config\r\nno-db: true\r\nother: value\r\n",
+		},
+		{
+			name:     "append keeps CRLF",
+			content:  "other: value\r\n",
+			key:      "actor",
+			value:    "alice",
+			expected: "other: value\r\n\r\nactor: \"alice\"\r\n",
+		},
+		{
+			name:     "mixed endings normalized to dominant CRLF",
+			content:  "a: 1\r\nb: 2\nno-db: false\r\n",
+			key:      "no-db",
+			value:    "true",
+			expected: "a: 1\r\nb: 2\r\nno-db: true\r\n",
+		},
+		{
+			name:     "mostly LF stays LF",
+			content:  "a: 1\nb: 2\r\nc: 3\nno-db: false\n",
+			key:      "no-db",
+			value:    "true",
+			expected: "a: 1\nb: 2\nc: 3\nno-db: true\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := updateYamlKey(tt.content, tt.key, tt.value)
+			if err != nil {
+				t.Fatalf("updateYamlKey() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("updateYamlKey() =\n%q\nwant:\n%q", got, tt.expected)
+			}
+			if strings.Contains(got, "\r\r") {
+				t.Errorf("stray carriage returns in %q", got)
+			}
+
+			// A second write must not change anything
+			again, err := updateYamlKey(got, tt.key, tt.value)
+			if err != nil {
+				t.Fatalf("updateYamlKey() error = %v", err)
+			}
+			if again != got {
+				t.Errorf("second write changed content: %q -> %q", got, again)
+			}
+		})
+	}
+}
+
+func TestUnsetYamlConfig_DefaultTakesOver(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create .beads dir: %v", err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+	initialConfig := `# Beads Config
+flush-debounce: 5s
+actor: bob # who we are
+
+routing:
+  mode: auto
+  default: "."
+no-daemon-commands:
+- list
+# - ready
+`
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("Failed to write config.yaml: %v", err)
+	}
+	t.Chdir(tmpDir)
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	if got := GetDuration("flush-debounce"); got != 5*time.Second {
+		t.Fatalf("flush-debounce = %v before reset, want 5s", got)
+	}
+
+	removed, err := UnsetYamlConfig("flush-debounce")
+	if err != nil || !removed {
+		t.Fatalf("UnsetYamlConfig(flush-debounce) = %v, %v; want removed", removed, err)
+	}
+	if got := GetDuration("flush-debounce"); got != 30*time.Second {
+		t.Errorf("flush-debounce = %v after reset, want the 30s default", got)
+	}
+	if got := GetString("actor"); got != "bob" {
+		t.Errorf("actor = %q, want other keys untouched", got)
+	}
+	if removed, err := UnsetYamlConfig("flush-debounce"); err != nil || removed {
+		t.Errorf("UnsetYamlConfig(unset key) = %v, %v; want not removed", removed, err)
+	}
+
+	// Nested keys go one at a time; the emptied section goes with the last
+	if removed, _ := UnsetYamlConfig("routing.mode"); !removed {
+		t.Error("UnsetYamlConfig(routing.mode) should remove the nested key")
+	}
+	if GetString("routing.default") != "." {
+		t.Errorf("routing.default = %q, want it kept", GetString("routing.default"))
+	}
+	if removed, _ := UnsetYamlConfig("routing.default"); !removed {
+		t.Error("UnsetYamlConfig(routing.default) should remove the nested key")
+	}
+	if removed, _ := UnsetYamlConfig("no-daemon-commands"); !removed {
+		t.Error("UnsetYamlConfig(no-daemon-commands) should remove the list")
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `# Beads Config
+actor: bob # who we are
+
+# - ready
+`
+	if string(content) != want {
+		t.Errorf("config.yaml after unsets:\n%s\nwant:\n%s", content, want)
+	}
+
+	keys, err := ResetYamlConfig()
+	if err != nil {
+		t.Fatalf("ResetYamlConfig() error = %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "actor" {
+		t.Errorf("ResetYamlConfig() = %v, want [actor]", keys)
+	}
+	content, _ = os.ReadFile(configPath)
+	if string(content) != "# Beads Config\n\n# - ready\n" {
+		t.Errorf("config.yaml after reset = %q, want only comments", content)
+	}
+	if GetString("actor") != "" {
+		t.Errorf("actor = %q after reset, want the default", GetString("actor"))
+	}
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExportYamlConfig returns the settings in the project's config.yaml, without
+// comments or secret keys, in a form ImportYamlConfig accepts in another
+// project.
+func ExportYamlConfig() (map[string]interface{}, error) {
+	configPath, err := findProjectConfigYaml()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(configPath) // #nosec G304 - config file path from findProjectConfigYaml
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config.yaml: %w", err)
+	}
+
+	settings := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("failed to parse config.yaml: %w", err)
+	}
+	redactSecrets(settings)
+	return settings, nil
+}
+
+// ImportYamlConfig merges the settings in data, a YAML mapping such as the
+// output of 'bd config export', into the project's config.yaml. Keys missing
+// from data keep their current values, nested sections are merged key by key,
+// and comments in config.yaml are preserved. It returns the dotted keys that
+// were set. Files containing secret keys (see SecretKeys) are refused.
+func ImportYamlConfig(data []byte) ([]string, error) {
+	if readFromStdin {
+		return nil, ErrConfigFromStdin
+	}
+
+	var src yaml.Node
+	if err := yaml.Unmarshal(data, &src); err != nil {
+		return nil, fmt.Errorf("failed to parse imported config: %w", err)
+	}
+	if src.Kind != yaml.DocumentNode || len(src.Content) == 0 {
+		return nil, nil // empty file: nothing to merge
+	}
+	if src.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("imported config must be a mapping of keys to values")
+	}
+
+	configPath, err := findProjectConfigYaml()
+	if err != nil {
+		return nil, err
+	}
+	current, err := os.ReadFile(configPath) // #nosec G304 - config file path from findProjectConfigYaml
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config.yaml: %w", err)
+	}
+
+	// Parse into yaml.Node to keep comments and key order
+	var root yaml.Node
+	if len(current) > 0 {
+		if err := yaml.Unmarshal(current, &root); err != nil {
+			return nil, fmt.Errorf("failed to parse config.yaml: %w", err)
+		}
+	}
+	// A new or comment-only config.yaml (as written by bd init) has no
+	// mapping to merge into; its text is kept above the imported settings
+	var header string
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 {
+		header = strings.TrimRight(string(current), "\r\n")
+		if header != "" {
+			header += "\n\n"
+		}
+		root = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	if root.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("config.yaml is not a mapping of keys to values")
+	}
+
+	var keys []string
+	mergeYamlMapping(root.Content[0], src.Content[0], "", &keys)
+	for _, key := range keys {
+		if IsSecretKey(key) {
+			return nil, secretKeyError(key)
+		}
+	}
+
+	var buf strings.Builder
+	buf.WriteString(header)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&root); err != nil {
+		return nil, fmt.Errorf("failed to encode config.yaml: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close encoder: %w", err)
+	}
+	if err := os.WriteFile(configPath, []byte(buf.String()), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write config.yaml: %w", err)
+	}
+
+	// Reload viper config so changes take effect immediately
+	if v != nil {
+		_ = v.ReadInConfig()
+	}
+
+	return keys, nil
+}
+
+// mergeYamlMapping copies every key of src into dst. Where both hold a
+// mapping for a key the two are merged recursively; otherwise src's value
+// replaces dst's. The dotted names of the keys set are appended to keys.
+func mergeYamlMapping(dst, src *yaml.Node, prefix string, keys *[]string) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		srcKey, srcValue := src.Content[i], src.Content[i+1]
+		name := prefix + srcKey.Value
+
+		var dstValue *yaml.Node
+		for j := 0; j+1 < len(dst.Content); j += 2 {
+			if dst.Content[j].Value == srcKey.Value {
+				dstValue = dst.Content[j+1]
+				break
+			}
+		}
+
+		switch {
+		case dstValue != nil && dstValue.Kind == yaml.MappingNode && srcValue.Kind == yaml.MappingNode:
+			mergeYamlMapping(dstValue, srcValue, name+".", keys)
+			continue
+		case dstValue != nil:
+			// Keep the comments next to the old value
+			head, line, foot := dstValue.HeadComment, dstValue.LineComment, dstValue.FootComment
+			*dstValue = *srcValue
+			dstValue.HeadComment, dstValue.LineComment, dstValue.FootComment = head, line, foot
+		default:
+			dst.Content = append(dst.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: srcKey.Value}, srcValue)
+		}
+		appendYamlKeys(srcValue, name, keys)
+	}
+}
+
+// appendYamlKeys appends name, or for a mapping the dotted names of its
+// leaves, to keys.
+func appendYamlKeys(node *yaml.Node, name string, keys *[]string) {
+	if node.Kind != yaml.MappingNode || len(node.Content) == 0 {
+		*keys = append(*keys, name)
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		appendYamlKeys(node.Content[i+1], name+"."+node.Content[i].Value, keys)
+	}
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestImportYamlConfig_MergesPartialConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create .beads dir: %v", err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+	initialConfig := `# Beads Config
+no-push: false # set by the team
+actor: alice
+routing:
+  mode: auto
+  default: .
+`
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("Failed to write config.yaml: %v", err)
+	}
+	t.Chdir(tmpDir)
+
+	partial := `no-push: true
+routing:
+  mode: maintainer
+no-daemon-commands: [list, show]
+`
+	keys, err := ImportYamlConfig([]byte(partial))
+	if err != nil {
+		t.Fatalf("ImportYamlConfig() error = %v", err)
+	}
+	wantKeys := []string{"no-push", "routing.mode", "no-daemon-commands"}
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("ImportYamlConfig() keys = %v, want %v", keys, wantKeys)
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config.yaml: %v", err)
+	}
+	if !strings.Contains(string(content), "# Beads Config") || !strings.Contains(string(content), "no-push: true # set by the team") {
+		t.Errorf("comments should be preserved, got:\n%s", content)
+	}
+
+	var got struct {
+		NoPush  bool   `yaml:"no-push"`
+		Actor   string `yaml:"actor"`
+		Routing struct {
+			Mode    string `yaml:"mode"`
+			Default string `yaml:"default"`
+		} `yaml:"routing"`
+		NoDaemonCommands []string `yaml:"no-daemon-commands"`
+	}
+	if err := yaml.Unmarshal(content, &got); err != nil {
+		t.Fatalf("merged config is not valid YAML: %v\n%s", err, content)
+	}
+	if !got.NoPush || got.Routing.Mode != "maintainer" || !reflect.DeepEqual(got.NoDaemonCommands, []string{"list", "show"}) {
+		t.Errorf("imported keys not applied: %+v", got)
+	}
+	// Keys the import didn't mention are untouched
+	if got.Actor != "alice" || got.Routing.Default != "." {
+		t.Errorf("unspecified keys clobbered: actor=%q routing.default=%q", got.Actor, got.Routing.Default)
+	}
+
+	// Export round-trips what is now in config.yaml
+	settings, err := ExportYamlConfig()
+	if err != nil {
+		t.Fatalf("ExportYamlConfig() error = %v", err)
+	}
+	if settings["actor"] != "alice" || settings["no-push"] != true {
+		t.Errorf("ExportYamlConfig() = %v", settings)
+	}
+
+	if _, err := ImportYamlConfig([]byte("- not\n- a mapping\n")); err == nil {
+		t.Error("expected error importing a non-mapping")
+	}
+}
+
+func TestImportYamlConfig_CommentOnlyConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("Failed to create .beads dir: %v", err)
+	}
+	configPath := filepath.Join(beadsDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("# Beads Config\n# no-db: false\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config.yaml: %v", err)
+	}
+	t.Chdir(tmpDir)
+
+	if _, err := ImportYamlConfig([]byte("no-push: true\n")); err != nil {
+		t.Fatalf("ImportYamlConfig() error = %v", err)
+	}
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config.yaml: %v", err)
+	}
+	want := "# Beads Config\n# no-db: false\n\nno-push: true\n"
+	if string(content) != want {
+		t.Errorf("config.yaml = %q, want %q", content, want)
+	}
+}
@@ -9,6 +9,9 @@ import (
 
 const ConfigFileName = "metadata.json"
 
+// DefaultDatabaseName is the database file used when metadata.json does not name one.
+const DefaultDatabaseName = "beads.db"
+
 type Config struct {
 	Database    string `json:"database"`
 	JSONLExport string `json:"jsonl_export,omitempty"`
@@ -25,7 +28,7 @@ type Config struct {
 
 func DefaultConfig() *Config {
 	return &Config{
-		Database:    "beads.db",
+		Database:    DefaultDatabaseName,
 		JSONLExport: "issues.jsonl", // Canonical name (bd-6xd)
 	}
 }
@@ -92,7 +95,15 @@ func (c *Config) Save(beadsDir string) error {
 	return nil
 }
 
+// DatabasePath returns the database file named by the config. Relative names
+// are resolved against beadsDir; an empty name means DefaultDatabaseName.
 func (c *Config) DatabasePath(beadsDir string) string {
+	if c.Database == "" {
+		return filepath.Join(beadsDir, DefaultDatabaseName)
+	}
+	if filepath.IsAbs(c.Database) {
+		return c.Database
+	}
 	return filepath.Join(beadsDir, c.Database)
 }
 
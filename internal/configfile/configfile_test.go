@@ -65,13 +65,22 @@ func TestLoadNonexistent(t *testing.T) {
 
 func TestDatabasePath(t *testing.T) {
 	beadsDir := "/home/user/project/.beads"
-	cfg := &Config{Database: "beads.db"}
-	
-	got := cfg.DatabasePath(beadsDir)
-	want := filepath.Join(beadsDir, "beads.db")
-	
-	if got != want {
-		t.Errorf("DatabasePath() = %q, want %q", got, want)
+	absDB := filepath.Join(string(filepath.Separator), "srv", "beads", "shared.db")
+
+	tests := []struct {
+		database string
+		want     string
+	}{
+		{"beads.db", filepath.Join(beadsDir, "beads.db")},
+		{"custom.db", filepath.Join(beadsDir, "custom.db")},
+		{"", filepath.Join(beadsDir, DefaultDatabaseName)},
+		{absDB, absDB},
+	}
+	for _, tt := range tests {
+		cfg := &Config{Database: tt.database}
+		if got := cfg.DatabasePath(beadsDir); got != tt.want {
+			t.Errorf("DatabasePath() with Database=%q = %q, want %q", tt.database, got, tt.want)
+		}
 	}
 }
 
@@ -3,7 +3,10 @@
 package hooks
 
 import (
+	"bytes"
+	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"time"
 
@@ -94,6 +97,28 @@ func (r *Runner) RunSync(event string, issue *types.Issue) error {
 	return r.runHook(hookPath, event, issue)
 }
 
+// RunCommand runs the executable at path with dir as its working directory
+// and env added to the environment, and returns its combined stdout and
+// stderr. The process (and on Unix its descendants) is killed if it outlives
+// timeout.
+func RunCommand(path, dir string, env []string, timeout time.Duration, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// #nosec G204 -- path comes from the user's own configuration
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Dir = dir
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	err := waitWithTimeout(ctx, cmd)
+	return output.Bytes(), err
+}
+
 // HookExists checks if a hook exists for an event
 func (r *Runner) HookExists(event string) bool {
 	hookName := eventToHook(event)
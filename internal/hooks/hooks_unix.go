@@ -36,6 +36,12 @@ func (r *Runner) runHook(hookPath, event string, issue *types.Issue) error {
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	return waitWithTimeout(ctx, cmd)
+}
+
+// waitWithTimeout starts cmd and waits for it, killing its whole process
+// group if ctx expires first.
+func waitWithTimeout(ctx context.Context, cmd *exec.Cmd) error {
 	// Start the hook so we can manage its process group and kill children on timeout.
 	//
 	// Rationale: scripts may spawn child processes (backgrounded or otherwise).
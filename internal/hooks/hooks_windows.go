@@ -31,6 +31,12 @@ func (r *Runner) runHook(hookPath, event string, issue *types.Issue) error {
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	return waitWithTimeout(ctx, cmd)
+}
+
+// waitWithTimeout starts cmd and waits for it, killing the process if ctx
+// expires first.
+func waitWithTimeout(ctx context.Context, cmd *exec.Cmd) error {
 	if err := cmd.Start(); err != nil {
 		return err
 	}
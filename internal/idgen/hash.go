@@ -81,5 +81,5 @@ func GenerateHashID(prefix, title, description, creator string, timestamp time.T
 
 	shortHash := EncodeBase36(hash[:numBytes], length)
 
-	return fmt.Sprintf("%s-%s", prefix, shortHash)
+	return FormatID(prefix, shortHash)
 }
@@ -0,0 +1,52 @@
+package idgen
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// DefaultSeparator joins the prefix and hash in issue IDs ("bd-a3f8e9").
+const DefaultSeparator = "-"
+
+// allowedSeparators are the characters that can join prefix and hash.
+// Letters and digits belong to the prefix/hash charset, and "." is reserved
+// for hierarchical child IDs ("bd-a3f8e9.1"), so neither may be used.
+const allowedSeparators = "-_/:~+"
+
+var separator atomic.Value
+
+func init() {
+	separator.Store(DefaultSeparator)
+}
+
+// Separator returns the configured separator between prefix and hash.
+func Separator() string {
+	return separator.Load().(string)
+}
+
+// SetSeparator sets the separator used when formatting and parsing issue IDs.
+// An empty value restores the default.
+func SetSeparator(sep string) error {
+	if sep == "" {
+		sep = DefaultSeparator
+	}
+	if err := ValidateSeparator(sep); err != nil {
+		return err
+	}
+	separator.Store(sep)
+	return nil
+}
+
+// ValidateSeparator reports whether sep can be used as an ID separator.
+func ValidateSeparator(sep string) error {
+	if len(sep) != 1 || !strings.Contains(allowedSeparators, sep) {
+		return fmt.Errorf("invalid id-separator %q: must be one of %s", sep, strings.Join(strings.Split(allowedSeparators, ""), " "))
+	}
+	return nil
+}
+
+// FormatID joins a prefix and hash with the configured separator.
+func FormatID(prefix, hash string) string {
+	return prefix + Separator() + hash
+}
@@ -0,0 +1,93 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/idgen"
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// importMappingKeyPrefix prefixes the metadata keys that record which local
+// ID an issue imported under an import prefix was given.
+const importMappingKeyPrefix = "import_mapping:"
+
+// applyImportPrefix gives every issue whose ID doesn't already use prefix a
+// new ID under it, allocated with NextIssueID, and rewrites references to the
+// old IDs. Child IDs (foo-abc.1) keep their suffix under their parent's new
+// ID. Each old -> new mapping is added to result.IDMapping and recorded in
+// the database metadata, so importing the same issues again updates them
+// instead of creating copies, and dependencies on issues imported earlier are
+// rewritten too.
+func applyImportPrefix(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues []*types.Issue, prefix string, dryRun bool, result *Result) error {
+	sep := idgen.Separator()
+	prefix = strings.TrimSuffix(prefix, sep)
+
+	// Parents before children, so a child can reuse its parent's new ID
+	ordered := make([]*types.Issue, len(issues))
+	copy(ordered, issues)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return strings.Count(ordered[i].ID, ".") < strings.Count(ordered[j].ID, ".")
+	})
+
+	idMapping := make(map[string]string)
+	reserved := make(map[string]bool)
+	for _, issue := range ordered {
+		if strings.HasPrefix(issue.ID, prefix+sep) {
+			continue
+		}
+		newID, err := recordedImportID(ctx, sqliteStore, issue.ID)
+		if err != nil {
+			return err
+		}
+		if newID == "" {
+			if isChild, parentID := sqlite.IsHierarchicalID(issue.ID); isChild && idMapping[parentID] != "" {
+				newID = idMapping[parentID] + strings.TrimPrefix(issue.ID, parentID)
+			} else {
+				newID, err = sqliteStore.NextIssueID(ctx, prefix, issue, "import", reserved)
+				if err != nil {
+					return fmt.Errorf("failed to allocate an ID for %s: %w", issue.ID, err)
+				}
+			}
+			if !dryRun {
+				if err := sqliteStore.SetMetadata(ctx, importMappingKeyPrefix+issue.ID, newID); err != nil {
+					return fmt.Errorf("failed to record import mapping for %s: %w", issue.ID, err)
+				}
+			}
+		}
+		reserved[newID] = true
+		idMapping[issue.ID] = newID
+		result.IDMapping[issue.ID] = newID
+	}
+
+	// Dependencies may point at issues imported under the prefix earlier
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if _, ok := idMapping[dep.DependsOnID]; ok || strings.HasPrefix(dep.DependsOnID, prefix+sep) {
+				continue
+			}
+			newID, err := recordedImportID(ctx, sqliteStore, dep.DependsOnID)
+			if err != nil {
+				return err
+			}
+			if newID != "" {
+				idMapping[dep.DependsOnID] = newID
+			}
+		}
+	}
+
+	rewriteIssueIDs(issues, idMapping)
+	return nil
+}
+
+// recordedImportID returns the ID an earlier import gave oldID, or "".
+func recordedImportID(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, oldID string) (string, error) {
+	newID, err := sqliteStore.GetMetadata(ctx, importMappingKeyPrefix+oldID)
+	if err != nil {
+		return "", fmt.Errorf("failed to read import mapping for %s: %w", oldID, err)
+	}
+	return newID, nil
+}
@@ -0,0 +1,81 @@
+package importer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage/sqlite"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestImportIssues_ImportPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	tmpDB := t.TempDir() + "/test.db"
+	store, err := sqlite.New(ctx, tmpDB)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+	if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		t.Fatalf("Failed to set prefix: %v", err)
+	}
+
+	// A local issue with the same ID as an incoming one must not be touched
+	local := &types.Issue{ID: "bd-1", Title: "Local issue", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, local, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	incoming := func() []*types.Issue {
+		return []*types.Issue{
+			{ID: "bd-1", Title: "Upstream parser bug", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeBug},
+			{ID: "bd-2", Title: "Follow-up", Description: "Needs bd-1 first", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask,
+				Dependencies: []*types.Dependency{{IssueID: "bd-2", DependsOnID: "bd-1", Type: types.DepBlocks}}},
+		}
+	}
+
+	result, err := ImportIssues(ctx, tmpDB, store, incoming(), Options{ImportPrefix: "ext"})
+	if err != nil {
+		t.Fatalf("ImportIssues() error = %v", err)
+	}
+	if result.Created != 2 {
+		t.Errorf("Created = %d, want 2", result.Created)
+	}
+	bug, followUp := result.IDMapping["bd-1"], result.IDMapping["bd-2"]
+	if !strings.HasPrefix(bug, "ext-") || !strings.HasPrefix(followUp, "ext-") || bug == followUp {
+		t.Fatalf("IDMapping = %v, want distinct ext- IDs for bd-1 and bd-2", result.IDMapping)
+	}
+
+	got, err := store.GetIssue(ctx, "bd-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "Local issue" {
+		t.Errorf("local bd-1 title = %q, want it unchanged", got.Title)
+	}
+	got, err = store.GetIssue(ctx, followUp)
+	if err != nil || got == nil {
+		t.Fatalf("GetIssue(%s) = %v, %v", followUp, got, err)
+	}
+	if got.Description != "Needs "+bug+" first" {
+		t.Errorf("description = %q, want the reference rewritten to %s", got.Description, bug)
+	}
+	deps, err := store.GetDependencies(ctx, followUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps) != 1 || deps[0].ID != bug {
+		t.Errorf("dependencies of %s = %v, want [%s]", followUp, deps, bug)
+	}
+
+	// Importing the same issues again reuses the recorded mapping
+	result, err = ImportIssues(ctx, tmpDB, store, incoming(), Options{ImportPrefix: "ext"})
+	if err != nil {
+		t.Fatalf("second ImportIssues() error = %v", err)
+	}
+	if result.Created != 0 || result.IDMapping["bd-1"] != bug {
+		t.Errorf("re-import created %d issues with mapping %v, want 0 and bd-1 -> %s", result.Created, result.IDMapping, bug)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/linear"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
@@ -36,6 +37,7 @@ type Options struct {
 	SkipUpdate                 bool            // Skip updating existing issues (create-only mode)
 	Strict                     bool            // Fail on any error (dependencies, labels, etc.)
 	RenameOnImport             bool            // Rename imported issues to match database prefix
+	ImportPrefix               string          // Give imported issues new IDs under this prefix (see applyImportPrefix)
 	SkipPrefixValidation       bool            // Skip prefix validation (for auto-import)
 	OrphanHandling             OrphanHandling  // How to handle missing parent issues (default: allow)
 	ClearDuplicateExternalRefs bool            // Clear duplicate external_ref values instead of erroring
@@ -75,12 +77,31 @@ type Result struct {
 // - store: Existing storage instance (can be nil for direct mode)
 // - issues: Parsed issues from JSONL
 // - opts: Import options
+//
+// If ctx is canceled, the import stops between issues and returns ctx.Err().
 func ImportIssues(ctx context.Context, dbPath string, store storage.Storage, issues []*types.Issue, opts Options) (*Result, error) {
+	result, err := importIssues(ctx, dbPath, store, issues, opts)
+	if err != nil && ctx.Err() != nil {
+		// The driver reports a query stopped by cancellation as interrupted
+		return result, ctx.Err()
+	}
+	return result, err
+}
+
+// importIssues does the work of ImportIssues.
+func importIssues(ctx context.Context, dbPath string, store storage.Storage, issues []*types.Issue, opts Options) (*Result, error) {
 	result := &Result{
 		IDMapping:        make(map[string]string),
 		MismatchPrefixes: make(map[string]int),
 	}
 
+	// Refuse records from a newer JSONL format before touching the database
+	for _, issue := range issues {
+		if err := types.CheckJSONLVersion(issue); err != nil {
+			return nil, err
+		}
+	}
+
 	// Normalize Linear external_refs to canonical form to avoid slug-based duplicates.
 	for _, issue := range issues {
 		if issue.ExternalRef == nil || *issue.ExternalRef == "" {
@@ -127,6 +148,13 @@ func ImportIssues(ctx context.Context, dbPath string, store storage.Storage, iss
 		opts.OrphanHandling = sqliteStore.GetOrphanHandling(ctx)
 	}
 
+	// Move imported issues under the import prefix before checking prefixes
+	if opts.ImportPrefix != "" {
+		if err := applyImportPrefix(ctx, sqliteStore, issues, opts.ImportPrefix, opts.DryRun, result); err != nil {
+			return result, err
+		}
+	}
+
 	// Check and handle prefix mismatches
 	issues, err = handlePrefixMismatch(ctx, sqliteStore, issues, opts, result)
 	if err != nil {
@@ -167,6 +195,11 @@ func ImportIssues(ctx context.Context, dbPath string, store storage.Storage, iss
 		return nil, err
 	}
 
+	// Import references
+	if err := importReferences(ctx, sqliteStore, issues, opts); err != nil {
+		return nil, err
+	}
+
 	// Checkpoint WAL to ensure data persistence and reduce WAL file size
 	if err := sqliteStore.CheckpointWAL(ctx); err != nil {
 		// Non-fatal - just log warning
@@ -224,6 +257,9 @@ func handlePrefixMismatch(ctx context.Context, sqliteStore *sqlite.SQLiteStorage
 	if allowedPrefixes == nil {
 		return issues, nil
 	}
+	if opts.ImportPrefix != "" {
+		allowedPrefixes[strings.TrimSuffix(opts.ImportPrefix, idgen.Separator())] = true
+	}
 
 	// Analyze prefixes in imported issues
 	// Track tombstones separately - they don't count as "real" mismatches (bd-6pni)
@@ -241,7 +277,7 @@ func handlePrefixMismatch(ctx context.Context, sqliteStore *sqlite.SQLiteStorage
 		// gt-2z6s: Also check against allowed_prefixes config
 		prefixMatches := false
 		for prefix := range allowedPrefixes {
-			if strings.HasPrefix(issue.ID, prefix+"-") {
+			if strings.HasPrefix(issue.ID, prefix+idgen.Separator()) {
 				prefixMatches = true
 				break
 			}
@@ -394,6 +430,7 @@ func handleRename(ctx context.Context, s *sqlite.SQLiteStorage, existing *types.
 				"priority":            incoming.Priority,
 				"issue_type":          incoming.IssueType,
 				"assignee":            incoming.Assignee,
+				"due_date":            incoming.DueDate,
 			}
 			if err := s.UpdateIssue(ctx, existing.ID, updates, "importer"); err != nil {
 				return "", fmt.Errorf("failed to update issue %s: %w", existing.ID, err)
@@ -508,6 +545,12 @@ func upsertIssues(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 	dbByHash := buildHashMap(dbIssues)
 	dbByID := buildIDMap(dbIssues)
 
+	// Archived issues live outside the issues table; never re-create them from JSONL
+	archivedIDs, err := sqliteStore.ArchivedIssueIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get archived issues: %w", err)
+	}
+
 	// Build external_ref map for O(1) lookup
 	dbByExternalRef := make(map[string]*types.Issue)
 	for _, issue := range dbIssues {
@@ -527,6 +570,9 @@ func upsertIssues(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 	seenIDs := make(map[string]bool) // Track IDs to prevent UNIQUE constraint errors
 
 	for _, incoming := range issues {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		hash := incoming.ContentHash
 		if hash == "" {
 			// Shouldn't happen (computed earlier), but be defensive
@@ -558,6 +604,10 @@ func upsertIssues(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 				continue
 			}
 		}
+		if archivedIDs[incoming.ID] {
+			result.Skipped++
+			continue
+		}
 
 		// Phase 0: Match by external_ref first (if present)
 		// This enables re-syncing from external systems (Jira, GitHub, Linear)
@@ -583,6 +633,7 @@ func upsertIssues(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 					updates["acceptance_criteria"] = incoming.AcceptanceCriteria
 					updates["notes"] = incoming.Notes
 					updates["closed_at"] = incoming.ClosedAt
+					updates["due_date"] = incoming.DueDate
 					// Pinned field (bd-phtv): Only update if explicitly true in JSONL
 					// (omitempty means false values are absent, so false = don't change existing)
 					if incoming.Pinned {
@@ -681,6 +732,7 @@ func upsertIssues(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 				updates["acceptance_criteria"] = incoming.AcceptanceCriteria
 				updates["notes"] = incoming.Notes
 				updates["closed_at"] = incoming.ClosedAt
+				updates["due_date"] = incoming.DueDate
 				// Pinned field (bd-phtv): Only update if explicitly true in JSONL
 				// (omitempty means false values are absent, so false = don't change existing)
 				if incoming.Pinned {
@@ -778,7 +830,7 @@ func upsertIssues(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 			if len(batchForDepth) > 0 {
 				batchOpts := sqlite.BatchCreateOptions{
 					OrphanHandling:       opts.OrphanHandling,
-					SkipPrefixValidation: opts.SkipPrefixValidation,
+					SkipPrefixValidation: opts.SkipPrefixValidation || opts.ImportPrefix != "",
 				}
 				if err := sqliteStore.CreateIssuesWithFullOptions(ctx, batchForDepth, "import", batchOpts); err != nil {
 					return fmt.Errorf("error creating depth-%d issues: %w", depth, err)
@@ -796,6 +848,10 @@ func upsertIssues(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 // importDependencies imports dependency relationships
 func importDependencies(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues []*types.Issue, opts Options, result *Result) error {
 	for _, issue := range issues {
+		// Errors are skipped outside strict mode, so check for cancellation here
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if len(issue.Dependencies) == 0 {
 			continue
 		}
@@ -848,6 +904,9 @@ func importDependencies(ctx context.Context, sqliteStore *sqlite.SQLiteStorage,
 // importLabels imports labels for issues
 func importLabels(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues []*types.Issue, opts Options) error {
 	for _, issue := range issues {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if len(issue.Labels) == 0 {
 			continue
 		}
@@ -882,6 +941,9 @@ func importLabels(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues
 // importComments imports comments for issues
 func importComments(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues []*types.Issue, opts Options) error {
 	for _, issue := range issues {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if len(issue.Comments) == 0 {
 			continue
 		}
@@ -919,6 +981,26 @@ func importComments(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issu
 	return nil
 }
 
+// importReferences imports links to external URLs for issues
+func importReferences(ctx context.Context, sqliteStore *sqlite.SQLiteStorage, issues []*types.Issue, opts Options) error {
+	for _, issue := range issues {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for _, ref := range issue.References {
+			ref.IssueID = issue.ID
+			if err := sqliteStore.ImportReference(ctx, ref); err != nil {
+				if opts.Strict {
+					return fmt.Errorf("error adding reference to %s: %w", issue.ID, err)
+				}
+				continue
+			}
+		}
+	}
+
+	return nil
+}
+
 func GetPrefixList(prefixes map[string]int) []string {
 	var result []string
 	keys := make([]string, 0, len(prefixes))
@@ -988,15 +1070,15 @@ func buildAllowedPrefixSet(primaryPrefix string, allowedPrefixesConfig string) m
 
 	allowed := map[string]bool{primaryPrefix: true}
 
-	// gt-2z6s: Parse allowed_prefixes config (comma-separated, with or without trailing -)
+	// gt-2z6s: Parse allowed_prefixes config (comma-separated, with or without trailing separator)
 	if allowedPrefixesConfig != "" {
 		for _, prefix := range strings.Split(allowedPrefixesConfig, ",") {
 			prefix = strings.TrimSpace(prefix)
 			if prefix == "" {
 				continue
 			}
-			// Normalize: remove trailing - if present (we match without it)
-			prefix = strings.TrimSuffix(prefix, "-")
+			// Normalize: remove trailing separator if present (we match without it)
+			prefix = strings.TrimSuffix(prefix, idgen.Separator())
 			allowed[prefix] = true
 		}
 	}
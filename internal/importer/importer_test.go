@@ -5,11 +5,14 @@ package importer
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/storage/sqlite"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -279,6 +282,21 @@ func TestRenameImportedIssuePrefixes(t *testing.T) {
 		}
 	})
 
+	t.Run("rename with configured separator", func(t *testing.T) {
+		if err := idgen.SetSeparator("_"); err != nil {
+			t.Fatalf("SetSeparator failed: %v", err)
+		}
+		t.Cleanup(func() { _ = idgen.SetSeparator(idgen.DefaultSeparator) })
+
+		issues := []*types.Issue{{ID: "old_abc.1", Title: "Test Issue"}}
+		if err := RenameImportedIssuePrefixes(issues, "new"); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if issues[0].ID != "new_abc.1" {
+			t.Errorf("Expected ID 'new_abc.1', got '%s'", issues[0].ID)
+		}
+	})
+
 	t.Run("rename multiple issues", func(t *testing.T) {
 		issues := []*types.Issue{
 			{ID: "old-1", Title: "Issue 1"},
@@ -809,6 +827,86 @@ func TestImportIssues_Labels(t *testing.T) {
 	}
 }
 
+// cancelAfterContext cancels itself on the nth call to Err, so a test can
+// interrupt an import partway through. With n of 0 it only counts calls.
+type cancelAfterContext struct {
+	context.Context
+	cancel context.CancelFunc
+	n      int64
+	calls  atomic.Int64
+}
+
+func newCancelAfterContext(n int64) *cancelAfterContext {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &cancelAfterContext{Context: ctx, cancel: cancel, n: n}
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.calls.Add(1) == c.n {
+		c.cancel()
+	}
+	return c.Context.Err()
+}
+
+func TestImportIssues_CanceledMidImport(t *testing.T) {
+	newStore := func() (*sqlite.SQLiteStorage, string) {
+		tmpDB := t.TempDir() + "/test.db"
+		store, err := sqlite.New(context.Background(), tmpDB)
+		if err != nil {
+			t.Fatalf("Failed to create store: %v", err)
+		}
+		t.Cleanup(func() { store.Close() })
+		if err := store.SetConfig(context.Background(), "issue_prefix", "test"); err != nil {
+			t.Fatalf("Failed to set prefix: %v", err)
+		}
+		return store, tmpDB
+	}
+	newIssues := func() []*types.Issue {
+		var issues []*types.Issue
+		for i := 0; i < 10; i++ {
+			issues = append(issues, &types.Issue{
+				ID:        fmt.Sprintf("test-%d", i),
+				Title:     fmt.Sprintf("Issue %d", i),
+				Status:    types.StatusOpen,
+				Priority:  2,
+				IssueType: types.TypeTask,
+				Labels:    []string{"imported"},
+			})
+		}
+		return issues
+	}
+
+	// Count the cancellation checks of a full import, to cancel partway
+	store, tmpDB := newStore()
+	counter := newCancelAfterContext(0)
+	if _, err := ImportIssues(counter, tmpDB, store, newIssues(), Options{}); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	total := counter.calls.Load()
+	if total < 4 {
+		t.Fatalf("import checked for cancellation %d times, want it checked throughout", total)
+	}
+
+	for _, n := range []int64{total / 4, total / 2, total * 3 / 4} {
+		store, tmpDB := newStore()
+		ctx := newCancelAfterContext(n)
+		_, err := ImportIssues(ctx, tmpDB, store, newIssues(), Options{})
+		if err != context.Canceled {
+			t.Errorf("Import canceled at check %d of %d: got %v, want %v", n, total, err, context.Canceled)
+			continue
+		}
+
+		// The import stopped before labeling every issue
+		labeled, err := store.GetIssuesByLabel(context.Background(), "imported")
+		if err != nil {
+			t.Fatalf("GetIssuesByLabel failed: %v", err)
+		}
+		if len(labeled) == 10 {
+			t.Errorf("Import canceled at check %d of %d labeled every issue", n, total)
+		}
+	}
+}
+
 func TestGetOrCreateStore_ExistingStore(t *testing.T) {
 	ctx := context.Background()
 	
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/utils"
 )
@@ -143,6 +144,8 @@ func (fc *fieldComparator) checkFieldChanged(key string, existing *types.Issue,
 		return !fc.equalStr(existing.Assignee, newVal)
 	case "external_ref":
 		return !fc.equalPtrStr(existing.ExternalRef, newVal)
+	case "due_date":
+		return !fc.equalStr(existing.DueDate, newVal)
 	case "pinned":
 		return !fc.equalBool(existing.Pinned, newVal)
 	default:
@@ -170,24 +173,30 @@ func RenameImportedIssuePrefixes(issues []*types.Issue, targetPrefix string) err
 	for _, issue := range issues {
 		oldPrefix := utils.ExtractIssuePrefix(issue.ID)
 		if oldPrefix == "" {
-			return fmt.Errorf("cannot rename issue %s: malformed ID (no hyphen found)", issue.ID)
+			return fmt.Errorf("cannot rename issue %s: malformed ID (no separator found)", issue.ID)
 		}
 
 		if oldPrefix != targetPrefix {
 			// Extract the suffix part (supports both numeric "123" and hash "abc1" and hierarchical "abc.1.2")
-			suffix := strings.TrimPrefix(issue.ID, oldPrefix+"-")
+			suffix := strings.TrimPrefix(issue.ID, oldPrefix+idgen.Separator())
 
 			// Validate that the suffix is valid (alphanumeric + dots for hierarchy)
 			if suffix == "" || !isValidIDSuffix(suffix) {
 				return fmt.Errorf("cannot rename issue %s: invalid suffix '%s'", issue.ID, suffix)
 			}
 
-			newID := fmt.Sprintf("%s-%s", targetPrefix, suffix)
+			newID := idgen.FormatID(targetPrefix, suffix)
 			idMapping[issue.ID] = newID
 		}
 	}
 
-	// Now update all issues and their references
+	rewriteIssueIDs(issues, idMapping)
+	return nil
+}
+
+// rewriteIssueIDs renames issues per idMapping (old ID -> new ID) and rewrites
+// references to renamed IDs in text fields, dependencies and comments.
+func rewriteIssueIDs(issues []*types.Issue, idMapping map[string]string) {
 	for _, issue := range issues {
 		// Update the issue ID itself if it needs renaming
 		if newID, ok := idMapping[issue.ID]; ok {
@@ -222,8 +231,6 @@ func RenameImportedIssuePrefixes(issues []*types.Issue, targetPrefix string) err
 			issue.Comments[i].Text = replaceIDReferences(issue.Comments[i].Text, idMapping)
 		}
 	}
-
-	return nil
 }
 
 // replaceIDReferences replaces all old issue ID references with new ones in text
@@ -34,7 +34,7 @@ func TryDaemonLock(beadsDir string) (running bool, pid int) {
 	if err != nil {
 		// No lock file - could be old daemon without lock support
 		// Fall back to PID file check for backward compatibility
-		return checkPIDFile(beadsDir)
+		return CheckPIDFile(beadsDir)
 	}
 	defer func() { _ = f.Close() }()
 
@@ -57,7 +57,7 @@ func TryDaemonLock(beadsDir string) (running bool, pid int) {
 				}
 				// Fallback to PID file if we couldn't read PID from lock file
 				if pid == 0 {
-					_, pid = checkPIDFile(beadsDir)
+					_, pid = CheckPIDFile(beadsDir)
 				}
 			}
 			return true, pid
@@ -71,10 +71,24 @@ func TryDaemonLock(beadsDir string) (running bool, pid int) {
 	return false, 0
 }
 
-// checkPIDFile checks if a daemon is running by reading the PID file.
+// pidReuseSlack absorbs the coarse resolution of process start times and
+// PID file timestamps.
+const pidReuseSlack = 2 * time.Second
+
+// CheckPIDFile checks if a daemon is running by reading .beads/daemon.pid.
 // This is used for backward compatibility with pre-lock daemons.
-func checkPIDFile(beadsDir string) (running bool, pid int) {
+//
+// The daemon writes the PID file right after it starts, so the file's mtime
+// doubles as a start-time marker: a process with that PID that started later
+// is not the daemon but an unrelated process that reused the PID after the
+// daemon crashed. A PID file whose process is dead or reused is stale and is
+// removed, so the next daemon starts fresh.
+func CheckPIDFile(beadsDir string) (running bool, pid int) {
 	pidFile := filepath.Join(beadsDir, "daemon.pid")
+	info, err := os.Stat(pidFile)
+	if err != nil {
+		return false, 0
+	}
 	// #nosec G304 - controlled path from config
 	data, err := os.ReadFile(pidFile)
 	if err != nil {
@@ -86,13 +100,21 @@ func checkPIDFile(beadsDir string) (running bool, pid int) {
 		return false, 0
 	}
 
-	if !isProcessRunning(pidVal) {
+	if !isProcessRunning(pidVal) || pidReused(pidVal, info.ModTime()) {
+		_ = os.Remove(pidFile)
 		return false, 0
 	}
 
 	return true, pidVal
 }
 
+// pidReused reports whether the process with the given PID started after
+// marker, meaning it can't be the process that wrote the marker.
+func pidReused(pid int, marker time.Time) bool {
+	started, ok := processStartTime(pid)
+	return ok && started.After(marker.Add(pidReuseSlack))
+}
+
 // ReadLockInfo reads and parses the daemon lock file
 // Returns lock info if available, or error if file doesn't exist or can't be parsed
 func ReadLockInfo(beadsDir string) (*LockInfo, error) {
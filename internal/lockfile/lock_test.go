@@ -86,7 +86,7 @@ func TestCheckPIDFile(t *testing.T) {
 	tmpDir := t.TempDir()
 
 	t.Run("file not found", func(t *testing.T) {
-		running, pid := checkPIDFile(tmpDir)
+		running, pid := CheckPIDFile(tmpDir)
 		if running {
 			t.Error("expected running=false when PID file doesn't exist")
 		}
@@ -101,7 +101,7 @@ func TestCheckPIDFile(t *testing.T) {
 			t.Fatalf("failed to write PID file: %v", err)
 		}
 
-		running, pid := checkPIDFile(tmpDir)
+		running, pid := CheckPIDFile(tmpDir)
 		if running {
 			t.Error("expected running=false for invalid PID")
 		}
@@ -117,13 +117,40 @@ func TestCheckPIDFile(t *testing.T) {
 			t.Fatalf("failed to write PID file: %v", err)
 		}
 
-		running, pid := checkPIDFile(tmpDir)
+		running, pid := CheckPIDFile(tmpDir)
 		if running {
 			t.Error("expected running=false for non-existent process")
 		}
 		if pid != 0 {
 			t.Errorf("expected pid=0 for non-running process, got %d", pid)
 		}
+		if _, err := os.Stat(pidFile); !os.IsNotExist(err) {
+			t.Errorf("stale PID file should be removed, stat err = %v", err)
+		}
+	})
+
+	t.Run("PID reused after crash", func(t *testing.T) {
+		if _, ok := processStartTime(os.Getpid()); !ok {
+			t.Skip("process start times not available on this platform")
+		}
+		// A PID file written long before this process started: the daemon
+		// that wrote it died and the OS handed its PID to us
+		pidFile := filepath.Join(tmpDir, "daemon.pid")
+		if err := os.WriteFile(pidFile, []byte(fmt.Sprintf("%d", os.Getpid())), 0644); err != nil {
+			t.Fatalf("failed to write PID file: %v", err)
+		}
+		written := time.Now().Add(-24 * time.Hour)
+		if err := os.Chtimes(pidFile, written, written); err != nil {
+			t.Fatalf("failed to set PID file time: %v", err)
+		}
+
+		running, pid := CheckPIDFile(tmpDir)
+		if running || pid != 0 {
+			t.Errorf("CheckPIDFile() = %v, %d; want stale PID file treated as not running", running, pid)
+		}
+		if _, err := os.Stat(pidFile); !os.IsNotExist(err) {
+			t.Errorf("stale PID file should be removed, stat err = %v", err)
+		}
 	})
 
 	t.Run("current process is running", func(t *testing.T) {
@@ -133,7 +160,7 @@ func TestCheckPIDFile(t *testing.T) {
 			t.Fatalf("failed to write PID file: %v", err)
 		}
 
-		running, pid := checkPIDFile(tmpDir)
+		running, pid := CheckPIDFile(tmpDir)
 		if !running {
 			t.Error("expected running=true for current process")
 		}
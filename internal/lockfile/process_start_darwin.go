@@ -0,0 +1,19 @@
+//go:build darwin
+
+package lockfile
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// processStartTime returns when the process with the given PID started.
+func processStartTime(pid int) (time.Time, bool) {
+	info, err := unix.SysctlKinfoProc("kern.proc.pid", pid)
+	if err != nil {
+		return time.Time{}, false
+	}
+	start := info.Proc.P_starttime
+	return time.Unix(start.Sec, int64(start.Usec)*int64(time.Microsecond)), true
+}
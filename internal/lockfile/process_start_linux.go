@@ -0,0 +1,63 @@
+//go:build linux
+
+package lockfile
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicksPerSecond is USER_HZ, the unit of /proc/<pid>/stat times. The
+// kernel fixes it at 100 for userspace on every architecture.
+const clockTicksPerSecond = 100
+
+// processStartTime returns when the process with the given PID started.
+func processStartTime(pid int) (time.Time, bool) {
+	// #nosec G304 - path built from an integer PID
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return time.Time{}, false
+	}
+	// The command name (field 2) is parenthesized and may contain spaces, so
+	// count from the closing paren: starttime is field 22, the 20th after it
+	end := bytes.LastIndexByte(data, ')')
+	if end < 0 {
+		return time.Time{}, false
+	}
+	fields := strings.Fields(string(data[end+1:]))
+	if len(fields) < 20 {
+		return time.Time{}, false
+	}
+	ticks, err := strconv.ParseInt(fields[19], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	boot, ok := bootTime()
+	if !ok {
+		return time.Time{}, false
+	}
+	return boot.Add(time.Duration(ticks) * time.Second / clockTicksPerSecond), true
+}
+
+// bootTime reads the system boot time from /proc/stat.
+func bootTime() (time.Time, bool) {
+	data, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return time.Time{}, false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(line, "btime "); ok {
+			secs, err := strconv.ParseInt(strings.TrimSpace(rest), 10, 64)
+			if err != nil {
+				return time.Time{}, false
+			}
+			return time.Unix(secs, 0), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,11 @@
+//go:build !linux && !darwin
+
+package lockfile
+
+import "time"
+
+// processStartTime is not implemented on this platform, so PID reuse goes
+// undetected and only process liveness is checked.
+func processStartTime(pid int) (time.Time, bool) {
+	return time.Time{}, false
+}
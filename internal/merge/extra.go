@@ -0,0 +1,188 @@
+package merge
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// knownFields are the JSON keys modeled by Issue. Every other key of a JSONL
+// record is kept in Issue.Extra.
+var (
+	knownFieldsOnce sync.Once
+	knownFields     map[string]bool
+)
+
+func isKnownField(key string) bool {
+	knownFieldsOnce.Do(func() {
+		knownFields = make(map[string]bool)
+		t := reflect.TypeOf(Issue{})
+		for i := 0; i < t.NumField(); i++ {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name != "" && name != "-" {
+				knownFields[name] = true
+			}
+		}
+	})
+	return knownFields[key]
+}
+
+// issueFields avoids recursion into Issue's JSON methods.
+type issueFields Issue
+
+// UnmarshalJSON decodes the modeled fields and stashes the rest in Extra.
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*issueFields)(i)); err != nil {
+		return err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	i.Extra = nil
+	for key, value := range all {
+		if isKnownField(key) {
+			continue
+		}
+		if i.Extra == nil {
+			i.Extra = make(map[string]json.RawMessage)
+		}
+		i.Extra[key] = value
+	}
+	return nil
+}
+
+// MarshalJSON encodes the modeled fields in declaration order, followed by
+// the Extra fields sorted by key.
+func (i Issue) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(issueFields(i))
+	if err != nil || len(i.Extra) == 0 {
+		return data, err
+	}
+
+	keys := make([]string, 0, len(i.Extra))
+	for key := range i.Extra {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1]) // drop closing brace
+	for _, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(',')
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(i.Extra[key])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// mergeExtra merges the fields the driver does not model (labels, assignee,
+// design, comments, ...) key by key. A field changed on one side takes that
+// side's value; a field changed differently on both sides takes the value from
+// the side with the later updated_at, like title and description.
+func mergeExtra(base, left, right map[string]json.RawMessage, leftUpdatedAt, rightUpdatedAt string) map[string]json.RawMessage {
+	keys := make(map[string]bool)
+	for _, m := range []map[string]json.RawMessage{base, left, right} {
+		for key := range m {
+			keys[key] = true
+		}
+	}
+
+	var result map[string]json.RawMessage
+	for key := range keys {
+		b, l, r := base[key], left[key], right[key]
+		var merged json.RawMessage
+		switch {
+		case bytes.Equal(b, l):
+			merged = r
+		case bytes.Equal(b, r), bytes.Equal(l, r):
+			merged = l
+		case isTimeAfter(leftUpdatedAt, rightUpdatedAt):
+			merged = l
+		default:
+			merged = r
+		}
+		if merged == nil {
+			continue // removed on the side that changed it
+		}
+		if result == nil {
+			result = make(map[string]json.RawMessage)
+		}
+		result[key] = merged
+	}
+	return result
+}
+
+// exportFields are the JSON keys modeled by types.Issue.
+var (
+	exportFieldsOnce sync.Once
+	exportFields     map[string]bool
+)
+
+func isExportField(key string) bool {
+	exportFieldsOnce.Do(func() {
+		exportFields = make(map[string]bool)
+		t := reflect.TypeOf(types.Issue{})
+		for i := 0; i < t.NumField(); i++ {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name != "" && name != "-" {
+				exportFields[name] = true
+			}
+		}
+	})
+	return exportFields[key]
+}
+
+// encodeIssue writes issue to w as one JSONL line the way bd export does,
+// with timestamps in the given format. Fields types.Issue does not model, such
+// as those written by a newer bd, follow sorted by key.
+func encodeIssue(w io.Writer, issue Issue, format types.TimestampFormat) error {
+	data, err := json.Marshal(issue)
+	if err != nil {
+		return err
+	}
+	var full types.Issue
+	if err := types.UnmarshalJSONL(data, &full); err != nil {
+		return err
+	}
+	line, err := types.MarshalIssueJSONL(&full, format)
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	for key := range issue.Extra {
+		if !isExportField(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(line[:len(line)-1]) // drop closing brace
+	for _, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(issue.Extra[key])
+	}
+	buf.WriteString("}\n")
+	_, err = w.Write(buf.Bytes())
+	return err
+}
@@ -34,6 +34,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -57,6 +58,9 @@ type Issue struct {
 	DeletedBy    string `json:"deleted_by,omitempty"`    // Who deleted the issue
 	DeleteReason string `json:"delete_reason,omitempty"` // Why the issue was deleted
 	OriginalType string `json:"original_type,omitempty"` // Issue type before deletion
+	// Extra holds every other field of the record (labels, assignee, design, ...)
+	// so the driver carries them through instead of dropping them
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Dependency represents an issue dependency
@@ -129,14 +133,11 @@ func Merge3Way(outputPath, basePath, leftPath, rightPath string, debug bool) err
 	}
 	defer outFile.Close()
 
-	// Write merged result to output file
+	// Write merged result to output file, in the timestamp format export uses
+	format := config.TimestampFormat()
 	for _, issue := range result {
-		line, err := json.Marshal(issue)
-		if err != nil {
-			return fmt.Errorf("error marshaling issue %s: %w", issue.ID, err)
-		}
-		if _, err := fmt.Fprintln(outFile, string(line)); err != nil {
-			return fmt.Errorf("error writing merged issue: %w", err)
+		if err := encodeIssue(outFile, issue, format); err != nil {
+			return fmt.Errorf("error writing merged issue %s: %w", issue.ID, err)
 		}
 	}
 
@@ -218,7 +219,7 @@ func readIssues(path string) ([]Issue, error) {
 		}
 
 		var issue Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err != nil {
 			return nil, fmt.Errorf("failed to parse line %d: %w", lineNum, err)
 		}
 		issue.RawLine = line
@@ -578,6 +579,9 @@ func mergeIssue(base, left, right Issue) (Issue, string) {
 	// Merge dependencies - proper 3-way merge where removals win (bd-ndye)
 	result.Dependencies = mergeDependencies(base.Dependencies, left.Dependencies, right.Dependencies)
 
+	// Merge all other fields key by key - on conflict, side with latest updated_at wins
+	result.Extra = mergeExtra(base.Extra, left.Extra, right.Extra, left.UpdatedAt, right.UpdatedAt)
+
 	// bd-1sn: If status became tombstone via mergeStatus safety fallback,
 	// copy tombstone fields from whichever side has them
 	if result.Status == StatusTombstone {
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/steveyegge/beads/internal/config"
 )
 
 // TestMergeStatus tests the status merging logic with special rules
@@ -1250,6 +1252,105 @@ func TestMerge3Way_Integration(t *testing.T) {
 	})
 }
 
+// TestMerge3Way_UnmodeledFields verifies fields the driver does not model
+// (assignee, labels, design, ...) are merged instead of dropped
+func TestMerge3Way_UnmodeledFields(t *testing.T) {
+	tmpDir := t.TempDir()
+	baseFile := filepath.Join(tmpDir, "base.jsonl")
+	leftFile := filepath.Join(tmpDir, "left.jsonl")
+	rightFile := filepath.Join(tmpDir, "right.jsonl")
+	outputFile := filepath.Join(tmpDir, "output.jsonl")
+
+	// Left reassigns and relabels; right adds a design and relabels differently later
+	baseData := `{"id":"bd-1","title":"Issue 1","status":"open","priority":2,"assignee":"bob","labels":["a"],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-01T00:00:00Z","schema_version":1}
+`
+	leftData := `{"id":"bd-1","title":"Issue 1","status":"open","priority":2,"assignee":"alice","labels":["a","left"],"created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-02T00:00:00Z","schema_version":1}
+`
+	rightData := `{"id":"bd-1","title":"Issue 1","status":"open","priority":2,"assignee":"bob","labels":["a","right"],"design":"Use a queue","created_at":"2024-01-01T00:00:00Z","updated_at":"2024-01-03T00:00:00Z","schema_version":1}
+`
+	for path, data := range map[string]string{baseFile: baseData, leftFile: leftData, rightFile: rightData} {
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+
+	if err := Merge3Way(outputFile, baseFile, leftFile, rightFile, false); err != nil {
+		t.Fatalf("merge failed: %v", err)
+	}
+	content, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("failed to read output file: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatalf("failed to parse output: %v", err)
+	}
+	if got["assignee"] != "alice" {
+		t.Errorf("assignee = %v, want alice (changed only on left)", got["assignee"])
+	}
+	if got["design"] != "Use a queue" {
+		t.Errorf("design = %v, want right's design (added only on right)", got["design"])
+	}
+	if labels, _ := json.Marshal(got["labels"]); string(labels) != `["a","right"]` {
+		t.Errorf("labels = %s, want right's labels (both changed, right updated later)", labels)
+	}
+	if got["schema_version"] != float64(1) {
+		t.Errorf("schema_version = %v, want 1", got["schema_version"])
+	}
+}
+
+// TestMerge3Way_TimestampFormat verifies the merged issues are written in the
+// configured timestamp-format, like bd export, and keep fields bd does not know
+func TestMerge3Way_TimestampFormat(t *testing.T) {
+	// Initialize config if not already done (required for Set to work)
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("failed to initialize config: %v", err)
+	}
+	config.Set("timestamp-format", "unix")
+	t.Cleanup(func() { config.Set("timestamp-format", "rfc3339") })
+
+	tmpDir := t.TempDir()
+	baseFile := filepath.Join(tmpDir, "base.jsonl")
+	leftFile := filepath.Join(tmpDir, "left.jsonl")
+	rightFile := filepath.Join(tmpDir, "right.jsonl")
+	outputFile := filepath.Join(tmpDir, "output.jsonl")
+
+	baseData := `{"id":"bd-1","title":"Issue 1","status":"open","priority":2,"created_at":1704067200,"updated_at":1704067200,"schema_version":1,"zz_future":{"a":1}}
+`
+	leftData := `{"id":"bd-1","title":"Issue 1","status":"closed","priority":2,"created_at":1704067200,"updated_at":1704153600,"closed_at":1704153600,"schema_version":1,"zz_future":{"a":1}}
+`
+	for path, data := range map[string]string{baseFile: baseData, leftFile: leftData, rightFile: baseData} {
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+
+	if err := Merge3Way(outputFile, baseFile, leftFile, rightFile, false); err != nil {
+		t.Fatalf("merge failed: %v", err)
+	}
+	content, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("failed to read output file: %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatalf("failed to parse output %s: %v", content, err)
+	}
+	for field, want := range map[string]float64{"created_at": 1704067200, "updated_at": 1704153600, "closed_at": 1704153600} {
+		if got[field] != want {
+			t.Errorf("%s = %v, want unix seconds %v", field, got[field], int64(want))
+		}
+	}
+	if got["status"] != "closed" {
+		t.Errorf("status = %v, want closed", got["status"])
+	}
+	if future, _ := json.Marshal(got["zz_future"]); string(future) != `{"a":1}` {
+		t.Errorf("zz_future = %s, want it carried through", future)
+	}
+}
+
 // TestIsTombstone tests the tombstone detection helper
 func TestIsTombstone(t *testing.T) {
 	tests := []struct {
@@ -303,6 +303,11 @@ func (c *Client) CloseIssue(args *CloseArgs) (*Response, error) {
 	return c.Execute(OpClose, args)
 }
 
+// ReopenIssue reopens a closed issue via the daemon.
+func (c *Client) ReopenIssue(args *ReopenArgs) (*Response, error) {
+	return c.Execute(OpReopen, args)
+}
+
 // Delete deletes one or more issues via the daemon.
 func (c *Client) Delete(args *DeleteArgs) (*Response, error) {
 	return c.Execute(OpDelete, args)
@@ -390,6 +395,13 @@ func (c *Client) Export(args *ExportArgs) (*Response, error) {
 	return c.Execute(OpExport, args)
 }
 
+// NotifyFlushed tells the daemon the JSONL was just flushed, so that it
+// resets its export debounce timer instead of exporting again
+func (c *Client) NotifyFlushed() error {
+	_, err := c.Execute(OpFlushed, nil)
+	return err
+}
+
 // EpicStatus gets epic completion status via the daemon
 func (c *Client) EpicStatus(args *EpicStatusArgs) (*Response, error) {
 	return c.Execute(OpEpicStatus, args)
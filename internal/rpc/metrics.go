@@ -7,6 +7,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/steveyegge/beads/internal/storage/sqlite"
 )
 
 // Metrics holds all telemetry data for the daemon
@@ -156,6 +158,7 @@ func (m *Metrics) Snapshot(activeConns int) MetricsSnapshot {
 		TotalConns:     atomic.LoadInt64(&m.totalConns),
 		ActiveConns:    activeConns,
 		RejectedConns:  atomic.LoadInt64(&m.rejectedConns),
+		WriteRetries:   sqlite.WriteRetries(),
 		MemoryAllocMB:  memStats.Alloc / 1024 / 1024,
 		MemorySysMB:    memStats.Sys / 1024 / 1024,
 		GoroutineCount: runtime.NumGoroutine(),
@@ -170,6 +173,7 @@ type MetricsSnapshot struct {
 	TotalConns     int64              `json:"total_connections"`
 	ActiveConns    int                `json:"active_connections"`
 	RejectedConns  int64              `json:"rejected_connections"`
+	WriteRetries   int64              `json:"write_retries"`
 	MemoryAllocMB  uint64             `json:"memory_alloc_mb"`
 	MemorySysMB    uint64             `json:"memory_sys_mb"`
 	GoroutineCount int                `json:"goroutine_count"`
@@ -14,6 +14,7 @@ const (
 	OpCreate          = "create"
 	OpUpdate          = "update"
 	OpClose           = "close"
+	OpReopen          = "reopen"
 	OpList            = "list"
 	OpCount           = "count"
 	OpShow            = "show"
@@ -33,6 +34,7 @@ const (
 	OpCompact         = "compact"
 	OpCompactStats    = "compact_stats"
 	OpExport          = "export"
+	OpFlushed         = "flushed"
 	OpImport          = "import"
 	OpEpicStatus      = "epic_status"
 	OpGetMutations        = "get_mutations"
@@ -118,6 +120,8 @@ type UpdateArgs struct {
 	SupersededBy *string `json:"superseded_by,omitempty"` // Replacement issue ID if obsolete
 	// Pinned field (bd-iea)
 	Pinned *bool `json:"pinned,omitempty"` // If true, issue is a persistent context marker
+	// DueDate is YYYY-MM-DD; "" clears the due date
+	DueDate *string `json:"due_date,omitempty"`
 }
 
 // CloseArgs represents arguments for the close operation
@@ -126,6 +130,12 @@ type CloseArgs struct {
 	Reason string `json:"reason,omitempty"`
 }
 
+// ReopenArgs represents arguments for the reopen operation
+type ReopenArgs struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason,omitempty"` // Added as a comment when set
+}
+
 // DeleteArgs represents arguments for the delete operation
 type DeleteArgs struct {
 	IDs     []string `json:"ids"`               // Issue IDs to delete
@@ -158,6 +168,7 @@ type ListArgs struct {
 	CreatedBefore string `json:"created_before,omitempty"`
 	UpdatedAfter  string `json:"updated_after,omitempty"`
 	UpdatedBefore string `json:"updated_before,omitempty"`
+	UpdatedSince  string `json:"updated_since,omitempty"` // Inclusive (--since)
 	ClosedAfter   string `json:"closed_after,omitempty"`
 	ClosedBefore  string `json:"closed_before,omitempty"`
 	
@@ -181,6 +192,9 @@ type ListArgs struct {
 
 	// Wisp filtering (bd-bkul)
 	Wisp *bool `json:"wisp,omitempty"`
+
+	// Expression filtering (bd list --where)
+	Where string `json:"where,omitempty"`
 }
 
 // CountArgs represents arguments for the count operation
@@ -245,9 +259,10 @@ type ReadyArgs struct {
 
 // StaleArgs represents arguments for the stale command
 type StaleArgs struct {
-	Days   int    `json:"days,omitempty"`
-	Status string `json:"status,omitempty"`
-	Limit  int    `json:"limit,omitempty"`
+	Days      int           `json:"days,omitempty"`
+	OlderThan time.Duration `json:"older_than,omitempty"`
+	Status    string        `json:"status,omitempty"`
+	Limit     int           `json:"limit,omitempty"`
 }
 
 // DepAddArgs represents arguments for adding a dependency
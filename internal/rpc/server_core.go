@@ -35,6 +35,9 @@ type Server struct {
 	shutdownChan  chan struct{}
 	stopOnce      sync.Once
 	doneChan      chan struct{} // closed when Start() cleanup is complete
+	// Stop requests from clients (bd daemon stop)
+	stopRequested   chan struct{}
+	stopRequestOnce sync.Once
 	// Health and metrics
 	startTime        time.Time
 	lastActivityTime atomic.Value // time.Time - last request timestamp
@@ -51,6 +54,7 @@ type Server struct {
 	importInProgress atomic.Bool
 	// Mutation events for event-driven daemon
 	mutationChan    chan MutationEvent
+	flushedChan     chan struct{} // Signalled when a client has flushed the JSONL itself
 	droppedEvents   atomic.Int64 // Counter for dropped mutation events
 	// Recent mutations buffer for polling (circular buffer, max 100 events)
 	recentMutations   []MutationEvent
@@ -125,6 +129,7 @@ func NewServer(socketPath string, store storage.Storage, workspacePath string, d
 		storage:           store,
 		shutdownChan:      make(chan struct{}),
 		doneChan:          make(chan struct{}),
+		stopRequested:     make(chan struct{}),
 		startTime:         time.Now(),
 		metrics:           NewMetrics(),
 		maxConns:          maxConns,
@@ -132,6 +137,7 @@ func NewServer(socketPath string, store storage.Storage, workspacePath string, d
 		requestTimeout:    requestTimeout,
 		readyChan:         make(chan struct{}),
 		mutationChan:      make(chan MutationEvent, mutationBufferSize), // Configurable buffer
+		flushedChan:       make(chan struct{}, 1),
 		recentMutations:   make([]MutationEvent, 0, 100),
 		maxMutationBuffer: 100,
 	}
@@ -180,11 +186,29 @@ func (s *Server) emitRichMutation(event MutationEvent) {
 	s.recentMutationsMu.Unlock()
 }
 
+// LastActivity returns when the server last handled a request (or when it
+// was created, if it hasn't handled any).
+func (s *Server) LastActivity() time.Time {
+	return s.lastActivityTime.Load().(time.Time)
+}
+
+// StopRequested is closed when a client asks the daemon to shut down. The
+// daemon loop owns the shutdown: it should flush pending work and then Stop.
+func (s *Server) StopRequested() <-chan struct{} {
+	return s.stopRequested
+}
+
 // MutationChan returns the mutation event channel for the daemon to consume
 func (s *Server) MutationChan() <-chan MutationEvent {
 	return s.mutationChan
 }
 
+// FlushedChan is signalled when a client reports that it flushed the JSONL
+// itself (bd flush), so the daemon can drop its pending debounced export
+func (s *Server) FlushedChan() <-chan struct{} {
+	return s.flushedChan
+}
+
 // SetConfig sets the daemon configuration for status reporting
 func (s *Server) SetConfig(autoCommit, autoPush, autoPull, localMode bool, syncInterval, daemonMode string) {
 	s.mu.Lock()
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/beads/internal/autoimport"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/export"
 	"github.com/steveyegge/beads/internal/importer"
@@ -21,6 +22,16 @@ import (
 	"github.com/steveyegge/beads/internal/utils"
 )
 
+// handleFlushed handles a client's notice that it just wrote the JSONL
+// (bd flush). Non-blocking: one pending notice is as good as several.
+func (s *Server) handleFlushed(_ *Request) Response {
+	select {
+	case s.flushedChan <- struct{}{}:
+	default:
+	}
+	return Response{Success: true}
+}
+
 // handleExport handles the export operation
 func (s *Server) handleExport(req *Request) Response {
 	var exportArgs ExportArgs
@@ -137,6 +148,32 @@ func (s *Server) handleExport(req *Request) Response {
 		issue.Comments = allComments[issue.ID]
 	}
 
+	// Populate references for all issues (enrichment data)
+	var allRefs map[string][]*types.Reference
+	result = export.FetchWithPolicy(ctx, cfg, export.DataTypeReferences, "get references", func() error {
+		var err error
+		allRefs, err = store.GetReferencesForIssues(ctx, issueIDs)
+		return err
+	})
+	if result.Err != nil {
+		return Response{
+			Success: false,
+			Error:   fmt.Sprintf("failed to get references: %v", result.Err),
+		}
+	}
+	if !result.Success {
+		// References fetch failed but policy allows continuing
+		allRefs = make(map[string][]*types.Reference)
+		if manifest != nil {
+			manifest.PartialData = append(manifest.PartialData, "references")
+			manifest.Warnings = append(manifest.Warnings, result.Warnings...)
+			manifest.Complete = false
+		}
+	}
+	for _, issue := range issues {
+		issue.References = allRefs[issue.ID]
+	}
+
 	// Create temp file for atomic write
 	dir := filepath.Dir(exportArgs.JSONLPath)
 	base := filepath.Base(exportArgs.JSONLPath)
@@ -154,11 +191,12 @@ func (s *Server) handleExport(req *Request) Response {
 	}()
 
 	// Write JSONL
-	encoder := json.NewEncoder(tempFile)
+	format := config.TimestampFormat()
 	exportedIDs := make([]string, 0, len(issues))
 	var encodingWarnings []string
 	for _, issue := range issues {
-		if err := encoder.Encode(issue); err != nil {
+		issue.SchemaVersion = types.JSONLSchemaVersion
+		if err := types.EncodeIssueJSONL(tempFile, issue, format); err != nil {
 			if cfg.SkipEncodingErrors {
 				// Skip this issue and continue
 				warning := fmt.Sprintf("skipped encoding issue %s: %v", issue.ID, err)
@@ -193,8 +231,8 @@ func (s *Server) handleExport(req *Request) Response {
 		}
 	}
 
-	// Set appropriate file permissions (0600: rw-------)
-	if err := os.Chmod(exportArgs.JSONLPath, 0600); err != nil {
+	// Set appropriate file permissions (0600: rw------- unless file-mode is configured)
+	if err := os.Chmod(exportArgs.JSONLPath, config.FileMode(0600)); err != nil {
 		// Non-fatal, just log
 		fmt.Fprintf(os.Stderr, "Warning: failed to set file permissions: %v\n", err)
 	}
@@ -533,6 +571,24 @@ func (s *Server) triggerExport(ctx context.Context, store storage.Storage, dbPat
 		issue.Comments = allComments[issue.ID]
 	}
 
+	// Populate references for all issues (enrichment data)
+	var allRefs map[string][]*types.Reference
+	result = export.FetchWithPolicy(ctx, cfg, export.DataTypeReferences, "get references", func() error {
+		var err error
+		allRefs, err = store.GetReferencesForIssues(ctx, issueIDs)
+		return err
+	})
+	if result.Err != nil {
+		return fmt.Errorf("failed to get references: %w", result.Err)
+	}
+	if !result.Success {
+		// References fetch failed but policy allows continuing
+		allRefs = make(map[string][]*types.Reference)
+	}
+	for _, issue := range allIssues {
+		issue.References = allRefs[issue.ID]
+	}
+
 	// Write to JSONL file with atomic replace (temp file + rename)
 	dir := filepath.Dir(jsonlPath)
 	base := filepath.Base(jsonlPath)
@@ -546,9 +602,10 @@ func (s *Server) triggerExport(ctx context.Context, store storage.Storage, dbPat
 		_ = os.Remove(tempPath)
 	}()
 
-	encoder := json.NewEncoder(tempFile)
+	format := config.TimestampFormat()
 	for _, issue := range allIssues {
-		if err := encoder.Encode(issue); err != nil {
+		issue.SchemaVersion = types.JSONLSchemaVersion
+		if err := types.EncodeIssueJSONL(tempFile, issue, format); err != nil {
 			return fmt.Errorf("failed to encode issue %s: %w", issue.ID, err)
 		}
 	}
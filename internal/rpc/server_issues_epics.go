@@ -101,6 +101,9 @@ func updatesFromArgs(a UpdateArgs) map[string]interface{} {
 	if a.Pinned != nil {
 		u["pinned"] = *a.Pinned
 	}
+	if a.DueDate != nil {
+		u["due_date"] = *a.DueDate
+	}
 	return u
 }
 
@@ -174,7 +177,6 @@ func (s *Server) handleCreate(req *Request) Response {
 		Assignee:           strValue(assignee),
 		ExternalRef:        externalRef,
 		EstimatedMinutes:   createArgs.EstimatedMinutes,
-		Status:             types.StatusOpen,
 		// Messaging fields (bd-kwro)
 		Sender: createArgs.Sender,
 		Wisp:   createArgs.Wisp,
@@ -560,6 +562,82 @@ func (s *Server) handleClose(req *Request) Response {
 	}
 }
 
+func (s *Server) handleReopen(req *Request) Response {
+	var reopenArgs ReopenArgs
+	if err := json.Unmarshal(req.Args, &reopenArgs); err != nil {
+		return Response{
+			Success: false,
+			Error:   fmt.Sprintf("invalid reopen args: %v", err),
+		}
+	}
+
+	store := s.storage
+	if store == nil {
+		return Response{
+			Success: false,
+			Error:   "storage not available (global daemon deprecated - use local daemon instead with 'bd daemon' in your project)",
+		}
+	}
+
+	ctx := s.reqCtx(req)
+	issue, err := store.GetIssue(ctx, reopenArgs.ID)
+	if err != nil {
+		return Response{
+			Success: false,
+			Error:   fmt.Sprintf("failed to get issue: %v", err),
+		}
+	}
+	if issue == nil {
+		return Response{
+			Success: false,
+			Error:   fmt.Sprintf("issue %s not found", reopenArgs.ID),
+		}
+	}
+
+	if sqliteStore, ok := store.(*sqlite.SQLiteStorage); ok {
+		err = sqliteStore.ReopenIssue(ctx, reopenArgs.ID, s.reqActor(req))
+	} else if issue.Status != types.StatusClosed {
+		err = fmt.Errorf("issue %s is not closed (status: %s)", reopenArgs.ID, issue.Status)
+	} else {
+		err = store.UpdateIssue(ctx, reopenArgs.ID, map[string]interface{}{"status": string(types.StatusOpen)}, s.reqActor(req))
+	}
+	if err != nil {
+		return Response{
+			Success: false,
+			Error:   fmt.Sprintf("failed to reopen issue: %v", err),
+		}
+	}
+
+	if reopenArgs.Reason != "" {
+		if err := store.AddComment(ctx, reopenArgs.ID, s.reqActor(req), reopenArgs.Reason); err != nil {
+			return Response{
+				Success: false,
+				Error:   fmt.Sprintf("reopened %s but failed to add reason: %v", reopenArgs.ID, err),
+			}
+		}
+	}
+
+	reopenedIssue, _ := store.GetIssue(ctx, reopenArgs.ID)
+	newStatus := string(types.StatusOpen)
+	if reopenedIssue != nil {
+		newStatus = string(reopenedIssue.Status)
+	}
+	s.emitRichMutation(MutationEvent{
+		Type:      MutationStatus,
+		IssueID:   reopenArgs.ID,
+		Title:     issue.Title,
+		Assignee:  issue.Assignee,
+		OldStatus: string(types.StatusClosed),
+		NewStatus: newStatus,
+	})
+
+	data, _ := json.Marshal(reopenedIssue)
+	return Response{
+		Success: true,
+		Data:    data,
+	}
+}
+
 func (s *Server) handleDelete(req *Request) Response {
 	var deleteArgs DeleteArgs
 	if err := json.Unmarshal(req.Args, &deleteArgs); err != nil {
@@ -777,6 +855,16 @@ func (s *Server) handleList(req *Request) Response {
 		}
 		filter.UpdatedBefore = &t
 	}
+	if listArgs.UpdatedSince != "" {
+		t, err := parseTimeRPC(listArgs.UpdatedSince)
+		if err != nil {
+			return Response{
+				Success: false,
+				Error:   fmt.Sprintf("invalid --since date: %v", err),
+			}
+		}
+		filter.UpdatedSince = &t
+	}
 	if listArgs.ClosedAfter != "" {
 		t, err := parseTimeRPC(listArgs.ClosedAfter)
 		if err != nil {
@@ -802,6 +890,7 @@ func (s *Server) handleList(req *Request) Response {
 	filter.EmptyDescription = listArgs.EmptyDescription
 	filter.NoAssignee = listArgs.NoAssignee
 	filter.NoLabels = listArgs.NoLabels
+	filter.Where = listArgs.Where
 	
 	// Priority range
 	filter.PriorityMin = listArgs.PriorityMin
@@ -1125,7 +1214,7 @@ func (s *Server) handleResolveID(req *Request) Response {
 	}
 
 	ctx := s.reqCtx(req)
-	resolvedID, err := utils.ResolvePartialID(ctx, s.storage, args.ID)
+	resolvedID, err := utils.ResolveIssueID(ctx, s.storage, args.ID)
 	if err != nil {
 		return Response{
 			Success: false,
@@ -1199,6 +1288,9 @@ func (s *Server) handleShow(req *Request) Response {
 		}
 	}
 
+	// References ride along on the embedded issue
+	issue.References, _ = store.GetReferences(ctx, issue.ID)
+
 	// Create detailed response with related data
 	type IssueDetails struct {
 		*types.Issue
@@ -1285,9 +1377,10 @@ func (s *Server) handleStale(req *Request) Response {
 	}
 
 	filter := types.StaleFilter{
-		Days:   staleArgs.Days,
-		Status: staleArgs.Status,
-		Limit:  staleArgs.Limit,
+		Days:      staleArgs.Days,
+		OlderThan: staleArgs.OlderThan,
+		Status:    staleArgs.Status,
+		Limit:     staleArgs.Limit,
 	}
 
 	ctx := s.reqCtx(req)
@@ -1501,7 +1594,7 @@ func (s *Server) handleGateShow(req *Request) Response {
 	ctx := s.reqCtx(req)
 
 	// Resolve partial ID
-	gateID, err := utils.ResolvePartialID(ctx, store, args.ID)
+	gateID, err := utils.ResolveIssueID(ctx, store, args.ID)
 	if err != nil {
 		return Response{
 			Success: false,
@@ -1556,7 +1649,7 @@ func (s *Server) handleGateClose(req *Request) Response {
 	ctx := s.reqCtx(req)
 
 	// Resolve partial ID
-	gateID, err := utils.ResolvePartialID(ctx, store, args.ID)
+	gateID, err := utils.ResolveIssueID(ctx, store, args.ID)
 	if err != nil {
 		return Response{
 			Success: false,
@@ -1635,7 +1728,7 @@ func (s *Server) handleGateWait(req *Request) Response {
 	ctx := s.reqCtx(req)
 
 	// Resolve partial ID
-	gateID, err := utils.ResolvePartialID(ctx, store, args.ID)
+	gateID, err := utils.ResolveIssueID(ctx, store, args.ID)
 	if err != nil {
 		return Response{
 			Success: false,
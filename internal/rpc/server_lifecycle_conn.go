@@ -252,13 +252,9 @@ func (s *Server) writeResponse(writer *bufio.Writer, resp Response) error {
 }
 
 func (s *Server) handleShutdown(_ *Request) Response {
-	// Schedule shutdown in a goroutine so we can return a response first
-	go func() {
-		time.Sleep(100 * time.Millisecond) // Give time for response to be sent
-		if err := s.Stop(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error during shutdown: %v\n", err)
-		}
-	}()
+	// Only signal here: the daemon loop flushes pending exports before
+	// calling Stop, which would close the storage under it
+	s.stopRequestOnce.Do(func() { close(s.stopRequested) })
 
 	return Response{
 		Success: true,
@@ -0,0 +1,20 @@
+package storage
+
+import "errors"
+
+// Sentinel errors returned by storage backends, wrapped with context. Test
+// for them with errors.Is; the underlying driver error stays in the chain.
+var (
+	// ErrNotFound indicates the requested issue or record does not exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrLocked indicates the database is locked by another process or
+	// connection and the busy timeout ran out
+	ErrLocked = errors.New("database locked")
+
+	// ErrConflict indicates a unique constraint violation or conflicting state
+	ErrConflict = errors.New("conflict")
+
+	// ErrCorrupt indicates the database file is malformed or not a database
+	ErrCorrupt = errors.New("database corrupt")
+)
@@ -7,15 +7,22 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/where"
 )
 
+// Verify MemoryStorage implements storage.Storage at compile time
+var _ storage.Storage = (*MemoryStorage)(nil)
+
 // MemoryStorage implements the Storage interface using in-memory data structures
 type MemoryStorage struct {
 	mu sync.RWMutex // Protects all maps
@@ -26,6 +33,7 @@ type MemoryStorage struct {
 	labels       map[string][]string           // IssueID -> Labels
 	events       map[string][]*types.Event     // IssueID -> Events
 	comments     map[string][]*types.Comment   // IssueID -> Comments
+	references   map[string][]*types.Reference // IssueID -> References
 	config       map[string]string             // Config key-value pairs
 	metadata     map[string]string             // Metadata key-value pairs
 	counters     map[string]int                // Prefix -> Last ID
@@ -48,6 +56,7 @@ func New(jsonlPath string) *MemoryStorage {
 		labels:          make(map[string][]string),
 		events:          make(map[string][]*types.Event),
 		comments:        make(map[string][]*types.Comment),
+		references:      make(map[string][]*types.Reference),
 		config:          make(map[string]string),
 		metadata:        make(map[string]string),
 		counters:        make(map[string]int),
@@ -91,6 +100,11 @@ func (m *MemoryStorage) LoadFromIssues(issues []*types.Issue) error {
 			m.comments[issue.ID] = issue.Comments
 		}
 
+		// Store references
+		if len(issue.References) > 0 {
+			m.references[issue.ID] = issue.References
+		}
+
 		// Update counter based on issue ID
 		prefix, num := extractPrefixAndNumber(issue.ID)
 		if prefix != "" && num > 0 {
@@ -136,6 +150,11 @@ func (m *MemoryStorage) GetAllIssues() []*types.Issue {
 			issueCopy.Comments = comments
 		}
 
+		// Attach references
+		if refs, ok := m.references[issue.ID]; ok {
+			issueCopy.References = refs
+		}
+
 		issues = append(issues, &issueCopy)
 	}
 
@@ -189,10 +208,29 @@ func (m *MemoryStorage) CreateIssue(ctx context.Context, issue *types.Issue, act
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	// New issues without a status start in the configured default
+	customStatuses := m.customStatuses()
+	if issue.Status == "" {
+		status, err := types.DefaultStatus(m.config["default-status"], customStatuses)
+		if err != nil {
+			return err
+		}
+		issue.Status = status
+	}
+
 	// Validate
-	if err := issue.Validate(); err != nil {
+	if err := issue.ValidateWithCustomStatuses(customStatuses); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
+	if err := types.CheckStatusAllowed(issue.Status, parseCustomStatuses(m.config["statuses"])); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	caseInsensitive, _ := strconv.ParseBool(m.config["assignee-case-insensitive"])
+	assignee, err := types.NormalizeAssignee(issue.Assignee, parseCustomStatuses(m.config["assignees"]), caseInsensitive)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	issue.Assignee = assignee
 
 	// Set timestamps
 	now := time.Now()
@@ -208,7 +246,7 @@ func (m *MemoryStorage) CreateIssue(ctx context.Context, issue *types.Issue, act
 
 		// Get next ID
 		m.counters[prefix]++
-		issue.ID = fmt.Sprintf("%s-%d", prefix, m.counters[prefix])
+		issue.ID = idgen.FormatID(prefix, strconv.Itoa(m.counters[prefix]))
 	}
 
 	// Check for duplicate
@@ -265,7 +303,7 @@ func (m *MemoryStorage) CreateIssues(ctx context.Context, issues []*types.Issue,
 
 		if issue.ID == "" {
 			m.counters[prefix]++
-			issue.ID = fmt.Sprintf("%s-%d", prefix, m.counters[prefix])
+			issue.ID = idgen.FormatID(prefix, strconv.Itoa(m.counters[prefix]))
 		}
 
 		// Check for duplicates in existing issues
@@ -368,7 +406,7 @@ func (m *MemoryStorage) UpdateIssue(ctx context.Context, id string, updates map[
 
 	issue, exists := m.issues[id]
 	if !exists {
-		return fmt.Errorf("issue %s not found", id)
+		return fmt.Errorf("issue %s %w", id, storage.ErrNotFound)
 	}
 
 	now := time.Now()
@@ -443,6 +481,12 @@ func (m *MemoryStorage) UpdateIssue(ctx context.Context, id string, updates map[
 				}
 				issue.ExternalRef = nil
 			}
+		case "due_date":
+			if v, ok := value.(string); ok {
+				issue.DueDate = v
+			} else if value == nil {
+				issue.DueDate = ""
+			}
 		}
 	}
 
@@ -482,7 +526,7 @@ func (m *MemoryStorage) DeleteIssue(ctx context.Context, id string) error {
 	// Check if issue exists
 	issue, ok := m.issues[id]
 	if !ok {
-		return fmt.Errorf("issue not found: %s", id)
+		return fmt.Errorf("issue %s %w", id, storage.ErrNotFound)
 	}
 
 	// Remove external ref index entry
@@ -498,6 +542,7 @@ func (m *MemoryStorage) DeleteIssue(ctx context.Context, id string) error {
 	delete(m.labels, id)
 	delete(m.events, id)
 	delete(m.comments, id)
+	delete(m.references, id)
 	delete(m.dirty, id)
 
 	return nil
@@ -508,6 +553,14 @@ func (m *MemoryStorage) SearchIssues(ctx context.Context, query string, filter t
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	var whereExpr where.Expr
+	if filter.Where != "" {
+		var err error
+		if whereExpr, err = where.Parse(filter.Where); err != nil {
+			return nil, fmt.Errorf("invalid where expression: %w", err)
+		}
+	}
+
 	var results []*types.Issue
 
 	for _, issue := range m.issues {
@@ -524,6 +577,9 @@ func (m *MemoryStorage) SearchIssues(ctx context.Context, query string, filter t
 		if filter.Assignee != nil && issue.Assignee != *filter.Assignee {
 			continue
 		}
+		if filter.UpdatedSince != nil && issue.UpdatedAt.Before(*filter.UpdatedSince) {
+			continue
+		}
 
 		// Query search (title, description, or ID)
 		if query != "" {
@@ -585,6 +641,11 @@ func (m *MemoryStorage) SearchIssues(ctx context.Context, query string, filter t
 			}
 		}
 
+		// Expression filtering (bd list --where)
+		if whereExpr != nil && !where.Match(whereExpr, issue, m.labels[issue.ID]) {
+			continue
+		}
+
 		// Copy issue and attach metadata
 		issueCopy := *issue
 		if deps, ok := m.dependencies[issue.ID]; ok {
@@ -613,6 +674,44 @@ func (m *MemoryStorage) SearchIssues(ctx context.Context, query string, filter t
 	return results, nil
 }
 
+// ListIssues returns one page of issues matching filter, ordered by (created_at, id)
+func (m *MemoryStorage) ListIssues(ctx context.Context, filter types.IssueFilter, page types.PageRequest) (*types.IssuePage, error) {
+	filter.Limit = 0
+	results, err := m.SearchIssues(ctx, "", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if !results[i].CreatedAt.Equal(results[j].CreatedAt) {
+			return results[i].CreatedAt.Before(results[j].CreatedAt)
+		}
+		return results[i].ID < results[j].ID
+	})
+
+	if page.Cursor != "" {
+		createdAt, id, err := types.DecodeIssueCursor(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		start := sort.Search(len(results), func(i int) bool {
+			if !results[i].CreatedAt.Equal(createdAt) {
+				return results[i].CreatedAt.After(createdAt)
+			}
+			return results[i].ID > id
+		})
+		results = results[start:]
+	}
+
+	result := &types.IssuePage{Issues: results}
+	if page.Limit > 0 && len(results) > page.Limit {
+		result.Issues = results[:page.Limit]
+		last := result.Issues[page.Limit-1]
+		result.NextCursor = types.EncodeIssueCursor(last.CreatedAt, last.ID)
+	}
+	return result, nil
+}
+
 // AddDependency adds a dependency between issues
 func (m *MemoryStorage) AddDependency(ctx context.Context, dep *types.Dependency, actor string) error {
 	m.mu.Lock()
@@ -620,10 +719,10 @@ func (m *MemoryStorage) AddDependency(ctx context.Context, dep *types.Dependency
 
 	// Check that both issues exist
 	if _, exists := m.issues[dep.IssueID]; !exists {
-		return fmt.Errorf("issue %s not found", dep.IssueID)
+		return fmt.Errorf("issue %s %w", dep.IssueID, storage.ErrNotFound)
 	}
 	if _, exists := m.issues[dep.DependsOnID]; !exists {
-		return fmt.Errorf("issue %s not found", dep.DependsOnID)
+		return fmt.Errorf("issue %s %w", dep.DependsOnID, storage.ErrNotFound)
 	}
 
 	// Check for duplicates
@@ -833,7 +932,7 @@ func (m *MemoryStorage) AddLabel(ctx context.Context, issueID, label, actor stri
 
 	// Check if issue exists
 	if _, exists := m.issues[issueID]; !exists {
-		return fmt.Errorf("issue %s not found", issueID)
+		return fmt.Errorf("issue %s %w", issueID, storage.ErrNotFound)
 	}
 
 	// Check for duplicate
@@ -1017,6 +1116,9 @@ func (m *MemoryStorage) GetReadyWork(ctx context.Context, filter types.WorkFilte
 		if comments, ok := m.comments[issue.ID]; ok {
 			issueCopy.Comments = comments
 		}
+		if refs, ok := m.references[issue.ID]; ok {
+			issueCopy.References = refs
+		}
 
 		results = append(results, &issueCopy)
 	}
@@ -1033,10 +1135,26 @@ func (m *MemoryStorage) GetReadyWork(ctx context.Context, filter types.WorkFilte
 			return results[i].CreatedAt.Before(results[j].CreatedAt)
 		})
 	case types.SortPolicyPriority:
+		// Within a priority, unblock the most open work first
+		blocking := make(map[string]int)
+		for issueID, deps := range m.dependencies {
+			dependent, ok := m.issues[issueID]
+			if !ok || dependent.Status == types.StatusClosed || dependent.Status == types.StatusTombstone {
+				continue
+			}
+			for _, dep := range deps {
+				if dep.Type == types.DepBlocks {
+					blocking[dep.DependsOnID]++
+				}
+			}
+		}
 		sort.Slice(results, func(i, j int) bool {
 			if results[i].Priority != results[j].Priority {
 				return results[i].Priority < results[j].Priority
 			}
+			if bi, bj := blocking[results[i].ID], blocking[results[j].ID]; bi != bj {
+				return bi > bj
+			}
 			return results[i].CreatedAt.Before(results[j].CreatedAt)
 		})
 	case types.SortPolicyHybrid:
@@ -1130,6 +1248,9 @@ func (m *MemoryStorage) GetBlockedIssues(ctx context.Context) ([]*types.BlockedI
 		if comments, ok := m.comments[issue.ID]; ok {
 			issueCopy.Comments = comments
 		}
+		if refs, ok := m.references[issue.ID]; ok {
+			issueCopy.References = refs
+		}
 
 		results = append(results, &types.BlockedIssue{
 			Issue:          issueCopy,
@@ -1158,6 +1279,9 @@ func (m *MemoryStorage) GetStaleIssues(ctx context.Context, filter types.StaleFi
 	defer m.mu.RUnlock()
 
 	cutoff := time.Now().AddDate(0, 0, -filter.Days)
+	if filter.OlderThan > 0 {
+		cutoff = time.Now().Add(-filter.OlderThan)
+	}
 	var stale []*types.Issue
 
 	for _, issue := range m.issues {
@@ -1238,7 +1362,75 @@ func (m *MemoryStorage) GetCommentsForIssues(ctx context.Context, issueIDs []str
 	return result, nil
 }
 
+func (m *MemoryStorage) AddReference(ctx context.Context, issueID, url, label, actor string) error {
+	if err := types.ValidateReferenceURL(url); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.issues[issueID]; !exists {
+		return fmt.Errorf("issue %s %w", issueID, storage.ErrNotFound)
+	}
+	for _, ref := range m.references[issueID] {
+		if ref.URL == url {
+			ref.Label = label
+			m.dirty[issueID] = true
+			return nil
+		}
+	}
+	m.references[issueID] = append(m.references[issueID], &types.Reference{
+		IssueID:   issueID,
+		URL:       url,
+		Label:     label,
+		CreatedAt: time.Now(),
+	})
+	m.dirty[issueID] = true
+	return nil
+}
+
+func (m *MemoryStorage) RemoveReference(ctx context.Context, issueID, url, actor string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	refs := m.references[issueID]
+	for i, ref := range refs {
+		if ref.URL == url {
+			m.references[issueID] = append(refs[:i:i], refs[i+1:]...)
+			m.dirty[issueID] = true
+			break
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStorage) GetReferences(ctx context.Context, issueID string) ([]*types.Reference, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.references[issueID], nil
+}
+
+func (m *MemoryStorage) GetReferencesForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Reference, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make(map[string][]*types.Reference)
+	for _, issueID := range issueIDs {
+		if refs, exists := m.references[issueID]; exists {
+			result[issueID] = refs
+		}
+	}
+	return result, nil
+}
+
 func (m *MemoryStorage) GetStatistics(ctx context.Context) (*types.Statistics, error) {
+	customStatuses, err := m.GetCustomStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -1299,9 +1491,43 @@ func (m *MemoryStorage) GetStatistics(ctx context.Context) (*types.Statistics, e
 	// Calculate epics eligible for closure
 	stats.EpicsEligibleForClosure = m.countEpicsEligibleForClosure()
 
+	stats.ByStatus = m.countByStatus(customStatuses)
+
 	return stats, nil
 }
 
+// CountByStatus returns the number of issues in each status. Built-in workflow
+// statuses and configured custom statuses are always present.
+func (m *MemoryStorage) CountByStatus(ctx context.Context) (map[string]int, error) {
+	customStatuses, err := m.GetCustomStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.countByStatus(customStatuses), nil
+}
+
+// countByStatus tallies issues per status. Caller must hold m.mu.
+func (m *MemoryStorage) countByStatus(customStatuses []string) map[string]int {
+	counts := make(map[string]int)
+	for _, status := range []types.Status{
+		types.StatusOpen, types.StatusInProgress, types.StatusBlocked,
+		types.StatusDeferred, types.StatusClosed,
+	} {
+		counts[string(status)] = 0
+	}
+	for _, status := range customStatuses {
+		counts[status] = 0
+	}
+	for _, issue := range m.issues {
+		counts[string(issue.Status)]++
+	}
+	return counts
+}
+
 // countEpicsEligibleForClosure returns the count of non-closed epics where all children are closed
 func (m *MemoryStorage) countEpicsEligibleForClosure() int {
 	// Build a map of epic -> children using parent-child dependencies
@@ -1432,15 +1658,22 @@ func (m *MemoryStorage) GetAllConfig(ctx context.Context) (map[string]string, er
 }
 
 // GetCustomStatuses retrieves the list of custom status states from config.
+// Statuses named in the "statuses" list are included too.
 func (m *MemoryStorage) GetCustomStatuses(ctx context.Context) ([]string, error) {
-	value, err := m.GetConfig(ctx, "status.custom")
-	if err != nil {
-		return nil, err
-	}
-	if value == "" {
-		return nil, nil
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.customStatuses(), nil
+}
+
+// customStatuses returns status.custom plus the "statuses" list. Caller must hold m.mu.
+func (m *MemoryStorage) customStatuses() []string {
+	result := parseCustomStatuses(m.config["status.custom"])
+	for _, status := range parseCustomStatuses(m.config["statuses"]) {
+		if !slices.Contains(result, status) {
+			result = append(result, status)
+		}
 	}
-	return parseCustomStatuses(value), nil
+	return result
 }
 
 // parseCustomStatuses splits a comma-separated string into a slice of trimmed status names.
@@ -1213,3 +1213,38 @@ func TestGetIssueByExternalRefLoadFromIssues(t *testing.T) {
 		t.Errorf("Expected to find bd-2 by external ref jira#200")
 	}
 }
+
+func TestListIssuesCursor(t *testing.T) {
+	store := setupTestMemory(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	issues := []*types.Issue{
+		{ID: "bd-1", Title: "First", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, CreatedAt: base},
+		{ID: "bd-2", Title: "Second", Status: types.StatusOpen, Priority: 0, IssueType: types.TypeTask, CreatedAt: base.Add(time.Minute)},
+		{ID: "bd-3", Title: "Third", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask, CreatedAt: base.Add(time.Minute)},
+	}
+	if err := store.LoadFromIssues(issues); err != nil {
+		t.Fatalf("LoadFromIssues failed: %v", err)
+	}
+
+	first, err := store.ListIssues(ctx, types.IssueFilter{}, types.PageRequest{Limit: 2})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if len(first.Issues) != 2 || first.Issues[0].ID != "bd-1" || first.Issues[1].ID != "bd-2" {
+		t.Fatalf("first page = %v, want [bd-1 bd-2]", first.Issues)
+	}
+	if first.NextCursor == "" {
+		t.Fatal("expected a next cursor after the first page")
+	}
+
+	second, err := store.ListIssues(ctx, types.IssueFilter{}, types.PageRequest{Limit: 2, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if len(second.Issues) != 1 || second.Issues[0].ID != "bd-3" || second.NextCursor != "" {
+		t.Fatalf("second page = %v (cursor %q), want [bd-3] and no cursor", second.Issues, second.NextCursor)
+	}
+}
@@ -0,0 +1,274 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// Archive moves closed issues whose closed_at is before olderThan into the
+// archived_issues table and returns the IDs of the archived issues. With
+// dryRun set nothing is committed; the IDs are those that would be archived.
+//
+// An issue counts as closed if its status is closed or one of the
+// closed-statuses; the latter have no closed_at, so their updated_at is used
+// as the close time. Pinned issues are never archived. Each archived issue is
+// stored as a JSON snapshot including its labels, outgoing dependencies and
+// comments. Archived issues no longer appear in default queries or JSONL
+// export. Dependencies on archived issues from active issues are kept
+// (depends_on_id has no FK), and GetArchivedIssue resolves those references.
+// Events are kept too, so the audit trail of an archived issue survives.
+func (s *SQLiteStorage) Archive(ctx context.Context, olderThan time.Time, dryRun bool) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id FROM issues
+		WHERE (status = ? OR `+inClosedStatuses("status")+`)
+		  AND COALESCE(closed_at, updated_at) < ?
+		  AND COALESCE(pinned, 0) = 0
+		ORDER BY COALESCE(closed_at, updated_at) ASC
+	`, types.StatusClosed, olderThan)
+	if err != nil {
+		return nil, wrapDBError("query archive candidates", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, wrapDBError("scan archive candidate", err)
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError("iterate archive candidates", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// Build snapshots before opening the write transaction
+	snapshots := make(map[string][]byte, len(ids))
+	closedAts := make(map[string]*time.Time, len(ids))
+	for _, id := range ids {
+		issue, err := s.GetIssue(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s for archival: %w", id, err)
+		}
+		if issue == nil {
+			continue
+		}
+		deps, err := s.GetDependencyRecords(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load dependencies of %s: %w", id, err)
+		}
+		issue.Dependencies = deps
+		comments, err := s.GetIssueComments(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load comments of %s: %w", id, err)
+		}
+		issue.Comments = comments
+		refs, err := s.GetReferences(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load references of %s: %w", id, err)
+		}
+		issue.References = refs
+
+		data, err := json.Marshal(issue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize %s: %w", id, err)
+		}
+		snapshots[id] = data
+		closedAt := issue.ClosedAt
+		if closedAt == nil {
+			closedAt = &issue.UpdatedAt
+		}
+		closedAts[id] = closedAt
+	}
+
+	var archived []string
+	err = s.withDryRunTx(ctx, dryRun, func(tx *sql.Tx) error {
+		archived = nil // reset if the transaction is retried
+		for _, id := range ids {
+			data, ok := snapshots[id]
+			if !ok {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, `
+				INSERT OR REPLACE INTO archived_issues (id, closed_at, archived_at, data)
+				VALUES (?, ?, ?, ?)
+			`, id, closedAts[id], time.Now().UTC(), string(data)); err != nil {
+				return wrapDBErrorf(err, "archive %s", id)
+			}
+
+			// Incoming dependencies (depends_on_id = id) are intentionally kept
+			for _, stmt := range []string{
+				`DELETE FROM dependencies WHERE issue_id = ?`,
+				`DELETE FROM labels WHERE issue_id = ?`,
+				`DELETE FROM comments WHERE issue_id = ?`,
+				`DELETE FROM dirty_issues WHERE issue_id = ?`,
+				`DELETE FROM export_hashes WHERE issue_id = ?`,
+				`DELETE FROM issues WHERE id = ?`,
+			} {
+				if _, err := tx.ExecContext(ctx, stmt, id); err != nil {
+					return wrapDBErrorf(err, "remove %s after archival", id)
+				}
+			}
+			archived = append(archived, id)
+		}
+		return s.invalidateBlockedCache(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return archived, nil
+}
+
+// Unarchive moves an archived issue back into the issues table, together
+// with the labels, outgoing dependencies, comments and references saved in
+// its snapshot, and returns the restored issue.
+func (s *SQLiteStorage) Unarchive(ctx context.Context, id string, actor string) (*types.Issue, error) {
+	issue, err := s.GetArchivedIssue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("%w: archived issue %s", ErrNotFound, id)
+	}
+
+	err = s.withTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM issues WHERE id = ?)`, id).Scan(&exists); err != nil {
+			return wrapDBErrorf(err, "check issue %s", id)
+		}
+		if exists {
+			return fmt.Errorf("%w: cannot unarchive %s, an issue with that ID exists", ErrConflict, id)
+		}
+
+		if err := insertIssue(ctx, tx, issue); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", id, err)
+		}
+		for _, label := range issue.Labels {
+			if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO labels (issue_id, label) VALUES (?, ?)`, id, label); err != nil {
+				return wrapDBErrorf(err, "restore label %s of %s", label, id)
+			}
+		}
+		for _, dep := range issue.Dependencies {
+			if _, err := tx.ExecContext(ctx, `
+				INSERT OR IGNORE INTO dependencies (issue_id, depends_on_id, type, created_at, created_by, metadata, thread_id)
+				VALUES (?, ?, ?, ?, ?, ?, ?)
+			`, id, dep.DependsOnID, dep.Type, dep.CreatedAt, dep.CreatedBy, dep.Metadata, dep.ThreadID); err != nil {
+				return wrapDBErrorf(err, "restore dependency %s of %s", dep.DependsOnID, id)
+			}
+		}
+		for _, comment := range issue.Comments {
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO comments (issue_id, author, text, created_at)
+				VALUES (?, ?, ?, ?)
+			`, id, comment.Author, comment.Text, comment.CreatedAt); err != nil {
+				return wrapDBErrorf(err, "restore comment of %s", id)
+			}
+		}
+		for _, ref := range issue.References {
+			if _, err := tx.ExecContext(ctx, `
+				INSERT OR IGNORE INTO issue_references (issue_id, url, label, created_at)
+				VALUES (?, ?, ?, ?)
+			`, id, ref.URL, ref.Label, ref.CreatedAt); err != nil {
+				return wrapDBErrorf(err, "restore reference %s of %s", ref.URL, id)
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM archived_issues WHERE id = ?`, id); err != nil {
+			return wrapDBErrorf(err, "remove %s from the archive", id)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO events (issue_id, event_type, actor, comment)
+			VALUES (?, ?, ?, ?)
+		`, id, "restored", actor, "restored from archive"); err != nil {
+			return wrapDBErrorf(err, "record unarchive event for %s", id)
+		}
+		if err := markIssuesDirtyTx(ctx, tx, []string{id}); err != nil {
+			return err
+		}
+		return s.invalidateBlockedCache(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// GetArchivedIssue returns the archived snapshot of an issue, or nil if the
+// issue is not archived.
+func (s *SQLiteStorage) GetArchivedIssue(ctx context.Context, id string) (*types.Issue, error) {
+	var data string
+	err := s.db.QueryRowContext(ctx, `SELECT data FROM archived_issues WHERE id = ?`, id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, wrapDBErrorf(err, "get archived issue %s", id)
+	}
+
+	var issue types.Issue
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		return nil, fmt.Errorf("failed to parse archived issue %s: %w", id, err)
+	}
+	return &issue, nil
+}
+
+// ListArchivedIssues returns all archived issues, most recently closed first.
+func (s *SQLiteStorage) ListArchivedIssues(ctx context.Context) ([]*types.Issue, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT data FROM archived_issues ORDER BY closed_at DESC, id ASC`)
+	if err != nil {
+		return nil, wrapDBError("list archived issues", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var issues []*types.Issue
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, wrapDBError("scan archived issue", err)
+		}
+		var issue types.Issue
+		if err := json.Unmarshal([]byte(data), &issue); err != nil {
+			return nil, fmt.Errorf("failed to parse archived issue: %w", err)
+		}
+		issues = append(issues, &issue)
+	}
+	return issues, rows.Err()
+}
+
+// IsArchived reports whether an issue ID has been moved to the archive.
+func (s *SQLiteStorage) IsArchived(ctx context.Context, id string) (bool, error) {
+	var count int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM archived_issues WHERE id = ?`, id).Scan(&count); err != nil {
+		return false, wrapDBErrorf(err, "check archive for %s", id)
+	}
+	return count > 0, nil
+}
+
+// ArchivedIssueIDs returns the set of archived issue IDs. The importer uses it
+// to avoid resurrecting archived issues from a stale JSONL file.
+func (s *SQLiteStorage) ArchivedIssueIDs(ctx context.Context) (map[string]bool, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id FROM archived_issues`)
+	if err != nil {
+		return nil, wrapDBError("list archived issue ids", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	ids := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, wrapDBError("scan archived issue id", err)
+		}
+		ids[id] = true
+	}
+	return ids, rows.Err()
+}
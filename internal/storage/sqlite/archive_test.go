@@ -0,0 +1,279 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestArchive(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	old := &types.Issue{
+		ID:        "bd-old",
+		Title:     "Closed long ago",
+		Status:    types.StatusClosed,
+		Priority:  2,
+		IssueType: types.TypeTask,
+		ClosedAt:  timePtr(time.Now().Add(-100 * 24 * time.Hour)),
+	}
+	recent := &types.Issue{
+		ID:        "bd-recent",
+		Title:     "Closed yesterday",
+		Status:    types.StatusClosed,
+		Priority:  2,
+		IssueType: types.TypeTask,
+		ClosedAt:  timePtr(time.Now().Add(-24 * time.Hour)),
+	}
+	active := &types.Issue{
+		ID:        "bd-active",
+		Title:     "Still open",
+		Status:    types.StatusOpen,
+		Priority:  1,
+		IssueType: types.TypeTask,
+	}
+	for _, issue := range []*types.Issue{old, recent, active} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) failed: %v", issue.ID, err)
+		}
+	}
+	if err := store.AddLabel(ctx, old.ID, "legacy", "test"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	dep := &types.Dependency{IssueID: active.ID, DependsOnID: old.ID, Type: types.DepBlocks}
+	if err := store.AddDependency(ctx, dep, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+
+	// A dry run reports the candidates and changes nothing
+	ids, err := store.Archive(ctx, time.Now().Add(-30*24*time.Hour), true)
+	if err != nil {
+		t.Fatalf("Archive dry run failed: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != old.ID {
+		t.Fatalf("Archive dry run = %v, want [%s]", ids, old.ID)
+	}
+	if ok, _ := store.IsArchived(ctx, old.ID); ok {
+		t.Fatalf("dry run archived %s", old.ID)
+	}
+	if issue, _ := store.GetIssue(ctx, old.ID); issue == nil {
+		t.Fatalf("dry run removed %s", old.ID)
+	}
+	if labels, _ := store.GetLabels(ctx, old.ID); len(labels) == 0 {
+		t.Fatalf("dry run removed the labels of %s", old.ID)
+	}
+
+	ids, err = store.Archive(ctx, time.Now().Add(-30*24*time.Hour), false)
+	if err != nil {
+		t.Fatalf("Archive failed: %v", err)
+	}
+	if len(ids) != 1 {
+		t.Fatalf("Archive archived %v, want 1 issue", ids)
+	}
+
+	// Archived issue is gone from default queries
+	if got, err := store.GetIssue(ctx, old.ID); err != nil || got != nil {
+		t.Fatalf("GetIssue(%s) = %v, %v; want nil, nil", old.ID, got, err)
+	}
+	issues, err := store.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		t.Fatalf("SearchIssues failed: %v", err)
+	}
+	for _, issue := range issues {
+		if issue.ID == old.ID {
+			t.Fatalf("archived issue %s still returned by SearchIssues", old.ID)
+		}
+	}
+	if got, _ := store.GetIssue(ctx, recent.ID); got == nil {
+		t.Fatalf("recently closed issue %s should not be archived", recent.ID)
+	}
+
+	// Dependencies on the archived issue still resolve
+	deps, err := store.GetDependencyRecords(ctx, active.ID)
+	if err != nil {
+		t.Fatalf("GetDependencyRecords failed: %v", err)
+	}
+	if len(deps) != 1 || deps[0].DependsOnID != old.ID {
+		t.Fatalf("dependency on archived issue lost: %+v", deps)
+	}
+	archived, err := store.GetArchivedIssue(ctx, deps[0].DependsOnID)
+	if err != nil {
+		t.Fatalf("GetArchivedIssue failed: %v", err)
+	}
+	if archived == nil || archived.Title != old.Title {
+		t.Fatalf("GetArchivedIssue = %+v, want title %q", archived, old.Title)
+	}
+	if len(archived.Labels) != 1 || archived.Labels[0] != "legacy" {
+		t.Errorf("archived labels = %v, want [legacy]", archived.Labels)
+	}
+
+	// The archived blocker is closed, so it must not block the active issue
+	ready, err := store.GetReadyWork(ctx, types.WorkFilter{})
+	if err != nil {
+		t.Fatalf("GetReadyWork failed: %v", err)
+	}
+	found := false
+	for _, issue := range ready {
+		if issue.ID == active.ID {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("%s should be ready; an archived blocker is closed", active.ID)
+	}
+
+	list, err := store.ListArchivedIssues(ctx)
+	if err != nil {
+		t.Fatalf("ListArchivedIssues failed: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != old.ID {
+		t.Fatalf("ListArchivedIssues = %v, want [%s]", list, old.ID)
+	}
+	if ok, _ := store.IsArchived(ctx, old.ID); !ok {
+		t.Errorf("IsArchived(%s) = false, want true", old.ID)
+	}
+
+	// Archiving again is a no-op
+	ids, err = store.Archive(ctx, time.Now().Add(-30*24*time.Hour), false)
+	if err != nil {
+		t.Fatalf("second Archive failed: %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("second Archive archived %v, want none", ids)
+	}
+}
+
+func TestArchiveClosedStatusesAndEvents(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	if err := store.SetConfig(ctx, StatusesConfigKey, "open,in_progress,closed,done"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	if err := store.SetConfig(ctx, ClosedStatusesConfigKey, "done"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+
+	longAgo := time.Now().Add(-100 * 24 * time.Hour)
+	done := &types.Issue{
+		ID:        "bd-done",
+		Title:     "Done long ago",
+		Status:    "done",
+		Priority:  2,
+		IssueType: types.TypeTask,
+		CreatedAt: longAgo,
+		UpdatedAt: longAgo,
+	}
+	closed := &types.Issue{
+		ID:        "bd-closed",
+		Title:     "Closed long ago",
+		Status:    types.StatusClosed,
+		Priority:  2,
+		IssueType: types.TypeTask,
+		ClosedAt:  timePtr(longAgo),
+	}
+	for _, issue := range []*types.Issue{done, closed} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) failed: %v", issue.ID, err)
+		}
+	}
+
+	ids, err := store.Archive(ctx, time.Now().Add(-30*24*time.Hour), false)
+	if err != nil {
+		t.Fatalf("Archive failed: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("Archive archived %v, want both issues in a closed status", ids)
+	}
+
+	// The audit trail outlives the issue row
+	for _, id := range ids {
+		events, err := store.GetEvents(ctx, id, 0)
+		if err != nil {
+			t.Fatalf("GetEvents(%s) failed: %v", id, err)
+		}
+		if len(events) == 0 {
+			t.Errorf("events of archived issue %s were deleted", id)
+		}
+	}
+}
+
+func TestUnarchiveRestoresSnapshot(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	old := &types.Issue{
+		ID:        "bd-old",
+		Title:     "Closed long ago",
+		Status:    types.StatusClosed,
+		Priority:  2,
+		IssueType: types.TypeTask,
+		ClosedAt:  timePtr(time.Now().Add(-100 * 24 * time.Hour)),
+	}
+	other := &types.Issue{ID: "bd-other", Title: "Other", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	for _, issue := range []*types.Issue{old, other} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) failed: %v", issue.ID, err)
+		}
+	}
+	if err := store.AddLabel(ctx, old.ID, "legacy", "test"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	if err := store.AddDependency(ctx, &types.Dependency{IssueID: old.ID, DependsOnID: other.ID, Type: types.DepRelated}, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+	if _, err := store.AddIssueComment(ctx, old.ID, "alice", "a comment"); err != nil {
+		t.Fatalf("AddIssueComment failed: %v", err)
+	}
+	if err := store.AddReference(ctx, old.ID, "https://example.com/pr/1", "PR", "test"); err != nil {
+		t.Fatalf("AddReference failed: %v", err)
+	}
+
+	if _, err := store.Archive(ctx, time.Now().Add(-30*24*time.Hour), false); err != nil {
+		t.Fatalf("Archive failed: %v", err)
+	}
+	archived, err := store.GetArchivedIssue(ctx, old.ID)
+	if err != nil || archived == nil {
+		t.Fatalf("GetArchivedIssue = %v, %v", archived, err)
+	}
+	if len(archived.References) != 1 || archived.References[0].URL != "https://example.com/pr/1" {
+		t.Fatalf("archived references = %+v, want the PR link", archived.References)
+	}
+
+	if _, err := store.Unarchive(ctx, old.ID, "test"); err != nil {
+		t.Fatalf("Unarchive failed: %v", err)
+	}
+	if ok, _ := store.IsArchived(ctx, old.ID); ok {
+		t.Errorf("%s still archived after Unarchive", old.ID)
+	}
+	if got, _ := store.GetIssue(ctx, old.ID); got == nil || got.Title != old.Title {
+		t.Fatalf("GetIssue after Unarchive = %+v", got)
+	}
+	if labels, _ := store.GetLabels(ctx, old.ID); len(labels) != 1 || labels[0] != "legacy" {
+		t.Errorf("labels after Unarchive = %v, want [legacy]", labels)
+	}
+	if deps, _ := store.GetDependencyRecords(ctx, old.ID); len(deps) != 1 || deps[0].DependsOnID != other.ID {
+		t.Errorf("dependencies after Unarchive = %+v", deps)
+	}
+	if comments, _ := store.GetIssueComments(ctx, old.ID); len(comments) != 1 || comments[0].Text != "a comment" {
+		t.Errorf("comments after Unarchive = %+v", comments)
+	}
+	refs, err := store.GetReferences(ctx, old.ID)
+	if err != nil {
+		t.Fatalf("GetReferences failed: %v", err)
+	}
+	if len(refs) != 1 || refs[0].Label != "PR" {
+		t.Errorf("references after Unarchive = %+v, want the PR link", refs)
+	}
+
+	if _, err := store.Unarchive(ctx, old.ID, "test"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("second Unarchive error = %v, want ErrNotFound", err)
+	}
+}
@@ -143,7 +143,7 @@ func checkForExistingIDs(ctx context.Context, conn *sql.Conn, issues []*types.Is
 		if issue.ID != "" {
 			// Check for duplicates within the batch
 			if seenIDs[issue.ID] {
-				return fmt.Errorf("duplicate issue ID within batch: %s", issue.ID)
+				return fmt.Errorf("%w: duplicate issue ID within batch: %s", ErrConflict, issue.ID)
 			}
 			seenIDs[issue.ID] = true
 			ids = append(ids, issue.ID)
@@ -167,7 +167,7 @@ func checkForExistingIDs(ctx context.Context, conn *sql.Conn, issues []*types.Is
 	err := conn.QueryRowContext(ctx, query, args...).Scan(&existingID)
 	if err == nil {
 		// Found an existing ID
-		return fmt.Errorf("issue ID %s already exists", existingID)
+		return fmt.Errorf("%w: issue ID %s already exists", ErrConflict, existingID)
 	}
 	if err != sql.ErrNoRows {
 		// Unexpected error
@@ -250,14 +250,7 @@ func (s *SQLiteStorage) CreateIssuesWithFullOptions(ctx context.Context, issues
 		return nil
 	}
 
-	// Fetch custom statuses for validation (bd-1pj6)
-	customStatuses, err := s.GetCustomStatuses(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get custom statuses: %w", err)
-	}
-
-	// Phase 1: Validate all issues first (fail-fast, with custom status support)
-	if err := validateBatchIssuesWithCustomStatuses(issues, customStatuses); err != nil {
+	if err := s.prepareBatchIssues(ctx, issues); err != nil {
 		return err
 	}
 
@@ -269,7 +262,7 @@ func (s *SQLiteStorage) CreateIssuesWithFullOptions(ctx context.Context, issues
 	defer func() { _ = conn.Close() }()
 
 	// Use retry logic with exponential backoff to handle SQLITE_BUSY under concurrent load (bd-ola6)
-	if err := beginImmediateWithRetry(ctx, conn, 5, 10*time.Millisecond); err != nil {
+	if err := beginImmediateWithRetry(ctx, conn, s.writeMaxRetries, 10*time.Millisecond); err != nil {
 		return fmt.Errorf("failed to begin immediate transaction: %w", err)
 	}
 
@@ -280,6 +273,49 @@ func (s *SQLiteStorage) CreateIssuesWithFullOptions(ctx context.Context, issues
 		}
 	}()
 
+	if err := s.insertBatchIssues(ctx, conn, issues, actor, opts); err != nil {
+		return err
+	}
+
+	// Phase 7: Commit transaction
+	if _, err := conn.ExecContext(ctx, "COMMIT"); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+	return nil
+}
+
+// prepareBatchIssues fills in default statuses and validates issues before a
+// batch insert. It reads through the connection pool, so call it before
+// starting the transaction.
+func (s *SQLiteStorage) prepareBatchIssues(ctx context.Context, issues []*types.Issue) error {
+	// Fetch custom statuses for validation (bd-1pj6)
+	customStatuses, err := s.GetCustomStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get custom statuses: %w", err)
+	}
+
+	// New issues without a status start in the configured default
+	var defaultStatus types.Status
+	for _, issue := range issues {
+		if issue == nil || issue.Status != "" {
+			continue
+		}
+		if defaultStatus == "" {
+			if defaultStatus, err = s.defaultStatus(ctx, customStatuses); err != nil {
+				return err
+			}
+		}
+		issue.Status = defaultStatus
+	}
+
+	// Phase 1: Validate all issues first (fail-fast, with custom status support)
+	return validateBatchIssuesWithCustomStatuses(issues, customStatuses)
+}
+
+// insertBatchIssues assigns IDs to issues and inserts them, with their
+// creation events and dirty marks, inside the transaction open on conn.
+func (s *SQLiteStorage) insertBatchIssues(ctx context.Context, conn *sql.Conn, issues []*types.Issue, actor string, opts BatchCreateOptions) error {
 	// Phase 3: Generate IDs for issues that need them
 	if err := s.generateBatchIDs(ctx, conn, issues, actor, opts.OrphanHandling, opts.SkipPrefixValidation); err != nil {
 		return wrapDBError("generate batch IDs", err)
@@ -310,11 +346,5 @@ func (s *SQLiteStorage) CreateIssuesWithFullOptions(ctx context.Context, issues
 	if err := bulkMarkDirty(ctx, conn, issues); err != nil {
 		return wrapDBError("mark issues dirty", err)
 	}
-
-	// Phase 7: Commit transaction
-	if _, err := conn.ExecContext(ctx, "COMMIT"); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	committed = true
 	return nil
 }
@@ -126,7 +126,8 @@ func (s *SQLiteStorage) rebuildBlockedCache(ctx context.Context, exec execer) er
 	// lazily at query time by GetReadyWork (bd-zmmy supersedes bd-om4a)
 	//
 	// Handles four blocking types:
-	// - 'blocks': B is blocked until A is closed (any close reason)
+	// - 'blocks': B is blocked until A is closed (any close reason) or in one
+	//   of the closed-statuses
 	// - 'conditional-blocks': B is blocked until A is closed with failure (bd-kzda)
 	// - 'waits-for': B is blocked until all children of spawner A are closed (bd-xo1o.2)
 	// - 'parent-child': Propagates blockage to children
@@ -146,7 +147,8 @@ func (s *SQLiteStorage) rebuildBlockedCache(ctx context.Context, exec execer) er
 		    FROM dependencies d
 		    JOIN issues blocker ON d.depends_on_id = blocker.id
 		    WHERE d.type = 'blocks'
-		      AND blocker.status IN ('open', 'in_progress', 'blocked', 'deferred')
+		      AND blocker.status NOT IN ('closed', 'tombstone', 'pinned')
+		      AND NOT ` + inClosedStatuses("blocker.status") + `
 
 		    UNION
 
@@ -160,7 +162,8 @@ func (s *SQLiteStorage) rebuildBlockedCache(ctx context.Context, exec execer) er
 		    WHERE d.type = 'conditional-blocks'
 		      AND (
 		        -- A is not closed: B stays blocked
-		        blocker.status IN ('open', 'in_progress', 'blocked', 'deferred')
+		        (blocker.status NOT IN ('closed', 'tombstone', 'pinned')
+		          AND NOT ` + inClosedStatuses("blocker.status") + `)
 		        OR
 		        -- A is closed but NOT with a failure: B stays blocked (condition not met)
 		        (blocker.status = 'closed' AND NOT (
@@ -199,6 +202,7 @@ func (s *SQLiteStorage) rebuildBlockedCache(ctx context.Context, exec execer) er
 		              d.depends_on_id
 		            )
 		            AND child.status NOT IN ('closed', 'tombstone')
+		            AND NOT ` + inClosedStatuses("child.status") + `
 		        )
 		        OR
 		        -- Alternative gate: "any-children" - blocked until ANY child closes
@@ -211,7 +215,7 @@ func (s *SQLiteStorage) rebuildBlockedCache(ctx context.Context, exec execer) er
 		              json_extract(d.metadata, '$.spawner_id'),
 		              d.depends_on_id
 		            )
-		            AND child.status IN ('closed', 'tombstone')
+		            AND (child.status IN ('closed', 'tombstone') OR ` + inClosedStatuses("child.status") + `)
 		        )
 		      )
 		  ),
@@ -241,6 +245,14 @@ func (s *SQLiteStorage) rebuildBlockedCache(ctx context.Context, exec execer) er
 	return nil
 }
 
+// inClosedStatuses returns an SQL condition that is true when column holds one
+// of the statuses listed in the closed-statuses config key. The list is read
+// inline so the rebuild works on whatever connection or transaction exec is.
+func inClosedStatuses(column string) string {
+	return `(instr(COALESCE((SELECT ',' || REPLACE(value, ' ', '') || ',' FROM config WHERE key = '` +
+		ClosedStatusesConfigKey + `'), ''), ',' || ` + column + ` || ',') > 0)`
+}
+
 // invalidateBlockedCache rebuilds the blocked issues cache
 // Called when dependencies change or issue status changes
 func (s *SQLiteStorage) invalidateBlockedCache(ctx context.Context, exec execer) error {
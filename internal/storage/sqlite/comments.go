@@ -16,7 +16,7 @@ func (s *SQLiteStorage) AddIssueComment(ctx context.Context, issueID, author, te
 		return nil, fmt.Errorf("failed to check issue existence: %w", err)
 	}
 	if !exists {
-		return nil, fmt.Errorf("issue %s not found", issueID)
+		return nil, fmt.Errorf("issue %s %w", issueID, ErrNotFound)
 	}
 
 	// Insert comment
@@ -64,7 +64,7 @@ func (s *SQLiteStorage) ImportIssueComment(ctx context.Context, issueID, author,
 		return nil, fmt.Errorf("failed to check issue existence: %w", err)
 	}
 	if !exists {
-		return nil, fmt.Errorf("issue %s not found", issueID)
+		return nil, fmt.Errorf("issue %s %w", issueID, ErrNotFound)
 	}
 
 	// Insert comment with provided timestamp
@@ -300,7 +300,7 @@ func (s *SQLiteStorage) ApplyCompaction(ctx context.Context, issueID string, lev
 			return fmt.Errorf("failed to get rows affected: %w", err)
 		}
 		if rows == 0 {
-			return fmt.Errorf("issue %s not found", issueID)
+			return fmt.Errorf("issue %s %w", issueID, ErrNotFound)
 		}
 		
 		reductionPct := 0.0
@@ -3,7 +3,11 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
 	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // SetConfig sets a configuration value
@@ -12,7 +16,14 @@ func (s *SQLiteStorage) SetConfig(ctx context.Context, key, value string) error
 		INSERT INTO config (key, value) VALUES (?, ?)
 		ON CONFLICT (key) DO UPDATE SET value = excluded.value
 	`, key, value)
-	return wrapDBError("set config", err)
+	if err != nil {
+		return wrapDBError("set config", err)
+	}
+	// Which statuses count as done decides what is blocked
+	if key == ClosedStatusesConfigKey {
+		return s.invalidateBlockedCache(ctx, nil)
+	}
+	return nil
 }
 
 // GetConfig gets a configuration value
@@ -47,7 +58,13 @@ func (s *SQLiteStorage) GetAllConfig(ctx context.Context) (map[string]string, er
 // DeleteConfig deletes a configuration value
 func (s *SQLiteStorage) DeleteConfig(ctx context.Context, key string) error {
 	_, err := s.db.ExecContext(ctx, `DELETE FROM config WHERE key = ?`, key)
-	return wrapDBError("delete config", err)
+	if err != nil {
+		return wrapDBError("delete config", err)
+	}
+	if key == ClosedStatusesConfigKey {
+		return s.invalidateBlockedCache(ctx, nil)
+	}
+	return nil
 }
 
 // OrphanHandling defines how to handle orphan issues during import
@@ -98,20 +115,116 @@ func (s *SQLiteStorage) GetMetadata(ctx context.Context, key string) (string, er
 // CustomStatusConfigKey is the config key for custom status states
 const CustomStatusConfigKey = "status.custom"
 
+// DefaultStatusConfigKey is the config key for the status new issues start in
+const DefaultStatusConfigKey = "default-status"
+
+// StatusesConfigKey is the config key for the complete, ordered list of
+// statuses a project allows. Unset means the built-in statuses plus
+// status.custom.
+const StatusesConfigKey = "statuses"
+
+// ClosedStatusesConfigKey is the config key for statuses that count as done:
+// issues in them no longer block their dependents.
+const ClosedStatusesConfigKey = "closed-statuses"
+
+// AssigneesConfigKey is the config key for the comma-separated list of people
+// issues may be assigned to. Unset accepts any assignee.
+const AssigneesConfigKey = "assignees"
+
+// AssigneeCaseInsensitiveConfigKey is the config key that makes assignees
+// match regardless of case ("true" or "false", default false).
+const AssigneeCaseInsensitiveConfigKey = "assignee-case-insensitive"
+
+// defaultStatus returns the status for new issues created without one.
+func (s *SQLiteStorage) defaultStatus(ctx context.Context, customStatuses []string) (types.Status, error) {
+	value, err := s.GetConfig(ctx, DefaultStatusConfigKey)
+	if err != nil {
+		return "", err
+	}
+	return types.DefaultStatus(value, customStatuses)
+}
+
+// normalizeAssignee applies the assignees and assignee-case-insensitive config
+// to assignee. See types.NormalizeAssignee.
+func (s *SQLiteStorage) normalizeAssignee(ctx context.Context, assignee string) (string, error) {
+	return normalizeAssigneeWithConfig(ctx, s.GetConfig, assignee)
+}
+
+// normalizeAssigneeWithConfig is normalizeAssignee for any config reader, so
+// transactions can share it.
+func normalizeAssigneeWithConfig(ctx context.Context, getConfig func(context.Context, string) (string, error), assignee string) (string, error) {
+	if assignee == "" {
+		return "", nil
+	}
+	allowed, err := getConfig(ctx, AssigneesConfigKey)
+	if err != nil {
+		return "", err
+	}
+	caseInsensitive, err := assigneeCaseInsensitive(ctx, getConfig)
+	if err != nil {
+		return "", err
+	}
+	return types.NormalizeAssignee(assignee, parseCustomStatuses(allowed), caseInsensitive)
+}
+
+// assigneeCaseInsensitive reads the assignee-case-insensitive config key.
+func assigneeCaseInsensitive(ctx context.Context, getConfig func(context.Context, string) (string, error)) (bool, error) {
+	value, err := getConfig(ctx, AssigneeCaseInsensitiveConfigKey)
+	if err != nil || value == "" {
+		return false, err
+	}
+	caseInsensitive, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s config %q: must be true or false", AssigneeCaseInsensitiveConfigKey, value)
+	}
+	return caseInsensitive, nil
+}
+
 // GetCustomStatuses retrieves the list of custom status states from config.
 // Custom statuses are stored as comma-separated values in the "status.custom" config key.
+// Statuses named in the "statuses" list are included too, so a project can
+// define its workflow with that key alone.
 // Returns an empty slice if no custom statuses are configured.
 func (s *SQLiteStorage) GetCustomStatuses(ctx context.Context) ([]string, error) {
 	value, err := s.GetConfig(ctx, CustomStatusConfigKey)
 	if err != nil {
 		return nil, err
 	}
-	if value == "" {
-		return nil, nil
+	allowed, err := s.GetAllowedStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mergeStatusLists(parseCustomStatuses(value), allowed), nil
+}
+
+// GetAllowedStatuses returns the "statuses" config list, or nil if the
+// project does not restrict its statuses.
+func (s *SQLiteStorage) GetAllowedStatuses(ctx context.Context) ([]string, error) {
+	value, err := s.GetConfig(ctx, StatusesConfigKey)
+	if err != nil {
+		return nil, err
 	}
 	return parseCustomStatuses(value), nil
 }
 
+// mergeStatusLists returns a followed by the entries of b not already in a.
+func mergeStatusLists(a, b []string) []string {
+	if len(b) == 0 {
+		return a
+	}
+	seen := make(map[string]bool, len(a))
+	for _, status := range a {
+		seen[status] = true
+	}
+	for _, status := range b {
+		if !seen[status] {
+			a = append(a, status)
+			seen[status] = true
+		}
+	}
+	return a
+}
+
 // parseCustomStatuses splits a comma-separated string into a slice of trimmed status names.
 // Empty entries are filtered out.
 func parseCustomStatuses(value string) []string {
@@ -0,0 +1,237 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDefaultStatus(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	newIssue := func(title string) *types.Issue {
+		return &types.Issue{Title: title, Priority: 2, IssueType: types.TypeTask}
+	}
+
+	unset := newIssue("No default configured")
+	if err := store.CreateIssue(ctx, unset, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if unset.Status != types.StatusOpen {
+		t.Errorf("status = %q, want %q when default-status is unset", unset.Status, types.StatusOpen)
+	}
+
+	if err := store.SetConfig(ctx, CustomStatusConfigKey, "backlog"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	if err := store.SetConfig(ctx, DefaultStatusConfigKey, "backlog"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+
+	configured := newIssue("Starts in backlog")
+	if err := store.CreateIssue(ctx, configured, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	got, err := store.GetIssue(ctx, configured.ID)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if got.Status != "backlog" {
+		t.Errorf("status = %q, want backlog", got.Status)
+	}
+
+	explicit := newIssue("Explicit status wins")
+	explicit.Status = types.StatusInProgress
+	if err := store.CreateIssue(ctx, explicit, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if explicit.Status != types.StatusInProgress {
+		t.Errorf("status = %q, want %q", explicit.Status, types.StatusInProgress)
+	}
+
+	batch := []*types.Issue{newIssue("Batch one"), newIssue("Batch two")}
+	if err := store.CreateIssues(ctx, batch, "test"); err != nil {
+		t.Fatalf("CreateIssues failed: %v", err)
+	}
+	for _, issue := range batch {
+		if issue.Status != "backlog" {
+			t.Errorf("batch issue status = %q, want backlog", issue.Status)
+		}
+	}
+
+	// A default that is not a known status is rejected at create time
+	if err := store.SetConfig(ctx, DefaultStatusConfigKey, "someday"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	err = store.CreateIssue(ctx, newIssue("Bad default"), "test")
+	if err == nil || !strings.Contains(err.Error(), "default-status") {
+		t.Errorf("expected invalid default-status error, got %v", err)
+	}
+}
+
+func TestAllowedStatuses(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	newIssue := func(title string, status types.Status) *types.Issue {
+		return &types.Issue{Title: title, Status: status, Priority: 2, IssueType: types.TypeTask}
+	}
+
+	if err := store.SetConfig(ctx, StatusesConfigKey, "triage, open, in_progress, done"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+
+	// Listed statuses are usable without also being in status.custom
+	triaged := newIssue("Needs triage", "triage")
+	if err := store.CreateIssue(ctx, triaged, "test"); err != nil {
+		t.Fatalf("CreateIssue with listed custom status failed: %v", err)
+	}
+
+	if err := store.CreateIssue(ctx, newIssue("Blocked", types.StatusBlocked), "test"); err == nil {
+		t.Error("expected CreateIssue to reject a built-in status missing from statuses")
+	}
+	if err := store.UpdateIssue(ctx, triaged.ID, map[string]interface{}{"status": string(types.StatusDeferred)}, "test"); err == nil {
+		t.Error("expected UpdateIssue to reject a status missing from statuses")
+	}
+	if err := store.UpdateIssue(ctx, triaged.ID, map[string]interface{}{"status": "done"}, "test"); err != nil {
+		t.Errorf("UpdateIssue to listed status failed: %v", err)
+	}
+	if err := store.CloseIssue(ctx, triaged.ID, "finished", "test"); err != nil {
+		t.Errorf("closing must always be allowed: %v", err)
+	}
+}
+
+func TestClosedStatusesUnblock(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	if err := store.SetConfig(ctx, StatusesConfigKey, "open,in_progress,done"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	blocker := &types.Issue{Title: "Blocker", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+	blocked := &types.Issue{Title: "Blocked", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+	for _, issue := range []*types.Issue{blocker, blocked} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+	if err := store.AddDependency(ctx, &types.Dependency{IssueID: blocked.ID, DependsOnID: blocker.ID, Type: types.DepBlocks}, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+
+	isBlocked := func() bool {
+		t.Helper()
+		ids, err := store.GetBlockedIssueIDs(ctx)
+		if err != nil {
+			t.Fatalf("GetBlockedIssueIDs failed: %v", err)
+		}
+		for _, id := range ids {
+			if id == blocked.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// A custom status that is not listed as closed keeps blocking
+	if err := store.UpdateIssue(ctx, blocker.ID, map[string]interface{}{"status": "done"}, "test"); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	if !isBlocked() {
+		t.Fatal("expected issue to stay blocked while closed-statuses is unset")
+	}
+
+	if err := store.SetConfig(ctx, ClosedStatusesConfigKey, "done"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	if isBlocked() {
+		t.Error("expected blocker in a closed status to stop blocking")
+	}
+
+	if err := store.DeleteConfig(ctx, ClosedStatusesConfigKey); err != nil {
+		t.Fatalf("DeleteConfig failed: %v", err)
+	}
+	if !isBlocked() {
+		t.Error("expected issue to be blocked again after closed-statuses is unset")
+	}
+}
+
+func TestAssigneeNormalization(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	newIssue := func(assignee string) *types.Issue {
+		return &types.Issue{Title: "Assigned", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Assignee: assignee}
+	}
+
+	// Without config any assignee is stored as given
+	free := newIssue("Alice")
+	if err := store.CreateIssue(ctx, free, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if free.Assignee != "Alice" {
+		t.Errorf("assignee = %q, want Alice", free.Assignee)
+	}
+
+	t.Run("case folding without allow-list", func(t *testing.T) {
+		if err := store.SetConfig(ctx, AssigneeCaseInsensitiveConfigKey, "true"); err != nil {
+			t.Fatalf("SetConfig failed: %v", err)
+		}
+		issue := newIssue("BOB")
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		if issue.Assignee != "bob" {
+			t.Errorf("assignee = %q, want bob", issue.Assignee)
+		}
+	})
+
+	if err := store.SetConfig(ctx, AssigneesConfigKey, "alice, Bob"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+
+	t.Run("case folding to listed spelling", func(t *testing.T) {
+		issue := newIssue("bob")
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		if issue.Assignee != "Bob" {
+			t.Errorf("assignee = %q, want Bob", issue.Assignee)
+		}
+		if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"assignee": "ALICE"}, "test"); err != nil {
+			t.Fatalf("UpdateIssue failed: %v", err)
+		}
+		got, err := store.GetIssue(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("GetIssue failed: %v", err)
+		}
+		if got.Assignee != "alice" {
+			t.Errorf("assignee = %q, want alice", got.Assignee)
+		}
+	})
+
+	t.Run("allow-list rejection", func(t *testing.T) {
+		if err := store.SetConfig(ctx, AssigneeCaseInsensitiveConfigKey, "false"); err != nil {
+			t.Fatalf("SetConfig failed: %v", err)
+		}
+		if err := store.CreateIssue(ctx, newIssue("mallory"), "test"); err == nil {
+			t.Error("expected CreateIssue to reject an unlisted assignee")
+		}
+		if err := store.CreateIssue(ctx, newIssue("bob"), "test"); err == nil {
+			t.Error("expected case-sensitive matching to reject bob")
+		}
+		if err := store.UpdateIssue(ctx, free.ID, map[string]interface{}{"assignee": "mallory"}, "test"); err == nil {
+			t.Error("expected UpdateIssue to reject an unlisted assignee")
+		}
+		if err := store.UpdateIssue(ctx, free.ID, map[string]interface{}{"assignee": ""}, "test"); err != nil {
+			t.Errorf("unassigning must always be allowed: %v", err)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -30,7 +31,7 @@ func (s *SQLiteStorage) AddDependency(ctx context.Context, dep *types.Dependency
 		return fmt.Errorf("failed to check issue %s: %w", dep.IssueID, err)
 	}
 	if issueExists == nil {
-		return fmt.Errorf("issue %s not found", dep.IssueID)
+		return fmt.Errorf("issue %s %w", dep.IssueID, ErrNotFound)
 	}
 
 	// External refs (external:<project>:<capability>) don't need target validation (bd-zmmy)
@@ -44,7 +45,7 @@ func (s *SQLiteStorage) AddDependency(ctx context.Context, dep *types.Dependency
 			return fmt.Errorf("failed to check dependency %s: %w", dep.DependsOnID, err)
 		}
 		if dependsOnExists == nil {
-			return fmt.Errorf("dependency target %s not found", dep.DependsOnID)
+			return fmt.Errorf("dependency target %s %w", dep.DependsOnID, ErrDependencyTargetNotFound)
 		}
 
 		// Prevent self-dependency (only for local deps)
@@ -242,6 +243,77 @@ func (s *SQLiteStorage) RemoveDependency(ctx context.Context, issueID, dependsOn
 	})
 }
 
+// danglingDepsWhere matches dependency rows whose issue or target no longer
+// exists or is a tombstone. External refs never match, and neither do targets
+// that were archived: archival keeps incoming dependencies on purpose.
+const danglingDepsWhere = `
+	NOT EXISTS (SELECT 1 FROM issues i WHERE i.id = d.issue_id AND i.status != ?)
+	OR (d.depends_on_id NOT LIKE 'external:%'
+		AND NOT EXISTS (SELECT 1 FROM issues i WHERE i.id = d.depends_on_id AND i.status != ?)
+		AND NOT EXISTS (SELECT 1 FROM archived_issues a WHERE a.id = d.depends_on_id))`
+
+// PruneDanglingDeps removes dependencies on issues that no longer exist or
+// were deleted, along with any left over from deleted issues. Deleting an
+// issue leaves the dependencies pointing at it in place, and the missing
+// nodes trip up ready work and cycle detection. It returns the number of
+// dependencies removed.
+func (s *SQLiteStorage) PruneDanglingDeps(ctx context.Context) (int, error) {
+	var count int
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		// Issues that keep existing lose a dependency and must be re-exported
+		rows, err := tx.QueryContext(ctx, `
+			SELECT DISTINCT d.issue_id FROM dependencies d
+			WHERE (`+danglingDepsWhere+`)
+			  AND EXISTS (SELECT 1 FROM issues i WHERE i.id = d.issue_id)
+		`, types.StatusTombstone, types.StatusTombstone)
+		if err != nil {
+			return wrapDBError("find dangling dependencies", err)
+		}
+		var dirtyIDs []string
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return wrapDBError("scan dangling dependency", err)
+			}
+			dirtyIDs = append(dirtyIDs, id)
+		}
+		_ = rows.Close()
+		if err := rows.Err(); err != nil {
+			return wrapDBError("iterate dangling dependencies", err)
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			DELETE FROM dependencies WHERE rowid IN (
+				SELECT d.rowid FROM dependencies d WHERE `+danglingDepsWhere+`
+			)
+		`, types.StatusTombstone, types.StatusTombstone)
+		if err != nil {
+			return wrapDBError("delete dangling dependencies", err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to check rows affected: %w", err)
+		}
+		count = int(n)
+		if count == 0 {
+			return nil
+		}
+
+		if err := markIssuesDirtyTx(ctx, tx, dirtyIDs); err != nil {
+			return wrapDBError("mark issues dirty after pruning dependencies", err)
+		}
+		if err := s.invalidateBlockedCache(ctx, tx); err != nil {
+			return fmt.Errorf("failed to invalidate blocked cache: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // GetDependenciesWithMetadata returns issues that this issue depends on, including dependency type
 func (s *SQLiteStorage) GetDependenciesWithMetadata(ctx context.Context, issueID string) ([]*types.IssueWithDependencyMetadata, error) {
 	rows, err := s.db.QueryContext(ctx, `
@@ -250,7 +322,7 @@ func (s *SQLiteStorage) GetDependenciesWithMetadata(ctx context.Context, issueID
 		       i.created_at, i.updated_at, i.closed_at, i.external_ref, i.source_repo,
 		       i.deleted_at, i.deleted_by, i.delete_reason, i.original_type,
 		       i.sender, i.ephemeral, i.pinned, i.is_template,
-		       i.await_type, i.await_id, i.timeout_ns, i.waiters,
+		       i.await_type, i.await_id, i.timeout_ns, i.waiters, i.due_date,
 		       d.type
 		FROM issues i
 		JOIN dependencies d ON i.id = d.depends_on_id
@@ -273,7 +345,7 @@ func (s *SQLiteStorage) GetDependentsWithMetadata(ctx context.Context, issueID s
 		       i.created_at, i.updated_at, i.closed_at, i.external_ref, i.source_repo,
 		       i.deleted_at, i.deleted_by, i.delete_reason, i.original_type,
 		       i.sender, i.ephemeral, i.pinned, i.is_template,
-		       i.await_type, i.await_id, i.timeout_ns, i.waiters,
+		       i.await_type, i.await_id, i.timeout_ns, i.waiters, i.due_date,
 		       d.type
 		FROM issues i
 		JOIN dependencies d ON i.id = d.issue_id
@@ -718,6 +790,115 @@ func parseExternalRefParts(ref string) (project, capability string) {
 	return parts[1], parts[2]
 }
 
+// TransitiveDeps returns the IDs of every issue that must be done before id:
+// its blocking dependencies (see DependencyType.AffectsReadyWork), theirs,
+// and so on. Each issue is listed once, after all of its own prerequisites,
+// so working down the list never starts an issue whose blockers are pending.
+// Cycles (see DetectCycles) are cut where the traversal re-enters an issue.
+func (s *SQLiteStorage) TransitiveDeps(ctx context.Context, id string) ([]string, error) {
+	edges, err := s.blockingEdges(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	order := dependencyOrder(id, edges)
+	return order[:len(order)-1], nil // the root comes last
+}
+
+// TransitiveDependents returns the IDs of every issue that id blocks,
+// directly or through other issues, in the order they become unblocked: each
+// issue is listed after all of the issues between it and id. Cycles are cut
+// as in TransitiveDeps.
+func (s *SQLiteStorage) TransitiveDependents(ctx context.Context, id string) ([]string, error) {
+	edges, err := s.blockingEdges(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	order := dependencyOrder(id, edges)
+	dependents := make([]string, 0, len(order)-1)
+	for i := len(order) - 2; i >= 0; i-- { // reverse, skipping the root
+		dependents = append(dependents, order[i])
+	}
+	return dependents, nil
+}
+
+// blockingEdges returns the blocking dependencies between existing issues as
+// an adjacency list: issue → what it depends on, or with reverse, issue →
+// what depends on it. It fails if id does not exist.
+func (s *SQLiteStorage) blockingEdges(ctx context.Context, id string, reverse bool) (map[string][]string, error) {
+	var exists int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM issues WHERE id = ?`, id).Scan(&exists); err != nil {
+		return nil, wrapDBErrorf(err, "look up %s", id)
+	}
+	if exists == 0 {
+		return nil, fmt.Errorf("issue %s %w", id, ErrNotFound)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT d.issue_id, d.depends_on_id
+		FROM dependencies d
+		JOIN issues i ON i.id = d.issue_id
+		JOIN issues t ON t.id = d.depends_on_id
+		WHERE d.type IN (?, ?, ?, ?)
+		ORDER BY d.issue_id, d.depends_on_id
+	`, types.DepBlocks, types.DepParentChild, types.DepConditionalBlocks, types.DepWaitsFor)
+	if err != nil {
+		return nil, wrapDBError("query blocking dependencies", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	edges := make(map[string][]string)
+	for rows.Next() {
+		var from, to string
+		if err := rows.Scan(&from, &to); err != nil {
+			return nil, wrapDBError("scan blocking dependency", err)
+		}
+		if reverse {
+			from, to = to, from
+		}
+		edges[from] = append(edges[from], to)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError("iterate blocking dependencies", err)
+	}
+	if reverse {
+		// Sorted descending, as TransitiveDependents reverses the walk's order
+		for _, targets := range edges {
+			sort.Sort(sort.Reverse(sort.StringSlice(targets)))
+		}
+	}
+	return edges, nil
+}
+
+// dependencyOrder walks edges depth-first from root and returns every issue
+// reached, root included, in post-order: each issue after all the issues it
+// has edges to. An issue reached twice, through a diamond or a cycle, is only
+// walked the first time.
+func dependencyOrder(root string, edges map[string][]string) []string {
+	type frame struct {
+		id   string
+		next int // index of the next edge to follow
+	}
+
+	seen := map[string]bool{root: true}
+	stack := []frame{{id: root}}
+	var order []string
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if targets := edges[top.id]; top.next < len(targets) {
+			target := targets[top.next]
+			top.next++
+			if !seen[target] {
+				seen[target] = true
+				stack = append(stack, frame{id: target})
+			}
+			continue
+		}
+		order = append(order, top.id)
+		stack = stack[:len(stack)-1]
+	}
+	return order
+}
+
 // DetectCycles finds circular dependencies and returns the actual cycle paths
 // Note: relates-to dependencies are excluded because they are intentionally bidirectional
 // ("see also" relationships) and do not represent problematic cycles.
@@ -834,6 +1015,7 @@ func (s *SQLiteStorage) scanIssues(ctx context.Context, rows *sql.Rows) ([]*type
 		var awaitID sql.NullString
 		var timeoutNs sql.NullInt64
 		var waiters sql.NullString
+		var dueDate sql.NullString
 
 		err := rows.Scan(
 			&issue.ID, &contentHash, &issue.Title, &issue.Description, &issue.Design,
@@ -842,7 +1024,7 @@ func (s *SQLiteStorage) scanIssues(ctx context.Context, rows *sql.Rows) ([]*type
 			&issue.CreatedAt, &issue.UpdatedAt, &closedAt, &externalRef, &sourceRepo, &closeReason,
 			&deletedAt, &deletedBy, &deleteReason, &originalType,
 			&sender, &wisp, &pinned, &isTemplate,
-			&awaitType, &awaitID, &timeoutNs, &waiters,
+			&awaitType, &awaitID, &timeoutNs, &waiters, &dueDate,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan issue: %w", err)
@@ -908,6 +1090,9 @@ func (s *SQLiteStorage) scanIssues(ctx context.Context, rows *sql.Rows) ([]*type
 		if waiters.Valid && waiters.String != "" {
 			issue.Waiters = parseJSONStringArray(waiters.String)
 		}
+		if dueDate.Valid {
+			issue.DueDate = dueDate.String
+		}
 
 		issues = append(issues, &issue)
 		issueIDs = append(issueIDs, issue.ID)
@@ -956,6 +1141,7 @@ func (s *SQLiteStorage) scanIssuesWithDependencyType(ctx context.Context, rows *
 		var awaitID sql.NullString
 		var timeoutNs sql.NullInt64
 		var waiters sql.NullString
+		var dueDate sql.NullString
 		var depType types.DependencyType
 
 		err := rows.Scan(
@@ -965,7 +1151,7 @@ func (s *SQLiteStorage) scanIssuesWithDependencyType(ctx context.Context, rows *
 			&issue.CreatedAt, &issue.UpdatedAt, &closedAt, &externalRef, &sourceRepo,
 			&deletedAt, &deletedBy, &deleteReason, &originalType,
 			&sender, &wisp, &pinned, &isTemplate,
-			&awaitType, &awaitID, &timeoutNs, &waiters,
+			&awaitType, &awaitID, &timeoutNs, &waiters, &dueDate,
 			&depType,
 		)
 		if err != nil {
@@ -1029,6 +1215,9 @@ func (s *SQLiteStorage) scanIssuesWithDependencyType(ctx context.Context, rows *
 		if waiters.Valid && waiters.String != "" {
 			issue.Waiters = parseJSONStringArray(waiters.String)
 		}
+		if dueDate.Valid {
+			issue.DueDate = dueDate.String
+		}
 
 		// Fetch labels for this issue
 		labels, err := s.GetLabels(ctx, issue.ID)
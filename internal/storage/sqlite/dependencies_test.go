@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -61,6 +62,25 @@ func TestAddDependencyDiscoveredFrom(t *testing.T) {
 	testAddDependencyWithType(t, types.DepDiscoveredFrom, "Parent task", "Bug found during work")
 }
 
+func TestAddDependencyMissingTarget(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issue := &types.Issue{Title: "Lonely", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+
+	err := store.AddDependency(ctx, &types.Dependency{IssueID: issue.ID, DependsOnID: "bd-missing", Type: types.DepBlocks}, "test")
+	if !errors.Is(err, ErrDependencyTargetNotFound) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("AddDependency error = %v, want ErrDependencyTargetNotFound", err)
+	}
+	if want := "dependency target bd-missing not found"; err.Error() != want {
+		t.Errorf("error message = %q, want %q", err.Error(), want)
+	}
+}
+
 func TestParentChildValidation(t *testing.T) {
 	store, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -1781,3 +1801,139 @@ func TestCycleDetectionWithExternalRefs(t *testing.T) {
 		t.Errorf("Expected no cycles with external deps, got %d", len(cycles))
 	}
 }
+
+func TestPruneDanglingDeps(t *testing.T) {
+	env := newTestEnv(t)
+
+	live := env.CreateIssue("Live")
+	deleted := env.CreateIssue("Deleted")
+	dependent := env.CreateIssue("Dependent")
+	env.AddDep(dependent, live)
+	env.AddDep(dependent, deleted)
+	if err := env.Store.AddDependency(env.Ctx, &types.Dependency{
+		IssueID: dependent.ID, DependsOnID: "external:other:api", Type: types.DepBlocks,
+	}, "test-user"); err != nil {
+		t.Fatalf("AddDependency(external) failed: %v", err)
+	}
+
+	// Soft-deleting an issue leaves the dependency on it dangling
+	if err := env.Store.CreateTombstone(env.Ctx, deleted.ID, "test-user", "test"); err != nil {
+		t.Fatalf("CreateTombstone failed: %v", err)
+	}
+	// ... as does an issue that disappeared entirely
+	if _, err := env.Store.db.ExecContext(env.Ctx,
+		`INSERT INTO dependencies (issue_id, depends_on_id, type, created_by) VALUES (?, ?, ?, ?)`,
+		dependent.ID, "test-gone", types.DepBlocks, "test-user"); err != nil {
+		t.Fatalf("failed to insert dangling dependency: %v", err)
+	}
+
+	count, err := env.Store.PruneDanglingDeps(env.Ctx)
+	if err != nil {
+		t.Fatalf("PruneDanglingDeps failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("PruneDanglingDeps() = %d, want 2", count)
+	}
+
+	deps, err := env.Store.GetDependencyRecords(env.Ctx, dependent.ID)
+	if err != nil {
+		t.Fatalf("GetDependencyRecords failed: %v", err)
+	}
+	var remaining []string
+	for _, dep := range deps {
+		remaining = append(remaining, dep.DependsOnID)
+	}
+	if len(remaining) != 2 || !strings.Contains(strings.Join(remaining, ","), live.ID) || !strings.Contains(strings.Join(remaining, ","), "external:other:api") {
+		t.Errorf("remaining dependencies = %v, want %s and the external ref", remaining, live.ID)
+	}
+
+	// Nothing left to prune
+	if count, err := env.Store.PruneDanglingDeps(env.Ctx); err != nil || count != 0 {
+		t.Errorf("second PruneDanglingDeps() = %d, %v; want 0", count, err)
+	}
+}
+
+func TestTransitiveDeps_Diamond(t *testing.T) {
+	env := newTestEnv(t)
+
+	// top depends on left and right, which both depend on base
+	base := env.CreateIssue("Base")
+	left := env.CreateIssue("Left")
+	right := env.CreateIssue("Right")
+	top := env.CreateIssue("Top")
+	env.AddDep(left, base)
+	env.AddDep(right, base)
+	env.AddDep(top, left)
+	env.AddDep(top, right)
+	// Non-blocking links are not prerequisites
+	unrelated := env.CreateIssue("Unrelated")
+	env.AddDepType(top, unrelated, types.DepRelated)
+
+	deps, err := env.Store.TransitiveDeps(env.Ctx, top.ID)
+	if err != nil {
+		t.Fatalf("TransitiveDeps failed: %v", err)
+	}
+	assertDependencyOrder(t, "TransitiveDeps", deps, []string{base.ID, left.ID, right.ID},
+		[][2]string{{base.ID, left.ID}, {base.ID, right.ID}})
+
+	dependents, err := env.Store.TransitiveDependents(env.Ctx, base.ID)
+	if err != nil {
+		t.Fatalf("TransitiveDependents failed: %v", err)
+	}
+	assertDependencyOrder(t, "TransitiveDependents", dependents, []string{left.ID, right.ID, top.ID},
+		[][2]string{{left.ID, top.ID}, {right.ID, top.ID}})
+
+	if _, err := env.Store.TransitiveDeps(env.Ctx, "test-missing"); err == nil {
+		t.Error("TransitiveDeps should fail for a missing issue")
+	}
+}
+
+func TestTransitiveDeps_Cycle(t *testing.T) {
+	env := newTestEnv(t)
+
+	a := env.CreateIssue("A")
+	b := env.CreateIssue("B")
+	c := env.CreateIssue("C")
+	env.AddDep(a, b)
+	env.AddDep(b, c)
+	// Close the loop directly; AddDependency refuses to create cycles
+	if _, err := env.Store.db.ExecContext(env.Ctx,
+		`INSERT INTO dependencies (issue_id, depends_on_id, type, created_by) VALUES (?, ?, ?, ?)`,
+		c.ID, a.ID, types.DepBlocks, "test-user"); err != nil {
+		t.Fatalf("failed to insert cyclic dependency: %v", err)
+	}
+
+	deps, err := env.Store.TransitiveDeps(env.Ctx, a.ID)
+	if err != nil {
+		t.Fatalf("TransitiveDeps failed: %v", err)
+	}
+	if len(deps) != 2 || deps[0] != c.ID || deps[1] != b.ID {
+		t.Errorf("TransitiveDeps() = %v, want [%s %s]", deps, c.ID, b.ID)
+	}
+}
+
+// assertDependencyOrder checks that got lists exactly the issues in want, once
+// each, and that every pair in before appears in that order.
+func assertDependencyOrder(t *testing.T, name string, got, want []string, before [][2]string) {
+	t.Helper()
+	pos := make(map[string]int, len(got))
+	for i, id := range got {
+		if _, dup := pos[id]; dup {
+			t.Errorf("%s() = %v, lists %s twice", name, got, id)
+		}
+		pos[id] = i
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%s() = %v, want %v in dependency order", name, got, want)
+	}
+	for _, id := range want {
+		if _, ok := pos[id]; !ok {
+			t.Fatalf("%s() = %v, missing %s", name, got, id)
+		}
+	}
+	for _, pair := range before {
+		if pos[pair[0]] > pos[pair[1]] {
+			t.Errorf("%s() = %v, want %s before %s", name, got, pair[0], pair[1])
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package sqlite
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// dotStatusColors maps issue status to a node fill color. Unlisted statuses
+// are drawn white like open issues.
+var dotStatusColors = map[types.Status]string{
+	types.StatusInProgress: "#fff3b0",
+	types.StatusBlocked:    "#f8b4b4",
+	types.StatusDeferred:   "#e2e8f0",
+	types.StatusClosed:     "#c6f6d5",
+	types.StatusPinned:     "#bee3f8",
+}
+
+// ExportDOT writes a Graphviz DOT graph of all issues and the dependencies
+// between them to w. Nodes are colored by status and edges point from an
+// issue to what it depends on, labeled with the dependency type. Output is
+// sorted so the same data always produces the same graph.
+func (s *SQLiteStorage) ExportDOT(ctx context.Context, w io.Writer) error {
+	return s.ExportDOTFrom(ctx, w, "")
+}
+
+// ExportDOTFrom is ExportDOT limited to the issues connected to rootID: what
+// it depends on, transitively, and what depends on it, transitively. An empty
+// rootID exports everything.
+func (s *SQLiteStorage) ExportDOTFrom(ctx context.Context, w io.Writer, rootID string) error {
+	issues, err := s.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		return fmt.Errorf("failed to query issues: %w", err)
+	}
+	allDeps, err := s.GetAllDependencyRecords(ctx)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+
+	// Keep only edges between exported issues (drops external: refs and
+	// dependencies on tombstones)
+	var edges []*types.Dependency
+	for _, deps := range allDeps {
+		for _, dep := range deps {
+			if byID[dep.IssueID] != nil && byID[dep.DependsOnID] != nil {
+				edges = append(edges, dep)
+			}
+		}
+	}
+
+	if rootID != "" {
+		if byID[rootID] == nil {
+			return fmt.Errorf("%w: issue %s", ErrNotFound, rootID)
+		}
+		keep := dotReachable(rootID, edges)
+		for id := range byID {
+			if !keep[id] {
+				delete(byID, id)
+			}
+		}
+		kept := edges[:0]
+		for _, dep := range edges {
+			if keep[dep.IssueID] && keep[dep.DependsOnID] {
+				kept = append(kept, dep)
+			}
+		}
+		edges = kept
+	}
+
+	ids := make([]string, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if a.IssueID != b.IssueID {
+			return a.IssueID < b.IssueID
+		}
+		if a.DependsOnID != b.DependsOnID {
+			return a.DependsOnID < b.DependsOnID
+		}
+		return a.Type < b.Type
+	})
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph beads {")
+	fmt.Fprintln(bw, "  rankdir=LR;")
+	fmt.Fprintln(bw, `  node [shape=box, style="rounded,filled", fillcolor="#ffffff", fontname="Helvetica"];`)
+	fmt.Fprintln(bw, `  edge [fontname="Helvetica", fontsize=10];`)
+	for _, id := range ids {
+		issue := byID[id]
+		attrs := fmt.Sprintf("label=%s", dotQuote(id+"\n"+issue.Title))
+		if color, ok := dotStatusColors[issue.Status]; ok {
+			attrs += fmt.Sprintf(", fillcolor=%s", dotQuote(color))
+		}
+		fmt.Fprintf(bw, "  %s [%s];\n", dotQuote(id), attrs)
+	}
+	for _, dep := range edges {
+		attrs := fmt.Sprintf("label=%s", dotQuote(string(dep.Type)))
+		if dep.Type == types.DepParentChild {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(bw, "  %s -> %s [%s];\n", dotQuote(dep.IssueID), dotQuote(dep.DependsOnID), attrs)
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// dotReachable returns rootID plus every issue reachable from it by following
+// edges forward (its dependencies) or backward (its dependents).
+func dotReachable(rootID string, edges []*types.Dependency) map[string]bool {
+	forward := make(map[string][]string)
+	backward := make(map[string][]string)
+	for _, dep := range edges {
+		forward[dep.IssueID] = append(forward[dep.IssueID], dep.DependsOnID)
+		backward[dep.DependsOnID] = append(backward[dep.DependsOnID], dep.IssueID)
+	}
+
+	keep := map[string]bool{rootID: true}
+	for _, adjacency := range []map[string][]string{forward, backward} {
+		seen := map[string]bool{rootID: true}
+		queue := []string{rootID}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for _, next := range adjacency[id] {
+				if !seen[next] {
+					seen[next] = true
+					keep[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+	}
+	return keep
+}
+
+// dotQuote returns s as a DOT double-quoted string.
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", "")
+	return `"` + r.Replace(s) + `"`
+}
@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestExportDOT(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issues := []*types.Issue{
+		{ID: "bd-e", Title: "Follow-up", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "bd-a", Title: "Epic", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeEpic},
+		{ID: "bd-c", Title: "Groundwork", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask, ClosedAt: timePtr(time.Now())},
+		{ID: "bd-b", Title: "Build it", Status: types.StatusInProgress, Priority: 1, IssueType: types.TypeTask},
+		{ID: "bd-d", Title: `Say "hi"`, Status: types.StatusOpen, Priority: 3, IssueType: types.TypeTask},
+	}
+	for _, issue := range issues {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) failed: %v", issue.ID, err)
+		}
+	}
+	for _, dep := range []*types.Dependency{
+		{IssueID: "bd-b", DependsOnID: "bd-a", Type: types.DepParentChild},
+		{IssueID: "bd-b", DependsOnID: "bd-c", Type: types.DepBlocks},
+		{IssueID: "bd-e", DependsOnID: "bd-d", Type: types.DepRelated},
+	} {
+		if err := store.AddDependency(ctx, dep, "test"); err != nil {
+			t.Fatalf("AddDependency failed: %v", err)
+		}
+	}
+
+	const header = `digraph beads {
+  rankdir=LR;
+  node [shape=box, style="rounded,filled", fillcolor="#ffffff", fontname="Helvetica"];
+  edge [fontname="Helvetica", fontsize=10];
+`
+	nodeA := `  "bd-a" [label="bd-a\nEpic"];` + "\n"
+	nodeB := `  "bd-b" [label="bd-b\nBuild it", fillcolor="#fff3b0"];` + "\n"
+	nodeC := `  "bd-c" [label="bd-c\nGroundwork", fillcolor="#c6f6d5"];` + "\n"
+	nodeD := `  "bd-d" [label="bd-d\nSay \"hi\""];` + "\n"
+	nodeE := `  "bd-e" [label="bd-e\nFollow-up"];` + "\n"
+	edgeBA := `  "bd-b" -> "bd-a" [label="parent-child", style=dashed];` + "\n"
+	edgeBC := `  "bd-b" -> "bd-c" [label="blocks"];` + "\n"
+	edgeED := `  "bd-e" -> "bd-d" [label="related"];` + "\n"
+
+	tests := []struct {
+		name string
+		root string
+		want string
+	}{
+		{"all issues", "", header + nodeA + nodeB + nodeC + nodeD + nodeE + edgeBA + edgeBC + edgeED + "}\n"},
+		{"dependents of a leaf", "bd-c", header + nodeB + nodeC + edgeBC + "}\n"},
+		{"children of an epic", "bd-a", header + nodeA + nodeB + edgeBA + "}\n"},
+		{"dependencies of an issue", "bd-b", header + nodeA + nodeB + nodeC + edgeBA + edgeBC + "}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := store.ExportDOTFrom(ctx, &buf, tt.root); err != nil {
+				t.Fatalf("ExportDOTFrom failed: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("DOT =\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+			assertValidDOT(t, buf.String())
+		})
+	}
+
+	if err := store.ExportDOTFrom(ctx, &bytes.Buffer{}, "bd-missing"); !IsNotFound(err) {
+		t.Errorf("ExportDOTFrom(missing root) error = %v, want not found", err)
+	}
+}
+
+// assertValidDOT checks dot's structure, and runs Graphviz on it when installed.
+func assertValidDOT(t *testing.T, dot string) {
+	t.Helper()
+	if !strings.HasPrefix(dot, "digraph beads {\n") || !strings.HasSuffix(dot, "}\n") {
+		t.Errorf("DOT is not a single digraph block:\n%s", dot)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(dot), "\n")[1:] {
+		if line != "}" && !strings.HasSuffix(line, ";") {
+			t.Errorf("DOT statement not terminated: %q", line)
+		}
+		if strings.Count(strings.ReplaceAll(line, `\"`, ""), `"`)%2 != 0 {
+			t.Errorf("DOT statement has an unbalanced quote: %q", line)
+		}
+	}
+	if _, err := exec.LookPath("dot"); err != nil {
+		return
+	}
+	cmd := exec.Command("dot", "-Tcanon")
+	cmd.Stdin = strings.NewReader(dot)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("graphviz rejected the DOT: %v\n%s", err, out)
+	}
+}
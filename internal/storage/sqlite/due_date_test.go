@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDueDateUpdateAndOverdue(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	create := func(title, due string) *types.Issue {
+		t.Helper()
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, DueDate: due}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", title, err)
+		}
+		return issue
+	}
+	late := create("Late", "2024-11-28")
+	dueToday := create("Due today", "2024-12-02")
+	noDue := create("No due date", "")
+	closed := create("Closed late", "2024-11-01")
+	if err := store.CloseIssue(ctx, closed.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue() error = %v", err)
+	}
+
+	// Setting a due date through UpdateIssue makes the issue overdue
+	if err := store.UpdateIssue(ctx, noDue.ID, map[string]interface{}{"due_date": "2024-11-30"}, "test"); err != nil {
+		t.Fatalf("UpdateIssue(due_date) error = %v", err)
+	}
+	got, err := store.GetIssue(ctx, noDue.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.DueDate != "2024-11-30" {
+		t.Errorf("DueDate = %q, want 2024-11-30", got.DueDate)
+	}
+
+	const today = "2024-12-02"
+	overdue, err := store.GetOverdueIssues(ctx, today)
+	if err != nil {
+		t.Fatalf("GetOverdueIssues() error = %v", err)
+	}
+	var ids []string
+	for _, issue := range overdue {
+		ids = append(ids, issue.ID)
+		if !issue.IsOverdue(today) {
+			t.Errorf("%s returned as overdue but IsOverdue(%s) is false", issue.ID, today)
+		}
+	}
+	if len(ids) != 2 || ids[0] != late.ID || ids[1] != noDue.ID {
+		t.Errorf("overdue = %v, want [%s %s] (not %s due today)", ids, late.ID, noDue.ID, dueToday.ID)
+	}
+
+	// Clearing the due date removes it from the overdue list
+	if err := store.UpdateIssue(ctx, late.ID, map[string]interface{}{"due_date": ""}, "test"); err != nil {
+		t.Fatalf("UpdateIssue(clear due_date) error = %v", err)
+	}
+	overdue, err = store.GetOverdueIssues(ctx, today)
+	if err != nil {
+		t.Fatalf("GetOverdueIssues() error = %v", err)
+	}
+	if len(overdue) != 1 || overdue[0].ID != noDue.ID {
+		t.Errorf("after clearing, overdue = %v, want only %s", overdue, noDue.ID)
+	}
+}
+
+func TestUpdateIssueRejectsInvalidDueDate(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issue := &types.Issue{Title: "Task", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	for _, due := range []string{"12/01/2024", "2024-13-01", "tomorrow"} {
+		if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"due_date": due}, "test"); err == nil {
+			t.Errorf("UpdateIssue(due_date=%q) succeeded, want error", due)
+		}
+	}
+}
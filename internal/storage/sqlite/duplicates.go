@@ -0,0 +1,140 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// FindDuplicates groups open issues whose titles look like duplicates, for
+// triage. Titles are normalized (lowercased, punctuation dropped, whitespace
+// collapsed) and issues with the same normalized title are grouped. With a
+// threshold in (0, 1), issues are also grouped when the word overlap of their
+// titles (Jaccard similarity of the word sets) is at least threshold; a
+// threshold of 0 or 1 groups exact matches only.
+//
+// Closed issues and tombstones are ignored. Groups are sorted by issue ID, and
+// by their first ID, so the result is the same on every run.
+func (s *SQLiteStorage) FindDuplicates(ctx context.Context, threshold float64) ([][]*types.Issue, error) {
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("threshold must be between 0 and 1, got %v", threshold)
+	}
+
+	all, err := s.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issues: %w", err)
+	}
+	issues := make([]*types.Issue, 0, len(all))
+	for _, issue := range all {
+		if issue.Status != types.StatusClosed && issue.Status != types.StatusTombstone {
+			issues = append(issues, issue)
+		}
+	}
+	return groupDuplicateTitles(issues, threshold), nil
+}
+
+// groupDuplicateTitles implements FindDuplicates' grouping.
+func groupDuplicateTitles(issues []*types.Issue, threshold float64) [][]*types.Issue {
+	sort.Slice(issues, func(i, j int) bool { return issues[i].ID < issues[j].ID })
+
+	// Union-find over issue indexes
+	parent := make([]int, len(issues))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	union := func(a, b int) {
+		ra, rb := find(a), find(b)
+		if ra < rb {
+			parent[rb] = ra
+		} else if rb < ra {
+			parent[ra] = rb
+		}
+	}
+
+	titles := make([]string, len(issues))
+	byTitle := make(map[string]int)
+	for i, issue := range issues {
+		titles[i] = normalizeTitle(issue.Title)
+		if titles[i] == "" {
+			continue
+		}
+		if first, ok := byTitle[titles[i]]; ok {
+			union(first, i)
+		} else {
+			byTitle[titles[i]] = i
+		}
+	}
+
+	if threshold > 0 && threshold < 1 {
+		words := make([]map[string]bool, len(issues))
+		for i, title := range titles {
+			words[i] = make(map[string]bool)
+			for _, w := range strings.Fields(title) {
+				words[i][w] = true
+			}
+		}
+		for i := range issues {
+			for j := i + 1; j < len(issues); j++ {
+				if find(i) != find(j) && wordOverlap(words[i], words[j]) >= threshold {
+					union(i, j)
+				}
+			}
+		}
+	}
+
+	members := make(map[int][]*types.Issue)
+	var roots []int
+	for i, issue := range issues {
+		root := find(i)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], issue)
+	}
+
+	var groups [][]*types.Issue
+	for _, root := range roots {
+		if len(members[root]) > 1 {
+			groups = append(groups, members[root])
+		}
+	}
+	return groups
+}
+
+// normalizeTitle lowercases a title, turns punctuation into spaces and
+// collapses runs of whitespace.
+func normalizeTitle(title string) string {
+	mapped := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, title)
+	return strings.Join(strings.Fields(mapped), " ")
+}
+
+// wordOverlap returns the Jaccard similarity of two word sets: the number of
+// shared words over the number of distinct words in either.
+func wordOverlap(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for w := range a {
+		if b[w] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
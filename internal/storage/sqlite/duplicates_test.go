@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	titles := []struct{ id, title string }{
+		{"bd-1", "Fix login timeout"},
+		{"bd-2", "fix login timeout!"},
+		{"bd-3", "Fix login timeout on mobile"},
+		{"bd-4", "Update README"},
+		{"bd-5", "Add dark mode"},
+		{"bd-6", "Fix login timeout"}, // closed, ignored
+	}
+	for _, tt := range titles {
+		issue := &types.Issue{ID: tt.id, Title: tt.title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", tt.id, err)
+		}
+	}
+	if err := store.CloseIssue(ctx, "bd-6", "done", "test"); err != nil {
+		t.Fatalf("CloseIssue() error = %v", err)
+	}
+
+	groupIDs := func(groups [][]*types.Issue) [][]string {
+		var out [][]string
+		for _, g := range groups {
+			var ids []string
+			for _, issue := range g {
+				ids = append(ids, issue.ID)
+			}
+			out = append(out, ids)
+		}
+		return out
+	}
+
+	// Exact normalized titles only
+	groups, err := store.FindDuplicates(ctx, 0)
+	if err != nil {
+		t.Fatalf("FindDuplicates(0) error = %v", err)
+	}
+	got := groupIDs(groups)
+	if len(got) != 1 || len(got[0]) != 2 || got[0][0] != "bd-1" || got[0][1] != "bd-2" {
+		t.Errorf("exact groups = %v, want [[bd-1 bd-2]]", got)
+	}
+
+	// "fix login timeout on mobile" shares 3 of 5 words with "fix login timeout"
+	groups, err = store.FindDuplicates(ctx, 0.6)
+	if err != nil {
+		t.Fatalf("FindDuplicates(0.6) error = %v", err)
+	}
+	got = groupIDs(groups)
+	if len(got) != 1 || len(got[0]) != 3 || got[0][2] != "bd-3" {
+		t.Errorf("overlap groups = %v, want [[bd-1 bd-2 bd-3]]", got)
+	}
+
+	// Above the overlap, only the exact match remains
+	groups, err = store.FindDuplicates(ctx, 0.8)
+	if err != nil {
+		t.Fatalf("FindDuplicates(0.8) error = %v", err)
+	}
+	if got := groupIDs(groups); len(got) != 1 || len(got[0]) != 2 {
+		t.Errorf("groups at 0.8 = %v, want [[bd-1 bd-2]]", got)
+	}
+
+	if _, err := store.FindDuplicates(ctx, 1.5); err == nil {
+		t.Error("FindDuplicates(1.5) succeeded, want error")
+	}
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := map[string]string{
+		"Fix login timeout":        "fix login timeout",
+		"  fix   LOGIN-timeout!! ": "fix login timeout",
+		"bd: crash (v2.0)":         "bd crash v2 0",
+		"":                         "",
+	}
+	for in, want := range tests {
+		if got := normalizeTitle(in); got != want {
+			t.Errorf("normalizeTitle(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -3,10 +3,106 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"slices"
 
 	"github.com/steveyegge/beads/internal/types"
 )
 
+// SetParent makes parentID the parent of childID, replacing any parent the
+// child already has. An empty parentID detaches the child. Parents are
+// parent-child dependencies, so AddDependency's cycle check rejects a parent
+// that is the child itself or one of its descendants.
+func (s *SQLiteStorage) SetParent(ctx context.Context, childID, parentID, actor string) error {
+	oldParents, err := s.getParentIDs(ctx, childID)
+	if err != nil {
+		return err
+	}
+
+	// Add the new parent first so a rejected parent leaves the old one in place
+	if parentID != "" && !slices.Contains(oldParents, parentID) {
+		dep := &types.Dependency{IssueID: childID, DependsOnID: parentID, Type: types.DepParentChild}
+		if err := s.AddDependency(ctx, dep, actor); err != nil {
+			return fmt.Errorf("failed to set parent of %s: %w", childID, err)
+		}
+	}
+	for _, oldParent := range oldParents {
+		if oldParent == parentID {
+			continue
+		}
+		if err := s.RemoveDependency(ctx, childID, oldParent, actor); err != nil {
+			return fmt.Errorf("failed to remove old parent %s of %s: %w", oldParent, childID, err)
+		}
+	}
+	return nil
+}
+
+// Children returns the direct children of parentID (issues with a
+// parent-child dependency on it).
+func (s *SQLiteStorage) Children(ctx context.Context, parentID string) ([]*types.Issue, error) {
+	dependents, err := s.GetDependentsWithMetadata(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	var children []*types.Issue
+	for _, dependent := range dependents {
+		if dependent.DependencyType == types.DepParentChild {
+			children = append(children, &dependent.Issue)
+		}
+	}
+	return children, nil
+}
+
+// EpicProgress counts the descendants of parentID (children, their children,
+// and so on) and how many of them are done: closed or in one of the
+// closed-statuses. Deleted issues (tombstones) are not counted. The hierarchy
+// is walked by a recursive query, so depth is not limited by the Go stack, and
+// UNION stops it from revisiting an issue.
+func (s *SQLiteStorage) EpicProgress(ctx context.Context, parentID string) (done, total int, err error) {
+	err = s.db.QueryRowContext(ctx, `
+		WITH RECURSIVE descendants(id) AS (
+			SELECT issue_id FROM dependencies
+			WHERE depends_on_id = ? AND type = 'parent-child'
+			UNION
+			SELECT d.issue_id FROM dependencies d
+			JOIN descendants ON d.depends_on_id = descendants.id
+			WHERE d.type = 'parent-child'
+		)
+		SELECT
+			COALESCE(SUM(CASE WHEN i.status = 'closed' OR `+inClosedStatuses("i.status")+` THEN 1 ELSE 0 END), 0),
+			COUNT(*)
+		FROM descendants
+		JOIN issues i ON i.id = descendants.id
+		WHERE i.status != 'tombstone'
+	`, parentID).Scan(&done, &total)
+	if err != nil {
+		return 0, 0, wrapDBError("get epic progress", err)
+	}
+	return done, total, nil
+}
+
+// getParentIDs returns the IDs of issueID's parents.
+func (s *SQLiteStorage) getParentIDs(ctx context.Context, issueID string) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT depends_on_id FROM dependencies
+		WHERE issue_id = ? AND type = ?
+	`, issueID, types.DepParentChild)
+	if err != nil {
+		return nil, wrapDBError("get parents", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var parents []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, wrapDBError("scan parent", err)
+		}
+		parents = append(parents, id)
+	}
+	return parents, rows.Err()
+}
+
 // GetEpicsEligibleForClosure returns all epics with their completion status
 func (s *SQLiteStorage) GetEpicsEligibleForClosure(ctx context.Context) ([]*types.EpicStatus, error) {
 	query := `
@@ -154,3 +154,113 @@ func TestGetEpicsEligibleForClosureWithNoChildren(t *testing.T) {
 	e := h.assertEpicFound(epics, epic.ID, "No children")
 	h.assertEpicStats(e, 0, 0, false, "No children")
 }
+
+func TestSetParent(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	h := newEpicTestHelper(t, store)
+	epic := h.createEpic("Epic")
+	subEpic := h.createEpic("Sub-epic")
+	other := h.createEpic("Other epic")
+	task := h.createTask("Task")
+
+	childIDs := func(parentID string) []string {
+		t.Helper()
+		children, err := store.Children(ctx, parentID)
+		if err != nil {
+			t.Fatalf("Children failed: %v", err)
+		}
+		var ids []string
+		for _, child := range children {
+			ids = append(ids, child.ID)
+		}
+		return ids
+	}
+
+	if err := store.SetParent(ctx, subEpic.ID, epic.ID, "test"); err != nil {
+		t.Fatalf("SetParent failed: %v", err)
+	}
+	if err := store.SetParent(ctx, task.ID, subEpic.ID, "test"); err != nil {
+		t.Fatalf("SetParent failed: %v", err)
+	}
+	if ids := childIDs(epic.ID); len(ids) != 1 || ids[0] != subEpic.ID {
+		t.Errorf("Children(epic) = %v, want [%s]", ids, subEpic.ID)
+	}
+
+	t.Run("rejects ancestor cycles", func(t *testing.T) {
+		if err := store.SetParent(ctx, epic.ID, epic.ID, "test"); err == nil {
+			t.Error("expected an issue to be rejected as its own parent")
+		}
+		if err := store.SetParent(ctx, epic.ID, subEpic.ID, "test"); err == nil {
+			t.Error("expected a child to be rejected as its ancestor's parent")
+		}
+		if ids := childIDs(subEpic.ID); len(ids) != 1 || ids[0] != task.ID {
+			t.Errorf("Children(sub-epic) = %v after rejected SetParent, want [%s]", ids, task.ID)
+		}
+	})
+
+	t.Run("replaces the existing parent", func(t *testing.T) {
+		if err := store.SetParent(ctx, task.ID, other.ID, "test"); err != nil {
+			t.Fatalf("SetParent failed: %v", err)
+		}
+		if ids := childIDs(subEpic.ID); len(ids) != 0 {
+			t.Errorf("Children(sub-epic) = %v, want none", ids)
+		}
+		if ids := childIDs(other.ID); len(ids) != 1 || ids[0] != task.ID {
+			t.Errorf("Children(other) = %v, want [%s]", ids, task.ID)
+		}
+	})
+
+	t.Run("empty parent detaches", func(t *testing.T) {
+		if err := store.SetParent(ctx, task.ID, "", "test"); err != nil {
+			t.Fatalf("SetParent failed: %v", err)
+		}
+		if ids := childIDs(other.ID); len(ids) != 0 {
+			t.Errorf("Children(other) = %v, want none", ids)
+		}
+	})
+}
+
+func TestEpicProgress(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// epic -> feature -> task1, task2, deleted; epic -> task3
+	h := newEpicTestHelper(t, store)
+	epic := h.createEpic("Epic")
+	feature := h.createEpic("Feature")
+	task1 := h.createTask("Task 1")
+	task2 := h.createTask("Task 2")
+	task3 := h.createTask("Task 3")
+	deleted := h.createTask("Deleted")
+	h.addParentChildDependency(feature.ID, epic.ID)
+	h.addParentChildDependency(task1.ID, feature.ID)
+	h.addParentChildDependency(task2.ID, feature.ID)
+	h.addParentChildDependency(deleted.ID, feature.ID)
+	h.addParentChildDependency(task3.ID, epic.ID)
+	if _, err := store.db.ExecContext(ctx, `UPDATE issues SET status = 'tombstone' WHERE id = ?`, deleted.ID); err != nil {
+		t.Fatalf("failed to tombstone issue: %v", err)
+	}
+	h.closeIssue(task1.ID, "Done")
+	h.closeIssue(task3.ID, "Done")
+
+	for _, tc := range []struct {
+		id          string
+		done, total int
+	}{
+		{epic.ID, 2, 4},
+		{feature.ID, 1, 2},
+		{task2.ID, 0, 0},
+	} {
+		done, total, err := store.EpicProgress(ctx, tc.id)
+		if err != nil {
+			t.Fatalf("EpicProgress(%s) failed: %v", tc.id, err)
+		}
+		if done != tc.done || total != tc.total {
+			t.Errorf("EpicProgress(%s) = %d/%d, want %d/%d", tc.id, done, total, tc.done, tc.total)
+		}
+	}
+}
@@ -4,25 +4,75 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+
+	sqlite3 "github.com/ncruces/go-sqlite3"
+	"github.com/steveyegge/beads/internal/storage"
 )
 
-// Sentinel errors for common database conditions
+// Sentinel errors for common database conditions. ErrNotFound, ErrLocked,
+// ErrConflict and ErrCorrupt are the storage package's, so callers can test
+// for them without depending on this backend.
 var (
 	// ErrNotFound indicates the requested resource was not found in the database
-	ErrNotFound = errors.New("not found")
+	ErrNotFound = storage.ErrNotFound
+
+	// ErrLocked indicates the database is locked by another connection
+	ErrLocked = storage.ErrLocked
+
+	// ErrCorrupt indicates the database file is malformed or not a database
+	ErrCorrupt = storage.ErrCorrupt
 
 	// ErrInvalidID indicates an ID format or validation error
 	ErrInvalidID = errors.New("invalid ID")
 
 	// ErrConflict indicates a unique constraint violation or conflicting state
-	ErrConflict = errors.New("conflict")
+	ErrConflict = storage.ErrConflict
 
 	// ErrCycle indicates a dependency cycle would be created
 	ErrCycle = errors.New("dependency cycle detected")
+
+	// ErrDependencyTargetNotFound indicates a dependency points at an issue
+	// that does not exist. It also matches ErrNotFound.
+	ErrDependencyTargetNotFound = fmt.Errorf("%w", ErrNotFound)
+
+	// ErrPrefixMismatch indicates the issues in the database use a different
+	// prefix than the configured issue_prefix
+	ErrPrefixMismatch = errors.New("issue prefix mismatch")
 )
 
+// classifiedError is a driver error that also matches one of the sentinel
+// errors, keeping the driver's message.
+type classifiedError struct {
+	err      error
+	sentinel error
+}
+
+func (e *classifiedError) Error() string   { return e.err.Error() }
+func (e *classifiedError) Unwrap() []error { return []error{e.err, e.sentinel} }
+
+// classifyDBError makes a driver error match the sentinel error for its
+// condition (locked, corrupt, constraint violation), so errors.Is works on
+// it. Other errors, and errors that already match, are returned unchanged.
+func classifyDBError(err error) error {
+	var sentinel error
+	switch {
+	case err == nil, errors.Is(err, ErrLocked), errors.Is(err, ErrCorrupt), errors.Is(err, ErrConflict):
+		return err
+	case errors.Is(err, sqlite3.BUSY), errors.Is(err, sqlite3.LOCKED), IsBusyError(err):
+		sentinel = ErrLocked
+	case errors.Is(err, sqlite3.CORRUPT), errors.Is(err, sqlite3.NOTADB):
+		sentinel = ErrCorrupt
+	case errors.Is(err, sqlite3.CONSTRAINT_UNIQUE), errors.Is(err, sqlite3.CONSTRAINT_PRIMARYKEY), IsUniqueConstraintError(err):
+		sentinel = ErrConflict
+	default:
+		return err
+	}
+	return &classifiedError{err: err, sentinel: sentinel}
+}
+
 // wrapDBError wraps a database error with operation context
-// It converts sql.ErrNoRows to ErrNotFound for consistent error handling
+// It converts sql.ErrNoRows to ErrNotFound for consistent error handling,
+// and classifies driver errors (see classifyDBError)
 func wrapDBError(op string, err error) error {
 	if err == nil {
 		return nil
@@ -30,7 +80,7 @@ func wrapDBError(op string, err error) error {
 	if errors.Is(err, sql.ErrNoRows) {
 		return fmt.Errorf("%s: %w", op, ErrNotFound)
 	}
-	return fmt.Errorf("%s: %w", op, err)
+	return fmt.Errorf("%s: %w", op, classifyDBError(err))
 }
 
 // wrapDBErrorf wraps a database error with formatted operation context
@@ -43,7 +93,7 @@ func wrapDBErrorf(err error, format string, args ...interface{}) error {
 	if errors.Is(err, sql.ErrNoRows) {
 		return fmt.Errorf("%s: %w", op, ErrNotFound)
 	}
-	return fmt.Errorf("%s: %w", op, err)
+	return fmt.Errorf("%s: %w", op, classifyDBError(err))
 }
 
 // IsNotFound checks if an error is or wraps ErrNotFound
@@ -56,6 +106,16 @@ func IsConflict(err error) bool {
 	return errors.Is(err, ErrConflict)
 }
 
+// IsLocked checks if an error is or wraps ErrLocked
+func IsLocked(err error) bool {
+	return errors.Is(err, ErrLocked)
+}
+
+// IsCorrupt checks if an error is or wraps ErrCorrupt
+func IsCorrupt(err error) bool {
+	return errors.Is(err, ErrCorrupt)
+}
+
 // IsCycle checks if an error is or wraps ErrCycle
 func IsCycle(err error) bool {
 	return errors.Is(err, ErrCycle)
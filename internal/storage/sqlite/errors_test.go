@@ -1,10 +1,18 @@
 package sqlite
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+
+	sqlite3 "github.com/ncruces/go-sqlite3"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // TestWrapDBError tests the wrapDBError function
@@ -246,3 +254,134 @@ func TestSQLErrNoRowsConversion(t *testing.T) {
 		t.Errorf("err2 message = %q, want %q", err2.Error(), "get metadata key: not found")
 	}
 }
+
+// TestClassifyDBError tests that driver errors match the storage sentinels
+func TestClassifyDBError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"busy code", sqlite3.BUSY, storage.ErrLocked},
+		{"locked message", errors.New("sqlite3: database is locked"), storage.ErrLocked},
+		{"corrupt code", sqlite3.CORRUPT, storage.ErrCorrupt},
+		{"not a database code", sqlite3.NOTADB, storage.ErrCorrupt},
+		{"unique constraint code", sqlite3.CONSTRAINT_UNIQUE, storage.ErrConflict},
+		{"unique constraint message", errors.New("UNIQUE constraint failed: issues.id"), storage.ErrConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapDBError("op", tt.err)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("wrapDBError(%v) doesn't match %v", tt.err, tt.want)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("wrapDBError(%v) lost the driver error", tt.err)
+			}
+			if want := "op: " + tt.err.Error(); err.Error() != want {
+				t.Errorf("error message = %q, want %q", err.Error(), want)
+			}
+		})
+	}
+
+	if err := classifyDBError(errors.New("timeout")); errors.Is(err, storage.ErrLocked) || errors.Is(err, storage.ErrCorrupt) || errors.Is(err, storage.ErrConflict) {
+		t.Errorf("classifyDBError(timeout) = %v, want no sentinel", err)
+	}
+}
+
+// TestStorageErrorsIs tests that storage methods return errors matching the
+// storage sentinels for each condition
+func TestStorageErrorsIs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		store, cleanup := setupTestDB(t)
+		defer cleanup()
+
+		err := store.UpdateIssue(ctx, "bd-missing", map[string]interface{}{"title": "x"}, "test")
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("UpdateIssue on missing issue: got %v, want ErrNotFound", err)
+		}
+		err = store.CloseIssue(ctx, "bd-missing", "done", "test")
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("CloseIssue on missing issue: got %v, want ErrNotFound", err)
+		}
+		err = store.AddComment(ctx, "bd-missing", "test", "hello")
+		if !errors.Is(err, storage.ErrNotFound) {
+			t.Errorf("AddComment on missing issue: got %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		store, cleanup := setupTestDB(t)
+		defer cleanup()
+
+		issue := &types.Issue{ID: "bd-dup", Title: "First", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue() error = %v", err)
+		}
+		again := &types.Issue{ID: "bd-dup", Title: "Second", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		err := store.CreateIssues(ctx, []*types.Issue{again}, "test")
+		if !errors.Is(err, storage.ErrConflict) {
+			t.Errorf("CreateIssues with a taken ID: got %v, want ErrConflict", err)
+		}
+
+		other := &types.Issue{ID: "bd-other", Title: "Other", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, other, "test"); err != nil {
+			t.Fatalf("CreateIssue() error = %v", err)
+		}
+		err = store.UpdateIssueID(ctx, other.ID, issue.ID, other, "test")
+		if !errors.Is(err, storage.ErrConflict) {
+			t.Errorf("UpdateIssueID to a taken ID: got %v, want ErrConflict", err)
+		}
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		store, cleanup := setupTestDB(t)
+		defer cleanup()
+
+		issue := &types.Issue{Title: "Task", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue() error = %v", err)
+		}
+
+		other, err := NewWithTimeout(ctx, store.dbPath, 0)
+		if err != nil {
+			t.Fatalf("NewWithTimeout() error = %v", err)
+		}
+		defer other.Close()
+		other.writeMaxRetries = 0
+
+		// Hold the write lock from the first store
+		conn, err := store.db.Conn(ctx)
+		if err != nil {
+			t.Fatalf("Conn() error = %v", err)
+		}
+		defer conn.Close()
+		if _, err := conn.ExecContext(ctx, "BEGIN IMMEDIATE"); err != nil {
+			t.Fatalf("BEGIN IMMEDIATE error = %v", err)
+		}
+		defer func() { _, _ = conn.ExecContext(ctx, "ROLLBACK") }()
+
+		err = other.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": "Renamed"}, "test")
+		if !errors.Is(err, storage.ErrLocked) {
+			t.Errorf("UpdateIssue while locked: got %v, want ErrLocked", err)
+		}
+	})
+
+	t.Run("corrupt", func(t *testing.T) {
+		dbPath := filepath.Join(t.TempDir(), "beads.db")
+		garbage := bytes.Repeat([]byte("this is not a database "), 512)
+		if err := os.WriteFile(dbPath, garbage, 0o600); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		store, err := New(ctx, dbPath)
+		if err == nil {
+			store.Close()
+			t.Fatal("New() on a garbage file succeeded, want error")
+		}
+		if !errors.Is(err, storage.ErrCorrupt) {
+			t.Errorf("New() on a garbage file: got %v, want ErrCorrupt", err)
+		}
+	})
+}
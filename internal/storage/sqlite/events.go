@@ -28,7 +28,7 @@ func (s *SQLiteStorage) AddComment(ctx context.Context, issueID, actor, comment
 			return fmt.Errorf("failed to get rows affected: %w", err)
 		}
 		if rows == 0 {
-			return fmt.Errorf("issue %s not found", issueID)
+			return fmt.Errorf("issue %s %w", issueID, ErrNotFound)
 		}
 
 		_, err = tx.ExecContext(ctx, `
@@ -205,5 +205,10 @@ func (s *SQLiteStorage) GetStatistics(ctx context.Context) (*types.Statistics, e
 		return nil, fmt.Errorf("failed to get eligible epics count: %w", err)
 	}
 
+	stats.ByStatus, err = s.CountByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count issues by status: %w", err)
+	}
+
 	return &stats, nil
 }
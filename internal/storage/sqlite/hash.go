@@ -83,3 +83,21 @@ func (s *SQLiteStorage) SetJSONLFileHash(ctx context.Context, fileHash string) e
 	
 	return nil
 }
+
+// IssueHash returns the content hash of an issue: a SHA-256 over its
+// substantive fields in a fixed order (see types.Issue.ComputeContentHash).
+// It ignores the ID, timestamps and compaction metadata, so it is stable
+// across processes and clones and only changes when the content does.
+//
+// The hash is computed from the current row rather than read from the
+// content_hash column, which not every write path (e.g. CloseIssue) refreshes.
+func (s *SQLiteStorage) IssueHash(ctx context.Context, id string) (string, error) {
+	issue, err := s.GetIssue(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if issue == nil {
+		return "", fmt.Errorf("%w: issue %s", ErrNotFound, id)
+	}
+	return issue.ComputeContentHash(), nil
+}
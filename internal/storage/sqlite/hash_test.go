@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestIssueHash(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issue := &types.Issue{
+		Title:       "Hash me",
+		Description: "Stable content",
+		Status:      types.StatusOpen,
+		Priority:    1,
+		IssueType:   types.TypeTask,
+	}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+
+	first, err := store.IssueHash(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("IssueHash failed: %v", err)
+	}
+	if len(first) != 64 {
+		t.Fatalf("hash %q is not hex SHA-256", first)
+	}
+
+	// Same content built independently, with different ID and timestamps, hashes the same
+	twin := &types.Issue{
+		ID:          "other-1",
+		Title:       issue.Title,
+		Description: issue.Description,
+		Status:      types.StatusOpen,
+		Priority:    1,
+		IssueType:   types.TypeTask,
+		CreatedAt:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if got := twin.ComputeContentHash(); got != first {
+		t.Errorf("hash depends on ID or timestamps: %s != %s", got, first)
+	}
+
+	// Reads do not change the hash
+	if _, err := store.GetIssue(ctx, issue.ID); err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	again, err := store.IssueHash(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("IssueHash failed: %v", err)
+	}
+	if again != first {
+		t.Errorf("hash changed between calls: %s != %s", again, first)
+	}
+
+	// Content changes do, including ones made by CloseIssue
+	if err := store.CloseIssue(ctx, issue.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+	closed, err := store.IssueHash(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("IssueHash failed: %v", err)
+	}
+	if closed == first {
+		t.Error("hash did not change after closing the issue")
+	}
+
+	if _, err := store.IssueHash(ctx, "bd-missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("IssueHash(missing) error = %v, want ErrNotFound", err)
+	}
+}
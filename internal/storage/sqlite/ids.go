@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/utils"
 )
 
 // isValidBase36 checks if a string contains only base36 characters
@@ -93,13 +95,65 @@ func ParseHierarchicalID(id string) (parentID string, childNum int, ok bool) {
 // ValidateIssueIDPrefix validates that an issue ID matches the configured prefix
 // Supports both top-level (bd-a3f8e9) and hierarchical (bd-a3f8e9.1) IDs
 func ValidateIssueIDPrefix(id, prefix string) error {
-	expectedPrefix := prefix + "-"
+	expectedPrefix := prefix + idgen.Separator()
 	if !strings.HasPrefix(id, expectedPrefix) {
 		return fmt.Errorf("issue ID '%s' does not match configured prefix '%s'", id, prefix)
 	}
 	return nil
 }
 
+// CheckIssuePrefix returns an error wrapping ErrPrefixMismatch when most
+// live issues use a prefix other than the configured issue_prefix, as happens
+// when issue_prefix is changed without renaming the issues. A database with
+// no issues, or with no prefix configured, passes.
+func (s *SQLiteStorage) CheckIssuePrefix(ctx context.Context) error {
+	configured, err := s.GetConfig(ctx, "issue_prefix")
+	if err != nil {
+		return fmt.Errorf("failed to get issue_prefix: %w", err)
+	}
+	if configured == "" {
+		return nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `SELECT id FROM issues WHERE status != 'tombstone'`)
+	if err != nil {
+		return fmt.Errorf("failed to query issue IDs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[string]int)
+	total := 0
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("failed to scan issue ID: %w", err)
+		}
+		counts[utils.ExtractIssuePrefix(id)]++
+		total++
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to query issue IDs: %w", err)
+	}
+
+	// Dominant prefix; on a tie the configured prefix wins
+	prefixes := make([]string, 0, len(counts))
+	for prefix := range counts {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	dominant, best := configured, counts[configured]
+	for _, prefix := range prefixes {
+		if counts[prefix] > best {
+			dominant, best = prefix, counts[prefix]
+		}
+	}
+	if dominant == configured || dominant == "" {
+		return nil
+	}
+	return fmt.Errorf("%w: %d of %d issues use prefix '%s' but issue_prefix is '%s'",
+		ErrPrefixMismatch, counts[dominant], total, dominant, configured)
+}
+
 // GenerateIssueID generates a unique hash-based ID for an issue
 // Uses adaptive length based on database size and tries multiple nonces on collision
 func GenerateIssueID(ctx context.Context, conn *sql.Conn, prefix string, issue *types.Issue, actor string) (string, error) {
@@ -189,6 +243,27 @@ func GenerateBatchIssueIDs(ctx context.Context, conn *sql.Conn, prefix string, i
 	return nil
 }
 
+// NextIssueID allocates an unused hash ID under prefix for issue, the way
+// CreateIssue does. IDs in reserved count as taken, so a caller can allocate
+// IDs for several issues before creating any of them; the new ID is added to
+// reserved.
+func (s *SQLiteStorage) NextIssueID(ctx context.Context, prefix string, issue *types.Issue, actor string, reserved map[string]bool) (string, error) {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if reserved == nil {
+		reserved = make(map[string]bool)
+	}
+	candidate := []*types.Issue{{Title: issue.Title, Description: issue.Description, CreatedAt: issue.CreatedAt}}
+	if err := GenerateBatchIssueIDs(ctx, conn, prefix, candidate, actor, reserved); err != nil {
+		return "", err
+	}
+	return candidate[0].ID, nil
+}
+
 // tryResurrectParent attempts to find and resurrect a deleted parent issue from the import batch
 // Returns true if parent was found and will be created, false otherwise
 func tryResurrectParent(parentID string, issues []*types.Issue) bool {
@@ -213,7 +288,7 @@ func EnsureIDs(ctx context.Context, conn *sql.Conn, prefix string, issues []*typ
 		if issues[i].ID != "" {
 			// Check for duplicate IDs within the batch
 			if usedIDs[issues[i].ID] {
-				return fmt.Errorf("duplicate issue ID within batch: %s", issues[i].ID)
+				return fmt.Errorf("%w: duplicate issue ID within batch: %s", ErrConflict, issues[i].ID)
 			}
 
 			// Check if ID already exists in database
@@ -223,7 +298,7 @@ func EnsureIDs(ctx context.Context, conn *sql.Conn, prefix string, issues []*typ
 				return fmt.Errorf("failed to check ID existence: %w", err)
 			}
 			if existingCount > 0 {
-				return fmt.Errorf("issue ID already exists: %s", issues[i].ID)
+				return fmt.Errorf("%w: issue ID already exists: %s", ErrConflict, issues[i].ID)
 			}
 
 			// Validate that explicitly provided ID matches the configured prefix (bd-177)
@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// GetIssueDetail returns the issue with its labels, dependencies, dependents,
+// parent, children, comments and references. Unlike GetIssue it fails with ErrNotFound
+// for a missing ID.
+func (s *SQLiteStorage) GetIssueDetail(ctx context.Context, id string) (*types.IssueDetail, error) {
+	issue, err := s.GetIssue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("%w: issue %s", ErrNotFound, id)
+	}
+
+	detail := &types.IssueDetail{Issue: issue}
+	if detail.Labels, err = s.GetLabels(ctx, id); err != nil {
+		return nil, fmt.Errorf("failed to get labels of %s: %w", id, err)
+	}
+	if detail.Dependencies, err = s.GetDependenciesWithMetadata(ctx, id); err != nil {
+		return nil, err
+	}
+	if detail.Dependents, err = s.GetDependentsWithMetadata(ctx, id); err != nil {
+		return nil, err
+	}
+	if detail.Comments, err = s.GetIssueComments(ctx, id); err != nil {
+		return nil, fmt.Errorf("failed to get comments of %s: %w", id, err)
+	}
+	if detail.References, err = s.GetReferences(ctx, id); err != nil {
+		return nil, fmt.Errorf("failed to get references of %s: %w", id, err)
+	}
+
+	// A child depends on its parent with a parent-child dependency
+	for _, dep := range detail.Dependencies {
+		if dep.DependencyType == types.DepParentChild {
+			parent := dep.Issue
+			detail.Parent = &parent
+			break
+		}
+	}
+	for _, dep := range detail.Dependents {
+		if dep.DependencyType == types.DepParentChild {
+			child := dep.Issue
+			detail.Children = append(detail.Children, &child)
+		}
+	}
+	return detail, nil
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGetIssueDetail(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	newIssue := func(title string, issueType types.IssueType) *types.Issue {
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: issueType, Assignee: "alice"}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", title, err)
+		}
+		return issue
+	}
+	addDep := func(from, to *types.Issue, depType types.DependencyType) {
+		if err := store.AddDependency(ctx, &types.Dependency{IssueID: from.ID, DependsOnID: to.ID, Type: depType}, "test"); err != nil {
+			t.Fatalf("AddDependency(%s -> %s) error = %v", from.ID, to.ID, err)
+		}
+	}
+
+	epic := newIssue("Epic", types.TypeEpic)
+	issue := newIssue("Middle", types.TypeTask)
+	child := newIssue("Child", types.TypeTask)
+	blocker := newIssue("Blocker", types.TypeTask)
+	blocked := newIssue("Blocked", types.TypeTask)
+	addDep(issue, epic, types.DepParentChild)
+	addDep(child, issue, types.DepParentChild)
+	addDep(issue, blocker, types.DepBlocks)
+	addDep(blocked, issue, types.DepBlocks)
+	if err := store.AddLabel(ctx, issue.ID, "backend", "test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AddIssueComment(ctx, issue.ID, "bob", "Looks good"); err != nil {
+		t.Fatal(err)
+	}
+
+	detail, err := store.GetIssueDetail(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssueDetail() error = %v", err)
+	}
+	if detail.ID != issue.ID || detail.Assignee != "alice" {
+		t.Errorf("issue = %s (assignee %q), want %s (alice)", detail.ID, detail.Assignee, issue.ID)
+	}
+	if len(detail.Labels) != 1 || detail.Labels[0] != "backend" {
+		t.Errorf("Labels = %v, want [backend]", detail.Labels)
+	}
+	if len(detail.Dependencies) != 2 || len(detail.Dependents) != 2 {
+		t.Errorf("got %d dependencies and %d dependents, want 2 and 2", len(detail.Dependencies), len(detail.Dependents))
+	}
+	if detail.Parent == nil || detail.Parent.ID != epic.ID {
+		t.Errorf("Parent = %v, want %s", detail.Parent, epic.ID)
+	}
+	if len(detail.Children) != 1 || detail.Children[0].ID != child.ID {
+		t.Errorf("Children = %v, want [%s]", detail.Children, child.ID)
+	}
+	if len(detail.Comments) != 1 || detail.Comments[0].Text != "Looks good" {
+		t.Errorf("Comments = %v, want the one comment", detail.Comments)
+	}
+
+	if _, err := store.GetIssueDetail(ctx, "bd-missing"); !IsNotFound(err) {
+		t.Errorf("GetIssueDetail(missing) error = %v, want ErrNotFound", err)
+	}
+}
@@ -160,7 +160,7 @@ func (s *SQLiteStorage) GetIssuesByLabel(ctx context.Context, label string) ([]*
 		       i.created_at, i.updated_at, i.closed_at, i.external_ref, i.source_repo, i.close_reason,
 		       i.deleted_at, i.deleted_by, i.delete_reason, i.original_type,
 		       i.sender, i.ephemeral, i.pinned, i.is_template,
-		       i.await_type, i.await_id, i.timeout_ns, i.waiters
+		       i.await_type, i.await_id, i.timeout_ns, i.waiters, i.due_date
 		FROM issues i
 		JOIN labels l ON i.id = l.issue_id
 		WHERE l.label = ?
@@ -173,3 +173,30 @@ func (s *SQLiteStorage) GetIssuesByLabel(ctx context.Context, label string) ([]*
 
 	return s.scanIssues(ctx, rows)
 }
+
+// CountByLabel returns the number of issues carrying each label using a
+// single grouped query. Deleted issues (tombstones) are not counted.
+func (s *SQLiteStorage) CountByLabel(ctx context.Context) (map[string]int, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT l.label, COUNT(*)
+		FROM labels l
+		JOIN issues i ON i.id = l.issue_id
+		WHERE i.status != ?
+		GROUP BY l.label
+	`, types.StatusTombstone)
+	if err != nil {
+		return nil, wrapDBError("count issues by label", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var label string
+		var count int
+		if err := rows.Scan(&label, &count); err != nil {
+			return nil, wrapDBError("scan label count", err)
+		}
+		counts[label] = count
+	}
+	return counts, rows.Err()
+}
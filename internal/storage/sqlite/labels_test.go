@@ -392,3 +392,38 @@ func TestLabelMarksDirty(t *testing.T) {
 		t.Error("Expected issue to be marked dirty after removing label")
 	}
 }
+
+func TestCountByLabel(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	var ids []string
+	for _, title := range []string{"First", "Second", "Deleted"} {
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test-user"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		ids = append(ids, issue.ID)
+	}
+	for _, id := range ids {
+		if err := store.AddLabel(ctx, id, "backend", "test-user"); err != nil {
+			t.Fatalf("AddLabel failed: %v", err)
+		}
+	}
+	if err := store.AddLabel(ctx, ids[0], "urgent", "test-user"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	if _, err := store.db.ExecContext(ctx, `UPDATE issues SET status = ? WHERE id = ?`, types.StatusTombstone, ids[2]); err != nil {
+		t.Fatalf("failed to tombstone issue: %v", err)
+	}
+
+	counts, err := store.CountByLabel(ctx)
+	if err != nil {
+		t.Fatalf("CountByLabel failed: %v", err)
+	}
+	if len(counts) != 2 || counts["backend"] != 2 || counts["urgent"] != 1 {
+		t.Errorf("counts = %v, want map[backend:2 urgent:1]", counts)
+	}
+}
@@ -0,0 +1,253 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/idgen"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// MergeStrategy controls what Merge does when an incoming issue has the same
+// ID as an existing issue with different content.
+type MergeStrategy string
+
+const (
+	// MergeRenumber gives colliding incoming issues fresh IDs and rewrites
+	// their dependencies to match.
+	MergeRenumber MergeStrategy = "renumber"
+	// MergeStrict aborts the merge, before writing anything, if any ID collides.
+	MergeStrict MergeStrategy = "strict"
+)
+
+// IsValid reports whether s is a known merge strategy.
+func (s MergeStrategy) IsValid() bool {
+	return s == MergeRenumber || s == MergeStrict
+}
+
+// MergeResult summarizes a Merge.
+type MergeResult struct {
+	Added               int               // Issues copied from the other database
+	Unchanged           int               // Incoming issues identical to an existing issue
+	Renumbered          map[string]string // Incoming ID -> new ID for colliding issues
+	SkippedDependencies []string          // Dependencies whose target does not exist here
+}
+
+// Merge copies every non-deleted issue from other into s, together with its
+// labels, dependencies and comments, in a single transaction. Issues whose
+// ID and content already exist here are left alone. An incoming issue whose ID is taken by an
+// issue with different content is a collision, handled per strategy.
+//
+// With MergeRenumber, children of a renumbered issue move with their parent
+// (bd-a3f8e9.1 becomes <new>.1) and all dependencies are rewritten to the
+// new IDs. Text that mentions the old ID is not rewritten.
+func (s *SQLiteStorage) Merge(ctx context.Context, other *SQLiteStorage, strategy MergeStrategy, actor string) (*MergeResult, error) {
+	if !strategy.IsValid() {
+		return nil, fmt.Errorf("invalid merge strategy %q (must be %s or %s)", strategy, MergeRenumber, MergeStrict)
+	}
+
+	incoming, err := other.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read issues to merge: %w", err)
+	}
+	// Parents before children so a renumbered parent is mapped before its children
+	sort.Slice(incoming, func(i, j int) bool {
+		di, dj := hierarchyDepth(incoming[i].ID), hierarchyDepth(incoming[j].ID)
+		if di != dj {
+			return di < dj
+		}
+		return incoming[i].ID < incoming[j].ID
+	})
+
+	prefix, err := s.GetConfig(ctx, "issue_prefix")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue prefix: %w", err)
+	}
+	if strings.TrimSpace(prefix) == "" {
+		// Renumbered issues would get IDs like "-a3f8e9"
+		return nil, fmt.Errorf("database not initialized: issue_prefix config is missing (run 'bd init --prefix <prefix>' first)")
+	}
+
+	result := &MergeResult{Renumbered: make(map[string]string)}
+	idMap := make(map[string]string, len(incoming))
+	used := make(map[string]bool)
+	var toCreate []*types.Issue
+	var collisions []string
+
+	for _, issue := range incoming {
+		newID := issue.ID
+		if isChild, parentID := IsHierarchicalID(issue.ID); isChild {
+			if mapped, ok := idMap[parentID]; ok && mapped != parentID {
+				newID = mapped + strings.TrimPrefix(issue.ID, parentID)
+			}
+		}
+
+		existing, err := s.GetIssue(ctx, newID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s: %w", newID, err)
+		}
+		if existing != nil && newID == issue.ID && existing.ComputeContentHash() == issue.ComputeContentHash() {
+			idMap[issue.ID] = issue.ID
+			result.Unchanged++
+			continue
+		}
+		if existing != nil || used[newID] {
+			if strategy == MergeStrict {
+				collisions = append(collisions, issue.ID)
+				continue
+			}
+			newID, err = s.mergeRenumberID(ctx, issue, prefix, actor, used)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		idMap[issue.ID] = newID
+		used[newID] = true
+		if newID != issue.ID {
+			result.Renumbered[issue.ID] = newID
+		}
+		clone := *issue
+		clone.ID = newID
+		clone.ContentHash = ""
+		toCreate = append(toCreate, &clone)
+	}
+
+	if len(collisions) > 0 {
+		return nil, fmt.Errorf("merge aborted: %d issue ID(s) collide with different content: %s",
+			len(collisions), strings.Join(collisions, ", "))
+	}
+	if len(toCreate) == 0 {
+		return result, nil
+	}
+
+	// Labels, comments and dependencies of the copied issues, under their new IDs
+	oldIDs := make([]string, 0, len(toCreate))
+	for oldID, newID := range idMap {
+		if used[newID] {
+			oldIDs = append(oldIDs, oldID)
+		}
+	}
+	sort.Strings(oldIDs)
+
+	labels, err := other.GetLabelsForIssues(ctx, oldIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read labels to merge: %w", err)
+	}
+	comments, err := other.GetCommentsForIssues(ctx, oldIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read comments to merge: %w", err)
+	}
+	deps, err := other.GetAllDependencyRecords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dependencies to merge: %w", err)
+	}
+
+	if err := s.prepareBatchIssues(ctx, toCreate); err != nil {
+		return nil, fmt.Errorf("failed to create merged issues: %w", err)
+	}
+
+	// Everything from here on is one transaction, so a failure leaves the
+	// database as it was
+	var skipped []string
+	err = s.runInTxStorage(ctx, func(t *sqliteTxStorage) error {
+		if err := s.insertBatchIssues(ctx, t.conn, toCreate, actor, BatchCreateOptions{
+			OrphanHandling:       OrphanAllow,
+			SkipPrefixValidation: true,
+		}); err != nil {
+			return fmt.Errorf("failed to create merged issues: %w", err)
+		}
+
+		for _, oldID := range oldIDs {
+			newID := idMap[oldID]
+			for _, label := range labels[oldID] {
+				if err := t.AddLabel(ctx, newID, label, actor); err != nil {
+					return fmt.Errorf("failed to add label %s to %s: %w", label, newID, err)
+				}
+			}
+			for _, comment := range comments[oldID] {
+				createdAt := comment.CreatedAt.UTC().Format(time.RFC3339)
+				if _, err := t.conn.ExecContext(ctx, `
+					INSERT INTO comments (issue_id, author, text, created_at)
+					VALUES (?, ?, ?, ?)
+				`, newID, comment.Author, comment.Text, createdAt); err != nil {
+					return fmt.Errorf("failed to add comment to %s: %w", newID, err)
+				}
+			}
+			for _, dep := range deps[oldID] {
+				rewritten := *dep
+				rewritten.IssueID = newID
+				if mapped, ok := idMap[dep.DependsOnID]; ok {
+					rewritten.DependsOnID = mapped
+				}
+				if err := t.AddDependency(ctx, &rewritten, actor); err != nil {
+					if IsForeignKeyConstraintError(err) || errors.Is(err, ErrDependencyTargetNotFound) {
+						skipped = append(skipped,
+							fmt.Sprintf("%s → %s (%s)", rewritten.IssueID, rewritten.DependsOnID, rewritten.Type))
+						continue
+					}
+					return fmt.Errorf("failed to add dependency %s → %s: %w", rewritten.IssueID, rewritten.DependsOnID, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Added = len(toCreate)
+	result.SkippedDependencies = skipped
+	return result, nil
+}
+
+// mergeRenumberID picks an unused ID for a colliding incoming issue. Child
+// issues keep their parent and get the next free child number; top-level
+// issues get a fresh hash ID with this database's prefix.
+func (s *SQLiteStorage) mergeRenumberID(ctx context.Context, issue *types.Issue, prefix, actor string, used map[string]bool) (string, error) {
+	if isChild, parentID := IsHierarchicalID(issue.ID); isChild {
+		for {
+			childID, err := s.GetNextChildID(ctx, parentID)
+			if err != nil {
+				break // Parent is not here; fall back to a top-level ID
+			}
+			if !used[childID] {
+				return childID, nil
+			}
+		}
+	}
+
+	for length := 6; length <= 8; length++ {
+		for nonce := 0; nonce < 10; nonce++ {
+			candidate := idgen.GenerateHashID(prefix, issue.Title, issue.Description, actor, issue.CreatedAt, length, nonce)
+			if used[candidate] {
+				continue
+			}
+			existing, err := s.GetIssue(ctx, candidate)
+			if err != nil {
+				return "", fmt.Errorf("failed to check %s: %w", candidate, err)
+			}
+			if existing == nil {
+				return candidate, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("failed to generate a new ID for %s", issue.ID)
+}
+
+// hierarchyDepth returns how many parents a hierarchical ID has.
+func hierarchyDepth(id string) int {
+	depth := 0
+	for {
+		isChild, parentID := IsHierarchicalID(id)
+		if !isChild {
+			return depth
+		}
+		depth++
+		id = parentID
+	}
+}
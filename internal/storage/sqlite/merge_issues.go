@@ -0,0 +1,132 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// MergedIntoReason returns the delete reason MergeIssues leaves on a merged
+// issue's tombstone.
+func MergedIntoReason(keep string) string {
+	return "merged into " + keep
+}
+
+// MergeIssues merges remove into keep, in a single transaction. The
+// dependencies, comments, references and labels of remove move to keep, and
+// dependencies on remove are rewritten to point at keep. Relations keep
+// already has are not duplicated, and a dependency between the two issues is
+// dropped rather than turned into a self-dependency. remove is then
+// tombstoned with the reason "merged into <keep>".
+func (s *SQLiteStorage) MergeIssues(ctx context.Context, keep, remove, actor string) error {
+	if keep == remove {
+		return fmt.Errorf("cannot merge %s into itself", keep)
+	}
+	var removeStatus types.Status
+	for _, id := range []string{keep, remove} {
+		issue, err := s.GetIssue(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get issue %s: %w", id, err)
+		}
+		if issue == nil {
+			return fmt.Errorf("%w: issue %s", ErrNotFound, id)
+		}
+		if issue.Status == types.StatusTombstone {
+			return fmt.Errorf("cannot merge %s: issue is deleted", id)
+		}
+		if id == remove {
+			removeStatus = issue.Status
+		}
+	}
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		// Issues whose exported dependencies change when remove goes away
+		rows, err := tx.QueryContext(ctx, `
+			SELECT DISTINCT issue_id FROM dependencies WHERE depends_on_id = ?
+		`, remove)
+		if err != nil {
+			return fmt.Errorf("failed to find dependents of %s: %w", remove, err)
+		}
+		dirty := []string{keep, remove}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return fmt.Errorf("failed to scan dependent: %w", err)
+			}
+			if id != keep && id != remove {
+				dirty = append(dirty, id)
+			}
+		}
+		_ = rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to iterate dependents: %w", err)
+		}
+
+		// Drop edges between the two issues, then move the rest. OR IGNORE
+		// skips edges keep already has; the leftovers are deleted.
+		statements := []struct {
+			what  string
+			query string
+			args  []interface{}
+		}{
+			{"drop dependencies between merged issues", `
+				DELETE FROM dependencies
+				WHERE (issue_id = ? AND depends_on_id = ?) OR (issue_id = ? AND depends_on_id = ?)
+			`, []interface{}{keep, remove, remove, keep}},
+			{"move dependencies", `UPDATE OR IGNORE dependencies SET issue_id = ? WHERE issue_id = ?`, []interface{}{keep, remove}},
+			{"rewrite dependencies", `UPDATE OR IGNORE dependencies SET depends_on_id = ? WHERE depends_on_id = ?`, []interface{}{keep, remove}},
+			{"delete duplicate dependencies", `DELETE FROM dependencies WHERE issue_id = ? OR depends_on_id = ?`, []interface{}{remove, remove}},
+			{"move comments", `UPDATE comments SET issue_id = ? WHERE issue_id = ?`, []interface{}{keep, remove}},
+			{"move references", `UPDATE OR IGNORE issue_references SET issue_id = ? WHERE issue_id = ?`, []interface{}{keep, remove}},
+			{"delete duplicate references", `DELETE FROM issue_references WHERE issue_id = ?`, []interface{}{remove}},
+			{"move labels", `UPDATE OR IGNORE labels SET issue_id = ? WHERE issue_id = ?`, []interface{}{keep, remove}},
+			{"delete duplicate labels", `DELETE FROM labels WHERE issue_id = ?`, []interface{}{remove}},
+		}
+		for _, stmt := range statements {
+			if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return fmt.Errorf("failed to %s: %w", stmt.what, err)
+			}
+		}
+
+		now := time.Now()
+		reason := MergedIntoReason(keep)
+		// closed_at must be NULL for a tombstone, see CreateTombstone
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE issues
+			SET status = ?, closed_at = NULL, deleted_at = ?, deleted_by = ?,
+			    delete_reason = ?, original_type = issue_type, updated_at = ?
+			WHERE id = ?
+		`, types.StatusTombstone, now, actor, reason, now, remove); err != nil {
+			return fmt.Errorf("failed to tombstone %s: %w", remove, err)
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE issues SET updated_at = ? WHERE id = ?`, now, keep); err != nil {
+			return fmt.Errorf("failed to update %s: %w", keep, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO events (issue_id, event_type, actor, comment)
+			VALUES (?, ?, ?, ?), (?, ?, ?, ?)
+		`, remove, "deleted", actor, reason,
+			keep, types.EventUpdated, actor, fmt.Sprintf("merged %s into this issue", remove)); err != nil {
+			return fmt.Errorf("failed to record merge events: %w", err)
+		}
+
+		if err := markIssuesDirtyTx(ctx, tx, dirty); err != nil {
+			return err
+		}
+		// Dependencies moved and remove no longer blocks anything
+		if err := s.invalidateBlockedCache(ctx, tx); err != nil {
+			return fmt.Errorf("failed to invalidate blocked cache: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.notifyStatusChange(remove, removeStatus, types.StatusTombstone, actor)
+	return nil
+}
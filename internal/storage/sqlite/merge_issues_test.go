@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestMergeIssuesTransfersRelations(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	newIssue := func(id, title string) {
+		t.Helper()
+		issue := &types.Issue{ID: id, Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", id, err)
+		}
+	}
+	newIssue("bd-keep", "Fix login timeout")
+	newIssue("bd-dup", "fix login timeout!")
+	newIssue("bd-blocker", "Upgrade auth library")
+	newIssue("bd-dependent", "Release 2.0")
+
+	addDep := func(from, to string) {
+		t.Helper()
+		dep := &types.Dependency{IssueID: from, DependsOnID: to, Type: types.DepBlocks}
+		if err := store.AddDependency(ctx, dep, "test"); err != nil {
+			t.Fatalf("AddDependency(%s -> %s) error = %v", from, to, err)
+		}
+	}
+	addDep("bd-dup", "bd-blocker")   // moves to bd-keep
+	addDep("bd-dependent", "bd-dup") // rewritten to bd-keep
+	addDep("bd-dup", "bd-keep")      // would be a self-dependency, dropped
+
+	for _, label := range []string{"auth", "bug"} {
+		if err := store.AddLabel(ctx, "bd-dup", label, "test"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.AddLabel(ctx, "bd-keep", "bug", "test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AddIssueComment(ctx, "bd-dup", "alice", "Seen on mobile too"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.AddReference(ctx, "bd-dup", "https://example.com/ticket/1", "report", "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.MergeIssues(ctx, "bd-keep", "bd-dup", "test"); err != nil {
+		t.Fatalf("MergeIssues() error = %v", err)
+	}
+
+	deps, err := store.GetDependencies(ctx, "bd-keep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps) != 1 || deps[0].ID != "bd-blocker" {
+		t.Errorf("bd-keep dependencies = %v, want [bd-blocker]", deps)
+	}
+	deps, err = store.GetDependencies(ctx, "bd-dependent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deps) != 1 || deps[0].ID != "bd-keep" {
+		t.Errorf("bd-dependent dependencies = %v, want [bd-keep]", deps)
+	}
+
+	labels, err := store.GetLabels(ctx, "bd-keep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 2 {
+		t.Errorf("bd-keep labels = %v, want [auth bug]", labels)
+	}
+	comments, err := store.GetIssueComments(ctx, "bd-keep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(comments) != 1 || comments[0].Text != "Seen on mobile too" {
+		t.Errorf("bd-keep comments = %+v, want the moved comment", comments)
+	}
+	refs, err := store.GetReferences(ctx, "bd-keep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 1 || refs[0].URL != "https://example.com/ticket/1" {
+		t.Errorf("bd-keep references = %+v, want the moved reference", refs)
+	}
+
+	removed, err := store.GetIssue(ctx, "bd-dup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed == nil || removed.Status != types.StatusTombstone || removed.DeleteReason != MergedIntoReason("bd-keep") {
+		t.Errorf("bd-dup after merge = %+v, want a tombstone merged into bd-keep", removed)
+	}
+	labels, err = store.GetLabels(ctx, "bd-dup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 0 {
+		t.Errorf("bd-dup still has labels %v", labels)
+	}
+}
+
+func TestMergeIssuesRejectsInvalid(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issue := &types.Issue{ID: "bd-1", Title: "Task", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.MergeIssues(ctx, "bd-1", "bd-1", "test"); err == nil {
+		t.Error("merging an issue into itself succeeded, want error")
+	}
+	if err := store.MergeIssues(ctx, "bd-1", "bd-missing", "test"); !IsNotFound(err) {
+		t.Errorf("merging a missing issue: err = %v, want not found", err)
+	}
+}
@@ -0,0 +1,203 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func createMergeIssue(t *testing.T, store *SQLiteStorage, id, title string) *types.Issue {
+	t.Helper()
+	issue := &types.Issue{ID: id, Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(context.Background(), issue, "test"); err != nil {
+		t.Fatalf("CreateIssue %s failed: %v", id, err)
+	}
+	return issue
+}
+
+func TestMergeWithoutCollisions(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	other, cleanupOther := setupTestDB(t)
+	defer cleanupOther()
+	ctx := context.Background()
+
+	shared := createMergeIssue(t, store, "bd-shared", "Same on both sides")
+	createMergeIssue(t, other, shared.ID, shared.Title)
+	createMergeIssue(t, other, "bd-a1", "Only in other")
+	createMergeIssue(t, other, "bd-a2", "Blocks a1")
+	if err := other.AddDependency(ctx, &types.Dependency{IssueID: "bd-a1", DependsOnID: "bd-a2", Type: types.DepBlocks}, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+	if err := other.AddLabel(ctx, "bd-a1", "backend", "test"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+
+	result, err := store.Merge(ctx, other, MergeStrict, "test")
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if result.Added != 2 || result.Unchanged != 1 || len(result.Renumbered) != 0 {
+		t.Fatalf("result = %+v, want 2 added, 1 unchanged, none renumbered", result)
+	}
+
+	deps, err := store.GetDependencies(ctx, "bd-a1")
+	if err != nil {
+		t.Fatalf("GetDependencies failed: %v", err)
+	}
+	if len(deps) != 1 || deps[0].ID != "bd-a2" {
+		t.Errorf("bd-a1 dependencies = %v, want [bd-a2]", deps)
+	}
+	labels, err := store.GetLabels(ctx, "bd-a1")
+	if err != nil {
+		t.Fatalf("GetLabels failed: %v", err)
+	}
+	if len(labels) != 1 || labels[0] != "backend" {
+		t.Errorf("bd-a1 labels = %v, want [backend]", labels)
+	}
+}
+
+func TestMergeCollisions(t *testing.T) {
+	setup := func(t *testing.T) (*SQLiteStorage, *SQLiteStorage, func()) {
+		store, cleanup := setupTestDB(t)
+		other, cleanupOther := setupTestDB(t)
+		ctx := context.Background()
+
+		createMergeIssue(t, store, "bd-1", "Ours")
+		createMergeIssue(t, other, "bd-1", "Theirs")
+		createMergeIssue(t, other, "bd-1.1", "Child of theirs")
+		createMergeIssue(t, other, "bd-2", "Depends on theirs")
+		if err := other.AddDependency(ctx, &types.Dependency{IssueID: "bd-2", DependsOnID: "bd-1", Type: types.DepBlocks}, "test"); err != nil {
+			t.Fatalf("AddDependency failed: %v", err)
+		}
+		return store, other, func() { cleanup(); cleanupOther() }
+	}
+
+	t.Run("strict", func(t *testing.T) {
+		store, other, cleanup := setup(t)
+		defer cleanup()
+		ctx := context.Background()
+
+		_, err := store.Merge(ctx, other, MergeStrict, "test")
+		if err == nil || !strings.Contains(err.Error(), "bd-1") {
+			t.Fatalf("expected collision error naming bd-1, got %v", err)
+		}
+		// Nothing is written when a strict merge fails
+		if got, _ := store.GetIssue(ctx, "bd-2"); got != nil {
+			t.Error("strict merge should not create any issues on collision")
+		}
+	})
+
+	t.Run("renumber", func(t *testing.T) {
+		store, other, cleanup := setup(t)
+		defer cleanup()
+		ctx := context.Background()
+
+		result, err := store.Merge(ctx, other, MergeRenumber, "test")
+		if err != nil {
+			t.Fatalf("Merge failed: %v", err)
+		}
+		if result.Added != 3 {
+			t.Errorf("Added = %d, want 3", result.Added)
+		}
+		newID := result.Renumbered["bd-1"]
+		if newID == "" || newID == "bd-1" || !strings.HasPrefix(newID, "bd-") {
+			t.Fatalf("bd-1 renumbered to %q", newID)
+		}
+		if result.Renumbered["bd-1.1"] != newID+".1" {
+			t.Errorf("child renumbered to %q, want %q", result.Renumbered["bd-1.1"], newID+".1")
+		}
+
+		ours, err := store.GetIssue(ctx, "bd-1")
+		if err != nil || ours == nil || ours.Title != "Ours" {
+			t.Fatalf("existing bd-1 changed: %+v, %v", ours, err)
+		}
+		theirs, err := store.GetIssue(ctx, newID)
+		if err != nil || theirs == nil || theirs.Title != "Theirs" {
+			t.Fatalf("renumbered issue = %+v, %v", theirs, err)
+		}
+
+		deps, err := store.GetDependencies(ctx, "bd-2")
+		if err != nil {
+			t.Fatalf("GetDependencies failed: %v", err)
+		}
+		if len(deps) != 1 || deps[0].ID != newID {
+			t.Errorf("bd-2 dependencies = %v, want [%s]", deps, newID)
+		}
+	})
+}
+
+func TestMergeIsAtomic(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	other, cleanupOther := setupTestDB(t)
+	defer cleanupOther()
+	ctx := context.Background()
+
+	createMergeIssue(t, other, "bd-a1", "Only in other")
+	if err := other.AddLabel(ctx, "bd-a1", "backend", "test"); err != nil {
+		t.Fatalf("AddLabel failed: %v", err)
+	}
+	// Fail after the issues are inserted
+	if _, err := store.db.ExecContext(ctx, `
+		CREATE TRIGGER fail_label BEFORE INSERT ON labels
+		BEGIN SELECT RAISE(ABORT, 'label insert failed'); END
+	`); err != nil {
+		t.Fatalf("creating trigger failed: %v", err)
+	}
+
+	if _, err := store.Merge(ctx, other, MergeRenumber, "test"); err == nil {
+		t.Fatal("Merge should fail when a label can't be added")
+	}
+	issue, err := store.GetIssue(ctx, "bd-a1")
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if issue != nil {
+		t.Error("failed merge left bd-a1 behind")
+	}
+}
+
+func TestMergeSkipsMissingDependencyTargets(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	other, cleanupOther := setupTestDB(t)
+	defer cleanupOther()
+	ctx := context.Background()
+
+	createMergeIssue(t, other, "bd-a1", "Depends on a deleted issue")
+	createMergeIssue(t, other, "bd-gone", "Deleted in other")
+	if err := other.AddDependency(ctx, &types.Dependency{IssueID: "bd-a1", DependsOnID: "bd-gone", Type: types.DepBlocks}, "test"); err != nil {
+		t.Fatalf("AddDependency failed: %v", err)
+	}
+	if err := other.CreateTombstone(ctx, "bd-gone", "test", "gone"); err != nil {
+		t.Fatalf("CreateTombstone failed: %v", err)
+	}
+
+	result, err := store.Merge(ctx, other, MergeStrict, "test")
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if len(result.SkippedDependencies) != 1 || !strings.Contains(result.SkippedDependencies[0], "bd-gone") {
+		t.Errorf("SkippedDependencies = %v, want the dependency on bd-gone", result.SkippedDependencies)
+	}
+}
+
+func TestMergeRejectsEmptyPrefix(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	other, cleanupOther := setupTestDB(t)
+	defer cleanupOther()
+	ctx := context.Background()
+
+	createMergeIssue(t, other, "bd-a1", "Only in other")
+	if err := store.SetConfig(ctx, "issue_prefix", ""); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+
+	if _, err := store.Merge(ctx, other, MergeRenumber, "test"); err == nil || !strings.Contains(err.Error(), "issue_prefix") {
+		t.Fatalf("Merge error = %v, want missing issue_prefix", err)
+	}
+}
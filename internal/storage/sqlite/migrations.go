@@ -44,6 +44,10 @@ var migrationsList = []Migration{
 	{"remove_depends_on_fk", migrations.MigrateRemoveDependsOnFK},
 	{"additional_indexes", migrations.MigrateAdditionalIndexes},
 	{"gate_columns", migrations.MigrateGateColumns},
+	{"archived_issues_table", migrations.MigrateArchivedIssuesTable},
+	{"issue_references_table", migrations.MigrateIssueReferencesTable},
+	{"due_date_column", migrations.MigrateDueDateColumn},
+	{"remove_events_issue_fk", migrations.MigrateRemoveEventsIssueFK},
 }
 
 // MigrationInfo contains metadata about a migration for inspection
@@ -95,6 +99,10 @@ func getMigrationDescription(name string) string {
 		"remove_depends_on_fk":         "Removes FK constraint on depends_on_id to allow external references (bd-zmmy)",
 		"additional_indexes":           "Adds performance optimization indexes for common query patterns (bd-h0we)",
 		"gate_columns":                 "Adds gate columns (await_type, await_id, timeout_ns, waiters) for async coordination (bd-udsi)",
+		"archived_issues_table":        "Adds archived_issues table for moving old closed issues out of default queries",
+		"issue_references_table":       "Adds issue_references table for links from issues to external URLs",
+		"due_date_column":              "Adds due_date column for issue due dates (YYYY-MM-DD)",
+		"remove_events_issue_fk":       "Removes FK constraint on events.issue_id so archived issues keep their audit trail",
 	}
 	
 	if desc, ok := descriptions[name]; ok {
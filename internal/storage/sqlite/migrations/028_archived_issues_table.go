@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateArchivedIssuesTable adds the archived_issues table used by Archive.
+// Archived issues are stored as JSON snapshots (including labels, dependencies
+// and comments) so they stay out of the issues table and default queries.
+func MigrateArchivedIssuesTable(db *sql.DB) error {
+	var tableName string
+	err := db.QueryRow(`
+		SELECT name FROM sqlite_master
+		WHERE type='table' AND name='archived_issues'
+	`).Scan(&tableName)
+
+	if err == sql.ErrNoRows {
+		_, err := db.Exec(`
+			CREATE TABLE archived_issues (
+				id TEXT PRIMARY KEY,
+				closed_at DATETIME,
+				archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				data TEXT NOT NULL
+			);
+			CREATE INDEX idx_archived_issues_closed_at ON archived_issues(closed_at);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create archived_issues table: %w", err)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check for archived_issues table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateIssueReferencesTable adds the issue_references table: links from
+// issues to external URLs such as pull requests and docs, each with an
+// optional label.
+func MigrateIssueReferencesTable(db *sql.DB) error {
+	var tableName string
+	err := db.QueryRow(`
+		SELECT name FROM sqlite_master
+		WHERE type='table' AND name='issue_references'
+	`).Scan(&tableName)
+
+	if err == sql.ErrNoRows {
+		_, err := db.Exec(`
+			CREATE TABLE issue_references (
+				issue_id TEXT NOT NULL,
+				url TEXT NOT NULL,
+				label TEXT NOT NULL DEFAULT '',
+				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				PRIMARY KEY (issue_id, url),
+				FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
+			);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create issue_references table: %w", err)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check for issue_references table: %w", err)
+	}
+
+	return nil
+}
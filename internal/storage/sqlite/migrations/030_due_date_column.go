@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateDueDateColumn adds the due_date column to the issues table. Due
+// dates are stored as YYYY-MM-DD text, so they compare correctly as strings.
+func MigrateDueDateColumn(db *sql.DB) error {
+	var columnExists bool
+	err := db.QueryRow(`
+		SELECT COUNT(*) > 0
+		FROM pragma_table_info('issues')
+		WHERE name = 'due_date'
+	`).Scan(&columnExists)
+	if err != nil {
+		return fmt.Errorf("failed to check due_date column: %w", err)
+	}
+
+	if columnExists {
+		return nil
+	}
+
+	_, err = db.Exec(`ALTER TABLE issues ADD COLUMN due_date TEXT`)
+	if err != nil {
+		return fmt.Errorf("failed to add due_date column: %w", err)
+	}
+
+	// Index issues with a due date, for overdue queries
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_issues_due_date ON issues(due_date) WHERE due_date IS NOT NULL`)
+	if err != nil {
+		return fmt.Errorf("failed to create due_date index: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// MigrateRemoveEventsIssueFK removes the FOREIGN KEY constraint on
+// events.issue_id so the audit trail of an archived issue survives the issue
+// leaving the issues table. Hard deletes already remove events explicitly.
+func MigrateRemoveEventsIssueFK(db *sql.DB) error {
+	// NOTE: Foreign keys are disabled in RunMigrations() before the EXCLUSIVE transaction starts,
+	// so dropping the old table does not cascade.
+	var tableSQL string
+	err := db.QueryRow(`SELECT sql FROM sqlite_master WHERE type='table' AND name='events'`).Scan(&tableSQL)
+	if err != nil {
+		return fmt.Errorf("failed to read events table definition: %w", err)
+	}
+	if !strings.Contains(tableSQL, "REFERENCES issues") {
+		return nil
+	}
+
+	_, err = db.Exec(`SAVEPOINT remove_events_issue_fk`)
+	if err != nil {
+		return err
+	}
+	savepointReleased := false
+	defer func() {
+		if !savepointReleased {
+			_, _ = db.Exec(`ROLLBACK TO SAVEPOINT remove_events_issue_fk`)
+		}
+	}()
+
+	if _, err = db.Exec(`
+		CREATE TABLE events_new (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			issue_id TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			actor TEXT NOT NULL,
+			old_value TEXT,
+			new_value TEXT,
+			comment TEXT,
+			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`); err != nil {
+		return fmt.Errorf("failed to create events_new table: %w", err)
+	}
+
+	if _, err = db.Exec(`
+		INSERT INTO events_new (id, issue_id, event_type, actor, old_value, new_value, comment, created_at)
+		SELECT id, issue_id, event_type, actor, old_value, new_value, comment, created_at
+		FROM events
+	`); err != nil {
+		return fmt.Errorf("failed to copy events: %w", err)
+	}
+
+	if _, err = db.Exec(`DROP TABLE events`); err != nil {
+		return fmt.Errorf("failed to drop old events table: %w", err)
+	}
+	if _, err = db.Exec(`ALTER TABLE events_new RENAME TO events`); err != nil {
+		return fmt.Errorf("failed to rename events_new: %w", err)
+	}
+
+	for _, stmt := range []string{
+		`CREATE INDEX IF NOT EXISTS idx_events_issue ON events(issue_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_events_created_at ON events(created_at)`,
+		`CREATE INDEX IF NOT EXISTS idx_events_issue_type ON events(issue_id, event_type)`,
+	} {
+		if _, err = db.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to recreate events index: %w", err)
+		}
+	}
+
+	_, err = db.Exec(`RELEASE SAVEPOINT remove_events_issue_fk`)
+	if err != nil {
+		return err
+	}
+	savepointReleased = true
+	return nil
+}
@@ -5,7 +5,6 @@ import (
 	"bufio"
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -171,7 +170,7 @@ func (s *SQLiteStorage) importJSONLFile(ctx context.Context, jsonlPath, sourceRe
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err != nil {
 			return 0, fmt.Errorf("failed to parse JSON at line %d: %w", lineNum, err)
 		}
 
@@ -303,8 +302,8 @@ func (s *SQLiteStorage) upsertIssueInTx(ctx context.Context, tx *sql.Tx, issue *
 				created_at, updated_at, closed_at, external_ref, source_repo, close_reason,
 				deleted_at, deleted_by, delete_reason, original_type,
 				sender, ephemeral, pinned, is_template,
-				await_type, await_id, timeout_ns, waiters
-			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+				await_type, await_id, timeout_ns, waiters, due_date
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		`,
 			issue.ID, issue.ContentHash, issue.Title, issue.Description, issue.Design,
 			issue.AcceptanceCriteria, issue.Notes, issue.Status,
@@ -314,6 +313,7 @@ func (s *SQLiteStorage) upsertIssueInTx(ctx context.Context, tx *sql.Tx, issue *
 			issue.DeletedAt, issue.DeletedBy, issue.DeleteReason, issue.OriginalType,
 			issue.Sender, wisp, pinned, isTemplate,
 			issue.AwaitType, issue.AwaitID, int64(issue.Timeout), formatJSONStringArray(issue.Waiters),
+			dueDateValue(issue.DueDate),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to insert issue: %w", err)
@@ -341,7 +341,7 @@ func (s *SQLiteStorage) upsertIssueInTx(ctx context.Context, tx *sql.Tx, issue *
 					updated_at = ?, closed_at = ?, external_ref = ?, source_repo = ?,
 					deleted_at = ?, deleted_by = ?, delete_reason = ?, original_type = ?,
 					sender = ?, ephemeral = ?, pinned = COALESCE(NULLIF(?, 0), pinned), is_template = ?,
-					await_type = ?, await_id = ?, timeout_ns = ?, waiters = ?
+					await_type = ?, await_id = ?, timeout_ns = ?, waiters = ?, due_date = ?
 				WHERE id = ?
 			`,
 				issue.ContentHash, issue.Title, issue.Description, issue.Design,
@@ -351,6 +351,7 @@ func (s *SQLiteStorage) upsertIssueInTx(ctx context.Context, tx *sql.Tx, issue *
 				issue.DeletedAt, issue.DeletedBy, issue.DeleteReason, issue.OriginalType,
 				issue.Sender, wisp, pinned, isTemplate,
 				issue.AwaitType, issue.AwaitID, int64(issue.Timeout), formatJSONStringArray(issue.Waiters),
+				dueDateValue(issue.DueDate),
 				issue.ID,
 			)
 			if err != nil {
@@ -3,7 +3,6 @@ package sqlite
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -152,9 +151,10 @@ func (s *SQLiteStorage) exportToRepo(ctx context.Context, repoPath string, issue
 	}()
 
 	// Write JSONL
-	encoder := json.NewEncoder(f)
+	format := config.TimestampFormat()
 	for _, issue := range issues {
-		if err := encoder.Encode(issue); err != nil {
+		issue.SchemaVersion = types.JSONLSchemaVersion
+		if err := types.EncodeIssueJSONL(f, issue, format); err != nil {
 			return 0, fmt.Errorf("failed to encode issue %s: %w", issue.ID, err)
 		}
 	}
@@ -175,7 +175,7 @@ func (s *SQLiteStorage) exportToRepo(ctx context.Context, repoPath string, issue
 	// Skip chmod for symlinks - os.Chmod follows symlinks and would change the target's
 	// permissions, which may be in a read-only location (e.g., /nix/store on NixOS).
 	if info, statErr := os.Lstat(jsonlPath); statErr == nil && info.Mode()&os.ModeSymlink == 0 {
-		if err := os.Chmod(jsonlPath, 0644); err != nil { // nolint:gosec // G302: 0644 intentional for git-tracked files
+		if err := os.Chmod(jsonlPath, config.FileMode(0644)); err != nil { // nolint:gosec // G302: 0644 intentional for git-tracked files
 			// Non-fatal
 			debug.Logf("Debug: failed to set permissions on %s: %v\n", jsonlPath, err)
 		}
@@ -0,0 +1,85 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// ListIssues returns one page of issues matching filter, ordered by
+// (created_at, id). Keyset pagination keeps pages stable while new issues
+// are created: they sort after every existing cursor. filter.Limit is
+// ignored in favor of page.Limit.
+//
+// The order compares the stored created_at text so the idx_issues_created_at
+// index serves it. That is creation order for timestamps written with the
+// same UTC offset; issues imported with another offset still page stably.
+func (s *SQLiteStorage) ListIssues(ctx context.Context, filter types.IssueFilter, page types.PageRequest) (*types.IssuePage, error) {
+	s.checkFreshness()
+
+	s.reconnectMu.RLock()
+	defer s.reconnectMu.RUnlock()
+
+	whereClauses, args, err := issueFilterClauses("", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.Cursor != "" {
+		createdAt, id, err := types.DecodeIssueCursor(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// The driver binds createdAt in the same text format it stored it in,
+		// so the comparison matches the ORDER BY below
+		whereClauses = append(whereClauses, "(created_at > ? OR (created_at = ? AND id > ?))")
+		args = append(args, createdAt, createdAt, id)
+	}
+
+	whereSQL := ""
+	if len(whereClauses) > 0 {
+		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	// Fetch one extra row to know whether another page follows
+	limitSQL := ""
+	if page.Limit > 0 {
+		limitSQL = " LIMIT ?"
+		args = append(args, page.Limit+1)
+	}
+
+	// #nosec G201 - safe SQL with controlled formatting
+	querySQL := fmt.Sprintf(`
+		SELECT id, content_hash, title, description, design, acceptance_criteria, notes,
+		       status, priority, issue_type, assignee, estimated_minutes,
+		       created_at, updated_at, closed_at, external_ref, source_repo, close_reason,
+		       deleted_at, deleted_by, delete_reason, original_type,
+		       sender, ephemeral, pinned, is_template,
+		       await_type, await_id, timeout_ns, waiters, due_date
+		FROM issues
+		%s
+		ORDER BY created_at ASC, id ASC
+		%s
+	`, whereSQL, limitSQL)
+
+	rows, err := s.db.QueryContext(ctx, querySQL, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	issues, err := s.scanIssues(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.IssuePage{Issues: issues}
+	if page.Limit > 0 && len(issues) > page.Limit {
+		result.Issues = issues[:page.Limit]
+		last := result.Issues[page.Limit-1]
+		result.NextCursor = types.EncodeIssueCursor(last.CreatedAt, last.ID)
+	}
+	return result, nil
+}
@@ -0,0 +1,184 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestListIssuesCursorContinuation(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Two issues share a created_at so the id tiebreaker is exercised
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	createdAts := []time.Time{base, base.Add(time.Minute), base.Add(time.Minute), base.Add(2 * time.Minute), base.Add(3 * time.Minute)}
+	var want []string
+	for i, createdAt := range createdAts {
+		issue := &types.Issue{
+			ID:        fmt.Sprintf("bd-%d", i+1),
+			Title:     fmt.Sprintf("Issue %d", i+1),
+			Status:    types.StatusOpen,
+			Priority:  i % 3,
+			IssueType: types.TypeTask,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		want = append(want, issue.ID)
+	}
+
+	var got []string
+	cursor := ""
+	pages := 0
+	for {
+		page, err := store.ListIssues(ctx, types.IssueFilter{}, types.PageRequest{Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("ListIssues failed: %v", err)
+		}
+		pages++
+		for _, issue := range page.Issues {
+			got = append(got, issue.ID)
+		}
+
+		// An issue created mid-iteration lands after the current cursor
+		if pages == 1 {
+			late := &types.Issue{
+				ID:        "bd-late",
+				Title:     "Created while paging",
+				Status:    types.StatusOpen,
+				Priority:  0,
+				IssueType: types.TypeTask,
+				CreatedAt: base.Add(time.Hour),
+				UpdatedAt: base.Add(time.Hour),
+			}
+			if err := store.CreateIssue(ctx, late, "test"); err != nil {
+				t.Fatalf("CreateIssue failed: %v", err)
+			}
+			want = append(want, late.ID)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+		if len(page.Issues) != 2 {
+			t.Fatalf("page %d has %d issues, want 2", pages, len(page.Issues))
+		}
+		cursor = page.NextCursor
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("paged IDs = %v, want %v", got, want)
+	}
+	if pages != 3 {
+		t.Errorf("pages = %d, want 3", pages)
+	}
+}
+
+func TestListIssuesFilterAndInvalidCursor(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for i, status := range []types.Status{types.StatusOpen, types.StatusInProgress, types.StatusOpen} {
+		issue := &types.Issue{
+			ID:        fmt.Sprintf("bd-%d", i+1),
+			Title:     fmt.Sprintf("Issue %d", i+1),
+			Status:    status,
+			Priority:  1,
+			IssueType: types.TypeTask,
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+
+	open := types.StatusOpen
+	page, err := store.ListIssues(ctx, types.IssueFilter{Status: &open}, types.PageRequest{})
+	if err != nil {
+		t.Fatalf("ListIssues failed: %v", err)
+	}
+	if len(page.Issues) != 2 || page.NextCursor != "" {
+		t.Fatalf("got %d issues (next cursor %q), want 2 and no cursor", len(page.Issues), page.NextCursor)
+	}
+
+	if _, err := store.ListIssues(ctx, types.IssueFilter{}, types.PageRequest{Limit: 1, Cursor: "not a cursor"}); err == nil {
+		t.Fatal("expected error for invalid cursor")
+	}
+}
+
+func TestListIssuesCursorNonUTC(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	zone := time.FixedZone("CEST", 2*60*60)
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, zone)
+	var want []string
+	for i := 0; i < 5; i++ {
+		createdAt := base.Add(time.Duration(i/2) * time.Minute)
+		issue := &types.Issue{
+			ID:        fmt.Sprintf("bd-%d", i+1),
+			Title:     fmt.Sprintf("Issue %d", i+1),
+			Status:    types.StatusOpen,
+			Priority:  1,
+			IssueType: types.TypeTask,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		want = append(want, issue.ID)
+	}
+
+	var got []string
+	cursor := ""
+	for {
+		page, err := store.ListIssues(ctx, types.IssueFilter{}, types.PageRequest{Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("ListIssues failed: %v", err)
+		}
+		for _, issue := range page.Issues {
+			got = append(got, issue.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("paged IDs = %v, want %v", got, want)
+	}
+}
+
+func TestListIssuesUsesCreatedAtIndex(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	rows, err := store.db.QueryContext(ctx, `EXPLAIN QUERY PLAN SELECT id FROM issues ORDER BY created_at ASC, id ASC`)
+	if err != nil {
+		t.Fatalf("EXPLAIN failed: %v", err)
+	}
+	defer rows.Close()
+	var plan []string
+	for rows.Next() {
+		var id, parent, notused int
+		var detail string
+		if err := rows.Scan(&id, &parent, &notused, &detail); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		plan = append(plan, detail)
+	}
+	if !strings.Contains(strings.Join(plan, "\n"), "idx_issues_created_at") {
+		t.Errorf("listing order does not use idx_issues_created_at:\n%s", strings.Join(plan, "\n"))
+	}
+}
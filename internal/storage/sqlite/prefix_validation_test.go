@@ -2,8 +2,10 @@ package sqlite
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/steveyegge/beads/internal/types"
@@ -167,3 +169,48 @@ func TestPrefixValidationBatch(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckIssuePrefix(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	create := func(n int) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			issue := &types.Issue{Title: "Issue", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+			if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+				t.Fatalf("CreateIssue failed: %v", err)
+			}
+		}
+	}
+
+	// Empty database passes
+	if err := store.CheckIssuePrefix(ctx); err != nil {
+		t.Fatalf("empty database: %v", err)
+	}
+
+	create(3)
+	if err := store.CheckIssuePrefix(ctx); err != nil {
+		t.Fatalf("matching prefix: %v", err)
+	}
+
+	// Prefix changed without renaming: most issues still use "bd"
+	if err := store.SetConfig(ctx, "issue_prefix", "web"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	create(1)
+	err := store.CheckIssuePrefix(ctx)
+	if !errors.Is(err, ErrPrefixMismatch) {
+		t.Fatalf("expected ErrPrefixMismatch, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "3 of 4 issues use prefix 'bd'") {
+		t.Errorf("unexpected message: %v", err)
+	}
+
+	// Once the configured prefix is no longer outnumbered it passes
+	create(2)
+	if err := store.CheckIssuePrefix(ctx); err != nil {
+		t.Errorf("tied prefixes: %v", err)
+	}
+}
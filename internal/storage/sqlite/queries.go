@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -59,6 +60,14 @@ func formatJSONStringArray(arr []string) string {
 	return string(data)
 }
 
+// dueDateValue returns the database value for a due date: NULL when unset.
+func dueDateValue(dueDate string) interface{} {
+	if dueDate == "" {
+		return nil
+	}
+	return dueDate
+}
+
 // REMOVED (bd-8e05): getNextIDForPrefix and AllocateNextID - sequential ID generation
 // no longer needed with hash-based IDs
 // Migration functions moved to migrations.go (bd-fc2d, bd-b245)
@@ -81,6 +90,13 @@ func (s *SQLiteStorage) CreateIssue(ctx context.Context, issue *types.Issue, act
 		return fmt.Errorf("failed to get custom statuses: %w", err)
 	}
 
+	// New issues without a status start in the configured default
+	if issue.Status == "" {
+		if issue.Status, err = s.defaultStatus(ctx, customStatuses); err != nil {
+			return err
+		}
+	}
+
 	// Set timestamps first so defensive fixes can use them
 	now := time.Now()
 	if issue.CreatedAt.IsZero() {
@@ -115,6 +131,16 @@ func (s *SQLiteStorage) CreateIssue(ctx context.Context, issue *types.Issue, act
 	if err := issue.ValidateWithCustomStatuses(customStatuses); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
+	allowed, err := s.GetAllowedStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get allowed statuses: %w", err)
+	}
+	if err := types.CheckStatusAllowed(issue.Status, allowed); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if issue.Assignee, err = s.normalizeAssignee(ctx, issue.Assignee); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
 
 	// Compute content hash (bd-95)
 	if issue.ContentHash == "" {
@@ -139,7 +165,7 @@ func (s *SQLiteStorage) CreateIssue(ctx context.Context, issue *types.Issue, act
 	// modes in BeginTx, and modernc.org/sqlite's BeginTx always uses DEFERRED mode.
 	//
 	// Use retry logic with exponential backoff to handle SQLITE_BUSY under concurrent load (bd-ola6)
-	if err := beginImmediateWithRetry(ctx, conn, 5, 10*time.Millisecond); err != nil {
+	if err := beginImmediateWithRetry(ctx, conn, s.writeMaxRetries, 10*time.Millisecond); err != nil {
 		return fmt.Errorf("failed to begin immediate transaction: %w", err)
 	}
 
@@ -265,6 +291,7 @@ func (s *SQLiteStorage) GetIssue(ctx context.Context, id string) (*types.Issue,
 	var awaitID sql.NullString
 	var timeoutNs sql.NullInt64
 	var waiters sql.NullString
+	var dueDate sql.NullString
 
 	var contentHash sql.NullString
 	var compactedAtCommit sql.NullString
@@ -275,7 +302,7 @@ func (s *SQLiteStorage) GetIssue(ctx context.Context, id string) (*types.Issue,
 		       compaction_level, compacted_at, compacted_at_commit, original_size, source_repo, close_reason,
 		       deleted_at, deleted_by, delete_reason, original_type,
 		       sender, ephemeral, pinned, is_template,
-		       await_type, await_id, timeout_ns, waiters
+		       await_type, await_id, timeout_ns, waiters, due_date
 		FROM issues
 		WHERE id = ?
 	`, id).Scan(
@@ -286,14 +313,14 @@ func (s *SQLiteStorage) GetIssue(ctx context.Context, id string) (*types.Issue,
 		&issue.CompactionLevel, &compactedAt, &compactedAtCommit, &originalSize, &sourceRepo, &closeReason,
 		&deletedAt, &deletedBy, &deleteReason, &originalType,
 		&sender, &wisp, &pinned, &isTemplate,
-		&awaitType, &awaitID, &timeoutNs, &waiters,
+		&awaitType, &awaitID, &timeoutNs, &waiters, &dueDate,
 	)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get issue: %w", err)
+		return nil, wrapDBError("failed to get issue", err)
 	}
 
 	if contentHash.Valid {
@@ -365,6 +392,9 @@ func (s *SQLiteStorage) GetIssue(ctx context.Context, id string) (*types.Issue,
 	if waiters.Valid && waiters.String != "" {
 		issue.Waiters = parseJSONStringArray(waiters.String)
 	}
+	if dueDate.Valid {
+		issue.DueDate = dueDate.String
+	}
 
 	// Fetch labels for this issue
 	labels, err := s.GetLabels(ctx, issue.ID)
@@ -480,6 +510,7 @@ func (s *SQLiteStorage) GetIssueByExternalRef(ctx context.Context, externalRef s
 	var awaitID sql.NullString
 	var timeoutNs sql.NullInt64
 	var waiters sql.NullString
+	var dueDate sql.NullString
 
 	err := s.db.QueryRowContext(ctx, `
 		SELECT id, content_hash, title, description, design, acceptance_criteria, notes,
@@ -488,7 +519,7 @@ func (s *SQLiteStorage) GetIssueByExternalRef(ctx context.Context, externalRef s
 		       compaction_level, compacted_at, compacted_at_commit, original_size, source_repo, close_reason,
 		       deleted_at, deleted_by, delete_reason, original_type,
 		       sender, ephemeral, pinned, is_template,
-		       await_type, await_id, timeout_ns, waiters
+		       await_type, await_id, timeout_ns, waiters, due_date
 		FROM issues
 		WHERE external_ref = ?
 	`, externalRef).Scan(
@@ -499,7 +530,7 @@ func (s *SQLiteStorage) GetIssueByExternalRef(ctx context.Context, externalRef s
 		&issue.CompactionLevel, &compactedAt, &compactedAtCommit, &originalSize, &sourceRepo, &closeReason,
 		&deletedAt, &deletedBy, &deleteReason, &originalType,
 		&sender, &wisp, &pinned, &isTemplate,
-		&awaitType, &awaitID, &timeoutNs, &waiters,
+		&awaitType, &awaitID, &timeoutNs, &waiters, &dueDate,
 	)
 
 	if err == sql.ErrNoRows {
@@ -578,6 +609,9 @@ func (s *SQLiteStorage) GetIssueByExternalRef(ctx context.Context, externalRef s
 	if waiters.Valid && waiters.String != "" {
 		issue.Waiters = parseJSONStringArray(waiters.String)
 	}
+	if dueDate.Valid {
+		issue.DueDate = dueDate.String
+	}
 
 	// Fetch labels for this issue
 	labels, err := s.GetLabels(ctx, issue.ID)
@@ -608,6 +642,8 @@ var allowedUpdateFields = map[string]bool{
 	"wisp":   true, // Database column is 'ephemeral', mapped in UpdateIssue
 	// Pinned field (bd-7h5)
 	"pinned": true,
+	// Due date (YYYY-MM-DD); "" clears it
+	"due_date": true,
 	// NOTE: replies_to, relates_to, duplicate_of, superseded_by removed per Decision 004
 	// Use AddDependency() to create graph edges instead
 }
@@ -689,7 +725,7 @@ func (s *SQLiteStorage) UpdateIssue(ctx context.Context, id string, updates map[
 		return wrapDBError("get issue for update", err)
 	}
 	if oldIssue == nil {
-		return fmt.Errorf("issue %s not found", id)
+		return fmt.Errorf("issue %s %w", id, ErrNotFound)
 	}
 
 	// Fetch custom statuses for validation (bd-1pj6)
@@ -697,6 +733,20 @@ func (s *SQLiteStorage) UpdateIssue(ctx context.Context, id string, updates map[
 	if err != nil {
 		return wrapDBError("get custom statuses", err)
 	}
+	if status, ok := updates["status"].(string); ok {
+		allowed, err := s.GetAllowedStatuses(ctx)
+		if err != nil {
+			return wrapDBError("get allowed statuses", err)
+		}
+		if err := types.CheckStatusAllowed(types.Status(status), allowed); err != nil {
+			return wrapDBError("validate field update", err)
+		}
+	}
+	if assignee, ok := updates["assignee"].(string); ok {
+		if updates["assignee"], err = s.normalizeAssignee(ctx, assignee); err != nil {
+			return wrapDBError("validate field update", err)
+		}
+	}
 
 	// Build update query with validated field names
 	setClauses := []string{"updated_at = ?"}
@@ -718,6 +768,9 @@ func (s *SQLiteStorage) UpdateIssue(ctx context.Context, id string, updates map[
 		if key == "wisp" {
 			columnName = "ephemeral"
 		}
+		if dueDate, ok := value.(string); ok && key == "due_date" {
+			value = dueDateValue(dueDate)
+		}
 		setClauses = append(setClauses, fmt.Sprintf("%s = ?", columnName))
 		args = append(args, value)
 	}
@@ -727,7 +780,7 @@ func (s *SQLiteStorage) UpdateIssue(ctx context.Context, id string, updates map[
 
 	// Recompute content_hash if any content fields changed (bd-95)
 	contentChanged := false
-	contentFields := []string{"title", "description", "design", "acceptance_criteria", "notes", "status", "priority", "issue_type", "assignee", "external_ref"}
+	contentFields := []string{"title", "description", "design", "acceptance_criteria", "notes", "status", "priority", "issue_type", "assignee", "external_ref", "due_date"}
 	for _, field := range contentFields {
 		if _, exists := updates[field]; exists {
 			contentChanged = true
@@ -785,6 +838,12 @@ func (s *SQLiteStorage) UpdateIssue(ctx context.Context, id string, updates map[
 						return fmt.Errorf("external_ref must be string or *string, got %T", value)
 					}
 				}
+			case "due_date":
+				if dueDate, ok := value.(string); ok {
+					updatedIssue.DueDate = dueDate
+				} else {
+					updatedIssue.DueDate = ""
+				}
 			}
 		}
 		newHash := updatedIssue.ComputeContentHash()
@@ -794,65 +853,68 @@ func (s *SQLiteStorage) UpdateIssue(ctx context.Context, id string, updates map[
 
 	args = append(args, id)
 
-	// Start transaction
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func() { _ = tx.Rollback() }()
+	err = s.withTx(ctx, func(tx *sql.Tx) error {
+		// Update issue
+		query := fmt.Sprintf("UPDATE issues SET %s WHERE id = ?", strings.Join(setClauses, ", ")) // #nosec G201 - safe SQL with controlled column names
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to update issue: %w", err)
+		}
 
-	// Update issue
-	query := fmt.Sprintf("UPDATE issues SET %s WHERE id = ?", strings.Join(setClauses, ", ")) // #nosec G201 - safe SQL with controlled column names
-	_, err = tx.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("failed to update issue: %w", err)
-	}
+		// Record event
+		oldData, err := json.Marshal(oldIssue)
+		if err != nil {
+			// Fall back to minimal description if marshaling fails
+			oldData = []byte(fmt.Sprintf(`{"id":"%s"}`, id))
+		}
+		newData, err := json.Marshal(updates)
+		if err != nil {
+			// Fall back to minimal description if marshaling fails
+			newData = []byte(`{}`)
+		}
+		oldDataStr := string(oldData)
+		newDataStr := string(newData)
 
-	// Record event
-	oldData, err := json.Marshal(oldIssue)
-	if err != nil {
-		// Fall back to minimal description if marshaling fails
-		oldData = []byte(fmt.Sprintf(`{"id":"%s"}`, id))
-	}
-	newData, err := json.Marshal(updates)
-	if err != nil {
-		// Fall back to minimal description if marshaling fails
-		newData = []byte(`{}`)
-	}
-	oldDataStr := string(oldData)
-	newDataStr := string(newData)
+		eventType := determineEventType(oldIssue, updates)
 
-	eventType := determineEventType(oldIssue, updates)
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO events (issue_id, event_type, actor, old_value, new_value)
+			VALUES (?, ?, ?, ?, ?)
+		`, id, eventType, actor, oldDataStr, newDataStr)
+		if err != nil {
+			return fmt.Errorf("failed to record event: %w", err)
+		}
 
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO events (issue_id, event_type, actor, old_value, new_value)
-		VALUES (?, ?, ?, ?, ?)
-	`, id, eventType, actor, oldDataStr, newDataStr)
-	if err != nil {
-		return fmt.Errorf("failed to record event: %w", err)
-	}
+		// NOTE: Graph edges now managed via AddDependency() per Decision 004 Phase 4.
+
+		// Mark issue as dirty for incremental export
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO dirty_issues (issue_id, marked_at)
+			VALUES (?, ?)
+			ON CONFLICT (issue_id) DO UPDATE SET marked_at = excluded.marked_at
+		`, id, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to mark issue dirty: %w", err)
+		}
 
-	// NOTE: Graph edges now managed via AddDependency() per Decision 004 Phase 4.
+		// Invalidate blocked issues cache if status changed (bd-5qim)
+		// Status changes affect which issues are blocked (blockers must be open/in_progress/blocked)
+		if _, statusChanged := updates["status"]; statusChanged {
+			if err := s.invalidateBlockedCache(ctx, tx); err != nil {
+				return fmt.Errorf("failed to invalidate blocked cache: %w", err)
+			}
+		}
 
-	// Mark issue as dirty for incremental export
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO dirty_issues (issue_id, marked_at)
-		VALUES (?, ?)
-		ON CONFLICT (issue_id) DO UPDATE SET marked_at = excluded.marked_at
-	`, id, time.Now())
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to mark issue dirty: %w", err)
+		return err
 	}
 
-	// Invalidate blocked issues cache if status changed (bd-5qim)
-	// Status changes affect which issues are blocked (blockers must be open/in_progress/blocked)
-	if _, statusChanged := updates["status"]; statusChanged {
-		if err := s.invalidateBlockedCache(ctx, tx); err != nil {
-			return fmt.Errorf("failed to invalidate blocked cache: %w", err)
-		}
+	if newStatus, ok := statusUpdate(updates); ok {
+		s.notifyStatusChange(id, oldIssue.Status, newStatus, actor)
 	}
-
-	return tx.Commit()
+	return nil
 }
 
 // UpdateIssueID updates an issue ID and all its text fields in a single transaction
@@ -882,7 +944,7 @@ func (s *SQLiteStorage) UpdateIssueID(ctx context.Context, oldID, newID string,
 		WHERE id = ?
 	`, newID, issue.Title, issue.Description, issue.Design, issue.AcceptanceCriteria, issue.Notes, time.Now(), oldID)
 	if err != nil {
-		return fmt.Errorf("failed to update issue ID: %w", err)
+		return wrapDBError("failed to update issue ID", err)
 	}
 
 	rows, err := result.RowsAffected()
@@ -890,7 +952,7 @@ func (s *SQLiteStorage) UpdateIssueID(ctx context.Context, oldID, newID string,
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("issue not found: %s", oldID)
+		return fmt.Errorf("issue %w: %s", ErrNotFound, oldID)
 	}
 
 	_, err = tx.ExecContext(ctx, `UPDATE dependencies SET issue_id = ? WHERE issue_id = ?`, newID, oldID)
@@ -998,59 +1060,73 @@ func (s *SQLiteStorage) ResetCounter(ctx context.Context, prefix string) error {
 // CloseIssue closes an issue with a reason
 func (s *SQLiteStorage) CloseIssue(ctx context.Context, id string, reason string, actor string) error {
 	now := time.Now()
+	var oldStatus types.Status
 
-	// Update with special event handling
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func() { _ = tx.Rollback() }()
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		// Previous status, for the webhook and for ReopenIssue to restore
+		if err := tx.QueryRowContext(ctx, `SELECT status FROM issues WHERE id = ?`, id).Scan(&oldStatus); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("issue %w: %s", ErrNotFound, id)
+			}
+			return fmt.Errorf("failed to get issue status: %w", err)
+		}
 
-	// NOTE: close_reason is stored in two places:
-	// 1. issues.close_reason - for direct queries (bd show --json, exports)
-	// 2. events.comment - for audit history (when was it closed, by whom)
-	// Keep both in sync. If refactoring, consider deriving one from the other.
-	result, err := tx.ExecContext(ctx, `
-		UPDATE issues SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?
-		WHERE id = ?
-	`, types.StatusClosed, now, now, reason, id)
-	if err != nil {
-		return fmt.Errorf("failed to close issue: %w", err)
-	}
+		// NOTE: close_reason is stored in two places:
+		// 1. issues.close_reason - for direct queries (bd show --json, exports)
+		// 2. events.comment - for audit history (when was it closed, by whom)
+		// Keep both in sync. If refactoring, consider deriving one from the other.
+		result, err := tx.ExecContext(ctx, `
+			UPDATE issues SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?
+			WHERE id = ?
+		`, types.StatusClosed, now, now, reason, id)
+		if err != nil {
+			return fmt.Errorf("failed to close issue: %w", err)
+		}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-	if rows == 0 {
-		return fmt.Errorf("issue not found: %s", id)
-	}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("issue %w: %s", ErrNotFound, id)
+		}
 
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO events (issue_id, event_type, actor, comment)
-		VALUES (?, ?, ?, ?)
-	`, id, types.EventClosed, actor, reason)
-	if err != nil {
-		return fmt.Errorf("failed to record event: %w", err)
-	}
+		oldValue, err := json.Marshal(map[string]types.Status{"status": oldStatus})
+		if err != nil {
+			return fmt.Errorf("failed to encode previous status: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO events (issue_id, event_type, actor, old_value, comment)
+			VALUES (?, ?, ?, ?, ?)
+		`, id, types.EventClosed, actor, string(oldValue), reason)
+		if err != nil {
+			return fmt.Errorf("failed to record event: %w", err)
+		}
 
-	// Mark issue as dirty for incremental export
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO dirty_issues (issue_id, marked_at)
-		VALUES (?, ?)
-		ON CONFLICT (issue_id) DO UPDATE SET marked_at = excluded.marked_at
-	`, id, time.Now())
-	if err != nil {
-		return fmt.Errorf("failed to mark issue dirty: %w", err)
-	}
+		// Mark issue as dirty for incremental export
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO dirty_issues (issue_id, marked_at)
+			VALUES (?, ?)
+			ON CONFLICT (issue_id) DO UPDATE SET marked_at = excluded.marked_at
+		`, id, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to mark issue dirty: %w", err)
+		}
 
-	// Invalidate blocked issues cache since status changed to closed (bd-5qim)
-	// Closed issues don't block others, so this affects blocking calculations
-	if err := s.invalidateBlockedCache(ctx, tx); err != nil {
-		return fmt.Errorf("failed to invalidate blocked cache: %w", err)
+		// Invalidate blocked issues cache since status changed to closed (bd-5qim)
+		// Closed issues don't block others, so this affects blocking calculations
+		if err := s.invalidateBlockedCache(ctx, tx); err != nil {
+			return fmt.Errorf("failed to invalidate blocked cache: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	return tx.Commit()
+	s.notifyStatusChange(id, oldStatus, types.StatusClosed, actor)
+	return nil
 }
 
 // CreateTombstone converts an existing issue to a tombstone record.
@@ -1064,7 +1140,7 @@ func (s *SQLiteStorage) CreateTombstone(ctx context.Context, id string, actor st
 		return fmt.Errorf("failed to get issue: %w", err)
 	}
 	if issue == nil {
-		return fmt.Errorf("issue not found: %s", id)
+		return fmt.Errorf("issue %w: %s", ErrNotFound, id)
 	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -1122,6 +1198,7 @@ func (s *SQLiteStorage) CreateTombstone(ctx context.Context, id string, actor st
 	if err := tx.Commit(); err != nil {
 		return wrapDBError("commit tombstone transaction", err)
 	}
+	s.notifyStatusChange(id, issue.Status, types.StatusTombstone, actor)
 
 	return nil
 }
@@ -1134,8 +1211,22 @@ func (s *SQLiteStorage) DeleteIssue(ctx context.Context, id string) error {
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	if err := deleteIssueTx(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return wrapDBError("commit delete transaction", err)
+	}
+
+	// REMOVED (bd-c7af): Counter sync after deletion - no longer needed with hash IDs
+	return nil
+}
+
+// deleteIssueTx removes an issue and everything attached to it within tx.
+func deleteIssueTx(ctx context.Context, tx *sql.Tx, id string) error {
 	// Delete dependencies (both directions)
-	_, err = tx.ExecContext(ctx, `DELETE FROM dependencies WHERE issue_id = ? OR depends_on_id = ?`, id, id)
+	_, err := tx.ExecContext(ctx, `DELETE FROM dependencies WHERE issue_id = ? OR depends_on_id = ?`, id, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete dependencies: %w", err)
 	}
@@ -1169,14 +1260,8 @@ func (s *SQLiteStorage) DeleteIssue(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to check rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("issue not found: %s", id)
-	}
-
-	if err := tx.Commit(); err != nil {
-		return wrapDBError("commit delete transaction", err)
+		return fmt.Errorf("issue %w: %s", ErrNotFound, id)
 	}
-
-	// REMOVED (bd-c7af): Counter sync after deletion - no longer needed with hash IDs
 	return nil
 }
 
@@ -1222,13 +1307,15 @@ func (s *SQLiteStorage) DeleteIssues(ctx context.Context, ids []string, cascade
 		return result, nil
 	}
 
-	if err := s.executeDelete(ctx, tx, inClause, args, result); err != nil {
+	changes, err := s.executeDelete(ctx, tx, inClause, args, result)
+	if err != nil {
 		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	s.notifyStatusChanges(changes)
 
 	// REMOVED (bd-c7af): Counter sync after deletion - no longer needed with hash IDs
 
@@ -1381,7 +1468,7 @@ func (s *SQLiteStorage) populateDeleteStats(ctx context.Context, tx *sql.Tx, inC
 	return nil
 }
 
-func (s *SQLiteStorage) executeDelete(ctx context.Context, tx *sql.Tx, inClause string, args []interface{}, result *DeleteIssuesResult) error {
+func (s *SQLiteStorage) executeDelete(ctx context.Context, tx *sql.Tx, inClause string, args []interface{}, result *DeleteIssuesResult) ([]statusChange, error) {
 	// Note: This method now creates tombstones instead of hard-deleting (bd-3b4)
 	// Only dependencies are deleted - issues are converted to tombstones
 
@@ -1390,24 +1477,27 @@ func (s *SQLiteStorage) executeDelete(ctx context.Context, tx *sql.Tx, inClause
 		fmt.Sprintf(`DELETE FROM dependencies WHERE issue_id IN (%s) OR depends_on_id IN (%s)`, inClause, inClause),
 		append(args, args...)...)
 	if err != nil {
-		return fmt.Errorf("failed to delete dependencies: %w", err)
+		return nil, fmt.Errorf("failed to delete dependencies: %w", err)
 	}
 
-	// 2. Get issue types before converting to tombstones (need for original_type)
+	// 2. Get issue types before converting to tombstones (need for original_type),
+	// and statuses for the status change webhook
 	issueTypes := make(map[string]string)
+	issueStatuses := make(map[string]types.Status)
 	rows, err := tx.QueryContext(ctx,
-		fmt.Sprintf(`SELECT id, issue_type FROM issues WHERE id IN (%s)`, inClause),
+		fmt.Sprintf(`SELECT id, issue_type, status FROM issues WHERE id IN (%s)`, inClause),
 		args...)
 	if err != nil {
-		return fmt.Errorf("failed to get issue types: %w", err)
+		return nil, fmt.Errorf("failed to get issue types: %w", err)
 	}
 	for rows.Next() {
-		var id, issueType string
-		if err := rows.Scan(&id, &issueType); err != nil {
+		var id, issueType, status string
+		if err := rows.Scan(&id, &issueType, &status); err != nil {
 			_ = rows.Close() // #nosec G104 - error handling not critical in error path
-			return fmt.Errorf("failed to scan issue type: %w", err)
+			return nil, fmt.Errorf("failed to scan issue type: %w", err)
 		}
 		issueTypes[id] = issueType
+		issueStatuses[id] = types.Status(status)
 	}
 	_ = rows.Close()
 
@@ -1416,6 +1506,7 @@ func (s *SQLiteStorage) executeDelete(ctx context.Context, tx *sql.Tx, inClause
 	// (status = 'closed') = (closed_at IS NOT NULL)
 	now := time.Now()
 	deletedCount := 0
+	var changes []statusChange
 	for id, originalType := range issueTypes {
 		execResult, err := tx.ExecContext(ctx, `
 			UPDATE issues
@@ -1429,7 +1520,7 @@ func (s *SQLiteStorage) executeDelete(ctx context.Context, tx *sql.Tx, inClause
 			WHERE id = ?
 		`, types.StatusTombstone, now, "batch delete", "batch delete", originalType, now, id)
 		if err != nil {
-			return fmt.Errorf("failed to create tombstone for %s: %w", id, err)
+			return nil, fmt.Errorf("failed to create tombstone for %s: %w", id, err)
 		}
 
 		rowsAffected, _ := execResult.RowsAffected()
@@ -1444,7 +1535,7 @@ func (s *SQLiteStorage) executeDelete(ctx context.Context, tx *sql.Tx, inClause
 			VALUES (?, ?, ?, ?)
 		`, id, "deleted", "batch delete", "batch delete")
 		if err != nil {
-			return fmt.Errorf("failed to record tombstone event for %s: %w", id, err)
+			return nil, fmt.Errorf("failed to record tombstone event for %s: %w", id, err)
 		}
 
 		// Mark issue as dirty for incremental export
@@ -1454,17 +1545,18 @@ func (s *SQLiteStorage) executeDelete(ctx context.Context, tx *sql.Tx, inClause
 			ON CONFLICT (issue_id) DO UPDATE SET marked_at = excluded.marked_at
 		`, id, now)
 		if err != nil {
-			return fmt.Errorf("failed to mark issue dirty for %s: %w", id, err)
+			return nil, fmt.Errorf("failed to mark issue dirty for %s: %w", id, err)
 		}
+		changes = append(changes, statusChange{id, issueStatuses[id], types.StatusTombstone, "batch delete"})
 	}
 
 	// 4. Invalidate blocked issues cache since statuses changed (bd-5qim)
 	if err := s.invalidateBlockedCache(ctx, tx); err != nil {
-		return fmt.Errorf("failed to invalidate blocked cache: %w", err)
+		return nil, fmt.Errorf("failed to invalidate blocked cache: %w", err)
 	}
 
 	result.DeletedCount = deletedCount
-	return nil
+	return changes, nil
 }
 
 // findAllDependentsRecursive finds all issues that depend on the given issues, recursively
@@ -1516,6 +1608,49 @@ func (s *SQLiteStorage) SearchIssues(ctx context.Context, query string, filter t
 	s.reconnectMu.RLock()
 	defer s.reconnectMu.RUnlock()
 
+	whereClauses, args, err := issueFilterClauses(query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	whereSQL := ""
+	if len(whereClauses) > 0 {
+		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	limitSQL := ""
+	if filter.Limit > 0 {
+		limitSQL = " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	// #nosec G201 - safe SQL with controlled formatting
+	querySQL := fmt.Sprintf(`
+		SELECT id, content_hash, title, description, design, acceptance_criteria, notes,
+		       status, priority, issue_type, assignee, estimated_minutes,
+		       created_at, updated_at, closed_at, external_ref, source_repo, close_reason,
+		       deleted_at, deleted_by, delete_reason, original_type,
+		       sender, ephemeral, pinned, is_template,
+		       await_type, await_id, timeout_ns, waiters, due_date
+		FROM issues
+		%s
+		ORDER BY priority ASC, created_at DESC
+		%s
+	`, whereSQL, limitSQL)
+
+	rows, err := s.db.QueryContext(ctx, querySQL, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return s.scanIssues(ctx, rows)
+}
+
+// issueFilterClauses translates a search query and IssueFilter into WHERE
+// clauses (joined with AND by the caller) and their bind arguments.
+// filter.Limit is not applied here. It fails only on an invalid filter.Where.
+func issueFilterClauses(query string, filter types.IssueFilter) ([]string, []interface{}, error) {
 	whereClauses := []string{}
 	args := []interface{}{}
 
@@ -1596,6 +1731,11 @@ func (s *SQLiteStorage) SearchIssues(ctx context.Context, query string, filter t
 		whereClauses = append(whereClauses, "updated_at < ?")
 		args = append(args, filter.UpdatedBefore.Format(time.RFC3339))
 	}
+	if filter.UpdatedSince != nil {
+		// julianday() compares instants, so an exactly equal timestamp matches
+		whereClauses = append(whereClauses, "julianday(updated_at) >= julianday(?)")
+		args = append(args, filter.UpdatedSince.Format(time.RFC3339Nano))
+	}
 	if filter.ClosedAfter != nil {
 		whereClauses = append(whereClauses, "closed_at > ?")
 		args = append(args, filter.ClosedAfter.Format(time.RFC3339))
@@ -1677,36 +1817,15 @@ func (s *SQLiteStorage) SearchIssues(ctx context.Context, query string, filter t
 		args = append(args, *filter.ParentID)
 	}
 
-	whereSQL := ""
-	if len(whereClauses) > 0 {
-		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
-	}
-
-	limitSQL := ""
-	if filter.Limit > 0 {
-		limitSQL = " LIMIT ?"
-		args = append(args, filter.Limit)
-	}
-
-	// #nosec G201 - safe SQL with controlled formatting
-	querySQL := fmt.Sprintf(`
-		SELECT id, content_hash, title, description, design, acceptance_criteria, notes,
-		       status, priority, issue_type, assignee, estimated_minutes,
-		       created_at, updated_at, closed_at, external_ref, source_repo, close_reason,
-		       deleted_at, deleted_by, delete_reason, original_type,
-		       sender, ephemeral, pinned, is_template,
-		       await_type, await_id, timeout_ns, waiters
-		FROM issues
-		%s
-		ORDER BY priority ASC, created_at DESC
-		%s
-	`, whereSQL, limitSQL)
-
-	rows, err := s.db.QueryContext(ctx, querySQL, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search issues: %w", err)
+	// Expression filtering (bd list --where)
+	if filter.Where != "" {
+		clause, whereArgs, err := parseWhere(filter.Where)
+		if err != nil {
+			return nil, nil, err
+		}
+		whereClauses = append(whereClauses, clause)
+		args = append(args, whereArgs...)
 	}
-	defer func() { _ = rows.Close() }()
 
-	return s.scanIssues(ctx, rows)
+	return whereClauses, args, nil
 }
@@ -121,7 +121,7 @@ func (s *SQLiteStorage) GetReadyWork(ctx context.Context, filter types.WorkFilte
 		i.created_at, i.updated_at, i.closed_at, i.external_ref, i.source_repo, i.close_reason,
 		i.deleted_at, i.deleted_by, i.delete_reason, i.original_type,
 		i.sender, i.ephemeral, i.pinned, i.is_template,
-		i.await_type, i.await_id, i.timeout_ns, i.waiters
+		i.await_type, i.await_id, i.timeout_ns, i.waiters, i.due_date
 		FROM issues i
 		WHERE %s
 		AND NOT EXISTS (
@@ -247,6 +247,30 @@ func (s *SQLiteStorage) getExternalDepsForIssues(ctx context.Context, issueIDs [
 	return result, rows.Err()
 }
 
+// GetOverdueIssues returns issues that are not closed and whose due date is
+// before today (YYYY-MM-DD), earliest due date first. The caller decides what
+// today is, so the project's timezone setting is applied outside the store.
+func (s *SQLiteStorage) GetOverdueIssues(ctx context.Context, today string) ([]*types.Issue, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, content_hash, title, description, design, acceptance_criteria, notes,
+		       status, priority, issue_type, assignee, estimated_minutes,
+		       created_at, updated_at, closed_at, external_ref, source_repo, close_reason,
+		       deleted_at, deleted_by, delete_reason, original_type,
+		       sender, ephemeral, pinned, is_template,
+		       await_type, await_id, timeout_ns, waiters, due_date
+		FROM issues
+		WHERE status NOT IN (?, ?)
+		  AND due_date IS NOT NULL AND due_date < ?
+		ORDER BY due_date ASC, priority ASC, id ASC
+	`, types.StatusClosed, types.StatusTombstone, today)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query overdue issues: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return s.scanIssues(ctx, rows)
+}
+
 // GetStaleIssues returns issues that haven't been updated recently
 func (s *SQLiteStorage) GetStaleIssues(ctx context.Context, filter types.StaleFilter) ([]*types.Issue, error) {
 	// Build query with optional status filter
@@ -258,13 +282,20 @@ func (s *SQLiteStorage) GetStaleIssues(ctx context.Context, filter types.StaleFi
 			compaction_level, compacted_at, compacted_at_commit, original_size, close_reason,
 			deleted_at, deleted_by, delete_reason, original_type,
 			sender, ephemeral, pinned, is_template,
-			await_type, await_id, timeout_ns, waiters
+			await_type, await_id, timeout_ns, waiters, due_date
 		FROM issues
 		WHERE status != 'closed'
-		  AND datetime(updated_at) < datetime('now', '-' || ? || ' days')
 	`
 
-	args := []interface{}{filter.Days}
+	var args []interface{}
+	if filter.OlderThan > 0 {
+		cutoff := time.Now().Add(-filter.OlderThan).UTC()
+		query += " AND datetime(updated_at) < datetime(?)"
+		args = append(args, cutoff.Format("2006-01-02 15:04:05"))
+	} else {
+		query += " AND datetime(updated_at) < datetime('now', '-' || ? || ' days')"
+		args = append(args, filter.Days)
+	}
 
 	// Add optional status filter
 	if filter.Status != "" {
@@ -316,6 +347,7 @@ func (s *SQLiteStorage) GetStaleIssues(ctx context.Context, filter types.StaleFi
 		var awaitID sql.NullString
 		var timeoutNs sql.NullInt64
 		var waiters sql.NullString
+		var dueDate sql.NullString
 
 		err := rows.Scan(
 			&issue.ID, &contentHash, &issue.Title, &issue.Description, &issue.Design,
@@ -325,7 +357,7 @@ func (s *SQLiteStorage) GetStaleIssues(ctx context.Context, filter types.StaleFi
 			&compactionLevel, &compactedAt, &compactedAtCommit, &originalSize, &closeReason,
 			&deletedAt, &deletedBy, &deleteReason, &originalType,
 			&sender, &ephemeral, &pinned, &isTemplate,
-			&awaitType, &awaitID, &timeoutNs, &waiters,
+			&awaitType, &awaitID, &timeoutNs, &waiters, &dueDate,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan stale issue: %w", err)
@@ -403,6 +435,9 @@ func (s *SQLiteStorage) GetStaleIssues(ctx context.Context, filter types.StaleFi
 		if waiters.Valid && waiters.String != "" {
 			issue.Waiters = parseJSONStringArray(waiters.String)
 		}
+		if dueDate.Valid {
+			issue.DueDate = dueDate.String
+		}
 
 		issues = append(issues, &issue)
 	}
@@ -600,7 +635,13 @@ func filterBlockedByExternalDeps(ctx context.Context, blocked []*types.BlockedIs
 func buildOrderByClause(policy types.SortPolicy) string {
 	switch policy {
 	case types.SortPolicyPriority:
-		return `ORDER BY i.priority ASC, i.created_at ASC`
+		// Within a priority, unblock the most open work first
+		return `ORDER BY i.priority ASC,
+			(SELECT COUNT(*) FROM dependencies d
+			 JOIN issues b ON b.id = d.issue_id
+			 WHERE d.depends_on_id = i.id AND d.type = 'blocks'
+			   AND b.status NOT IN ('closed', 'tombstone')) DESC,
+			i.created_at ASC`
 
 	case types.SortPolicyOldest:
 		return `ORDER BY i.created_at ASC`
@@ -765,6 +765,62 @@ func TestSortPolicyPriority(t *testing.T) {
 	}
 }
 
+// TestSortPolicyPriorityDependencyOrder tests that within a priority, issues
+// blocking more open work come first
+func TestSortPolicyPriorityDependencyOrder(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	create := func(title string, priority int) *types.Issue {
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: priority, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test-user"); err != nil {
+			t.Fatalf("CreateIssue(%s) failed: %v", title, err)
+		}
+		return issue
+	}
+	block := func(blocker, blocked *types.Issue) {
+		dep := &types.Dependency{IssueID: blocked.ID, DependsOnID: blocker.ID, Type: types.DepBlocks}
+		if err := store.AddDependency(ctx, dep, "test-user"); err != nil {
+			t.Fatalf("AddDependency failed: %v", err)
+		}
+	}
+
+	// Created oldest first, so creation order alone would give leaf, one, two
+	leaf := create("P1-blocks-nothing", 1)
+	one := create("P1-blocks-one", 1)
+	two := create("P1-blocks-two", 1)
+	create("P2-blocks-two", 2)
+	urgent := create("P0", 0)
+	block(one, create("waits-on-one", 3))
+	block(two, create("waits-on-two-a", 3))
+	block(two, create("waits-on-two-b", 3))
+	// Closed dependents don't count
+	closedDependent := create("closed-dependent", 3)
+	block(leaf, closedDependent)
+	if err := store.CloseIssue(ctx, closedDependent.ID, "done", "test-user"); err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+
+	ready, err := store.GetReadyWork(ctx, types.WorkFilter{
+		Status:     types.StatusOpen,
+		SortPolicy: types.SortPolicyPriority,
+	})
+	if err != nil {
+		t.Fatalf("GetReadyWork failed: %v", err)
+	}
+
+	want := []string{urgent.Title, two.Title, one.Title, leaf.Title, "P2-blocks-two"}
+	var got []string
+	for _, issue := range ready {
+		got = append(got, issue.Title)
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ready order = %v, want %v", got, want)
+	}
+}
+
 // TestSortPolicyOldest tests oldest-first sorting (ignoring priority)
 func TestSortPolicyOldest(t *testing.T) {
 	store, cleanup := setupTestDB(t)
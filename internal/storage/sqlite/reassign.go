@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// Reassign moves every open issue assigned to from over to to, in a single
+// transaction, and returns how many issues were reassigned. An empty from
+// matches unassigned issues; an empty to unassigns. Closed issues and
+// tombstones keep their assignee. With dryRun set nothing is committed and
+// the count is the number of issues that would be reassigned.
+//
+// to is checked against the assignees config like any other assignee.
+func (s *SQLiteStorage) Reassign(ctx context.Context, from, to, actor string, dryRun bool) (int, error) {
+	to, err := s.normalizeAssignee(ctx, to)
+	if err != nil {
+		return 0, err
+	}
+	if to == from {
+		return 0, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id FROM issues
+		WHERE COALESCE(assignee, '') = ? AND status NOT IN (?, ?)
+		ORDER BY id
+	`, from, types.StatusClosed, types.StatusTombstone)
+	if err != nil {
+		return 0, wrapDBError("query issues to reassign", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return 0, wrapDBError("scan issue to reassign", err)
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, wrapDBError("iterate issues to reassign", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Load issues before opening the write transaction, to recompute hashes
+	issues := make([]*types.Issue, 0, len(ids))
+	for _, id := range ids {
+		issue, err := s.GetIssue(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("failed to load %s for reassignment: %w", id, err)
+		}
+		if issue != nil {
+			issues = append(issues, issue)
+		}
+	}
+
+	newData, err := json.Marshal(map[string]interface{}{"assignee": to})
+	if err != nil {
+		return 0, fmt.Errorf("failed to serialize update: %w", err)
+	}
+
+	count := 0
+	err = s.withDryRunTx(ctx, dryRun, func(tx *sql.Tx) error {
+		now := time.Now()
+		for _, issue := range issues {
+			oldData, err := json.Marshal(issue)
+			if err != nil {
+				return fmt.Errorf("failed to serialize %s: %w", issue.ID, err)
+			}
+			updated := *issue
+			updated.Assignee = to
+
+			// Re-check the assignee so a concurrent update isn't overwritten
+			result, err := tx.ExecContext(ctx, `
+				UPDATE issues SET assignee = ?, updated_at = ?, content_hash = ?
+				WHERE id = ? AND COALESCE(assignee, '') = ?
+			`, to, now, updated.ComputeContentHash(), issue.ID, from)
+			if err != nil {
+				return fmt.Errorf("failed to reassign %s: %w", issue.ID, err)
+			}
+			n, err := result.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("failed to check rows affected: %w", err)
+			}
+			if n == 0 {
+				continue
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO events (issue_id, event_type, actor, old_value, new_value)
+				VALUES (?, ?, ?, ?, ?)
+			`, issue.ID, types.EventUpdated, actor, string(oldData), string(newData)); err != nil {
+				return fmt.Errorf("failed to record event: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO dirty_issues (issue_id, marked_at)
+				VALUES (?, ?)
+				ON CONFLICT (issue_id) DO UPDATE SET marked_at = excluded.marked_at
+			`, issue.ID, now); err != nil {
+				return fmt.Errorf("failed to mark issue dirty: %w", err)
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
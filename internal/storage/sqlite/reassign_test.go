@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestReassign(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	newIssue := func(title, assignee string, status types.Status) *types.Issue {
+		issue := &types.Issue{Title: title, Status: status, Priority: 2, IssueType: types.TypeTask, Assignee: assignee}
+		if status == types.StatusClosed {
+			now := time.Now()
+			issue.ClosedAt = &now
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", title, err)
+		}
+		return issue
+	}
+	assigneeOf := func(issue *types.Issue) string {
+		got, err := store.GetIssue(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("GetIssue(%s) error = %v", issue.ID, err)
+		}
+		return got.Assignee
+	}
+
+	open1 := newIssue("Open 1", "alice", types.StatusOpen)
+	open2 := newIssue("Open 2", "alice", types.StatusInProgress)
+	closed := newIssue("Done", "alice", types.StatusClosed)
+	other := newIssue("Carol's", "carol", types.StatusOpen)
+	unassigned1 := newIssue("Nobody 1", "", types.StatusOpen)
+	unassigned2 := newIssue("Nobody 2", "", types.StatusOpen)
+
+	// Dry run counts without changing anything
+	count, err := store.Reassign(ctx, "alice", "bob", "test", true)
+	if err != nil {
+		t.Fatalf("Reassign(dry run) error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("dry run count = %d, want 2", count)
+	}
+	if got := assigneeOf(open1); got != "alice" {
+		t.Errorf("after dry run %s assignee = %q, want alice", open1.ID, got)
+	}
+
+	count, err = store.Reassign(ctx, "alice", "bob", "test", false)
+	if err != nil {
+		t.Fatalf("Reassign() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+	for issue, want := range map[*types.Issue]string{open1: "bob", open2: "bob", closed: "alice", other: "carol", unassigned1: ""} {
+		if got := assigneeOf(issue); got != want {
+			t.Errorf("%s (%s) assignee = %q, want %q", issue.ID, issue.Title, got, want)
+		}
+	}
+
+	// Reassigned issues are dirty and carry an updated content hash
+	dirty, err := store.GetDirtyIssues(ctx)
+	if err != nil {
+		t.Fatalf("GetDirtyIssues() error = %v", err)
+	}
+	dirtySet := map[string]bool{}
+	for _, id := range dirty {
+		dirtySet[id] = true
+	}
+	if !dirtySet[open1.ID] || !dirtySet[open2.ID] {
+		t.Errorf("dirty issues = %v, want %s and %s included", dirty, open1.ID, open2.ID)
+	}
+	reloaded, _ := store.GetIssue(ctx, open1.ID)
+	if reloaded.ContentHash != reloaded.ComputeContentHash() {
+		t.Errorf("content hash %s is stale, want %s", reloaded.ContentHash, reloaded.ComputeContentHash())
+	}
+
+	// An empty from assigns all unassigned open issues
+	count, err = store.Reassign(ctx, "", "dave", "test", false)
+	if err != nil {
+		t.Fatalf("Reassign(unassigned) error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("unassigned count = %d, want 2", count)
+	}
+	if assigneeOf(unassigned1) != "dave" || assigneeOf(unassigned2) != "dave" {
+		t.Errorf("unassigned issues were not assigned to dave")
+	}
+}
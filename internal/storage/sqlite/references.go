@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// AddReference links an issue to an external URL with an optional label.
+// Adding a URL the issue already links to updates its label.
+func (s *SQLiteStorage) AddReference(ctx context.Context, issueID, url, label, actor string) error {
+	if err := types.ValidateReferenceURL(url); err != nil {
+		return err
+	}
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM issues WHERE id = ?)`, issueID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check issue existence: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("issue %s %w", issueID, ErrNotFound)
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO issue_references (issue_id, url, label, created_at)
+			VALUES (?, ?, ?, ?)
+			ON CONFLICT (issue_id, url) DO UPDATE SET label = excluded.label
+			WHERE label != excluded.label
+		`, issueID, url, label, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to add reference: %w", err)
+		}
+		return s.recordReferenceChange(ctx, tx, result, issueID, actor, fmt.Sprintf("Added reference: %s", url))
+	})
+}
+
+// RemoveReference removes an issue's link to url, if it has one.
+func (s *SQLiteStorage) RemoveReference(ctx context.Context, issueID, url, actor string) error {
+	return s.withTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `DELETE FROM issue_references WHERE issue_id = ? AND url = ?`, issueID, url)
+		if err != nil {
+			return fmt.Errorf("failed to remove reference: %w", err)
+		}
+		return s.recordReferenceChange(ctx, tx, result, issueID, actor, fmt.Sprintf("Removed reference: %s", url))
+	})
+}
+
+// recordReferenceChange records an event and marks the issue dirty if the
+// statement that produced result changed a reference.
+func (s *SQLiteStorage) recordReferenceChange(ctx context.Context, tx *sql.Tx, result sql.Result, issueID, actor, comment string) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO events (issue_id, event_type, actor, comment)
+		VALUES (?, ?, ?, ?)
+	`, issueID, types.EventUpdated, actor, comment)
+	if err != nil {
+		return fmt.Errorf("failed to record event: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO dirty_issues (issue_id, marked_at)
+		VALUES (?, ?)
+		ON CONFLICT (issue_id) DO UPDATE SET marked_at = excluded.marked_at
+	`, issueID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to mark issue dirty: %w", err)
+	}
+	return nil
+}
+
+// ImportReference adds a reference during import, keeping its original
+// created_at, or updates the label of an existing one.
+func (s *SQLiteStorage) ImportReference(ctx context.Context, ref *types.Reference) error {
+	createdAt := ref.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO issue_references (issue_id, url, label, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (issue_id, url) DO UPDATE SET label = excluded.label
+	`, ref.IssueID, ref.URL, ref.Label, createdAt)
+	if err != nil {
+		return fmt.Errorf("failed to import reference %s of %s: %w", ref.URL, ref.IssueID, err)
+	}
+	return nil
+}
+
+// GetReferences returns an issue's references, oldest first.
+func (s *SQLiteStorage) GetReferences(ctx context.Context, issueID string) ([]*types.Reference, error) {
+	refs, err := s.GetReferencesForIssues(ctx, []string{issueID})
+	if err != nil {
+		return nil, err
+	}
+	return refs[issueID], nil
+}
+
+// GetReferencesForIssues fetches the references of several issues in a
+// single query, as a map of issue ID to references, oldest first.
+func (s *SQLiteStorage) GetReferencesForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Reference, error) {
+	result := make(map[string][]*types.Reference)
+	if len(issueIDs) == 0 {
+		return result, nil
+	}
+
+	args := make([]interface{}, len(issueIDs))
+	for i, id := range issueIDs {
+		args[i] = id
+	}
+	query := fmt.Sprintf(`
+		SELECT issue_id, url, label, created_at
+		FROM issue_references
+		WHERE issue_id IN (%s)
+		ORDER BY issue_id, created_at ASC, url ASC
+	`, buildPlaceholders(len(issueIDs))) // #nosec G201 -- placeholders are generated internally
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get references: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		ref := &types.Reference{}
+		if err := rows.Scan(&ref.IssueID, &ref.URL, &ref.Label, &ref.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reference: %w", err)
+		}
+		result[ref.IssueID] = append(result[ref.IssueID], ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating references: %w", err)
+	}
+	return result, nil
+}
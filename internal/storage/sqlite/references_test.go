@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestAddAndListReferences(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issue := &types.Issue{Title: "Fix login", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeBug}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	const pr = "https://github.com/org/repo/pull/42"
+	const doc = "https://docs.example.com/login"
+	if err := store.AddReference(ctx, issue.ID, pr, "fix PR", "test"); err != nil {
+		t.Fatalf("AddReference(pr) error = %v", err)
+	}
+	if err := store.AddReference(ctx, issue.ID, doc, "", "test"); err != nil {
+		t.Fatalf("AddReference(doc) error = %v", err)
+	}
+	// Re-adding a URL updates its label rather than duplicating it
+	if err := store.AddReference(ctx, issue.ID, pr, "merged fix", "test"); err != nil {
+		t.Fatalf("AddReference(pr again) error = %v", err)
+	}
+
+	refs, err := store.GetReferences(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetReferences() error = %v", err)
+	}
+	if len(refs) != 2 {
+		t.Fatalf("got %d references, want 2: %+v", len(refs), refs)
+	}
+	labels := map[string]string{}
+	for _, ref := range refs {
+		if ref.IssueID != issue.ID {
+			t.Errorf("reference %s has issue %s, want %s", ref.URL, ref.IssueID, issue.ID)
+		}
+		labels[ref.URL] = ref.Label
+	}
+	if labels[pr] != "merged fix" || labels[doc] != "" {
+		t.Errorf("labels = %v, want %s=\"merged fix\" and %s=\"\"", labels, pr, doc)
+	}
+
+	if err := store.RemoveReference(ctx, issue.ID, doc, "test"); err != nil {
+		t.Fatalf("RemoveReference() error = %v", err)
+	}
+	refs, err = store.GetReferences(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetReferences() error = %v", err)
+	}
+	if len(refs) != 1 || refs[0].URL != pr {
+		t.Errorf("after remove got %+v, want only %s", refs, pr)
+	}
+}
+
+func TestAddReferenceRejectsInvalidURL(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issue := &types.Issue{Title: "Task", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+
+	for _, url := range []string{"", "not a url", "/relative/path", "example.com/no-scheme"} {
+		if err := store.AddReference(ctx, issue.ID, url, "", "test"); err == nil {
+			t.Errorf("AddReference(%q) succeeded, want error", url)
+		}
+	}
+	if err := store.AddReference(ctx, "bd-missing", "https://example.com", "", "test"); err == nil {
+		t.Error("AddReference on a missing issue succeeded, want error")
+	}
+}
@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// ReopenIssue reopens a closed issue: it goes back to the status it had
+// before it was closed, or to the default status when that isn't recorded or
+// no longer allowed, and closed_at and close_reason are cleared. An issue in
+// one of the closed-statuses counts as closed. It fails with ErrNotFound for
+// a missing ID and ErrConflict if the issue isn't closed.
+func (s *SQLiteStorage) ReopenIssue(ctx context.Context, id string, actor string) error {
+	issue, err := s.GetIssue(ctx, id)
+	if err != nil {
+		return err
+	}
+	if issue == nil {
+		return fmt.Errorf("%w: issue %s", ErrNotFound, id)
+	}
+	closed, err := s.isClosedStatus(ctx, issue.Status)
+	if err != nil {
+		return err
+	}
+	if !closed {
+		return fmt.Errorf("%w: issue %s is not closed (status: %s)", ErrConflict, id, issue.Status)
+	}
+
+	status, err := s.statusBeforeClose(ctx, id, issue.Status)
+	if err != nil {
+		return err
+	}
+	// UpdateIssue clears closed_at and close_reason and records a reopened event
+	return s.UpdateIssue(ctx, id, map[string]interface{}{"status": string(status)}, actor)
+}
+
+// statusBeforeClose returns the status an issue had when it last moved into
+// closedStatus, from the old value of that event, falling back to the
+// default-status config.
+func (s *SQLiteStorage) statusBeforeClose(ctx context.Context, id string, closedStatus types.Status) (types.Status, error) {
+	// The new value of an update event is its JSON updates map; CASE keeps
+	// json_extract away from events whose new value isn't JSON.
+	var oldValue sql.NullString
+	err := s.db.QueryRowContext(ctx, `
+		SELECT old_value FROM events
+		WHERE issue_id = ?
+		  AND (event_type = ?
+		       OR CASE WHEN json_valid(new_value) THEN json_extract(new_value, '$.status') END = ?)
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1
+	`, id, types.EventClosed, closedStatus).Scan(&oldValue)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("failed to get close event of %s: %w", id, err)
+	}
+
+	var before struct {
+		Status types.Status `json:"status"`
+	}
+	if !oldValue.Valid || json.Unmarshal([]byte(oldValue.String), &before) != nil ||
+		before.Status == "" || before.Status == types.StatusTombstone {
+		return s.reopenFallbackStatus(ctx)
+	}
+	closed, err := s.isClosedStatus(ctx, before.Status)
+	if err != nil {
+		return "", err
+	}
+	if closed {
+		return s.reopenFallbackStatus(ctx)
+	}
+	allowed, err := s.GetAllowedStatuses(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get allowed statuses: %w", err)
+	}
+	if types.CheckStatusAllowed(before.Status, allowed) != nil {
+		return s.reopenFallbackStatus(ctx)
+	}
+	return before.Status, nil
+}
+
+// reopenFallbackStatus returns the status a reopened issue gets when its
+// status before close is unknown: the one new issues start in.
+func (s *SQLiteStorage) reopenFallbackStatus(ctx context.Context) (types.Status, error) {
+	customStatuses, err := s.GetCustomStatuses(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get custom statuses: %w", err)
+	}
+	return s.defaultStatus(ctx, customStatuses)
+}
+
+// isClosedStatus reports whether status counts as closed: closed itself or
+// one of the closed-statuses, tested the way the archive and stats queries do.
+func (s *SQLiteStorage) isClosedStatus(ctx context.Context, status types.Status) (bool, error) {
+	var closed bool
+	err := s.db.QueryRowContext(ctx, `SELECT ?1 = ?2 OR `+inClosedStatuses("?1"), status, types.StatusClosed).Scan(&closed)
+	if err != nil {
+		return false, wrapDBError("check closed status", err)
+	}
+	return closed, nil
+}
@@ -0,0 +1,131 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestReopenIssue(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	issue := &types.Issue{Title: "Reopen me", Status: types.StatusInProgress, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	if err := store.CloseIssue(ctx, issue.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue() error = %v", err)
+	}
+
+	if err := store.ReopenIssue(ctx, issue.ID, "test"); err != nil {
+		t.Fatalf("ReopenIssue() error = %v", err)
+	}
+	got, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != types.StatusInProgress {
+		t.Errorf("status after reopen = %s, want %s (the status before close)", got.Status, types.StatusInProgress)
+	}
+	if got.ClosedAt != nil || got.CloseReason != "" {
+		t.Errorf("closed_at = %v, close_reason = %q after reopen, want both cleared", got.ClosedAt, got.CloseReason)
+	}
+
+	// Reopening an issue that isn't closed is a conflict
+	err = store.ReopenIssue(ctx, issue.ID, "test")
+	if !IsConflict(err) {
+		t.Errorf("ReopenIssue(not closed) error = %v, want ErrConflict", err)
+	}
+	if err := store.ReopenIssue(ctx, "bd-missing", "test"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ReopenIssue(missing) error = %v, want ErrNotFound", err)
+	}
+
+	// Without a recorded previous status, the issue goes back to open
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"status": string(types.StatusClosed)}, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.db.ExecContext(ctx, `UPDATE events SET old_value = NULL WHERE issue_id = ? AND event_type = ?`, issue.ID, types.EventClosed); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.ReopenIssue(ctx, issue.ID, "test"); err != nil {
+		t.Fatalf("ReopenIssue() error = %v", err)
+	}
+	if got, _ := store.GetIssue(ctx, issue.ID); got.Status != types.StatusOpen {
+		t.Errorf("status after reopen without history = %s, want open", got.Status)
+	}
+}
+
+func TestReopenIssueCustomClosedStatus(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	if err := store.SetConfig(ctx, CustomStatusConfigKey, "done"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetConfig(ctx, ClosedStatusesConfigKey, "done"); err != nil {
+		t.Fatal(err)
+	}
+	issue := &types.Issue{Title: "Finish me", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	// An older close must not decide the status for the later one
+	if err := store.CloseIssue(ctx, issue.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue() error = %v", err)
+	}
+	if err := store.ReopenIssue(ctx, issue.ID, "test"); err != nil {
+		t.Fatalf("ReopenIssue() error = %v", err)
+	}
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"status": string(types.StatusInProgress)}, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"status": "done"}, "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.ReopenIssue(ctx, issue.ID, "test"); err != nil {
+		t.Fatalf("ReopenIssue(done) error = %v", err)
+	}
+	got, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != types.StatusInProgress {
+		t.Errorf("status after reopen = %s, want %s (the status before done)", got.Status, types.StatusInProgress)
+	}
+}
+
+func TestReopenIssueFallsBackToDefaultStatus(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	if err := store.SetConfig(ctx, CustomStatusConfigKey, "triage"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetConfig(ctx, DefaultStatusConfigKey, "triage"); err != nil {
+		t.Fatal(err)
+	}
+	issue := &types.Issue{Title: "Reopen me", Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	if err := store.CloseIssue(ctx, issue.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue() error = %v", err)
+	}
+	if _, err := store.db.ExecContext(ctx, `UPDATE events SET old_value = NULL WHERE issue_id = ? AND event_type = ?`, issue.ID, types.EventClosed); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.ReopenIssue(ctx, issue.ID, "test"); err != nil {
+		t.Fatalf("ReopenIssue() error = %v", err)
+	}
+	if got, _ := store.GetIssue(ctx, issue.ID); got.Status != "triage" {
+		t.Errorf("status after reopen without history = %s, want the default status triage", got.Status)
+	}
+}
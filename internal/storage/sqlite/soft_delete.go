@@ -0,0 +1,108 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// Undelete reverses CreateTombstone: the issue becomes open again with its
+// original type, and the deleted_at/deleted_by/delete_reason fields are cleared.
+func (s *SQLiteStorage) Undelete(ctx context.Context, id string, actor string) error {
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var status string
+		err := tx.QueryRowContext(ctx, `SELECT status FROM issues WHERE id = ?`, id).Scan(&status)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+		if err != nil {
+			return wrapDBErrorf(err, "get issue %s", id)
+		}
+		if types.Status(status) != types.StatusTombstone {
+			return fmt.Errorf("issue %s is not deleted (status: %s)", id, status)
+		}
+
+		now := time.Now()
+		_, err = tx.ExecContext(ctx, `
+			UPDATE issues
+			SET status = ?,
+			    issue_type = COALESCE(NULLIF(original_type, ''), issue_type),
+			    deleted_at = NULL,
+			    deleted_by = '',
+			    delete_reason = '',
+			    original_type = '',
+			    updated_at = ?
+			WHERE id = ?
+		`, types.StatusOpen, now, id)
+		if err != nil {
+			return wrapDBErrorf(err, "undelete %s", id)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO events (issue_id, event_type, actor, comment)
+			VALUES (?, ?, ?, ?)
+		`, id, "restored", actor, "restored from tombstone")
+		if err != nil {
+			return wrapDBErrorf(err, "record undelete event for %s", id)
+		}
+
+		if err := markIssuesDirtyTx(ctx, tx, []string{id}); err != nil {
+			return err
+		}
+
+		return s.invalidateBlockedCache(ctx, tx)
+	})
+	if err != nil {
+		return err
+	}
+	s.notifyStatusChange(id, types.StatusTombstone, types.StatusOpen, actor)
+	return nil
+}
+
+// Purge permanently removes tombstones whose deleted_at is before olderThan
+// and returns the IDs removed. With dryRun set nothing is committed; the IDs
+// are those that would be removed.
+//
+// Purged IDs are no longer protected from resurrection by an old JSONL copy,
+// so only purge tombstones that every clone has already synced.
+func (s *SQLiteStorage) Purge(ctx context.Context, olderThan time.Time, dryRun bool) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id FROM issues
+		WHERE status = ? AND deleted_at IS NOT NULL AND deleted_at < ?
+	`, types.StatusTombstone, olderThan)
+	if err != nil {
+		return nil, wrapDBError("query purge candidates", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, wrapDBError("scan purge candidate", err)
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError("iterate purge candidates", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	err = s.withDryRunTx(ctx, dryRun, func(tx *sql.Tx) error {
+		for _, id := range ids {
+			if err := deleteIssueTx(ctx, tx, id); err != nil {
+				return fmt.Errorf("failed to purge %s: %w", id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -2,11 +2,21 @@ package sqlite
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 	_ "github.com/ncruces/go-sqlite3/driver"
 	_ "github.com/ncruces/go-sqlite3/embed"
@@ -1489,3 +1499,439 @@ func TestIsClosed(t *testing.T) {
 		t.Error("Store should be closed after calling Close()")
 	}
 }
+
+func TestSingleFileDB(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("failed to initialize config: %v", err)
+	}
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "beads.db")
+
+	createIssue := func(store *SQLiteStorage, title string) {
+		t.Helper()
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+
+	// Start from a database created in the default WAL mode
+	store, err := New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	createIssue(store, "Written in WAL mode")
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	config.Set("single-file-db", true)
+	defer config.Set("single-file-db", false)
+
+	store, err = New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("New with single-file-db failed: %v", err)
+	}
+	var mode string
+	if err := store.UnderlyingDB().QueryRow("PRAGMA journal_mode").Scan(&mode); err != nil {
+		t.Fatalf("reading journal_mode failed: %v", err)
+	}
+	if mode != "delete" {
+		t.Errorf("journal_mode = %q, want delete", mode)
+	}
+	createIssue(store, "Written in single-file mode")
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if _, err := os.Stat(dbPath + suffix); !os.IsNotExist(err) {
+			t.Errorf("%s should not exist after Close, stat err = %v", filepath.Base(dbPath+suffix), err)
+		}
+	}
+
+	// Nothing was lost in the switch
+	store, err = New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	defer store.Close()
+	issues, err := store.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		t.Fatalf("SearchIssues failed: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("got %d issues after reopening, want 2", len(issues))
+	}
+}
+
+func TestCloseCheckpointsWAL(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "beads.db")
+
+	writer, err := New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	// A second open store (another bd process, or the daemon) keeps SQLite
+	// from checkpointing and deleting the WAL on its own when writer closes
+	other, err := New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("second New failed: %v", err)
+	}
+	defer other.Close()
+
+	if err := writer.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	issue := &types.Issue{Title: "Checkpointed", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := writer.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if info, err := os.Stat(dbPath + "-wal"); err != nil || info.Size() == 0 {
+		t.Fatalf("expected writes in the WAL before Close (stat err = %v)", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if info, err := os.Stat(dbPath + "-wal"); err == nil && info.Size() != 0 {
+		t.Errorf("WAL has %d bytes after Close, want it checkpointed and truncated", info.Size())
+	}
+}
+
+func TestUpdatedAtMaintained(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	created := time.Now().Add(-time.Hour).UTC()
+	issue := &types.Issue{
+		Title:     "Track my writes",
+		Status:    types.StatusOpen,
+		Priority:  2,
+		IssueType: types.TypeTask,
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	before, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+
+	// Pure reads leave updated_at alone
+	if _, err := store.SearchIssues(ctx, "", types.IssueFilter{}); err != nil {
+		t.Fatalf("SearchIssues failed: %v", err)
+	}
+	afterRead, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if !afterRead.UpdatedAt.Equal(before.UpdatedAt) {
+		t.Errorf("updated_at changed on read: %v -> %v", before.UpdatedAt, afterRead.UpdatedAt)
+	}
+
+	// Updates move it forward
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": "Renamed"}, "test"); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	afterUpdate, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if !afterUpdate.UpdatedAt.After(before.UpdatedAt) {
+		t.Errorf("updated_at not advanced by update: %v -> %v", before.UpdatedAt, afterUpdate.UpdatedAt)
+	}
+	if !afterUpdate.CreatedAt.Equal(before.CreatedAt) {
+		t.Errorf("created_at changed by update: %v -> %v", before.CreatedAt, afterUpdate.CreatedAt)
+	}
+}
+
+func TestStatusChangeWebhook(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("failed to initialize config: %v", err)
+	}
+
+	var mu sync.Mutex
+	var payloads []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Errorf("bad payload: %v", err)
+		}
+		mu.Lock()
+		payloads = append(payloads, p)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	config.Set("webhooks-enabled", true)
+	t.Setenv("BD_WEBHOOK_URL", server.URL)
+	t.Cleanup(func() { config.Set("webhooks-enabled", false) })
+
+	ctx := context.Background()
+	store, err := New(ctx, filepath.Join(t.TempDir(), "beads.db"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	issue := &types.Issue{Title: "Hook me", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": "No status change"}, "alice"); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"status": "in_progress"}, "alice"); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	if err := store.CloseIssue(ctx, issue.ID, "done", "bob"); err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+	// Close waits for pending deliveries
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(payloads) != 2 {
+		t.Fatalf("got %d webhook payloads, want 2: %v", len(payloads), payloads)
+	}
+	// Deliveries are concurrent, so match them by new status
+	want := map[string]map[string]interface{}{
+		"in_progress": {"event": "status_changed", "issue_id": issue.ID, "old_status": "open", "actor": "alice"},
+		"closed":      {"event": "status_changed", "issue_id": issue.ID, "old_status": "in_progress", "actor": "bob"},
+	}
+	for _, p := range payloads {
+		fields, ok := want[fmt.Sprint(p["new_status"])]
+		if !ok {
+			t.Errorf("unexpected payload %v", p)
+			continue
+		}
+		for k, v := range fields {
+			if p[k] != v {
+				t.Errorf("payload %s = %v, want %v", k, p[k], v)
+			}
+		}
+		if _, ok := p["timestamp"].(string); !ok {
+			t.Errorf("payload missing timestamp: %v", p)
+		}
+	}
+}
+
+func TestStatusChangeWebhookAllPaths(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("failed to initialize config: %v", err)
+	}
+
+	var mu sync.Mutex
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Errorf("bad payload: %v", err)
+		}
+		mu.Lock()
+		got = append(got, fmt.Sprintf("%v %v->%v %v", p["issue_id"], p["old_status"], p["new_status"], p["actor"]))
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	config.Set("webhooks-enabled", true)
+	t.Setenv("BD_WEBHOOK_URL", server.URL)
+	t.Cleanup(func() { config.Set("webhooks-enabled", false) })
+
+	ctx := context.Background()
+	store, err := New(ctx, filepath.Join(t.TempDir(), "beads.db"))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	var issues []*types.Issue
+	for _, title := range []string{"In a transaction", "Tombstoned", "Batch deleted"} {
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+		issues = append(issues, issue)
+	}
+	txIssue, tombstoned, batchDeleted := issues[0], issues[1], issues[2]
+
+	err = store.RunInTransaction(ctx, func(tx storage.Transaction) error {
+		if err := tx.UpdateIssue(ctx, txIssue.ID, map[string]interface{}{"status": "in_progress"}, "alice"); err != nil {
+			return err
+		}
+		return tx.CloseIssue(ctx, txIssue.ID, "done", "alice")
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction failed: %v", err)
+	}
+	// A rolled back transaction sends nothing
+	_ = store.RunInTransaction(ctx, func(tx storage.Transaction) error {
+		if err := tx.CloseIssue(ctx, tombstoned.ID, "never", "mallory"); err != nil {
+			return err
+		}
+		return fmt.Errorf("roll back")
+	})
+	if err := store.CreateTombstone(ctx, tombstoned.ID, "bob", "duplicate"); err != nil {
+		t.Fatalf("CreateTombstone failed: %v", err)
+	}
+	if err := store.Undelete(ctx, tombstoned.ID, "carol"); err != nil {
+		t.Fatalf("Undelete failed: %v", err)
+	}
+	if _, err := store.DeleteIssues(ctx, []string{batchDeleted.ID}, false, true, false); err != nil {
+		t.Fatalf("DeleteIssues failed: %v", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	want := []string{
+		txIssue.ID + " open->in_progress alice",
+		txIssue.ID + " in_progress->closed alice",
+		tombstoned.ID + " open->tombstone bob",
+		tombstoned.ID + " tombstone->open carol",
+		batchDeleted.ID + " open->tombstone batch delete",
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	// Deliveries are concurrent, so compare them in any order
+	sort.Strings(got)
+	sort.Strings(want)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("webhook payloads:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestNewAppliesFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix permissions")
+	}
+	t.Chdir(t.TempDir())
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("failed to initialize config: %v", err)
+	}
+	config.Set("file-mode", "0640")
+	t.Cleanup(func() { config.Set("file-mode", "") })
+
+	dbPath := filepath.Join(t.TempDir(), "beads.db")
+	store, err := New(context.Background(), dbPath)
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	_ = store.Close()
+
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != 0640 {
+		t.Errorf("new database mode = %o, want 640", got)
+	}
+
+	// An existing database keeps its permissions
+	if err := os.Chmod(dbPath, 0600); err != nil {
+		t.Fatal(err)
+	}
+	store, err = New(context.Background(), dbPath)
+	if err != nil {
+		t.Fatalf("failed to reopen storage: %v", err)
+	}
+	_ = store.Close()
+	info, err = os.Stat(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != 0600 {
+		t.Errorf("reopening changed the database mode to %o", got)
+	}
+}
+
+func TestNewInMemory(t *testing.T) {
+	ctx := context.Background()
+	open := func() *SQLiteStorage {
+		t.Helper()
+		store, err := NewInMemory(ctx)
+		if err != nil {
+			t.Fatalf("NewInMemory() error = %v", err)
+		}
+		t.Cleanup(func() { _ = store.Close() })
+		if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+			t.Fatalf("SetConfig() error = %v", err)
+		}
+		return store
+	}
+	store := open()
+
+	blocker := &types.Issue{Title: "Blocker", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+	blocked := &types.Issue{Title: "Blocked", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeBug}
+	for _, issue := range []*types.Issue{blocker, blocked} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", issue.Title, err)
+		}
+	}
+	if err := store.AddDependency(ctx, &types.Dependency{IssueID: blocked.ID, DependsOnID: blocker.ID, Type: types.DepBlocks}, "test"); err != nil {
+		t.Fatalf("AddDependency() error = %v", err)
+	}
+	if err := store.AddLabel(ctx, blocked.ID, "backend", "test"); err != nil {
+		t.Fatalf("AddLabel() error = %v", err)
+	}
+	if err := store.UpdateIssue(ctx, blocked.ID, map[string]interface{}{"assignee": "alice"}, "test"); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+
+	got, err := store.GetIssue(ctx, blocked.ID)
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if got.Assignee != "alice" {
+		t.Errorf("assignee = %q, want alice", got.Assignee)
+	}
+	labels, err := store.GetLabels(ctx, blocked.ID)
+	if err != nil || len(labels) != 1 || labels[0] != "backend" {
+		t.Errorf("GetLabels() = %v, %v, want [backend]", labels, err)
+	}
+	ready, err := store.GetReadyWork(ctx, types.WorkFilter{})
+	if err != nil {
+		t.Fatalf("GetReadyWork() error = %v", err)
+	}
+	if len(ready) != 1 || ready[0].ID != blocker.ID {
+		t.Errorf("GetReadyWork() = %v, want only %s", ready, blocker.ID)
+	}
+	if err := store.CloseIssue(ctx, blocker.ID, "done", "test"); err != nil {
+		t.Fatalf("CloseIssue() error = %v", err)
+	}
+	results, err := store.SearchIssues(ctx, "", types.IssueFilter{Labels: []string{"backend"}})
+	if err != nil || len(results) != 1 || results[0].ID != blocked.ID {
+		t.Errorf("SearchIssues(label backend) = %v, %v, want %s", results, err, blocked.ID)
+	}
+	if err := store.SetMetadata(ctx, "last_import_hash", "abc"); err != nil {
+		t.Fatalf("SetMetadata() error = %v", err)
+	}
+	if value, err := store.GetMetadata(ctx, "last_import_hash"); err != nil || value != "abc" {
+		t.Errorf("GetMetadata() = %q, %v, want abc", value, err)
+	}
+
+	// Each in-memory store is a separate database
+	other := open()
+	if issues, err := other.SearchIssues(ctx, "", types.IssueFilter{}); err != nil || len(issues) != 0 {
+		t.Errorf("second in-memory store has %d issues (err %v), want 0", len(issues), err)
+	}
+	if value, err := other.GetMetadata(ctx, "last_import_hash"); err != nil || value != "" {
+		t.Errorf("second in-memory store metadata = %q, %v, want empty", value, err)
+	}
+}
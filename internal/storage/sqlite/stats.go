@@ -0,0 +1,203 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/util"
+)
+
+// CountByStatus returns the number of issues in each status using a single
+// grouped query. The built-in workflow statuses and any configured custom
+// statuses are always present, with a zero count if no issue uses them.
+func (s *SQLiteStorage) CountByStatus(ctx context.Context) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, status := range []types.Status{
+		types.StatusOpen, types.StatusInProgress, types.StatusBlocked,
+		types.StatusDeferred, types.StatusClosed,
+	} {
+		counts[string(status)] = 0
+	}
+	customStatuses, err := s.GetCustomStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range customStatuses {
+		counts[status] = 0
+	}
+
+	rows, err := s.db.QueryContext(ctx, `SELECT status, COUNT(*) FROM issues GROUP BY status`)
+	if err != nil {
+		return nil, wrapDBError("count issues by status", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, wrapDBError("scan status count", err)
+		}
+		counts[status] = count
+	}
+	return counts, rows.Err()
+}
+
+// CountByAssignee returns the number of open (not closed or deleted) issues
+// per assignee using a single grouped query. Unassigned issues are counted
+// under "". With assignee-case-insensitive set, names differing only in case
+// are counted together under their normalized spelling.
+func (s *SQLiteStorage) CountByAssignee(ctx context.Context) (map[string]int, error) {
+	caseInsensitive, err := assigneeCaseInsensitive(ctx, s.GetConfig)
+	if err != nil {
+		return nil, err
+	}
+	allowedValue, err := s.GetConfig(ctx, AssigneesConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	allowed := parseCustomStatuses(allowedValue)
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT COALESCE(assignee, ''), COUNT(*) FROM issues
+		WHERE status NOT IN (?, ?)
+		GROUP BY COALESCE(assignee, '')
+	`, types.StatusClosed, types.StatusTombstone)
+	if err != nil {
+		return nil, wrapDBError("count issues by assignee", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var assignee string
+		var count int
+		if err := rows.Scan(&assignee, &count); err != nil {
+			return nil, wrapDBError("scan assignee count", err)
+		}
+		if caseInsensitive {
+			// Names no longer on the allow-list are still counted, folded to lowercase
+			if normalized, err := types.NormalizeAssignee(assignee, allowed, true); err == nil {
+				assignee = normalized
+			} else {
+				assignee = strings.ToLower(assignee)
+			}
+		}
+		counts[assignee] += count
+	}
+	return counts, rows.Err()
+}
+
+// Throughput returns created vs closed counts per bucket from since up to now.
+// Buckets are contiguous, start at since, and the last one contains now.
+// Tombstones are excluded; issues closed before closed_at was tracked are
+// only counted as created.
+func (s *SQLiteStorage) Throughput(ctx context.Context, bucket time.Duration, since time.Time) ([]types.ThroughputBucket, error) {
+	if bucket <= 0 {
+		return nil, fmt.Errorf("bucket size must be positive, got %v", bucket)
+	}
+
+	sinceStr := since.UTC().Format(time.RFC3339Nano)
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT created_at, closed_at FROM issues
+		WHERE status != ?
+		  AND (julianday(created_at) >= julianday(?) OR julianday(closed_at) >= julianday(?))
+	`, types.StatusTombstone, sinceStr, sinceStr)
+	if err != nil {
+		return nil, wrapDBError("query throughput", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var created, closed []time.Time
+	for rows.Next() {
+		var createdAt time.Time
+		var closedAt sql.NullTime
+		if err := rows.Scan(&createdAt, &closedAt); err != nil {
+			return nil, wrapDBError("scan throughput row", err)
+		}
+		created = append(created, createdAt)
+		if closedAt.Valid {
+			closed = append(closed, closedAt.Time)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError("iterate throughput rows", err)
+	}
+
+	return bucketThroughput(since, time.Now(), bucket, created, closed), nil
+}
+
+// bucketThroughput groups creation and close times into contiguous buckets
+// covering [since, until]. Times outside that range are ignored.
+func bucketThroughput(since, until time.Time, bucket time.Duration, created, closed []time.Time) []types.ThroughputBucket {
+	if bucket <= 0 || until.Before(since) {
+		return nil
+	}
+
+	n := int(until.Sub(since)/bucket) + 1
+	buckets := make([]types.ThroughputBucket, n)
+	for i := range buckets {
+		buckets[i].Start = since.Add(time.Duration(i) * bucket)
+	}
+
+	index := func(t time.Time) int {
+		if t.Before(since) || t.After(until) {
+			return -1
+		}
+		return int(t.Sub(since) / bucket)
+	}
+	for _, t := range created {
+		if i := index(t); i >= 0 {
+			buckets[i].Created++
+		}
+	}
+	for _, t := range closed {
+		if i := index(t); i >= 0 {
+			buckets[i].Closed++
+		}
+	}
+	return buckets
+}
+
+// CycleTimes returns how long each issue closed at or after since took from
+// creation to close, shortest first. Open issues are excluded. With
+// businessDaysOnly set, time on Saturdays and Sundays in since's location is
+// not counted.
+func (s *SQLiteStorage) CycleTimes(ctx context.Context, since time.Time, businessDaysOnly bool) ([]time.Duration, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT created_at, closed_at FROM issues
+		WHERE status = ? AND closed_at IS NOT NULL
+		  AND julianday(closed_at) >= julianday(?)
+	`, types.StatusClosed, since.UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return nil, wrapDBError("query cycle times", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var durations []time.Duration
+	for rows.Next() {
+		var createdAt, closedAt time.Time
+		if err := rows.Scan(&createdAt, &closedAt); err != nil {
+			return nil, wrapDBError("scan cycle time", err)
+		}
+		d := closedAt.Sub(createdAt)
+		if businessDaysOnly {
+			d = util.BusinessDuration(createdAt.In(since.Location()), closedAt)
+		}
+		if d < 0 {
+			d = 0
+		}
+		durations = append(durations, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError("iterate cycle times", err)
+	}
+
+	slices.Sort(durations)
+	return durations, nil
+}
@@ -0,0 +1,240 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCountByStatus(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	if err := store.SetConfig(ctx, CustomStatusConfigKey, "review"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+
+	statuses := []types.Status{types.StatusOpen, types.StatusOpen, types.StatusInProgress, types.StatusClosed, "review"}
+	for i, status := range statuses {
+		issue := &types.Issue{
+			ID:        fmt.Sprintf("bd-%d", i+1),
+			Title:     fmt.Sprintf("Issue %d", i+1),
+			Status:    status,
+			Priority:  2,
+			IssueType: types.TypeTask,
+		}
+		if status == types.StatusClosed {
+			issue.ClosedAt = timePtr(issue.CreatedAt)
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+
+	counts, err := store.CountByStatus(ctx)
+	if err != nil {
+		t.Fatalf("CountByStatus failed: %v", err)
+	}
+
+	want := map[string]int{
+		"open":        2,
+		"in_progress": 1,
+		"blocked":     0,
+		"deferred":    0,
+		"closed":      1,
+		"review":      1,
+	}
+	for status, n := range want {
+		if counts[status] != n {
+			t.Errorf("counts[%q] = %d, want %d", status, counts[status], n)
+		}
+	}
+	if len(counts) != len(want) {
+		t.Errorf("counts = %v, want exactly %v", counts, want)
+	}
+
+	stats, err := store.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("GetStatistics failed: %v", err)
+	}
+	if stats.ByStatus["open"] != 2 {
+		t.Errorf("GetStatistics ByStatus[open] = %d, want 2", stats.ByStatus["open"])
+	}
+}
+
+func TestBucketThroughput(t *testing.T) {
+	since := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC) // Monday
+	until := since.Add(20 * 24 * time.Hour)
+	week := 7 * 24 * time.Hour
+
+	created := []time.Time{
+		since.Add(-time.Hour),          // before range: ignored
+		since,                          // week 0 (inclusive start)
+		since.Add(6 * 24 * time.Hour),  // week 0
+		since.Add(week),                // week 1
+		since.Add(15 * 24 * time.Hour), // week 2
+	}
+	closed := []time.Time{
+		since.Add(week + time.Hour),     // week 1
+		since.Add(2*week + 2*time.Hour), // week 2
+		until.Add(time.Hour),            // after range: ignored
+	}
+
+	buckets := bucketThroughput(since, until, week, created, closed)
+	if len(buckets) != 3 {
+		t.Fatalf("got %d buckets, want 3", len(buckets))
+	}
+	want := []types.ThroughputBucket{
+		{Start: since, Created: 2, Closed: 0},
+		{Start: since.Add(week), Created: 1, Closed: 1},
+		{Start: since.Add(2 * week), Created: 1, Closed: 1},
+	}
+	for i, b := range buckets {
+		if !b.Start.Equal(want[i].Start) || b.Created != want[i].Created || b.Closed != want[i].Closed {
+			t.Errorf("bucket %d = %+v, want %+v", i, b, want[i])
+		}
+	}
+}
+
+func TestThroughput(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	day := 24 * time.Hour
+	since := time.Now().UTC().Truncate(day).Add(-2 * day)
+	issues := []*types.Issue{
+		{ID: "bd-1", Title: "Old", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask,
+			CreatedAt: since.Add(-10 * day)},
+		{ID: "bd-2", Title: "Created day 0", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask,
+			CreatedAt: since.Add(time.Hour)},
+		{ID: "bd-3", Title: "Created day 0, closed day 1", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask,
+			CreatedAt: since.Add(2 * time.Hour), ClosedAt: timePtr(since.Add(day + time.Hour))},
+		{ID: "bd-4", Title: "Old, closed day 1", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask,
+			CreatedAt: since.Add(-5 * day), ClosedAt: timePtr(since.Add(day + 2*time.Hour))},
+	}
+	for _, issue := range issues {
+		issue.UpdatedAt = issue.CreatedAt
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) failed: %v", issue.ID, err)
+		}
+	}
+
+	buckets, err := store.Throughput(ctx, day, since)
+	if err != nil {
+		t.Fatalf("Throughput failed: %v", err)
+	}
+	if len(buckets) < 2 {
+		t.Fatalf("got %d buckets, want at least 2", len(buckets))
+	}
+	if buckets[0].Created != 2 || buckets[0].Closed != 0 {
+		t.Errorf("day 0 = %+v, want 2 created, 0 closed", buckets[0])
+	}
+	if buckets[1].Created != 0 || buckets[1].Closed != 2 {
+		t.Errorf("day 1 = %+v, want 0 created, 2 closed", buckets[1])
+	}
+
+	if _, err := store.Throughput(ctx, 0, since); err == nil {
+		t.Error("expected error for zero bucket size")
+	}
+}
+
+func TestCycleTimes(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	base := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	issues := []*types.Issue{
+		{ID: "bd-1", Title: "Two days", Status: types.StatusClosed, CreatedAt: base, ClosedAt: timePtr(base.Add(48 * time.Hour))},
+		{ID: "bd-2", Title: "Three hours", Status: types.StatusClosed, CreatedAt: base, ClosedAt: timePtr(base.Add(3 * time.Hour))},
+		{ID: "bd-3", Title: "Closed before since", Status: types.StatusClosed, CreatedAt: base.Add(-72 * time.Hour), ClosedAt: timePtr(base.Add(-time.Hour))},
+		{ID: "bd-4", Title: "Still open", Status: types.StatusOpen, CreatedAt: base},
+	}
+	for _, issue := range issues {
+		issue.Priority = 2
+		issue.IssueType = types.TypeTask
+		issue.UpdatedAt = issue.CreatedAt
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) failed: %v", issue.ID, err)
+		}
+	}
+
+	durations, err := store.CycleTimes(ctx, base, false)
+	if err != nil {
+		t.Fatalf("CycleTimes failed: %v", err)
+	}
+	want := []time.Duration{3 * time.Hour, 48 * time.Hour}
+	if len(durations) != len(want) {
+		t.Fatalf("CycleTimes = %v, want %v", durations, want)
+	}
+	for i := range want {
+		if durations[i] != want[i] {
+			t.Errorf("durations[%d] = %v, want %v", i, durations[i], want[i])
+		}
+	}
+
+	// base is a Sunday: in business days the three-hour issue took no time and
+	// the two-day one only counts Monday and Tuesday morning
+	durations, err = store.CycleTimes(ctx, base, true)
+	if err != nil {
+		t.Fatalf("CycleTimes(businessDaysOnly) failed: %v", err)
+	}
+	want = []time.Duration{0, 33 * time.Hour}
+	if len(durations) != len(want) {
+		t.Fatalf("CycleTimes(businessDaysOnly) = %v, want %v", durations, want)
+	}
+	for i := range want {
+		if durations[i] != want[i] {
+			t.Errorf("business durations[%d] = %v, want %v", i, durations[i], want[i])
+		}
+	}
+}
+
+func TestCountByAssignee(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for i, assignee := range []string{"alice", "Alice", "bob", "", "bob"} {
+		issue := &types.Issue{
+			ID:        fmt.Sprintf("bd-%d", i+1),
+			Title:     fmt.Sprintf("Issue %d", i+1),
+			Status:    types.StatusOpen,
+			Priority:  2,
+			IssueType: types.TypeTask,
+			Assignee:  assignee,
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue failed: %v", err)
+		}
+	}
+	if err := store.CloseIssue(ctx, "bd-5", "done", "test"); err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+
+	counts, err := store.CountByAssignee(ctx)
+	if err != nil {
+		t.Fatalf("CountByAssignee failed: %v", err)
+	}
+	want := map[string]int{"alice": 1, "Alice": 1, "bob": 1, "": 1}
+	if fmt.Sprint(counts) != fmt.Sprint(want) {
+		t.Errorf("counts = %v, want %v", counts, want)
+	}
+
+	if err := store.SetConfig(ctx, AssigneeCaseInsensitiveConfigKey, "true"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	counts, err = store.CountByAssignee(ctx)
+	if err != nil {
+		t.Fatalf("CountByAssignee failed: %v", err)
+	}
+	want = map[string]int{"alice": 2, "bob": 1, "": 1}
+	if fmt.Sprint(counts) != fmt.Sprint(want) {
+		t.Errorf("case-insensitive counts = %v, want %v", counts, want)
+	}
+}
@@ -17,18 +17,27 @@ import (
 	sqlite3 "github.com/ncruces/go-sqlite3"
 	_ "github.com/ncruces/go-sqlite3/driver"
 	_ "github.com/ncruces/go-sqlite3/embed"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/webhook"
 	"github.com/tetratelabs/wazero"
 )
 
+// Verify SQLiteStorage implements storage.Storage at compile time
+var _ storage.Storage = (*SQLiteStorage)(nil)
+
 // SQLiteStorage implements the Storage interface using SQLite
 type SQLiteStorage struct {
-	db          *sql.DB
-	dbPath      string
-	closed      atomic.Bool // Tracks whether Close() has been called
-	connStr     string      // Connection string for reconnection
-	busyTimeout time.Duration
-	freshness   *FreshnessChecker // Optional freshness checker for daemon mode
-	reconnectMu sync.RWMutex      // Protects reconnection and db access (GH#607)
+	db              *sql.DB
+	dbPath          string
+	closed          atomic.Bool // Tracks whether Close() has been called
+	connStr         string      // Connection string for reconnection
+	busyTimeout     time.Duration
+	journalMode     string // WAL, or DELETE with single-file-db
+	writeMaxRetries int               // Retries for write transactions that hit SQLITE_BUSY
+	freshness       *FreshnessChecker // Optional freshness checker for daemon mode
+	webhook         *webhook.Notifier // Status change notifications, when webhooks-enabled
+	reconnectMu     sync.RWMutex      // Protects reconnection and db access (GH#607)
 }
 
 // setupWASMCache configures WASM compilation caching to reduce SQLite startup time.
@@ -78,25 +87,47 @@ func init() {
 	_ = setupWASMCache()
 }
 
+// memDBSeq numbers the in-memory databases opened by this process.
+var memDBSeq atomic.Int64
+
 // New creates a new SQLite storage backend with default 30s busy timeout
 func New(ctx context.Context, path string) (*SQLiteStorage, error) {
 	return NewWithTimeout(ctx, path, 30*time.Second)
 }
 
+// NewInMemory creates a storage backend on a fresh in-memory database, for
+// tests. Each call gets its own database, which is discarded on Close.
+func NewInMemory(ctx context.Context) (*SQLiteStorage, error) {
+	return New(ctx, ":memory:")
+}
+
 // NewWithTimeout creates a new SQLite storage backend with configurable busy timeout.
 // A timeout of 0 means fail immediately if the database is locked.
+//
+// Errors from a locked or corrupt database match ErrLocked or ErrCorrupt.
 func NewWithTimeout(ctx context.Context, path string, busyTimeout time.Duration) (*SQLiteStorage, error) {
+	s, err := openStorage(ctx, path, busyTimeout)
+	if err != nil {
+		return nil, classifyDBError(err)
+	}
+	return s, nil
+}
+
+// openStorage does the work of NewWithTimeout.
+func openStorage(ctx context.Context, path string, busyTimeout time.Duration) (*SQLiteStorage, error) {
 	// Convert timeout to milliseconds for SQLite pragma
 	timeoutMs := int64(busyTimeout / time.Millisecond)
 
 	// Build connection string with proper URI syntax
 	// For :memory: databases, use shared cache so multiple connections see the same data
 	var connStr string
+	created := false // whether this call creates the database file
 	if path == ":memory:" {
 		// Use shared in-memory database with a named identifier
 		// Note: WAL mode doesn't work with shared in-memory databases, so use DELETE mode
-		// The name "memdb" is required for cache=shared to work properly across connections
-		connStr = fmt.Sprintf("file:memdb?mode=memory&cache=shared&_pragma=journal_mode(DELETE)&_pragma=foreign_keys(ON)&_pragma=busy_timeout(%d)&_time_format=sqlite", timeoutMs)
+		// A name is required for cache=shared to work properly across connections;
+		// each store gets its own so stores in one process don't see each other's data
+		connStr = fmt.Sprintf("file:memdb%d?mode=memory&cache=shared&_pragma=journal_mode(DELETE)&_pragma=foreign_keys(ON)&_pragma=busy_timeout(%d)&_time_format=sqlite", memDBSeq.Add(1), timeoutMs)
 	} else if strings.HasPrefix(path, "file:") {
 		// Already a URI - append our pragmas if not present
 		connStr = path
@@ -109,6 +140,9 @@ func NewWithTimeout(ctx context.Context, path string, busyTimeout time.Duration)
 		if err := os.MkdirAll(dir, 0o750); err != nil {
 			return nil, fmt.Errorf("failed to create directory: %w", err)
 		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			created = true
+		}
 		// Use file URI with pragmas
 		connStr = fmt.Sprintf("file:%s?_pragma=foreign_keys(ON)&_pragma=busy_timeout(%d)&_time_format=sqlite", path, timeoutMs)
 	}
@@ -137,20 +171,21 @@ func NewWithTimeout(ctx context.Context, path string, busyTimeout time.Duration)
 		db.SetConnMaxLifetime(0) // SQLite doesn't need connection recycling
 	}
 
-	// For file-based databases, enable WAL mode once after opening the connection.
+	// For file-based databases, set the journal mode once after opening the connection.
+	mode := fileJournalMode()
 	if !isInMemory {
-		if _, err := db.Exec("PRAGMA journal_mode=WAL"); err != nil {
-			return nil, fmt.Errorf("failed to enable WAL mode: %w", err)
+		if err := setJournalMode(ctx, db, mode, busyTimeout); err != nil {
+			return nil, err
 		}
 	}
 
 	// Test connection
-	if err := db.Ping(); err != nil {
+	if err := db.PingContext(ctx); err != nil {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
 	// Initialize schema
-	if _, err := db.Exec(schema); err != nil {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
 		return nil, fmt.Errorf("failed to initialize schema: %w", err)
 	}
 
@@ -174,9 +209,18 @@ func NewWithTimeout(ctx context.Context, path string, busyTimeout time.Duration)
 		}
 	}
 
-	// Convert to absolute path for consistency (but keep :memory: as-is)
+	// A new database gets the configured file-mode; SQLite gives its -wal and
+	// -shm files the same permissions. Existing databases are left alone, as
+	// they may belong to another user of a shared checkout.
+	if created {
+		if err := config.ApplyFileMode(path); err != nil {
+			return nil, fmt.Errorf("failed to set database permissions: %w", err)
+		}
+	}
+
+	// Convert to absolute path for consistency (but keep in-memory paths as-is)
 	absPath := path
-	if path != ":memory:" {
+	if !isInMemory {
 		var err error
 		absPath, err = filepath.Abs(path)
 		if err != nil {
@@ -185,15 +229,18 @@ func NewWithTimeout(ctx context.Context, path string, busyTimeout time.Duration)
 	}
 
 	storage := &SQLiteStorage{
-		db:          db,
-		dbPath:      absPath,
-		connStr:     connStr,
-		busyTimeout: busyTimeout,
+		db:              db,
+		dbPath:          absPath,
+		connStr:         connStr,
+		busyTimeout:     busyTimeout,
+		journalMode:     mode,
+		writeMaxRetries: defaultWriteMaxRetries,
+		webhook:         newStatusWebhook(),
 	}
 
 	// Hydrate from multi-repo config if configured (bd-307)
 	// Skip for in-memory databases (used in tests)
-	if path != ":memory:" {
+	if !isInMemory {
 		_, err := storage.HydrateFromMultiRepo(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to hydrate from multi-repo: %w", err)
@@ -203,10 +250,61 @@ func NewWithTimeout(ctx context.Context, path string, busyTimeout time.Duration)
 	return storage, nil
 }
 
+// fileJournalMode returns the journal mode for file databases. WAL lets
+// readers and a writer work concurrently but keeps -wal and -shm files next to
+// the database; the single-file-db config key trades that concurrency for
+// DELETE mode, so only the database file exists at rest (for teams that
+// commit it to git).
+func fileJournalMode() string {
+	if config.GetBool("single-file-db") {
+		return "DELETE"
+	}
+	return "WAL"
+}
+
+// setJournalMode switches db to the given journal mode. Leaving WAL mode
+// checkpoints the WAL and removes the sidecar files, but needs exclusive
+// access: while another process (e.g. a daemon started before single-file-db
+// was set) has the database open, it stays in WAL mode for now and the switch
+// happens on a later open.
+func setJournalMode(ctx context.Context, db *sql.DB, mode string, busyTimeout time.Duration) error {
+	if mode == "WAL" {
+		if _, err := db.ExecContext(ctx, "PRAGMA journal_mode=WAL"); err != nil {
+			return fmt.Errorf("failed to enable WAL mode: %w", err)
+		}
+		return nil
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set journal mode %s: %w", mode, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	// Don't sit out the busy timeout waiting for other processes to close
+	_, _ = conn.ExecContext(ctx, "PRAGMA busy_timeout=0")
+	_, err = conn.ExecContext(ctx, "PRAGMA journal_mode="+mode)
+	_, _ = conn.ExecContext(ctx, fmt.Sprintf("PRAGMA busy_timeout=%d", busyTimeout.Milliseconds()))
+	if IsBusyError(err) {
+		debugPrintf("database in use elsewhere, staying in WAL mode for now\n")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set journal mode %s: %w", mode, err)
+	}
+	return nil
+}
+
 // Close closes the database connection.
-// It checkpoints the WAL to ensure all writes are flushed to the main database file.
+// It checkpoints the WAL to ensure all writes are flushed to the main database file,
+// so copies of the file (backups, a committed database) are complete even while
+// other processes keep the WAL open. Outside WAL mode the checkpoint is a no-op.
+// Pending webhook deliveries get at most webhookWaitTimeout to finish first.
 func (s *SQLiteStorage) Close() error {
 	s.closed.Store(true)
+	if s.webhook != nil && !s.webhook.Wait(webhookWaitTimeout) {
+		debugPrintf("gave up waiting for webhook deliveries\n")
+	}
 	// Acquire write lock to prevent racing with reconnect() (GH#607)
 	s.reconnectMu.Lock()
 	defer s.reconnectMu.Unlock()
@@ -234,6 +332,20 @@ func (s *SQLiteStorage) configureConnectionPool(db *sql.DB) {
 	}
 }
 
+// defaultWriteMaxRetries is how many times a locked write transaction is
+// retried when write-max-retries is not configured.
+const defaultWriteMaxRetries = 5
+
+// SetWriteMaxRetries sets how many times a write transaction is retried, with
+// exponential backoff, when the database is locked by another writer.
+// Zero disables retries.
+func (s *SQLiteStorage) SetWriteMaxRetries(n int) {
+	if n < 0 {
+		n = 0
+	}
+	s.writeMaxRetries = n
+}
+
 // Path returns the absolute path to the database file
 func (s *SQLiteStorage) Path() string {
 	return s.dbPath
@@ -403,13 +515,13 @@ func (s *SQLiteStorage) reconnect() error {
 	// Restore connection pool settings
 	s.configureConnectionPool(db)
 
-	// Re-enable WAL mode for file-based databases
+	// Restore the journal mode for file-based databases
 	isInMemory := s.dbPath == ":memory:" ||
 		(strings.HasPrefix(s.connStr, "file:") && strings.Contains(s.connStr, "mode=memory"))
 	if !isInMemory {
-		if _, err := db.Exec("PRAGMA journal_mode=WAL"); err != nil {
+		if err := setJournalMode(context.Background(), db, s.journalMode, s.busyTimeout); err != nil {
 			_ = db.Close()
-			return fmt.Errorf("failed to enable WAL mode on reconnect: %w", err)
+			return fmt.Errorf("reconnect: %w", err)
 		}
 	}
 
@@ -444,3 +444,102 @@ func TestDeleteIssuesCreatesTombstones(t *testing.T) {
 		}
 	})
 }
+
+func TestSoftDeleteLifecycle(t *testing.T) {
+	store := newTestStore(t, "file::memory:?mode=memory&cache=private")
+	ctx := context.Background()
+
+	issue := &types.Issue{
+		ID:        "bd-life",
+		Title:     "Deleted and restored",
+		Status:    types.StatusOpen,
+		Priority:  1,
+		IssueType: types.TypeBug,
+	}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	visible := func() bool {
+		t.Helper()
+		issues, err := store.SearchIssues(ctx, "", types.IssueFilter{})
+		if err != nil {
+			t.Fatalf("SearchIssues failed: %v", err)
+		}
+		for _, i := range issues {
+			if i.ID == issue.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Delete: hidden from default queries
+	if err := store.CreateTombstone(ctx, issue.ID, "tester", "oops"); err != nil {
+		t.Fatalf("CreateTombstone failed: %v", err)
+	}
+	if visible() {
+		t.Fatal("soft-deleted issue should be hidden from default queries")
+	}
+
+	// Purge leaves recent tombstones alone
+	purged, err := store.Purge(ctx, time.Now().Add(-time.Hour), false)
+	if err != nil {
+		t.Fatalf("Purge failed: %v", err)
+	}
+	if len(purged) != 0 {
+		t.Fatalf("Purge removed recent tombstones %v, want none", purged)
+	}
+
+	// Undelete: back to open with original type and no deletion metadata
+	if err := store.Undelete(ctx, issue.ID, "tester"); err != nil {
+		t.Fatalf("Undelete failed: %v", err)
+	}
+	restored, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if restored.Status != types.StatusOpen || restored.IssueType != types.TypeBug {
+		t.Errorf("restored issue = status %s type %s, want open bug", restored.Status, restored.IssueType)
+	}
+	if restored.DeletedAt != nil || restored.DeletedBy != "" || restored.DeleteReason != "" {
+		t.Errorf("deletion metadata not cleared: %+v", restored)
+	}
+	if !visible() {
+		t.Fatal("restored issue should be visible in default queries")
+	}
+	if err := store.Undelete(ctx, issue.ID, "tester"); err == nil {
+		t.Error("expected error undeleting an issue that is not deleted")
+	}
+
+	// Delete again, then purge with a cutoff after deletion
+	if err := store.CreateTombstone(ctx, issue.ID, "tester", "really"); err != nil {
+		t.Fatalf("CreateTombstone failed: %v", err)
+	}
+	// A dry run reports the tombstone and leaves it in place
+	purged, err = store.Purge(ctx, time.Now().Add(time.Hour), true)
+	if err != nil {
+		t.Fatalf("Purge dry run failed: %v", err)
+	}
+	if len(purged) != 1 || purged[0] != issue.ID {
+		t.Fatalf("Purge dry run = %v, want [%s]", purged, issue.ID)
+	}
+	if kept, _ := store.GetIssue(ctx, issue.ID); kept == nil || kept.Status != types.StatusTombstone {
+		t.Fatalf("dry run purged %s", issue.ID)
+	}
+
+	purged, err = store.Purge(ctx, time.Now().Add(time.Hour), false)
+	if err != nil {
+		t.Fatalf("Purge failed: %v", err)
+	}
+	if len(purged) != 1 {
+		t.Fatalf("Purge removed %v, want 1 tombstone", purged)
+	}
+	gone, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if gone != nil {
+		t.Fatal("purged issue should be gone from the database")
+	}
+}
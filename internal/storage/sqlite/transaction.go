@@ -20,8 +20,9 @@ var _ storage.Transaction = (*sqliteTxStorage)(nil)
 // sqliteTxStorage implements the storage.Transaction interface for SQLite.
 // It wraps a dedicated database connection with an active transaction.
 type sqliteTxStorage struct {
-	conn   *sql.Conn      // Dedicated connection for the transaction
-	parent *SQLiteStorage // Parent storage for accessing shared state
+	conn          *sql.Conn      // Dedicated connection for the transaction
+	parent        *SQLiteStorage // Parent storage for accessing shared state
+	statusChanges []statusChange // Webhook notifications to send once committed
 }
 
 // RunInTransaction executes a function within a database transaction.
@@ -39,6 +40,12 @@ type sqliteTxStorage struct {
 // Panic safety: If the callback panics, the transaction is rolled back
 // and the panic is re-raised to the caller.
 func (s *SQLiteStorage) RunInTransaction(ctx context.Context, fn func(tx storage.Transaction) error) error {
+	return s.runInTxStorage(ctx, func(t *sqliteTxStorage) error { return fn(t) })
+}
+
+// runInTxStorage is RunInTransaction for callers in this package that need
+// the transaction's connection as well as the storage.Transaction methods.
+func (s *SQLiteStorage) runInTxStorage(ctx context.Context, fn func(t *sqliteTxStorage) error) error {
 	// Acquire a dedicated connection for the transaction.
 	// This ensures all operations in the transaction use the same connection.
 	conn, err := s.db.Conn(ctx)
@@ -49,7 +56,7 @@ func (s *SQLiteStorage) RunInTransaction(ctx context.Context, fn func(tx storage
 
 	// Start IMMEDIATE transaction to acquire write lock early.
 	// Use retry logic with exponential backoff to handle SQLITE_BUSY (bd-ola6)
-	if err := beginImmediateWithRetry(ctx, conn, 5, 10*time.Millisecond); err != nil {
+	if err := beginImmediateWithRetry(ctx, conn, s.writeMaxRetries, 10*time.Millisecond); err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
@@ -86,6 +93,7 @@ func (s *SQLiteStorage) RunInTransaction(ctx context.Context, fn func(tx storage
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 	committed = true
+	s.notifyStatusChanges(txStorage.statusChanges)
 	return nil
 }
 
@@ -97,6 +105,17 @@ func (t *sqliteTxStorage) CreateIssue(ctx context.Context, issue *types.Issue, a
 		return fmt.Errorf("failed to get custom statuses: %w", err)
 	}
 
+	// New issues without a status start in the configured default
+	if issue.Status == "" {
+		value, err := t.GetConfig(ctx, DefaultStatusConfigKey)
+		if err != nil {
+			return fmt.Errorf("failed to get default status: %w", err)
+		}
+		if issue.Status, err = types.DefaultStatus(value, customStatuses); err != nil {
+			return err
+		}
+	}
+
 	// Set timestamps first so defensive fixes can use them
 	now := time.Now()
 	if issue.CreatedAt.IsZero() {
@@ -131,6 +150,16 @@ func (t *sqliteTxStorage) CreateIssue(ctx context.Context, issue *types.Issue, a
 	if err := issue.ValidateWithCustomStatuses(customStatuses); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
+	allowed, err := t.getAllowedStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get allowed statuses: %w", err)
+	}
+	if err := types.CheckStatusAllowed(issue.Status, allowed); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if issue.Assignee, err = normalizeAssigneeWithConfig(ctx, t.GetConfig, issue.Assignee); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
 
 	// Compute content hash (bd-95)
 	if issue.ContentHash == "" {
@@ -241,6 +270,9 @@ func (t *sqliteTxStorage) CreateIssues(ctx context.Context, issues []*types.Issu
 		if err := issue.ValidateWithCustomStatuses(customStatuses); err != nil {
 			return fmt.Errorf("validation failed for issue: %w", err)
 		}
+		if issue.Assignee, err = normalizeAssigneeWithConfig(ctx, t.GetConfig, issue.Assignee); err != nil {
+			return fmt.Errorf("validation failed for issue: %w", err)
+		}
 		if issue.ContentHash == "" {
 			issue.ContentHash = issue.ComputeContentHash()
 		}
@@ -274,7 +306,7 @@ func (t *sqliteTxStorage) CreateIssues(ctx context.Context, issues []*types.Issu
 	seenIDs := make(map[string]bool)
 	for _, issue := range issues {
 		if seenIDs[issue.ID] {
-			return fmt.Errorf("duplicate issue ID within batch: %s", issue.ID)
+			return fmt.Errorf("%w: duplicate issue ID within batch: %s", ErrConflict, issue.ID)
 		}
 		seenIDs[issue.ID] = true
 	}
@@ -307,7 +339,7 @@ func (t *sqliteTxStorage) GetIssue(ctx context.Context, id string) (*types.Issue
 		       compaction_level, compacted_at, compacted_at_commit, original_size, source_repo, close_reason,
 		       deleted_at, deleted_by, delete_reason, original_type,
 		       sender, ephemeral, pinned, is_template,
-		       await_type, await_id, timeout_ns, waiters
+		       await_type, await_id, timeout_ns, waiters, due_date
 		FROM issues
 		WHERE id = ?
 	`, id)
@@ -360,7 +392,7 @@ func (t *sqliteTxStorage) UpdateIssue(ctx context.Context, id string, updates ma
 		return fmt.Errorf("failed to get issue for update: %w", err)
 	}
 	if oldIssue == nil {
-		return fmt.Errorf("issue %s not found", id)
+		return fmt.Errorf("issue %s %w", id, ErrNotFound)
 	}
 
 	// Fetch custom statuses for validation (bd-1pj6)
@@ -368,6 +400,20 @@ func (t *sqliteTxStorage) UpdateIssue(ctx context.Context, id string, updates ma
 	if err != nil {
 		return fmt.Errorf("failed to get custom statuses: %w", err)
 	}
+	if status, ok := updates["status"].(string); ok {
+		allowed, err := t.getAllowedStatuses(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get allowed statuses: %w", err)
+		}
+		if err := types.CheckStatusAllowed(types.Status(status), allowed); err != nil {
+			return fmt.Errorf("failed to validate field update: %w", err)
+		}
+	}
+	if assignee, ok := updates["assignee"].(string); ok {
+		if updates["assignee"], err = normalizeAssigneeWithConfig(ctx, t.GetConfig, assignee); err != nil {
+			return fmt.Errorf("failed to validate field update: %w", err)
+		}
+	}
 
 	// Build update query with validated field names
 	setClauses := []string{"updated_at = ?"}
@@ -384,6 +430,9 @@ func (t *sqliteTxStorage) UpdateIssue(ctx context.Context, id string, updates ma
 			return fmt.Errorf("failed to validate field update: %w", err)
 		}
 
+		if dueDate, ok := value.(string); ok && key == "due_date" {
+			value = dueDateValue(dueDate)
+		}
 		setClauses = append(setClauses, fmt.Sprintf("%s = ?", key))
 		args = append(args, value)
 	}
@@ -393,7 +442,7 @@ func (t *sqliteTxStorage) UpdateIssue(ctx context.Context, id string, updates ma
 
 	// Recompute content_hash if any content fields changed (bd-95)
 	contentChanged := false
-	contentFields := []string{"title", "description", "design", "acceptance_criteria", "notes", "status", "priority", "issue_type", "assignee", "external_ref"}
+	contentFields := []string{"title", "description", "design", "acceptance_criteria", "notes", "status", "priority", "issue_type", "assignee", "external_ref", "due_date"}
 	for _, field := range contentFields {
 		if _, exists := updates[field]; exists {
 			contentChanged = true
@@ -444,10 +493,11 @@ func (t *sqliteTxStorage) UpdateIssue(ctx context.Context, id string, updates ma
 
 	// Invalidate blocked issues cache if status changed (bd-1c4h)
 	// Status changes affect which issues are blocked (blockers must be open/in_progress/blocked)
-	if _, statusChanged := updates["status"]; statusChanged {
+	if newStatus, statusChanged := statusUpdate(updates); statusChanged {
 		if err := t.parent.invalidateBlockedCache(ctx, t.conn); err != nil {
 			return fmt.Errorf("failed to invalidate blocked cache: %w", err)
 		}
+		t.statusChanges = append(t.statusChanges, statusChange{id, oldIssue.Status, newStatus, actor})
 	}
 
 	return nil
@@ -510,6 +560,12 @@ func applyUpdatesToIssue(issue *types.Issue, updates map[string]interface{}) {
 					issue.ExternalRef = v
 				}
 			}
+		case "due_date":
+			if s, ok := value.(string); ok {
+				issue.DueDate = s
+			} else if value == nil {
+				issue.DueDate = ""
+			}
 		}
 	}
 }
@@ -519,6 +575,11 @@ func applyUpdatesToIssue(issue *types.Issue, updates map[string]interface{}) {
 func (t *sqliteTxStorage) CloseIssue(ctx context.Context, id string, reason string, actor string) error {
 	now := time.Now()
 
+	var oldStatus types.Status
+	if err := t.conn.QueryRowContext(ctx, `SELECT status FROM issues WHERE id = ?`, id).Scan(&oldStatus); err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("failed to get issue status: %w", err)
+	}
+
 	result, err := t.conn.ExecContext(ctx, `
 		UPDATE issues SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?
 		WHERE id = ?
@@ -532,7 +593,7 @@ func (t *sqliteTxStorage) CloseIssue(ctx context.Context, id string, reason stri
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("issue not found: %s", id)
+		return fmt.Errorf("issue %w: %s", ErrNotFound, id)
 	}
 
 	_, err = t.conn.ExecContext(ctx, `
@@ -553,6 +614,7 @@ func (t *sqliteTxStorage) CloseIssue(ctx context.Context, id string, reason stri
 	if err := t.parent.invalidateBlockedCache(ctx, t.conn); err != nil {
 		return fmt.Errorf("failed to invalidate blocked cache: %w", err)
 	}
+	t.statusChanges = append(t.statusChanges, statusChange{id, oldStatus, types.StatusClosed, actor})
 
 	return nil
 }
@@ -588,7 +650,7 @@ func (t *sqliteTxStorage) DeleteIssue(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to check rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("issue not found: %s", id)
+		return fmt.Errorf("issue %w: %s", ErrNotFound, id)
 	}
 
 	return nil
@@ -607,7 +669,7 @@ func (t *sqliteTxStorage) AddDependency(ctx context.Context, dep *types.Dependen
 		return fmt.Errorf("failed to check issue %s: %w", dep.IssueID, err)
 	}
 	if issueExists == nil {
-		return fmt.Errorf("issue %s not found", dep.IssueID)
+		return fmt.Errorf("issue %s %w", dep.IssueID, ErrNotFound)
 	}
 
 	// External refs (external:<project>:<capability>) don't need target validation (bd-zmmy)
@@ -621,7 +683,7 @@ func (t *sqliteTxStorage) AddDependency(ctx context.Context, dep *types.Dependen
 			return fmt.Errorf("failed to check dependency %s: %w", dep.DependsOnID, err)
 		}
 		if dependsOnExists == nil {
-			return fmt.Errorf("dependency target %s not found", dep.DependsOnID)
+			return fmt.Errorf("dependency target %s %w", dep.DependsOnID, ErrDependencyTargetNotFound)
 		}
 
 		// Prevent self-dependency (only for local deps)
@@ -882,8 +944,18 @@ func (t *sqliteTxStorage) GetCustomStatuses(ctx context.Context) ([]string, erro
 	if err != nil {
 		return nil, err
 	}
-	if value == "" {
-		return nil, nil
+	allowed, err := t.getAllowedStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mergeStatusLists(parseCustomStatuses(value), allowed), nil
+}
+
+// getAllowedStatuses returns the "statuses" config list within the transaction.
+func (t *sqliteTxStorage) getAllowedStatuses(ctx context.Context) ([]string, error) {
+	value, err := t.GetConfig(ctx, StatusesConfigKey)
+	if err != nil {
+		return nil, err
 	}
 	return parseCustomStatuses(value), nil
 }
@@ -930,7 +1002,7 @@ func (t *sqliteTxStorage) AddComment(ctx context.Context, issueID, actor, commen
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("issue %s not found", issueID)
+		return fmt.Errorf("issue %s %w", issueID, ErrNotFound)
 	}
 
 	// Insert comment event
@@ -1124,7 +1196,7 @@ func (t *sqliteTxStorage) SearchIssues(ctx context.Context, query string, filter
 		       compaction_level, compacted_at, compacted_at_commit, original_size, source_repo, close_reason,
 		       deleted_at, deleted_by, delete_reason, original_type,
 		       sender, ephemeral, pinned, is_template,
-		       await_type, await_id, timeout_ns, waiters
+		       await_type, await_id, timeout_ns, waiters, due_date
 		FROM issues
 		%s
 		ORDER BY priority ASC, created_at DESC
@@ -1176,6 +1248,7 @@ func scanIssueRow(row scanner) (*types.Issue, error) {
 	var awaitID sql.NullString
 	var timeoutNs sql.NullInt64
 	var waiters sql.NullString
+	var dueDate sql.NullString
 
 	err := row.Scan(
 		&issue.ID, &contentHash, &issue.Title, &issue.Description, &issue.Design,
@@ -1185,7 +1258,7 @@ func scanIssueRow(row scanner) (*types.Issue, error) {
 		&issue.CompactionLevel, &compactedAt, &compactedAtCommit, &originalSize, &sourceRepo, &closeReason,
 		&deletedAt, &deletedBy, &deleteReason, &originalType,
 		&sender, &wisp, &pinned, &isTemplate,
-		&awaitType, &awaitID, &timeoutNs, &waiters,
+		&awaitType, &awaitID, &timeoutNs, &waiters, &dueDate,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan issue: %w", err)
@@ -1260,6 +1333,9 @@ func scanIssueRow(row scanner) (*types.Issue, error) {
 	if waiters.Valid && waiters.String != "" {
 		issue.Waiters = parseJSONStringArray(waiters.String)
 	}
+	if dueDate.Valid {
+		issue.DueDate = dueDate.String
+	}
 
 	return &issue, nil
 }
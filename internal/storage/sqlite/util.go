@@ -3,7 +3,9 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -18,10 +20,43 @@ func (s *SQLiteStorage) BeginTx(ctx context.Context) (*sql.Tx, error) {
 	return s.db.BeginTx(ctx, nil)
 }
 
+// writeRetries counts write transactions retried after SQLITE_BUSY, across
+// all stores in the process. Reported by the daemon metrics.
+var writeRetries atomic.Int64
+
+// WriteRetries returns how many times a write transaction has been retried
+// because the database was locked.
+func WriteRetries() int64 {
+	return writeRetries.Load()
+}
+
 // withTx executes a function within a database transaction.
 // If the function returns an error, the transaction is rolled back.
 // Otherwise, the transaction is committed.
+//
+// If the database is locked by another writer, the whole transaction is
+// retried with exponential backoff, up to s.writeMaxRetries times. Other
+// errors (constraint violations, etc.) are returned immediately.
 func (s *SQLiteStorage) withTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	delay := 10 * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		err := s.runTx(ctx, fn)
+		if err == nil || !IsBusyError(err) || attempt >= s.writeMaxRetries {
+			return classifyDBError(err)
+		}
+
+		writeRetries.Add(1)
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// runTx makes a single attempt at running fn in a transaction.
+func (s *SQLiteStorage) runTx(ctx context.Context, fn func(*sql.Tx) error) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return wrapDBError("begin transaction", err)
@@ -39,6 +74,28 @@ func (s *SQLiteStorage) withTx(ctx context.Context, fn func(*sql.Tx) error) erro
 	return nil
 }
 
+// errDryRun rolls back a transaction run by withDryRunTx.
+var errDryRun = errors.New("dry run")
+
+// withDryRunTx is withTx, except that with dryRun set the transaction is
+// rolled back after fn succeeds. Bulk operations use it to report exactly
+// what they would change, having run every statement, without committing.
+func (s *SQLiteStorage) withDryRunTx(ctx context.Context, dryRun bool, fn func(*sql.Tx) error) error {
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRun) {
+		return nil
+	}
+	return err
+}
+
 // ExecInTransaction is deprecated. Use withTx instead.
 func (s *SQLiteStorage) ExecInTransaction(ctx context.Context, fn func(*sql.Tx) error) error {
 	return s.withTx(ctx, fn)
@@ -68,6 +125,9 @@ func IsBusyError(err error) bool {
 	if err == nil {
 		return false
 	}
+	if errors.Is(err, ErrLocked) {
+		return true
+	}
 	errStr := err.Error()
 	return strings.Contains(errStr, "database is locked") ||
 		strings.Contains(errStr, "SQLITE_BUSY")
@@ -80,7 +140,7 @@ func IsBusyError(err error) bool {
 // Parameters:
 //   - ctx: context for cancellation checking
 //   - conn: dedicated database connection (must use same connection for entire transaction)
-//   - maxRetries: maximum number of retry attempts (default: 5)
+//   - maxRetries: maximum number of retry attempts (0 disables retries; negative uses the default of 5)
 //   - initialDelay: initial backoff delay (default: 10ms)
 //
 // Returns error if:
@@ -88,8 +148,8 @@ func IsBusyError(err error) bool {
 //   - BEGIN IMMEDIATE fails with non-busy error
 //   - All retries exhausted with SQLITE_BUSY
 func beginImmediateWithRetry(ctx context.Context, conn *sql.Conn, maxRetries int, initialDelay time.Duration) error {
-	if maxRetries <= 0 {
-		maxRetries = 5
+	if maxRetries < 0 {
+		maxRetries = defaultWriteMaxRetries
 	}
 	if initialDelay <= 0 {
 		initialDelay = 10 * time.Millisecond
@@ -114,7 +174,7 @@ func beginImmediateWithRetry(ctx context.Context, conn *sql.Conn, maxRetries int
 
 		// If not a busy error, fail immediately
 		if !IsBusyError(err) {
-			return err
+			return classifyDBError(err)
 		}
 
 		// On last attempt, don't sleep
@@ -123,6 +183,7 @@ func beginImmediateWithRetry(ctx context.Context, conn *sql.Conn, maxRetries int
 		}
 
 		// Exponential backoff: sleep before retry
+		writeRetries.Add(1)
 		select {
 		case <-time.After(delay):
 			delay *= 2 // Double the delay for next attempt
@@ -131,5 +192,5 @@ func beginImmediateWithRetry(ctx context.Context, conn *sql.Conn, maxRetries int
 		}
 	}
 
-	return lastErr // Return the last SQLITE_BUSY error after exhausting retries
+	return classifyDBError(lastErr) // Return the last SQLITE_BUSY error after exhausting retries
 }
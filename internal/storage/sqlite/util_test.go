@@ -5,6 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 func TestIsUniqueConstraintError(t *testing.T) {
@@ -306,7 +309,7 @@ func TestBeginImmediateWithRetry(t *testing.T) {
 		defer conn.Close()
 
 		// Should use defaults (5 retries, 10ms delay) when passed invalid values
-		err = beginImmediateWithRetry(ctx, conn, 0, 0)
+		err = beginImmediateWithRetry(ctx, conn, -1, 0)
 		if err != nil {
 			t.Errorf("beginImmediateWithRetry with invalid params failed: %v", err)
 		}
@@ -315,3 +318,85 @@ func TestBeginImmediateWithRetry(t *testing.T) {
 		_, _ = conn.ExecContext(context.Background(), "ROLLBACK")
 	})
 }
+
+func TestWithTxRetriesWhenLocked(t *testing.T) {
+	ctx := context.Background()
+	dbPath := t.TempDir() + "/test.db"
+	store := newTestStore(t, dbPath)
+	defer store.Close()
+
+	issue := &types.Issue{Title: "Contended", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+
+	// A second store on the same file that does not wait in SQLite itself,
+	// so every lock conflict surfaces as SQLITE_BUSY
+	writer, err := NewWithTimeout(ctx, dbPath, 0)
+	if err != nil {
+		t.Fatalf("failed to open second store: %v", err)
+	}
+	defer writer.Close()
+
+	// holdLock takes the write lock on store and releases it after d
+	holdLock := func(d time.Duration) {
+		t.Helper()
+		conn, err := store.db.Conn(ctx)
+		if err != nil {
+			t.Fatalf("Failed to acquire connection: %v", err)
+		}
+		if _, err := conn.ExecContext(ctx, "BEGIN IMMEDIATE"); err != nil {
+			t.Fatalf("BEGIN IMMEDIATE failed: %v", err)
+		}
+		go func() {
+			time.Sleep(d)
+			_, _ = conn.ExecContext(context.Background(), "ROLLBACK")
+			_ = conn.Close()
+		}()
+	}
+
+	t.Run("succeeds once the lock is released", func(t *testing.T) {
+		before := WriteRetries()
+		holdLock(100 * time.Millisecond)
+
+		if err := writer.AddLabel(ctx, issue.ID, "retried", "test"); err != nil {
+			t.Fatalf("AddLabel under contention failed: %v", err)
+		}
+		if WriteRetries() == before {
+			t.Error("expected the write to be retried")
+		}
+		labels, err := store.GetLabels(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("GetLabels failed: %v", err)
+		}
+		if len(labels) != 1 || labels[0] != "retried" {
+			t.Errorf("labels = %v, want [retried]", labels)
+		}
+	})
+
+	t.Run("fails without retries", func(t *testing.T) {
+		writer.SetWriteMaxRetries(0)
+		defer writer.SetWriteMaxRetries(defaultWriteMaxRetries)
+		holdLock(100 * time.Millisecond)
+		defer time.Sleep(150 * time.Millisecond) // let the lock go before cleanup
+
+		err := writer.AddLabel(ctx, issue.ID, "not-retried", "test")
+		if !IsBusyError(err) {
+			t.Fatalf("expected busy error, got %v", err)
+		}
+	})
+
+	t.Run("does not retry constraint errors", func(t *testing.T) {
+		before := WriteRetries()
+		err := writer.withTx(ctx, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `INSERT INTO labels (issue_id, label) VALUES (?, ?)`, issue.ID, "retried")
+			return err
+		})
+		if !IsUniqueConstraintError(err) {
+			t.Fatalf("expected UNIQUE constraint error, got %v", err)
+		}
+		if WriteRetries() != before {
+			t.Error("constraint errors should not be retried")
+		}
+	})
+}
@@ -67,6 +67,16 @@ func validateEstimatedMinutes(value interface{}) error {
 	return nil
 }
 
+// validateDueDate validates a due_date value
+func validateDueDate(value interface{}) error {
+	if dueDate, ok := value.(string); ok {
+		if _, err := types.ParseDueDate(dueDate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // fieldValidators maps field names to their validation functions
 var fieldValidators = map[string]func(interface{}) error{
 	"priority":          validatePriority,
@@ -74,6 +84,7 @@ var fieldValidators = map[string]func(interface{}) error{
 	"issue_type":        validateIssueType,
 	"title":             validateTitle,
 	"estimated_minutes": validateEstimatedMinutes,
+	"due_date":          validateDueDate,
 }
 
 // validateFieldUpdate validates a field update value (built-in statuses only)
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/webhook"
+)
+
+// webhookWaitTimeout bounds how long Close waits for pending webhook
+// deliveries. It gives a quick endpoint time to receive a CLI command's
+// notification without holding the command up for a slow or unreachable one.
+const webhookWaitTimeout = time.Second
+
+// newStatusWebhook returns the notifier for status changes, or nil unless
+// webhooks-enabled is set and the webhook-url secret is in the environment.
+func newStatusWebhook() *webhook.Notifier {
+	if !config.GetBool("webhooks-enabled") {
+		return nil
+	}
+	url := config.GetSecret("webhook-url")
+	if url == "" {
+		debugPrintf("webhooks-enabled is set but webhook-url is empty\n")
+		return nil
+	}
+	return webhook.New(url)
+}
+
+// notifyStatusChange posts a status change to the webhook, if one is
+// configured. It returns immediately; delivery happens in the background.
+func (s *SQLiteStorage) notifyStatusChange(id string, oldStatus, newStatus types.Status, actor string) {
+	if s.webhook == nil || oldStatus == newStatus {
+		return
+	}
+	s.webhook.Notify(webhook.Payload{
+		Event:     webhook.EventStatusChanged,
+		IssueID:   id,
+		OldStatus: string(oldStatus),
+		NewStatus: string(newStatus),
+		Actor:     actor,
+	})
+}
+
+// statusChange is a status change waiting for its transaction to commit
+// before it is posted to the webhook.
+type statusChange struct {
+	id        string
+	oldStatus types.Status
+	newStatus types.Status
+	actor     string
+}
+
+// notifyStatusChanges posts status changes made by a committed transaction.
+func (s *SQLiteStorage) notifyStatusChanges(changes []statusChange) {
+	for _, c := range changes {
+		s.notifyStatusChange(c.id, c.oldStatus, c.newStatus, c.actor)
+	}
+}
+
+// statusUpdate returns the new status in an UpdateIssue updates map, which
+// may hold a string or a types.Status.
+func statusUpdate(updates map[string]interface{}) (types.Status, bool) {
+	value, ok := updates["status"]
+	if !ok {
+		return "", false
+	}
+	return types.Status(fmt.Sprint(value)), true
+}
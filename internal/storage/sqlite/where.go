@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/where"
+)
+
+// whereColumns maps --where fields to SQL expressions. Dates compare by
+// their UTC day, matching where.Match.
+var whereColumns = map[string]string{
+	"id":       "id",
+	"title":    "title",
+	"status":   "status",
+	"type":     "issue_type",
+	"assignee": "COALESCE(assignee, '')",
+	"priority": "priority",
+	"created":  "date(created_at)",
+	"updated":  "date(updated_at)",
+	"closed":   "date(closed_at)",
+	"due":      "due_date",
+}
+
+// whereOps maps --where operators to SQL. Operators never come from the
+// expression text itself, only from this table.
+var whereOps = map[string]string{
+	"=":  "=",
+	"!=": "!=",
+	">":  ">",
+	"<":  "<",
+	">=": ">=",
+	"<=": "<=",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// parseWhere parses a --where expression into a SQL condition and its bind
+// arguments. Every value in the expression becomes a bind argument.
+func parseWhere(expr string) (string, []interface{}, error) {
+	e, err := where.Parse(expr)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid where expression: %w", err)
+	}
+	var args []interface{}
+	return compileWhere(e, &args), args, nil
+}
+
+func compileWhere(e where.Expr, args *[]interface{}) string {
+	switch e := e.(type) {
+	case *where.And:
+		return "(" + compileWhere(e.Left, args) + " AND " + compileWhere(e.Right, args) + ")"
+	case *where.Or:
+		return "(" + compileWhere(e.Left, args) + " OR " + compileWhere(e.Right, args) + ")"
+	case *where.Not:
+		return "NOT " + compileWhere(e.X, args)
+	case *where.Comparison:
+		return compileComparison(e, args)
+	}
+	panic(fmt.Sprintf("unexpected where expression %T", e))
+}
+
+func compileComparison(c *where.Comparison, args *[]interface{}) string {
+	if c.Field == "label" {
+		switch c.Op {
+		case "~":
+			*args = append(*args, "%"+likeEscaper.Replace(c.Value)+"%")
+			return `id IN (SELECT issue_id FROM labels WHERE label LIKE ? ESCAPE '\')`
+		case "!=":
+			*args = append(*args, c.Value)
+			return "id NOT IN (SELECT issue_id FROM labels WHERE label = ?)"
+		}
+		*args = append(*args, c.Value)
+		return "id IN (SELECT issue_id FROM labels WHERE label = ?)"
+	}
+
+	column := whereColumns[c.Field]
+	if c.Op == "~" {
+		*args = append(*args, "%"+likeEscaper.Replace(c.Value)+"%")
+		return column + ` LIKE ? ESCAPE '\'`
+	}
+	switch c.Field {
+	case "priority":
+		priority, _ := strconv.Atoi(c.Value)
+		*args = append(*args, priority)
+	case "created", "updated", "closed", "due":
+		// A missing date compares false rather than NULL, so NOT inverts it
+		*args = append(*args, c.Value)
+		return "COALESCE(" + column + " " + whereOps[c.Op] + " ?, 0)"
+	default:
+		*args = append(*args, c.Value)
+	}
+	return column + " " + whereOps[c.Op] + " ?"
+}
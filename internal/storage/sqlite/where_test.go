@@ -0,0 +1,142 @@
+package sqlite
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestSearchIssuesWhere(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	create := func(title string, status types.Status, priority int, issueType types.IssueType, assignee string, labels ...string) string {
+		t.Helper()
+		issue := &types.Issue{Title: title, Status: status, Priority: priority, IssueType: issueType, Assignee: assignee}
+		if status == types.StatusClosed {
+			now := time.Now()
+			issue.ClosedAt = &now
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s) error = %v", title, err)
+		}
+		for _, label := range labels {
+			if err := store.AddLabel(ctx, issue.ID, label, "test"); err != nil {
+				t.Fatalf("AddLabel(%s) error = %v", label, err)
+			}
+		}
+		return issue.ID
+	}
+	api := create("API timeout", types.StatusOpen, 2, types.TypeBug, "alice", "backend")
+	login := create("Login page 100% broken", types.StatusOpen, 0, types.TypeBug, "", "frontend", "urgent")
+	cache := create("Add cache", types.StatusInProgress, 3, types.TypeFeature, "bob", "backend")
+	docs := create("Write docs", types.StatusClosed, 4, types.TypeTask, "alice")
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"status=open and label=backend and priority>=2", []string{api}},
+		{"status=open", []string{api, login}},
+		{"status!=open", []string{cache, docs}},
+		{"label=backend or label=urgent", []string{api, login, cache}},
+		{"(type=bug or type=feature) and priority<3", []string{api, login}},
+		{"not label=backend", []string{login, docs}},
+		{"label!=backend and label!=frontend", []string{docs}},
+		{"label~END", []string{api, login, cache}},
+		{"assignee=''", []string{login}},
+		{"assignee=alice and priority>P2", []string{docs}},
+		{"title~'100%'", []string{login}},
+		{"title~'_'", nil},
+		{"closed>=2000-01-01", []string{docs}},
+		{"not closed>=2000-01-01", []string{api, login, cache}},
+		{"created<2000-01-01", nil},
+	}
+	for _, tt := range tests {
+		issues, err := store.SearchIssues(ctx, "", types.IssueFilter{Where: tt.expr})
+		if err != nil {
+			t.Errorf("SearchIssues(%q) error = %v", tt.expr, err)
+			continue
+		}
+		var got []string
+		for _, issue := range issues {
+			got = append(got, issue.ID)
+		}
+		slices.Sort(got)
+		want := slices.Clone(tt.want)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("SearchIssues(%q) = %v, want %v", tt.expr, got, want)
+		}
+	}
+
+	// Where combines with the other filters
+	bug := types.TypeBug
+	issues, err := store.SearchIssues(ctx, "", types.IssueFilter{IssueType: &bug, Where: "label=backend"})
+	if err != nil {
+		t.Fatalf("SearchIssues(type and where) error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].ID != api {
+		t.Errorf("SearchIssues(type and where) = %v, want only %s", issues, api)
+	}
+
+	if _, err := store.SearchIssues(ctx, "", types.IssueFilter{Where: "status=open and"}); err == nil {
+		t.Error("SearchIssues with an invalid expression succeeded, want error")
+	}
+}
+
+func TestParseWhereIsParameterized(t *testing.T) {
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, title := range []string{"first", "second"} {
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue() error = %v", err)
+		}
+	}
+
+	injections := []string{
+		`title="x' OR 1=1 --"`,
+		`title="x\" OR 1=1 --"`,
+		`label="x') OR 1=1 --"`,
+		`status="open'; DROP TABLE issues; --"`,
+		`title~"%' OR '1'='1"`,
+	}
+	for _, expr := range injections {
+		clause, args, err := parseWhere(expr)
+		if err != nil {
+			t.Fatalf("parseWhere(%q) error = %v", expr, err)
+		}
+		// The value must only reach SQLite as a bind argument
+		if strings.Contains(clause, "1=1") || strings.Contains(clause, "DROP") || strings.Contains(clause, "'1'") {
+			t.Errorf("parseWhere(%q) put user input into SQL: %s", expr, clause)
+		}
+		if strings.Count(clause, "?") != len(args) {
+			t.Errorf("parseWhere(%q) = %s with %d args, want one arg per placeholder", expr, clause, len(args))
+		}
+
+		issues, err := store.SearchIssues(ctx, "", types.IssueFilter{Where: expr})
+		if err != nil {
+			t.Errorf("SearchIssues(%q) error = %v", expr, err)
+			continue
+		}
+		if len(issues) != 0 {
+			t.Errorf("SearchIssues(%q) returned %d issues, want 0", expr, len(issues))
+		}
+	}
+
+	all, err := store.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		t.Fatalf("SearchIssues() error = %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("got %d issues after injection attempts, want 2", len(all))
+	}
+}
@@ -86,6 +86,7 @@ type Storage interface {
 	CloseIssue(ctx context.Context, id string, reason string, actor string) error
 	DeleteIssue(ctx context.Context, id string) error
 	SearchIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error)
+	ListIssues(ctx context.Context, filter types.IssueFilter, page types.PageRequest) (*types.IssuePage, error) // Keyset pagination on (created_at, id)
 
 	// Dependencies
 	AddDependency(ctx context.Context, dep *types.Dependency, actor string) error
@@ -120,6 +121,12 @@ type Storage interface {
 	GetIssueComments(ctx context.Context, issueID string) ([]*types.Comment, error)
 	GetCommentsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Comment, error)
 
+	// References (links to external URLs)
+	AddReference(ctx context.Context, issueID, url, label, actor string) error
+	RemoveReference(ctx context.Context, issueID, url, actor string) error
+	GetReferences(ctx context.Context, issueID string) ([]*types.Reference, error)
+	GetReferencesForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Reference, error)
+
 	// Statistics
 	GetStatistics(ctx context.Context) (*types.Statistics, error)
 
@@ -44,6 +44,9 @@ func (m *mockStorage) DeleteIssue(ctx context.Context, id string) error {
 func (m *mockStorage) SearchIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error) {
 	return nil, nil
 }
+func (m *mockStorage) ListIssues(ctx context.Context, filter types.IssueFilter, page types.PageRequest) (*types.IssuePage, error) {
+	return nil, nil
+}
 func (m *mockStorage) AddDependency(ctx context.Context, dep *types.Dependency, actor string) error {
 	return nil
 }
@@ -113,6 +116,18 @@ func (m *mockStorage) GetIssueComments(ctx context.Context, issueID string) ([]*
 func (m *mockStorage) GetCommentsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Comment, error) {
 	return nil, nil
 }
+func (m *mockStorage) AddReference(ctx context.Context, issueID, url, label, actor string) error {
+	return nil
+}
+func (m *mockStorage) RemoveReference(ctx context.Context, issueID, url, actor string) error {
+	return nil
+}
+func (m *mockStorage) GetReferences(ctx context.Context, issueID string) ([]*types.Reference, error) {
+	return nil, nil
+}
+func (m *mockStorage) GetReferencesForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Reference, error) {
+	return nil, nil
+}
 func (m *mockStorage) GetStatistics(ctx context.Context) (*types.Statistics, error) {
 	return nil, nil
 }
@@ -290,6 +305,7 @@ func TestInterfaceDocumentation(t *testing.T) {
 		_ = s.CloseIssue
 		_ = s.DeleteIssue
 		_ = s.SearchIssues
+		_ = s.ListIssues
 
 		// Verify dependency operations
 		_ = s.AddDependency
@@ -438,7 +438,7 @@ func importFromJSONL(ctx context.Context, store storage.Storage, path string) er
 		}
 
 		var issue types.Issue
-		if err := json.Unmarshal([]byte(line), &issue); err != nil {
+		if err := types.UnmarshalJSONL([]byte(line), &issue); err != nil {
 			return fmt.Errorf("failed to parse issue at line %d: %w", i+1, err)
 		}
 
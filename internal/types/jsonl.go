@@ -0,0 +1,195 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// TimestampFormat is how JSONL records write issue timestamps (created_at,
+// updated_at, closed_at, compacted_at and deleted_at), from the
+// timestamp-format config key. Either way they are written in UTC, so a
+// flush doesn't rewrite every line when developers in different timezones
+// share a JSONL file.
+type TimestampFormat string
+
+const (
+	// TimestampRFC3339 writes RFC 3339 strings, e.g. "2024-01-15T10:00:00.5Z" (the default)
+	TimestampRFC3339 TimestampFormat = "rfc3339"
+	// TimestampUnix writes whole seconds since the epoch, e.g. 1705312800
+	TimestampUnix TimestampFormat = "unix"
+)
+
+// ParseTimestampFormat validates a timestamp-format value. Empty means the
+// default, rfc3339.
+func ParseTimestampFormat(s string) (TimestampFormat, error) {
+	switch TimestampFormat(s) {
+	case "", TimestampRFC3339:
+		return TimestampRFC3339, nil
+	case TimestampUnix:
+		return TimestampUnix, nil
+	}
+	return "", fmt.Errorf("invalid timestamp-format %q (expected rfc3339 or unix)", s)
+}
+
+// jsonlTimestampFields are the Issue fields TimestampFormat applies to.
+var jsonlTimestampFields = []string{"created_at", "updated_at", "closed_at", "compacted_at", "deleted_at"}
+
+// issueJSON has Issue's fields without its methods, for the wrapper below.
+type issueJSON Issue
+
+// unixIssueJSON overrides the timestamp fields of an issue with Unix seconds.
+type unixIssueJSON struct {
+	*issueJSON
+	CreatedAt   int64  `json:"created_at"`
+	UpdatedAt   int64  `json:"updated_at"`
+	ClosedAt    *int64 `json:"closed_at,omitempty"`
+	CompactedAt *int64 `json:"compacted_at,omitempty"`
+	DeletedAt   *int64 `json:"deleted_at,omitempty"`
+}
+
+// MarshalIssueJSONL encodes issue as a JSONL record, without the trailing
+// newline. Timestamps are written in UTC, in format; the timestamps of
+// dependencies, comments and references are always RFC 3339. issue is not
+// modified.
+func MarshalIssueJSONL(issue *Issue, format TimestampFormat) ([]byte, error) {
+	utc := *issue
+	utc.CreatedAt = issue.CreatedAt.UTC()
+	utc.UpdatedAt = issue.UpdatedAt.UTC()
+	utc.ClosedAt = utcTime(issue.ClosedAt)
+	utc.CompactedAt = utcTime(issue.CompactedAt)
+	utc.DeletedAt = utcTime(issue.DeletedAt)
+	if issue.Dependencies != nil {
+		utc.Dependencies = make([]*Dependency, len(issue.Dependencies))
+		for i, dep := range issue.Dependencies {
+			d := *dep
+			d.CreatedAt = dep.CreatedAt.UTC()
+			utc.Dependencies[i] = &d
+		}
+	}
+	if issue.Comments != nil {
+		utc.Comments = make([]*Comment, len(issue.Comments))
+		for i, comment := range issue.Comments {
+			c := *comment
+			c.CreatedAt = comment.CreatedAt.UTC()
+			utc.Comments[i] = &c
+		}
+	}
+	if issue.References != nil {
+		utc.References = make([]*Reference, len(issue.References))
+		for i, ref := range issue.References {
+			r := *ref
+			r.CreatedAt = ref.CreatedAt.UTC()
+			utc.References[i] = &r
+		}
+	}
+
+	if format != TimestampUnix {
+		return json.Marshal(&utc)
+	}
+	return json.Marshal(&unixIssueJSON{
+		issueJSON:   (*issueJSON)(&utc),
+		CreatedAt:   utc.CreatedAt.Unix(),
+		UpdatedAt:   utc.UpdatedAt.Unix(),
+		ClosedAt:    unixTime(utc.ClosedAt),
+		CompactedAt: unixTime(utc.CompactedAt),
+		DeletedAt:   unixTime(utc.DeletedAt),
+	})
+}
+
+// EncodeIssueJSONL writes issue to w as one JSONL line (see MarshalIssueJSONL).
+func EncodeIssueJSONL(w io.Writer, issue *Issue, format TimestampFormat) error {
+	data, err := MarshalIssueJSONL(issue, format)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// UnmarshalJSONL decodes a JSONL issue record into v, usually an *Issue,
+// accepting timestamps in either TimestampFormat.
+func UnmarshalJSONL(data []byte, v interface{}) error {
+	if !hasUnixTimestamps(data) {
+		return json.Unmarshal(data, v)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for _, name := range jsonlTimestampFields {
+		raw, ok := fields[name]
+		if !ok || len(raw) == 0 || !isNumberStart(raw[0]) {
+			continue
+		}
+		secs, err := strconv.ParseInt(string(raw), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s %s: want Unix seconds or an RFC 3339 string", name, raw)
+		}
+		ts, _ := json.Marshal(time.Unix(secs, 0).UTC().Format(time.RFC3339Nano))
+		fields[name] = ts
+	}
+	normalized, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(normalized, v)
+}
+
+// DecodeIssueJSONL reads the next JSONL issue record from dec into issue,
+// like UnmarshalJSONL. It returns io.EOF at the end of the input.
+func DecodeIssueJSONL(dec *json.Decoder, issue *Issue) error {
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	return UnmarshalJSONL(raw, issue)
+}
+
+// hasUnixTimestamps reports whether any timestamp key in data is followed by
+// a number. Nested records only ever hold RFC 3339 strings, so this is a
+// cheap test for records written with TimestampUnix.
+func hasUnixTimestamps(data []byte) bool {
+	for _, name := range jsonlTimestampFields {
+		key := []byte(`"` + name + `"`)
+		rest := data
+		for {
+			i := bytes.Index(rest, key)
+			if i < 0 {
+				break
+			}
+			rest = bytes.TrimLeft(rest[i+len(key):], " \t\r\n")
+			if len(rest) > 0 && rest[0] == ':' {
+				value := bytes.TrimLeft(rest[1:], " \t\r\n")
+				if len(value) > 0 && isNumberStart(value[0]) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isNumberStart(c byte) bool {
+	return c == '-' || (c >= '0' && c <= '9')
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func unixTime(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	secs := t.Unix()
+	return &secs
+}
@@ -0,0 +1,252 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// JSONLSchemaVersion is the version of the issues.jsonl record format this
+// build writes. Every exported record carries it in schema_version; records
+// without one predate versioning and are treated as version 1.
+//
+// Bump it (and JSONLSchema) only when a change would make older builds
+// misread new records, e.g. a new required field. Adding optional fields
+// does not need a bump.
+const JSONLSchemaVersion = 1
+
+// JSONLSchema is the JSON Schema (draft 2020-12) describing one line of
+// issues.jsonl. It is printed by `bd schema jsonl`.
+const JSONLSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://github.com/steveyegge/beads/schemas/issues-jsonl-v1.json",
+  "title": "beads issue (issues.jsonl record)",
+  "description": "One issue per line. Records without schema_version predate versioning and are read as version 1.",
+  "type": "object",
+  "required": ["id", "title", "priority", "created_at", "updated_at"],
+  "additionalProperties": false,
+  "properties": {
+    "schema_version": {"type": "integer", "minimum": 1, "maximum": 1, "description": "Record format version"},
+    "id": {"type": "string", "minLength": 1, "description": "Issue ID, e.g. bd-a3f8e9 or bd-a3f8e9.1"},
+    "title": {"type": "string"},
+    "description": {"type": "string"},
+    "design": {"type": "string"},
+    "acceptance_criteria": {"type": "string"},
+    "notes": {"type": "string"},
+    "status": {"type": "string", "description": "open, in_progress, blocked, deferred, closed, tombstone, pinned, or a custom status"},
+    "priority": {"type": "integer", "minimum": 0, "maximum": 4},
+    "issue_type": {"type": "string"},
+    "assignee": {"type": "string"},
+    "estimated_minutes": {"type": "integer", "minimum": 0},
+    "created_at": {"type": ["string", "integer"], "format": "date-time", "description": "RFC 3339, or Unix seconds with timestamp-format: unix"},
+    "updated_at": {"type": ["string", "integer"], "format": "date-time", "description": "RFC 3339, or Unix seconds with timestamp-format: unix"},
+    "closed_at": {"type": ["string", "integer"], "format": "date-time", "description": "RFC 3339, or Unix seconds with timestamp-format: unix"},
+    "close_reason": {"type": "string"},
+    "external_ref": {"type": "string"},
+    "compaction_level": {"type": "integer", "minimum": 0},
+    "compacted_at": {"type": ["string", "integer"], "format": "date-time", "description": "RFC 3339, or Unix seconds with timestamp-format: unix"},
+    "compacted_at_commit": {"type": "string"},
+    "original_size": {"type": "integer", "minimum": 0},
+    "labels": {"type": "array", "items": {"type": "string"}},
+    "dependencies": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["issue_id", "depends_on_id", "type", "created_at"],
+        "properties": {
+          "issue_id": {"type": "string"},
+          "depends_on_id": {"type": "string"},
+          "type": {"type": "string"},
+          "created_at": {"type": "string", "format": "date-time"},
+          "created_by": {"type": "string"},
+          "metadata": {"type": "string"},
+          "thread_id": {"type": "string"}
+        }
+      }
+    },
+    "comments": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["id", "issue_id", "author", "text", "created_at"],
+        "properties": {
+          "id": {"type": "integer"},
+          "issue_id": {"type": "string"},
+          "author": {"type": "string"},
+          "text": {"type": "string"},
+          "created_at": {"type": "string", "format": "date-time"}
+        }
+      }
+    },
+    "references": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["issue_id", "url", "created_at"],
+        "properties": {
+          "issue_id": {"type": "string"},
+          "url": {"type": "string", "format": "uri"},
+          "label": {"type": "string"},
+          "created_at": {"type": "string", "format": "date-time"}
+        }
+      }
+    },
+    "deleted_at": {"type": ["string", "integer"], "format": "date-time", "description": "RFC 3339, or Unix seconds with timestamp-format: unix"},
+    "deleted_by": {"type": "string"},
+    "delete_reason": {"type": "string"},
+    "original_type": {"type": "string"},
+    "sender": {"type": "string"},
+    "wisp": {"type": "boolean"},
+    "pinned": {"type": "boolean"},
+    "is_template": {"type": "boolean"},
+    "bonded_from": {"type": "array", "items": {"type": "object"}},
+    "creator": {"type": "object"},
+    "validations": {"type": "array", "items": {"type": "object"}},
+    "await_type": {"type": "string"},
+    "await_id": {"type": "string"},
+    "timeout": {"type": "integer", "description": "Nanoseconds"},
+    "waiters": {"type": "array", "items": {"type": "string"}},
+    "due_date": {"type": "string", "format": "date"}
+  }
+}
+`
+
+// CheckJSONLVersion rejects records written in a newer format than this
+// build understands. Such records may depend on required fields that would
+// be silently dropped on import.
+func CheckJSONLVersion(issue *Issue) error {
+	if issue.SchemaVersion > JSONLSchemaVersion {
+		return fmt.Errorf("issue %s uses JSONL schema version %d, but this bd supports up to %d (upgrade bd)",
+			issue.ID, issue.SchemaVersion, JSONLSchemaVersion)
+	}
+	return nil
+}
+
+// UnmarshalJSONLRecord decodes one issues.jsonl line into issue, like
+// UnmarshalJSONL, and checks it against JSONLSchema. A record from a newer
+// format version fails with CheckJSONLVersion's error rather than a schema
+// error, since its new fields are what the schema doesn't know.
+func UnmarshalJSONLRecord(line []byte, issue *Issue) error {
+	if err := UnmarshalJSONL(line, issue); err != nil {
+		return err
+	}
+	if err := CheckJSONLVersion(issue); err != nil {
+		return err
+	}
+	return ValidateJSONLRecord(line)
+}
+
+// jsonlSchemaSpec is the subset of JSONLSchema that ValidateJSONLRecord checks.
+type jsonlSchemaSpec struct {
+	Required   []string `json:"required"`
+	Properties map[string]struct {
+		Type schemaTypes `json:"type"`
+	} `json:"properties"`
+}
+
+// schemaTypes is a JSON Schema "type" keyword: one type name or a list of them.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = schemaTypes{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+func (t schemaTypes) String() string {
+	return strings.Join(t, " or ")
+}
+
+var (
+	jsonlSpecOnce sync.Once
+	jsonlSpec     jsonlSchemaSpec
+	jsonlSpecErr  error
+)
+
+// ValidateJSONLRecord checks one raw issues.jsonl line against the top-level
+// rules of JSONLSchema: required fields are present, no unknown fields appear,
+// and every field has the declared JSON type.
+func ValidateJSONLRecord(line []byte) error {
+	jsonlSpecOnce.Do(func() {
+		jsonlSpecErr = json.Unmarshal([]byte(JSONLSchema), &jsonlSpec)
+	})
+	if jsonlSpecErr != nil {
+		return fmt.Errorf("invalid embedded JSONL schema: %w", jsonlSpecErr)
+	}
+
+	var record map[string]json.RawMessage
+	if err := json.Unmarshal(line, &record); err != nil {
+		return fmt.Errorf("record is not a JSON object: %w", err)
+	}
+
+	for _, field := range jsonlSpec.Required {
+		if _, ok := record[field]; !ok {
+			return fmt.Errorf("missing required field %q", field)
+		}
+	}
+
+	fields := make([]string, 0, len(record))
+	for field := range record {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		prop, ok := jsonlSpec.Properties[field]
+		if !ok {
+			return fmt.Errorf("unknown field %q", field)
+		}
+		if !slices.ContainsFunc(prop.Type, func(schemaType string) bool {
+			return jsonTypeMatches(record[field], schemaType)
+		}) {
+			return fmt.Errorf("field %q must be of type %s", field, prop.Type)
+		}
+	}
+	return nil
+}
+
+// jsonTypeMatches reports whether raw holds a value of the given JSON Schema type.
+func jsonTypeMatches(raw json.RawMessage, schemaType string) bool {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return false
+	}
+	if bytes.Equal(raw, []byte("null")) {
+		return false
+	}
+	switch schemaType {
+	case "string":
+		return raw[0] == '"'
+	case "object":
+		return raw[0] == '{'
+	case "array":
+		return raw[0] == '['
+	case "boolean":
+		return bytes.Equal(raw, []byte("true")) || bytes.Equal(raw, []byte("false"))
+	case "integer":
+		if raw[0] == '"' {
+			return false
+		}
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return false
+		}
+		_, err := n.Int64()
+		return err == nil
+	case "number":
+		var n json.Number
+		return raw[0] != '"' && json.Unmarshal(raw, &n) == nil
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshalIssueJSONLWritesUTC(t *testing.T) {
+	// Simulate a developer in UTC-8, creating timestamps in local time
+	oldLocal := time.Local
+	time.Local = time.FixedZone("PST", -8*3600)
+	defer func() { time.Local = oldLocal }()
+
+	created := time.Date(2024, 1, 15, 18, 30, 0, 500_000_000, time.Local)
+	closed := created.Add(2 * time.Hour)
+	issue := &Issue{
+		ID:        "bd-1",
+		Title:     "Timestamps",
+		Status:    StatusClosed,
+		Priority:  2,
+		IssueType: TypeTask,
+		CreatedAt: created,
+		UpdatedAt: closed,
+		ClosedAt:  &closed,
+		Dependencies: []*Dependency{
+			{IssueID: "bd-1", DependsOnID: "bd-2", Type: DepBlocks, CreatedAt: created},
+		},
+	}
+
+	data, err := MarshalIssueJSONL(issue, TimestampRFC3339)
+	if err != nil {
+		t.Fatalf("MarshalIssueJSONL(rfc3339) error = %v", err)
+	}
+	for _, want := range []string{
+		`"created_at":"2024-01-16T02:30:00.5Z"`,
+		`"updated_at":"2024-01-16T04:30:00.5Z"`,
+		`"closed_at":"2024-01-16T04:30:00.5Z"`,
+	} {
+		if !bytes.Contains(data, []byte(want)) {
+			t.Errorf("rfc3339 record missing %s:\n%s", want, data)
+		}
+	}
+	if bytes.Contains(data, []byte("-08:00")) {
+		t.Errorf("rfc3339 record has a local offset:\n%s", data)
+	}
+	if issue.CreatedAt.Location() != time.Local || issue.Dependencies[0].CreatedAt.Location() != time.Local {
+		t.Error("MarshalIssueJSONL modified the issue")
+	}
+
+	data, err = MarshalIssueJSONL(issue, TimestampUnix)
+	if err != nil {
+		t.Fatalf("MarshalIssueJSONL(unix) error = %v", err)
+	}
+	for _, want := range []string{`"created_at":1705372200`, `"updated_at":1705379400`, `"closed_at":1705379400`} {
+		if !bytes.Contains(data, []byte(want)) {
+			t.Errorf("unix record missing %s:\n%s", want, data)
+		}
+	}
+	// Nested timestamps stay RFC 3339, in UTC
+	if !bytes.Contains(data, []byte(`"created_at":"2024-01-16T02:30:00.5Z"`)) {
+		t.Errorf("unix record's dependency timestamp is not RFC 3339 UTC:\n%s", data)
+	}
+	if strings.Count(string(data), `"created_at"`) != 2 {
+		t.Errorf("unix record should have one issue and one dependency created_at:\n%s", data)
+	}
+}
+
+func TestUnmarshalJSONLAcceptsBothFormats(t *testing.T) {
+	closed := time.Date(2024, 1, 16, 4, 30, 0, 0, time.UTC)
+	issue := &Issue{
+		ID:        "bd-1",
+		Title:     `Title with "created_at":1 in it`,
+		Status:    StatusClosed,
+		Priority:  2,
+		IssueType: TypeTask,
+		CreatedAt: closed.Add(-2 * time.Hour),
+		UpdatedAt: closed,
+		ClosedAt:  &closed,
+	}
+	for _, format := range []TimestampFormat{TimestampRFC3339, TimestampUnix} {
+		data, err := MarshalIssueJSONL(issue, format)
+		if err != nil {
+			t.Fatalf("MarshalIssueJSONL(%s) error = %v", format, err)
+		}
+		var got Issue
+		if err := UnmarshalJSONL(data, &got); err != nil {
+			t.Fatalf("UnmarshalJSONL(%s) error = %v", format, err)
+		}
+		if got.Title != issue.Title || !got.CreatedAt.Equal(issue.CreatedAt) || !got.UpdatedAt.Equal(issue.UpdatedAt) ||
+			got.ClosedAt == nil || !got.ClosedAt.Equal(closed) {
+			t.Errorf("%s round trip = %+v, want %+v", format, got, issue)
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(append(data, '\n')))
+		var decoded Issue
+		if err := DecodeIssueJSONL(dec, &decoded); err != nil {
+			t.Fatalf("DecodeIssueJSONL(%s) error = %v", format, err)
+		}
+		if !decoded.CreatedAt.Equal(issue.CreatedAt) {
+			t.Errorf("DecodeIssueJSONL(%s) created_at = %v, want %v", format, decoded.CreatedAt, issue.CreatedAt)
+		}
+	}
+
+	// Records from tools with their own struct decode too
+	var partial struct {
+		CreatedAt string `json:"created_at"`
+	}
+	if err := UnmarshalJSONL([]byte(`{"id":"bd-1","created_at": 1705372200}`), &partial); err != nil {
+		t.Fatalf("UnmarshalJSONL(partial) error = %v", err)
+	}
+	if partial.CreatedAt != "2024-01-16T02:30:00Z" {
+		t.Errorf("partial created_at = %q, want 2024-01-16T02:30:00Z", partial.CreatedAt)
+	}
+}
+
+func TestParseTimestampFormat(t *testing.T) {
+	for input, want := range map[string]TimestampFormat{"": TimestampRFC3339, "rfc3339": TimestampRFC3339, "unix": TimestampUnix} {
+		got, err := ParseTimestampFormat(input)
+		if err != nil || got != want {
+			t.Errorf("ParseTimestampFormat(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := ParseTimestampFormat("iso"); err == nil {
+		t.Error("ParseTimestampFormat(iso) succeeded, want error")
+	}
+}
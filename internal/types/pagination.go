@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PageRequest selects one page of a keyset-paginated issue listing.
+// An empty Cursor starts at the oldest issue.
+type PageRequest struct {
+	Limit  int    // Maximum issues per page (<= 0 means no limit)
+	Cursor string // Opaque cursor from a previous IssuePage.NextCursor
+}
+
+// IssuePage is one page of issues ordered by (created_at, id).
+// NextCursor is empty when there are no further pages.
+type IssuePage struct {
+	Issues     []*Issue `json:"issues"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// EncodeIssueCursor builds the opaque cursor pointing just past the given issue.
+// createdAt keeps its UTC offset so storage can compare it with the stored value.
+func EncodeIssueCursor(createdAt time.Time, id string) string {
+	raw := createdAt.Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeIssueCursor parses a cursor produced by EncodeIssueCursor.
+func DecodeIssueCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid cursor %q", cursor)
+	}
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return time.Time{}, "", fmt.Errorf("invalid cursor %q", cursor)
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	return createdAt, id, nil
+}
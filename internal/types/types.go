@@ -4,6 +4,7 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -35,6 +36,7 @@ type Issue struct {
 	Labels             []string       `json:"labels,omitempty"` // Populated only for export/import
 	Dependencies       []*Dependency  `json:"dependencies,omitempty"` // Populated only for export/import
 	Comments           []*Comment     `json:"comments,omitempty"`     // Populated only for export/import
+	References         []*Reference   `json:"references,omitempty"`   // Populated only for export/import
 	// Tombstone fields (bd-vw8): inline soft-delete support
 	DeletedAt    *time.Time `json:"deleted_at,omitempty"`    // When the issue was deleted
 	DeletedBy    string     `json:"deleted_by,omitempty"`    // Who deleted the issue
@@ -65,6 +67,13 @@ type Issue struct {
 	AwaitID   string        `json:"await_id,omitempty"`   // Condition identifier (e.g., run ID, PR number)
 	Timeout   time.Duration `json:"timeout,omitempty"`    // Max wait time before escalation
 	Waiters   []string      `json:"waiters,omitempty"`    // Mail addresses to notify when gate clears
+
+	// DueDate is the day the issue is due, as YYYY-MM-DD (see ParseDueDate)
+	DueDate string `json:"due_date,omitempty"`
+
+	// SchemaVersion is the JSONL record format version (see JSONLSchemaVersion).
+	// Set only on export; not stored in the database.
+	SchemaVersion int `json:"schema_version,omitempty"`
 }
 
 // ComputeContentHash creates a deterministic hash of the issue's content.
@@ -157,10 +166,42 @@ func (i *Issue) ComputeContentHash() string {
 		h.Write([]byte(waiter))
 		h.Write([]byte{0})
 	}
+	// Only hash a due date when set, so hashes of issues without one don't change
+	if i.DueDate != "" {
+		h.Write([]byte("due:" + i.DueDate))
+		h.Write([]byte{0})
+	}
 
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
+// DueDateLayout is the format of Issue.DueDate.
+const DueDateLayout = "2006-01-02"
+
+// ParseDueDate checks that s is a date in YYYY-MM-DD form and returns it
+// normalized. An empty s clears the due date and is returned as is.
+func ParseDueDate(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", nil
+	}
+	d, err := time.Parse(DueDateLayout, s)
+	if err != nil {
+		return "", fmt.Errorf("invalid due date %q: want YYYY-MM-DD, e.g. 2024-12-01", s)
+	}
+	return d.Format(DueDateLayout), nil
+}
+
+// IsOverdue reports whether the issue is still open past its due date, where
+// today is the current date (YYYY-MM-DD) in the project's timezone. An issue
+// is due by the end of its due date.
+func (i *Issue) IsOverdue(today string) bool {
+	if i.DueDate == "" || i.Status == StatusClosed || i.Status == StatusTombstone {
+		return false
+	}
+	return i.DueDate < today
+}
+
 // DefaultTombstoneTTL is the default time-to-live for tombstones (30 days)
 const DefaultTombstoneTTL = 30 * 24 * time.Hour
 
@@ -317,6 +358,59 @@ func (s Status) IsValidWithCustom(customStatuses []string) bool {
 	return false
 }
 
+// DefaultStatus returns the status new issues start in, given the value of
+// the default-status config key. An empty value means StatusOpen. Otherwise
+// the value must be a built-in or custom status.
+func DefaultStatus(value string, customStatuses []string) (Status, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return StatusOpen, nil
+	}
+	status := Status(value)
+	if !status.IsValidWithCustom(customStatuses) {
+		return "", fmt.Errorf("invalid default-status %q: not a built-in or custom status", value)
+	}
+	return status, nil
+}
+
+// CheckStatusAllowed returns an error if the project restricts its statuses
+// (allowed is the statuses config list) and status is not one of them.
+// Closed and tombstone are always allowed: bd close and bd delete need them.
+func CheckStatusAllowed(status Status, allowed []string) error {
+	if len(allowed) == 0 || status == StatusClosed || status == StatusTombstone {
+		return nil
+	}
+	for _, s := range allowed {
+		if string(status) == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("status %q is not allowed (statuses: %s)", status, strings.Join(allowed, ", "))
+}
+
+// NormalizeAssignee checks assignee against the project's assignee
+// allow-list and returns the spelling to store. With caseInsensitive, an
+// assignee matching a listed name in any case becomes the listed spelling,
+// and without a list it is lowercased. An empty allow-list accepts anyone,
+// and the empty assignee (unassigned) is always accepted.
+func NormalizeAssignee(assignee string, allowed []string, caseInsensitive bool) (string, error) {
+	if assignee == "" {
+		return "", nil
+	}
+	for _, name := range allowed {
+		if name == assignee || (caseInsensitive && strings.EqualFold(name, assignee)) {
+			return name, nil
+		}
+	}
+	if len(allowed) > 0 {
+		return "", fmt.Errorf("assignee %q is not allowed (assignees: %s)", assignee, strings.Join(allowed, ", "))
+	}
+	if caseInsensitive {
+		return strings.ToLower(assignee), nil
+	}
+	return assignee, nil
+}
+
 // IssueType categorizes the kind of work
 type IssueType string
 
@@ -370,6 +464,20 @@ type IssueWithDependencyMetadata struct {
 	DependencyType DependencyType `json:"dependency_type"`
 }
 
+// IssueDetail is an issue with everything related to it, as bd show displays
+// it. Parent and Children repeat the parent-child links in Dependencies and
+// Dependents for convenience.
+type IssueDetail struct {
+	*Issue
+	Labels       []string                       `json:"labels,omitempty"`
+	Dependencies []*IssueWithDependencyMetadata `json:"dependencies,omitempty"`
+	Dependents   []*IssueWithDependencyMetadata `json:"dependents,omitempty"`
+	Parent       *Issue                         `json:"parent,omitempty"`
+	Children     []*Issue                       `json:"children,omitempty"`
+	Comments     []*Comment                     `json:"comments,omitempty"`
+	References   []*Reference                   `json:"references,omitempty"`
+}
+
 // IssueWithCounts extends Issue with dependency relationship counts
 type IssueWithCounts struct {
 	*Issue
@@ -492,6 +600,28 @@ type Comment struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Reference is a link from an issue to an external URL, such as a pull
+// request or a doc. Unlike a dependency it doesn't point at another issue.
+type Reference struct {
+	IssueID   string    `json:"issue_id"`
+	URL       string    `json:"url"`
+	Label     string    `json:"label,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ValidateReferenceURL checks that raw is an absolute URL with a scheme and
+// a host, e.g. https://github.com/org/repo/pull/12.
+func ValidateReferenceURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %w", raw, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid URL %q: want an absolute URL such as https://example.com/path", raw)
+	}
+	return nil
+}
+
 // Event represents an audit trail entry
 type Event struct {
 	ID        int64      `json:"id"`
@@ -539,17 +669,26 @@ type TreeNode struct {
 
 // Statistics provides aggregate metrics
 type Statistics struct {
-	TotalIssues              int     `json:"total_issues"`
-	OpenIssues               int     `json:"open_issues"`
-	InProgressIssues         int     `json:"in_progress_issues"`
-	ClosedIssues             int     `json:"closed_issues"`
-	BlockedIssues            int     `json:"blocked_issues"`
-	DeferredIssues           int     `json:"deferred_issues"`  // Issues on ice (bd-4jr)
-	ReadyIssues              int     `json:"ready_issues"`
-	TombstoneIssues          int     `json:"tombstone_issues"` // Soft-deleted issues (bd-nyt)
-	PinnedIssues             int     `json:"pinned_issues"`    // Persistent issues (bd-6v2)
-	EpicsEligibleForClosure  int     `json:"epics_eligible_for_closure"`
-	AverageLeadTime          float64 `json:"average_lead_time_hours"`
+	TotalIssues             int            `json:"total_issues"`
+	OpenIssues              int            `json:"open_issues"`
+	InProgressIssues        int            `json:"in_progress_issues"`
+	ClosedIssues            int            `json:"closed_issues"`
+	BlockedIssues           int            `json:"blocked_issues"`
+	DeferredIssues          int            `json:"deferred_issues"` // Issues on ice (bd-4jr)
+	ReadyIssues             int            `json:"ready_issues"`
+	TombstoneIssues         int            `json:"tombstone_issues"` // Soft-deleted issues (bd-nyt)
+	PinnedIssues            int            `json:"pinned_issues"`    // Persistent issues (bd-6v2)
+	EpicsEligibleForClosure int            `json:"epics_eligible_for_closure"`
+	AverageLeadTime         float64        `json:"average_lead_time_hours"`
+	ByStatus                map[string]int `json:"by_status,omitempty"` // Issue count per status, including custom statuses
+}
+
+// ThroughputBucket counts issues created and closed within one time bucket
+// starting at Start (inclusive) and lasting until the next bucket's Start.
+type ThroughputBucket struct {
+	Start   time.Time `json:"start"`
+	Created int       `json:"created"`
+	Closed  int       `json:"closed"`
 }
 
 // IssueFilter is used to filter issue queries
@@ -574,6 +713,7 @@ type IssueFilter struct {
 	CreatedBefore *time.Time
 	UpdatedAfter  *time.Time
 	UpdatedBefore *time.Time
+	UpdatedSince  *time.Time // Inclusive, unlike UpdatedAfter (--since)
 	ClosedAfter   *time.Time
 	ClosedBefore  *time.Time
 	
@@ -600,6 +740,10 @@ type IssueFilter struct {
 
 	// Parent filtering (bd-yqhh): filter children by parent issue ID
 	ParentID *string // Filter by parent issue (via parent-child dependency)
+
+	// Expression filtering: a boolean expression over issue fields, see
+	// internal/where (e.g. "status=open and priority<=1")
+	Where string
 }
 
 // SortPolicy determines how ready work is ordered
@@ -612,7 +756,8 @@ const (
 	// This is the default for backwards compatibility
 	SortPolicyHybrid SortPolicy = "hybrid"
 
-	// SortPolicyPriority always sorts by priority first, then creation date
+	// SortPolicyPriority always sorts by priority first, then dependency order
+	// (issues blocking the most open work first), then creation date
 	// Use for autonomous execution, CI/CD, priority-driven workflows
 	SortPolicyPriority SortPolicy = "priority"
 
@@ -645,9 +790,10 @@ type WorkFilter struct {
 
 // StaleFilter is used to filter stale issue queries
 type StaleFilter struct {
-	Days   int    // Issues not updated in this many days
-	Status string // Filter by status (open|in_progress|blocked), empty = all non-closed
-	Limit  int    // Maximum issues to return
+	Days      int           // Issues not updated in this many days
+	OlderThan time.Duration // Issues not updated in this long; takes precedence over Days when set
+	Status    string        // Filter by status (open|in_progress|blocked), empty = all non-closed
+	Limit     int           // Maximum issues to return
 }
 
 // EpicStatus represents an epic with its completion status
@@ -1314,3 +1314,49 @@ func TestComputeContentHashWithValidations(t *testing.T) {
 		t.Error("Expected different hash when Score is added")
 	}
 }
+
+func TestParseDueDate(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"2024-12-01", "2024-12-01", false},
+		{" 2024-12-01 ", "2024-12-01", false},
+		{"", "", false},
+		{"2024-02-30", "", true},
+		{"12/01/2024", "", true},
+		{"2024-12-01T10:00:00Z", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDueDate(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDueDate(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDueDate(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIsOverdue(t *testing.T) {
+	const today = "2024-12-02"
+	tests := []struct {
+		name   string
+		issue  Issue
+		expect bool
+	}{
+		{"past due", Issue{Status: StatusOpen, DueDate: "2024-12-01"}, true},
+		{"due today", Issue{Status: StatusOpen, DueDate: today}, false},
+		{"due later", Issue{Status: StatusInProgress, DueDate: "2025-01-01"}, false},
+		{"no due date", Issue{Status: StatusOpen}, false},
+		{"closed", Issue{Status: StatusClosed, DueDate: "2024-11-01"}, false},
+		{"tombstone", Issue{Status: StatusTombstone, DueDate: "2024-11-01"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.issue.IsOverdue(today); got != tt.expect {
+			t.Errorf("%s: IsOverdue() = %v, want %v", tt.name, got, tt.expect)
+		}
+	}
+}
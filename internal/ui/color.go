@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Color modes for the "color" config key
+const (
+	ColorAuto   = "auto"   // Color only when stdout is a terminal (honors NO_COLOR)
+	ColorAlways = "always" // Color even when piped
+	ColorNever  = "never"  // Plain text
+)
+
+// SetColorMode sets whether the Render* helpers emit ANSI escape codes. An
+// empty mode means ColorAuto.
+func SetColorMode(mode string) error {
+	switch mode {
+	case ColorAuto, "":
+		lipgloss.SetColorProfile(termenv.NewOutput(os.Stdout).EnvColorProfile())
+	case ColorAlways:
+		profile := termenv.NewOutput(os.Stdout).EnvColorProfile()
+		if profile == termenv.Ascii {
+			profile = termenv.ANSI256
+		}
+		lipgloss.SetColorProfile(profile)
+	case ColorNever:
+		lipgloss.SetColorProfile(termenv.Ascii)
+	default:
+		return fmt.Errorf("invalid color mode %q (expected %s, %s or %s)", mode, ColorAuto, ColorAlways, ColorNever)
+	}
+	return nil
+}
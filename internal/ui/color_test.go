@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+// rendered returns a sample of styled output covering the common helpers.
+func rendered() string {
+	return RenderPass("ok") + RenderFail("bad") + RenderWarn("hmm") +
+		RenderPriority(0) + RenderStatus("in_progress") + RenderType("bug") +
+		RenderCategory("Ready") + RenderBold("bold")
+}
+
+func TestSetColorMode(t *testing.T) {
+	t.Cleanup(func() { _ = SetColorMode(ColorAuto) })
+
+	if err := SetColorMode(ColorNever); err != nil {
+		t.Fatalf("SetColorMode(never) error = %v", err)
+	}
+	if out := rendered(); strings.Contains(out, "\x1b[") {
+		t.Errorf("color=never produced ANSI codes: %q", out)
+	}
+
+	// go test's stdout is not a terminal, so auto means no color
+	if err := SetColorMode(ColorAuto); err != nil {
+		t.Fatalf("SetColorMode(auto) error = %v", err)
+	}
+	if out := rendered(); strings.Contains(out, "\x1b[") {
+		t.Errorf("color=auto without a TTY produced ANSI codes: %q", out)
+	}
+
+	t.Setenv("NO_COLOR", "")
+	if err := SetColorMode(ColorAlways); err != nil {
+		t.Fatalf("SetColorMode(always) error = %v", err)
+	}
+	if out := rendered(); !strings.Contains(out, "\x1b[") {
+		t.Errorf("color=always produced no ANSI codes: %q", out)
+	}
+
+	if err := SetColorMode("sometimes"); err == nil {
+		t.Error("SetColorMode(sometimes) should fail")
+	}
+}
@@ -0,0 +1,47 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BusinessDuration returns the time between start and end that falls on
+// weekdays, skipping Saturdays and Sundays. Days are taken in start's
+// location. It returns 0 if end is not after start.
+func BusinessDuration(start, end time.Time) time.Duration {
+	var total time.Duration
+	for cur := start; cur.Before(end); {
+		y, m, d := cur.Date()
+		next := time.Date(y, m, d+1, 0, 0, 0, 0, cur.Location())
+		if next.After(end) {
+			next = end
+		}
+		if wd := cur.Weekday(); wd != time.Saturday && wd != time.Sunday {
+			total += next.Sub(cur)
+		}
+		cur = next
+	}
+	return total
+}
+
+// ParseWeekStart parses a week-start setting, "monday" or "sunday".
+// An empty string means monday.
+func ParseWeekStart(s string) (time.Weekday, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "monday":
+		return time.Monday, nil
+	case "sunday":
+		return time.Sunday, nil
+	default:
+		return 0, fmt.Errorf("invalid week start %q (valid: monday, sunday)", s)
+	}
+}
+
+// StartOfWeek returns midnight on the first day of the week containing t,
+// for weeks beginning on weekStart.
+func StartOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+	y, m, d := t.Date()
+	return time.Date(y, m, d-offset, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessDuration(t *testing.T) {
+	// Friday 2024-11-29 15:00 to Monday 2024-12-02 11:00: 68 hours in total,
+	// of which 9 on Friday and 11 on Monday fall on weekdays
+	start := time.Date(2024, 11, 29, 15, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 2, 11, 0, 0, 0, time.UTC)
+	if got := end.Sub(start); got != 68*time.Hour {
+		t.Fatalf("calendar duration = %v, want 68h", got)
+	}
+	if got := BusinessDuration(start, end); got != 20*time.Hour {
+		t.Errorf("BusinessDuration(Fri 15:00, Mon 11:00) = %v, want 20h", got)
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       time.Duration
+	}{
+		{"within a weekday", time.Date(2024, 12, 2, 9, 0, 0, 0, time.UTC), time.Date(2024, 12, 2, 17, 0, 0, 0, time.UTC), 8 * time.Hour},
+		{"entirely on a weekend", time.Date(2024, 11, 30, 9, 0, 0, 0, time.UTC), time.Date(2024, 12, 1, 17, 0, 0, 0, time.UTC), 0},
+		{"full week", time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 9, 0, 0, 0, 0, time.UTC), 5 * 24 * time.Hour},
+		{"end before start", time.Date(2024, 12, 3, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC), 0},
+	}
+	for _, tt := range tests {
+		if got := BusinessDuration(tt.start, tt.end); got != tt.want {
+			t.Errorf("%s: BusinessDuration() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseWeekStart(t *testing.T) {
+	for in, want := range map[string]time.Weekday{"": time.Monday, "monday": time.Monday, "Sunday": time.Sunday} {
+		got, err := ParseWeekStart(in)
+		if err != nil || got != want {
+			t.Errorf("ParseWeekStart(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseWeekStart("tuesday"); err == nil {
+		t.Error("ParseWeekStart(tuesday) succeeded, want error")
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	// Wednesday 2024-12-04
+	wed := time.Date(2024, 12, 4, 15, 30, 0, 0, time.UTC)
+	if got, want := StartOfWeek(wed, time.Monday), time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfWeek(monday) = %v, want %v", got, want)
+	}
+	if got, want := StartOfWeek(wed, time.Sunday), time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfWeek(sunday) = %v, want %v", got, want)
+	}
+	sun := time.Date(2024, 12, 1, 8, 0, 0, 0, time.UTC)
+	if got, want := StartOfWeek(sun, time.Monday), time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StartOfWeek(sunday date, monday) = %v, want %v", got, want)
+	}
+}
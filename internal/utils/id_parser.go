@@ -4,8 +4,10 @@ package utils
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -14,9 +16,10 @@ import (
 // If the input already has the prefix (e.g., "bd-a3f8e9"), returns it as-is.
 // If the input lacks the prefix (e.g., "a3f8e9"), adds the configured prefix.
 // Works with hierarchical IDs too: "a3f8e9.1.2" → "bd-a3f8e9.1.2"
+// The prefix includes its separator; the default is "bd" plus the configured id-separator.
 func ParseIssueID(input string, prefix string) string {
 	if prefix == "" {
-		prefix = "bd-"
+		prefix = "bd" + idgen.Separator()
 	}
 	
 	if strings.HasPrefix(input, prefix) {
@@ -26,7 +29,8 @@ func ParseIssueID(input string, prefix string) string {
 	return prefix + input
 }
 
-// ResolvePartialID resolves a potentially partial issue ID to a full ID.
+// ResolveIssueID resolves a potentially partial issue ID to a full ID. Every
+// command that takes an issue ID resolves it with this.
 // Supports:
 // - Full IDs: "bd-a3f8e9" or "a3f8e9" → "bd-a3f8e9"
 // - Without hyphen: "bda3f8e9" or "wya3f8e9" → "bd-a3f8e9"
@@ -34,9 +38,9 @@ func ParseIssueID(input string, prefix string) string {
 // - Hierarchical: "a3f8e9.1" → "bd-a3f8e9.1"
 //
 // Returns an error if:
-// - No issue found matching the ID
-// - Multiple issues match (ambiguous prefix)
-func ResolvePartialID(ctx context.Context, store storage.Storage, input string) (string, error) {
+// - No issue found matching the ID (suggesting IDs one typo away, if any)
+// - Multiple issues match (ambiguous prefix): an *AmbiguousIDError
+func ResolveIssueID(ctx context.Context, store storage.Storage, input string) (string, error) {
 	// Fast path: if the user typed an exact ID that exists, return it as-is.
 	// This preserves behavior where issue IDs may not match the configured
 	// issue_prefix (e.g. cross-repo IDs like "ao-izl"), while still allowing
@@ -51,10 +55,11 @@ func ResolvePartialID(ctx context.Context, store storage.Storage, input string)
 		prefix = "bd"
 	}
 	
-	// Ensure prefix has hyphen for ID format
+	// Ensure prefix has the ID separator
+	sep := idgen.Separator()
 	prefixWithHyphen := prefix
-	if !strings.HasSuffix(prefix, "-") {
-		prefixWithHyphen = prefix + "-"
+	if !strings.HasSuffix(prefix, sep) {
+		prefixWithHyphen = prefix + sep
 	}
 	
 	// Normalize input:
@@ -101,8 +106,8 @@ func ResolvePartialID(ctx context.Context, store storage.Storage, input string)
 		// Extract hash from each issue, regardless of its prefix
 		// This handles cross-prefix matching (e.g., "3d0" matching "offlinebrew-3d0")
 		var issueHash string
-		if idx := strings.Index(issue.ID, "-"); idx >= 0 {
-			issueHash = issue.ID[idx+1:]
+		if idx := strings.Index(issue.ID, sep); idx >= 0 {
+			issueHash = issue.ID[idx+len(sep):]
 		} else {
 			issueHash = issue.ID
 		}
@@ -125,22 +130,114 @@ func ResolvePartialID(ctx context.Context, store storage.Storage, input string)
 	}
 	
 	if len(matches) == 0 {
-		return "", fmt.Errorf("no issue found matching %q", input)
+		err := &NoMatchError{Input: input}
+		err.Suggestions = similarIDs(issues, hashPart, sep)
+		return "", err
 	}
 	
 	if len(matches) > 1 {
-		return "", fmt.Errorf("ambiguous ID %q matches %d issues: %v\nUse more characters to disambiguate", input, len(matches), matches)
+		err := &AmbiguousIDError{Input: input}
+		for _, issue := range issues {
+			if slices.Contains(matches, issue.ID) {
+				err.Matches = append(err.Matches, issue)
+			}
+		}
+		return "", err
 	}
 	
 	return matches[0], nil
 }
 
-// ResolvePartialIDs resolves multiple potentially partial issue IDs.
+// ResolvePartialID resolves a potentially partial issue ID to a full ID.
+//
+// Deprecated: Use ResolveIssueID.
+func ResolvePartialID(ctx context.Context, store storage.Storage, input string) (string, error) {
+	return ResolveIssueID(ctx, store, input)
+}
+
+// AmbiguousIDError is returned by ResolveIssueID when the input matches
+// several issues. Its message lists them, so the user can pick one.
+type AmbiguousIDError struct {
+	Input   string
+	Matches []*types.Issue
+}
+
+func (e *AmbiguousIDError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ambiguous ID %q matches %d issues:", e.Input, len(e.Matches))
+	for _, issue := range e.Matches {
+		fmt.Fprintf(&b, "\n  %s  %s", issue.ID, issue.Title)
+	}
+	b.WriteString("\nUse more characters to disambiguate")
+	return b.String()
+}
+
+// NoMatchError is returned by ResolveIssueID when no issue matches the
+// input. It matches storage.ErrNotFound.
+type NoMatchError struct {
+	Input       string
+	Suggestions []string // Similar IDs, for a "did you mean"
+}
+
+func (e *NoMatchError) Error() string {
+	if len(e.Suggestions) > 0 {
+		return fmt.Sprintf("no issue found matching %q; did you mean %s?", e.Input, strings.Join(e.Suggestions, ", "))
+	}
+	return fmt.Sprintf("no issue found matching %q", e.Input)
+}
+
+func (e *NoMatchError) Is(target error) bool { return target == storage.ErrNotFound }
+
+// maxIDSuggestions caps the "did you mean" list for an unknown ID.
+const maxIDSuggestions = 3
+
+// similarIDs returns the IDs of issues whose hash is one edit away from
+// hash, closest first, for suggesting after a typo.
+func similarIDs(issues []*types.Issue, hash, sep string) []string {
+	var ids []string
+	for _, issue := range issues {
+		issueHash := issue.ID
+		if idx := strings.Index(issue.ID, sep); idx >= 0 {
+			issueHash = issue.ID[idx+len(sep):]
+		}
+		if editDistance(issueHash, hash) == 1 {
+			ids = append(ids, issue.ID)
+		}
+	}
+	slices.Sort(ids)
+	if len(ids) > maxIDSuggestions {
+		ids = ids[:maxIDSuggestions]
+	}
+	return ids
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// ResolveIssueIDs resolves multiple potentially partial issue IDs.
 // Returns the resolved IDs and any errors encountered.
-func ResolvePartialIDs(ctx context.Context, store storage.Storage, inputs []string) ([]string, error) {
+func ResolveIssueIDs(ctx context.Context, store storage.Storage, inputs []string) ([]string, error) {
 	var resolved []string
 	for _, input := range inputs {
-		fullID, err := ResolvePartialID(ctx, store, input)
+		fullID, err := ResolveIssueID(ctx, store, input)
 		if err != nil {
 			return nil, err
 		}
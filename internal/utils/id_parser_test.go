@@ -2,8 +2,12 @@ package utils
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/storage/memory"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -63,7 +67,7 @@ func TestParseIssueID(t *testing.T) {
 	}
 }
 
-func TestResolvePartialID(t *testing.T) {
+func TestResolveIssueID(t *testing.T) {
 	ctx := context.Background()
 	store := memory.New("")
 	
@@ -184,27 +188,27 @@ func TestResolvePartialID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ResolvePartialID(ctx, store, tt.input)
+			result, err := ResolveIssueID(ctx, store, tt.input)
 			
 			if tt.shouldError {
 				if err == nil {
-					t.Errorf("ResolvePartialID(%q) expected error containing %q, got nil", tt.input, tt.errorMsg)
+					t.Errorf("ResolveIssueID(%q) expected error containing %q, got nil", tt.input, tt.errorMsg)
 				} else if tt.errorMsg != "" && !contains(err.Error(), tt.errorMsg) {
-					t.Errorf("ResolvePartialID(%q) error = %q; want error containing %q", tt.input, err.Error(), tt.errorMsg)
+					t.Errorf("ResolveIssueID(%q) error = %q; want error containing %q", tt.input, err.Error(), tt.errorMsg)
 				}
 			} else {
 				if err != nil {
-					t.Errorf("ResolvePartialID(%q) unexpected error: %v", tt.input, err)
+					t.Errorf("ResolveIssueID(%q) unexpected error: %v", tt.input, err)
 				}
 				if result != tt.expected {
-					t.Errorf("ResolvePartialID(%q) = %q; want %q", tt.input, result, tt.expected)
+					t.Errorf("ResolveIssueID(%q) = %q; want %q", tt.input, result, tt.expected)
 				}
 			}
 		})
 	}
 }
 
-func TestResolvePartialIDs(t *testing.T) {
+func TestResolveIssueIDs(t *testing.T) {
 	ctx := context.Background()
 	store := memory.New("")
 	
@@ -265,22 +269,22 @@ func TestResolvePartialIDs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ResolvePartialIDs(ctx, store, tt.inputs)
+			result, err := ResolveIssueIDs(ctx, store, tt.inputs)
 			
 			if tt.shouldError {
 				if err == nil {
-					t.Errorf("ResolvePartialIDs(%v) expected error, got nil", tt.inputs)
+					t.Errorf("ResolveIssueIDs(%v) expected error, got nil", tt.inputs)
 				}
 			} else {
 				if err != nil {
-					t.Errorf("ResolvePartialIDs(%v) unexpected error: %v", tt.inputs, err)
+					t.Errorf("ResolveIssueIDs(%v) unexpected error: %v", tt.inputs, err)
 				}
 				if len(result) != len(tt.expected) {
-					t.Errorf("ResolvePartialIDs(%v) returned %d results; want %d", tt.inputs, len(result), len(tt.expected))
+					t.Errorf("ResolveIssueIDs(%v) returned %d results; want %d", tt.inputs, len(result), len(tt.expected))
 				}
 				for i := range result {
 					if result[i] != tt.expected[i] {
-						t.Errorf("ResolvePartialIDs(%v)[%d] = %q; want %q", tt.inputs, i, result[i], tt.expected[i])
+						t.Errorf("ResolveIssueIDs(%v)[%d] = %q; want %q", tt.inputs, i, result[i], tt.expected[i])
 					}
 				}
 			}
@@ -288,7 +292,7 @@ func TestResolvePartialIDs(t *testing.T) {
 	}
 }
 
-func TestResolvePartialID_NoConfig(t *testing.T) {
+func TestResolveIssueID_NoConfig(t *testing.T) {
 	ctx := context.Background()
 	store := memory.New("")
 	
@@ -306,13 +310,53 @@ func TestResolvePartialID_NoConfig(t *testing.T) {
 	}
 	
 	// Don't set config - should use default "bd" prefix
-	result, err := ResolvePartialID(ctx, store, "1")
+	result, err := ResolveIssueID(ctx, store, "1")
 	if err != nil {
-		t.Fatalf("ResolvePartialID failed with default config: %v", err)
+		t.Fatalf("ResolveIssueID failed with default config: %v", err)
 	}
 	
 	if result != "bd-1" {
-		t.Errorf("ResolvePartialID(\"1\") with default config = %q; want \"bd-1\"", result)
+		t.Errorf("ResolveIssueID(\"1\") with default config = %q; want \"bd-1\"", result)
+	}
+}
+
+func TestResolveIssueID_Fuzzy(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New("")
+	if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"bd-123", "bd-124", "bd-9"} {
+		issue := &types.Issue{ID: id, Title: "Issue " + id, Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for input, want := range map[string]string{"123": "bd-123", "bd-124": "bd-124"} {
+		got, err := ResolveIssueID(ctx, store, input)
+		if err != nil || got != want {
+			t.Errorf("ResolveIssueID(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+
+	_, err := ResolveIssueID(ctx, store, "bd-12")
+	var ambiguous *AmbiguousIDError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("ResolveIssueID(\"bd-12\") error = %v, want *AmbiguousIDError", err)
+	}
+	if len(ambiguous.Matches) != 2 || !strings.Contains(err.Error(), "bd-123  Issue bd-123") || !strings.Contains(err.Error(), "bd-124  Issue bd-124") {
+		t.Errorf("ambiguous error should list bd-123 and bd-124:\n%v", err)
+	}
+
+	// A bare number that doesn't exist suggests IDs one typo away
+	_, err = ResolveIssueID(ctx, store, "125")
+	if err == nil || !strings.Contains(err.Error(), "no issue found") || !strings.Contains(err.Error(), "did you mean bd-123, bd-124?") {
+		t.Errorf("ResolveIssueID(\"125\") error = %v, want not found with suggestions", err)
+	}
+	_, err = ResolveIssueID(ctx, store, "777")
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("ResolveIssueID(\"777\") error = %v, want not found without suggestions", err)
 	}
 }
 
@@ -506,3 +550,46 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestIDSeparator(t *testing.T) {
+	t.Cleanup(func() { _ = idgen.SetSeparator(idgen.DefaultSeparator) })
+
+	for _, sep := range []string{"-", "_", "/", ":"} {
+		t.Run(sep, func(t *testing.T) {
+			if err := idgen.SetSeparator(sep); err != nil {
+				t.Fatalf("SetSeparator(%q) failed: %v", sep, err)
+			}
+
+			id := idgen.GenerateHashID("bd", "title", "desc", "tester", time.Unix(0, 0), 6, 0)
+			if !strings.HasPrefix(id, "bd"+sep) {
+				t.Fatalf("generated ID %q does not use separator %q", id, sep)
+			}
+			if got := ExtractIssuePrefix(id); got != "bd" {
+				t.Errorf("ExtractIssuePrefix(%q) = %q, want bd", id, got)
+			}
+			if got := ExtractIssuePrefix("bd" + sep + "a3f8e9.1"); got != "bd" {
+				t.Errorf("ExtractIssuePrefix of child ID = %q, want bd", got)
+			}
+			if got := ExtractIssueNumber("bd" + sep + "123"); got != 123 {
+				t.Errorf("ExtractIssueNumber = %d, want 123", got)
+			}
+			if got := ParseIssueID("a3f8e9", ""); got != "bd"+sep+"a3f8e9" {
+				t.Errorf("ParseIssueID default prefix = %q, want %q", got, "bd"+sep+"a3f8e9")
+			}
+		})
+	}
+
+	// Multi-part prefixes only stay unambiguous with a non-dash separator
+	if err := idgen.SetSeparator("/"); err != nil {
+		t.Fatal(err)
+	}
+	if got := ExtractIssuePrefix("beads-vscode/test"); got != "beads-vscode" {
+		t.Errorf("ExtractIssuePrefix(beads-vscode/test) = %q, want beads-vscode", got)
+	}
+
+	for _, bad := range []string{".", "a", "7", "--", " "} {
+		if err := idgen.SetSeparator(bad); err == nil {
+			t.Errorf("SetSeparator(%q) should fail", bad)
+		}
+	}
+}
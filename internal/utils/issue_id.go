@@ -3,6 +3,8 @@ package utils
 import (
 	"fmt"
 	"strings"
+
+	"github.com/steveyegge/beads/internal/idgen"
 )
 
 // ExtractIssuePrefix extracts the prefix from an issue ID like "bd-123" -> "bd"
@@ -18,7 +20,18 @@ import (
 //
 // This distinguishes hash IDs (which may contain letters but have digits or are 3 chars)
 // from multi-part IDs where the suffix after the first hyphen is the entire ID.
+//
+// With a non-default id-separator ("bd/123", "bd_123") the separator cannot
+// appear in a prefix, so the prefix is everything before its first occurrence.
 func ExtractIssuePrefix(issueID string) string {
+	if sep := idgen.Separator(); sep != idgen.DefaultSeparator {
+		idx := strings.Index(issueID, sep)
+		if idx <= 0 {
+			return ""
+		}
+		return issueID[:idx]
+	}
+
 	// Try last hyphen first (handles multi-part prefixes like "beads-vscode-1")
 	lastIdx := strings.LastIndex(issueID, "-")
 	if lastIdx <= 0 {
@@ -99,7 +112,7 @@ func isLikelyHash(s string) bool {
 
 // ExtractIssueNumber extracts the number from an issue ID like "bd-123" -> 123
 func ExtractIssueNumber(issueID string) int {
-	idx := strings.LastIndex(issueID, "-")
+	idx := strings.LastIndex(issueID, idgen.Separator())
 	if idx < 0 || idx == len(issueID)-1 {
 		return 0
 	}
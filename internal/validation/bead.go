@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/steveyegge/beads/internal/types"
@@ -88,3 +89,32 @@ func ValidatePrefix(requestedPrefix, dbPrefix string, force bool) error {
 
 	return fmt.Errorf("prefix mismatch: database uses '%s' but you specified '%s' (use --force to override)", dbPrefix, requestedPrefix)
 }
+
+// issuePrefixPattern is the shape of a valid issue prefix (without the
+// trailing hyphen that separates it from the hash).
+var issuePrefixPattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// ValidateIssuePrefix checks that prefix is usable as an issue prefix: up to
+// 8 characters, starting with a lowercase letter, followed by lowercase
+// letters, digits and single hyphens. A trailing hyphen is ignored.
+func ValidateIssuePrefix(prefix string) error {
+	prefix = strings.TrimRight(prefix, "-")
+
+	if prefix == "" {
+		return fmt.Errorf("prefix cannot be empty")
+	}
+
+	if len(prefix) > 8 {
+		return fmt.Errorf("prefix too long (max 8 characters): %s", prefix)
+	}
+
+	if !issuePrefixPattern.MatchString(prefix) {
+		return fmt.Errorf("prefix must start with a lowercase letter and contain only lowercase letters, numbers, and hyphens: %s", prefix)
+	}
+
+	if strings.HasPrefix(prefix, "-") || strings.HasSuffix(prefix, "--") {
+		return fmt.Errorf("prefix has invalid hyphen placement: %s", prefix)
+	}
+
+	return nil
+}
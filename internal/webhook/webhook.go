@@ -0,0 +1,149 @@
+// Package webhook posts issue events to an HTTP endpoint, such as a Slack or
+// Teams incoming webhook.
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// EventStatusChanged is the event sent when an issue's status changes.
+const EventStatusChanged = "status_changed"
+
+// Payload is the JSON body of a webhook request. Text is a one-line summary
+// for chat services, whose incoming webhooks display a "text" field.
+type Payload struct {
+	Event     string    `json:"event"`
+	IssueID   string    `json:"issue_id"`
+	OldStatus string    `json:"old_status"`
+	NewStatus string    `json:"new_status"`
+	Actor     string    `json:"actor"`
+	Timestamp time.Time `json:"timestamp"`
+	Text      string    `json:"text"`
+}
+
+// Defaults for New.
+const (
+	DefaultTimeout  = 5 * time.Second
+	DefaultAttempts = 3
+	DefaultBackoff  = 500 * time.Millisecond
+)
+
+// Notifier delivers payloads to a URL in the background. Each delivery is
+// retried with exponential backoff; failures are logged and otherwise
+// ignored, since a notification must never hold up the change it reports.
+type Notifier struct {
+	url      string
+	client   *http.Client
+	attempts int
+	backoff  time.Duration
+	logf     func(format string, args ...interface{})
+	wg       sync.WaitGroup
+}
+
+// New returns a Notifier posting to url, with DefaultTimeout per request and
+// DefaultAttempts tries. Failures are logged to stderr.
+func New(url string) *Notifier {
+	return &Notifier{
+		url:      url,
+		client:   &http.Client{Timeout: DefaultTimeout},
+		attempts: DefaultAttempts,
+		backoff:  DefaultBackoff,
+		logf: func(format string, args ...interface{}) {
+			fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", args...)
+		},
+	}
+}
+
+// Notify sends p asynchronously, filling in its timestamp and text if unset.
+func (n *Notifier) Notify(p Payload) {
+	if p.Timestamp.IsZero() {
+		p.Timestamp = time.Now().UTC()
+	}
+	if p.Text == "" && p.Event == EventStatusChanged {
+		p.Text = fmt.Sprintf("%s: %s → %s (%s)", p.IssueID, p.OldStatus, p.NewStatus, p.Actor)
+	}
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		if err := n.deliver(p); err != nil {
+			n.logf("webhook for %s %s failed: %v", p.IssueID, p.Event, err)
+		}
+	}()
+}
+
+// Wait blocks until pending deliveries finish or timeout passes, and reports
+// whether they all finished. Short-lived processes call it before exiting so
+// notifications aren't lost.
+func (n *Notifier) Wait(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		n.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// deliver posts p, retrying failed requests and non-2xx responses.
+func (n *Notifier) deliver(p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	backoff := n.backoff
+	for attempt := 1; ; attempt++ {
+		err = n.post(body)
+		if err == nil || attempt >= n.attempts {
+			break
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	if err != nil {
+		return fmt.Errorf("after %d attempts: %w", n.attempts, err)
+	}
+	return nil
+}
+
+func (n *Notifier) post(body []byte) error {
+	resp, err := n.client.Post(n.url, "application/json", bytes.NewReader(body)) // #nosec G107 - URL is from the user's config
+	if err != nil {
+		// The error quotes the URL, which must not end up in logs
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = redactURL(urlErr.URL)
+		}
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// redactURL keeps only the scheme and host of rawURL. Incoming webhook URLs
+// carry their token in the path or query.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "(redacted)"
+	}
+	if u.User == nil && (u.Path == "" || u.Path == "/") && u.RawQuery == "" {
+		return u.Scheme + "://" + u.Host
+	}
+	return u.Scheme + "://" + u.Host + "/(redacted)"
+}
@@ -0,0 +1,99 @@
+package where
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString
+	tokOp
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int // byte offset in the input
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of expression"
+	case tokString:
+		return fmt.Sprintf("string %q", t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// wordBreaks are the characters that end a bare word.
+const wordBreaks = "()=!<>~\"'"
+
+// lex splits input into tokens. A bare word runs until whitespace or one of
+// wordBreaks, so values like bd-a1b2 and 2024-01-31 need no quotes. Quoted
+// strings use single or double quotes, with backslash escaping the next
+// character.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case c == '=' || c == '~':
+			tokens = append(tokens, token{kind: tokOp, text: string(c), pos: i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			if i+1 < len(input) && input[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokOp, text: input[i : i+2], pos: i})
+				i += 2
+				continue
+			}
+			if c == '!' {
+				return nil, fmt.Errorf("unexpected ! at position %d (did you mean != or not?)", i+1)
+			}
+			tokens = append(tokens, token{kind: tokOp, text: string(c), pos: i})
+			i++
+		case c == '"' || c == '\'':
+			start := i
+			var sb strings.Builder
+			i++
+			for {
+				if i >= len(input) {
+					return nil, fmt.Errorf("unterminated string starting at position %d", start+1)
+				}
+				if input[i] == '\\' && i+1 < len(input) {
+					sb.WriteByte(input[i+1])
+					i += 2
+					continue
+				}
+				if input[i] == c {
+					i++
+					break
+				}
+				sb.WriteByte(input[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokString, text: sb.String(), pos: start})
+		default:
+			start := i
+			for i < len(input) && !strings.ContainsRune(" \t\n\r"+wordBreaks, rune(input[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokWord, text: input[start:i], pos: start})
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(input)}), nil
+}
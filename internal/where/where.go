@@ -0,0 +1,399 @@
+// Package where parses the boolean filter expressions accepted by
+// 'bd list --where', such as:
+//
+//	status=open and label=backend and priority>=2
+//	(type=bug or type=feature) and not assignee=""
+//
+// An expression compares issue fields with =, !=, >, <, >=, <= and ~
+// (contains, case-insensitive), combined with and, or, not and parentheses.
+// label=x tests label membership. Values are bare words or quoted strings;
+// priorities accept 0-4 or P0-P4 and dates use YYYY-MM-DD. The bare value
+// me in an assignee comparison stands for the current actor; see ResolveMe.
+//
+// Parse validates fields, operators and values, so a parsed Expr can be
+// compiled by a storage backend without further checks. Backends must bind
+// Comparison values as query parameters, never splice them into SQL.
+package where
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/validation"
+)
+
+// Expr is a parsed expression: *And, *Or, *Not or *Comparison. String
+// formats it back into an expression Parse accepts, with values quoted.
+type Expr interface {
+	expr()
+	String() string
+}
+
+// And matches when both sides match.
+type And struct {
+	Left, Right Expr
+}
+
+// Or matches when either side matches.
+type Or struct {
+	Left, Right Expr
+}
+
+// Not matches when X doesn't.
+type Not struct {
+	X Expr
+}
+
+// Comparison compares a field with a value. Field is the canonical field
+// name (see Fields), and Value is normalized: priorities are their number.
+type Comparison struct {
+	Field string
+	Op    string
+	Value string
+
+	bare bool // Value was an unquoted word
+}
+
+// Me is the bare assignee value that ResolveMe replaces with the actor.
+const Me = "me"
+
+func (*And) expr()        {}
+func (*Or) expr()         {}
+func (*Not) expr()        {}
+func (*Comparison) expr() {}
+
+func (e *And) String() string { return "(" + e.Left.String() + " and " + e.Right.String() + ")" }
+func (e *Or) String() string  { return "(" + e.Left.String() + " or " + e.Right.String() + ")" }
+func (e *Not) String() string { return "not " + e.X.String() }
+
+func (c *Comparison) String() string {
+	if c.bare && c.Field == "assignee" && c.Value == Me {
+		return c.Field + c.Op + Me
+	}
+	return c.Field + c.Op + `"` + quoteEscaper.Replace(c.Value) + `"`
+}
+
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// ResolveMe replaces the bare value me in assignee comparisons with actor.
+// A quoted "me" is left alone, for an assignee actually named me.
+func ResolveMe(e Expr, actor string) {
+	switch e := e.(type) {
+	case *And:
+		ResolveMe(e.Left, actor)
+		ResolveMe(e.Right, actor)
+	case *Or:
+		ResolveMe(e.Left, actor)
+		ResolveMe(e.Right, actor)
+	case *Not:
+		ResolveMe(e.X, actor)
+	case *Comparison:
+		if e.bare && e.Field == "assignee" && e.Value == Me {
+			e.Value = actor
+			e.bare = false
+		}
+	}
+}
+
+// Field kinds decide which operators a field accepts and how values parse.
+type fieldKind int
+
+const (
+	kindText fieldKind = iota
+	kindNumber
+	kindDate
+	kindLabel
+)
+
+var fieldKinds = map[string]fieldKind{
+	"id":       kindText,
+	"title":    kindText,
+	"status":   kindText,
+	"type":     kindText,
+	"assignee": kindText,
+	"priority": kindNumber,
+	"created":  kindDate,
+	"updated":  kindDate,
+	"closed":   kindDate,
+	"due":      kindDate,
+	"label":    kindLabel,
+}
+
+var fieldAliases = map[string]string{
+	"issue_type": "type",
+	"labels":     "label",
+	"created_at": "created",
+	"updated_at": "updated",
+	"closed_at":  "closed",
+	"due_date":   "due",
+}
+
+// Fields returns the field names an expression can use, sorted.
+func Fields() []string {
+	fields := make([]string, 0, len(fieldKinds))
+	for field := range fieldKinds {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func opAllowed(kind fieldKind, op string) bool {
+	switch kind {
+	case kindNumber, kindDate:
+		return op != "~"
+	default:
+		return op == "=" || op == "!=" || op == "~"
+	}
+}
+
+// Parse parses an expression.
+func Parse(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokEOF {
+		return nil, fmt.Errorf("empty expression")
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos+1)
+	}
+	return e, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// keyword reports whether the next token is the bare word kw, and consumes it.
+func (p *parser) keyword(kw string) bool {
+	tok := p.peek()
+	if tok.kind == tokWord && strings.EqualFold(tok.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.keyword("not") {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{X: x}, nil
+	}
+	if p.peek().kind == tokLParen {
+		open := p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != tokRParen {
+			return nil, fmt.Errorf("missing ) for ( at position %d", open.pos+1)
+		}
+		p.next()
+		return e, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	tok := p.next()
+	if tok.kind != tokWord {
+		return nil, fmt.Errorf("expected a field name at position %d, got %s", tok.pos+1, tok)
+	}
+	field := strings.ToLower(tok.text)
+	if alias, ok := fieldAliases[field]; ok {
+		field = alias
+	}
+	kind, ok := fieldKinds[field]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q (valid fields: %s)", tok.text, strings.Join(Fields(), ", "))
+	}
+
+	opTok := p.next()
+	if opTok.kind != tokOp {
+		return nil, fmt.Errorf("expected an operator after %s at position %d, got %s", field, opTok.pos+1, opTok)
+	}
+	if !opAllowed(kind, opTok.text) {
+		return nil, fmt.Errorf("operator %s is not supported for %s", opTok.text, field)
+	}
+
+	valTok := p.next()
+	if valTok.kind != tokWord && valTok.kind != tokString {
+		return nil, fmt.Errorf("expected a value after %s %s at position %d, got %s", field, opTok.text, valTok.pos+1, valTok)
+	}
+	value, err := normalizeValue(field, kind, valTok.text)
+	if err != nil {
+		return nil, err
+	}
+	return &Comparison{Field: field, Op: opTok.text, Value: value, bare: valTok.kind == tokWord}, nil
+}
+
+func normalizeValue(field string, kind fieldKind, value string) (string, error) {
+	switch kind {
+	case kindNumber:
+		priority, err := validation.ValidatePriority(value)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(priority), nil
+	case kindDate:
+		if _, err := time.Parse(types.DueDateLayout, value); err != nil {
+			return "", fmt.Errorf("invalid %s date %q (expected YYYY-MM-DD)", field, value)
+		}
+	}
+	return value, nil
+}
+
+// Match reports whether issue, with the given labels, matches e. It is the
+// in-memory counterpart of a backend's compiled query: dates compare in UTC
+// and an unset closed or due date matches nothing.
+func Match(e Expr, issue *types.Issue, labels []string) bool {
+	switch e := e.(type) {
+	case *And:
+		return Match(e.Left, issue, labels) && Match(e.Right, issue, labels)
+	case *Or:
+		return Match(e.Left, issue, labels) || Match(e.Right, issue, labels)
+	case *Not:
+		return !Match(e.X, issue, labels)
+	case *Comparison:
+		return e.match(issue, labels)
+	}
+	return false
+}
+
+func (c *Comparison) match(issue *types.Issue, labels []string) bool {
+	switch c.Field {
+	case "label":
+		// label!=x means no label is x
+		op := c.Op
+		if op == "!=" {
+			op = "="
+		}
+		for _, label := range labels {
+			if compareText(label, op, c.Value) {
+				return c.Op != "!="
+			}
+		}
+		return c.Op == "!="
+	case "priority":
+		want, _ := strconv.Atoi(c.Value)
+		return compareOrdered(issue.Priority-want, c.Op)
+	case "created":
+		return compareOrdered(strings.Compare(issue.CreatedAt.UTC().Format(types.DueDateLayout), c.Value), c.Op)
+	case "updated":
+		return compareOrdered(strings.Compare(issue.UpdatedAt.UTC().Format(types.DueDateLayout), c.Value), c.Op)
+	case "closed":
+		if issue.ClosedAt == nil {
+			return false
+		}
+		return compareOrdered(strings.Compare(issue.ClosedAt.UTC().Format(types.DueDateLayout), c.Value), c.Op)
+	case "due":
+		if issue.DueDate == "" {
+			return false
+		}
+		return compareOrdered(strings.Compare(issue.DueDate, c.Value), c.Op)
+	}
+	return compareText(c.textValue(issue), c.Op, c.Value)
+}
+
+func (c *Comparison) textValue(issue *types.Issue) string {
+	switch c.Field {
+	case "id":
+		return issue.ID
+	case "title":
+		return issue.Title
+	case "status":
+		return string(issue.Status)
+	case "type":
+		return string(issue.IssueType)
+	case "assignee":
+		return issue.Assignee
+	}
+	return ""
+}
+
+// compareText applies =, != or ~ to a text value.
+func compareText(have, op, want string) bool {
+	switch op {
+	case "~":
+		return strings.Contains(strings.ToLower(have), strings.ToLower(want))
+	case "!=":
+		return have != want
+	}
+	return have == want
+}
+
+// compareOrdered applies op to the sign of a comparison result.
+func compareOrdered(cmp int, op string) bool {
+	switch op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	case ">=":
+		return cmp >= 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
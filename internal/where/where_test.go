@@ -0,0 +1,163 @@
+package where
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"status=open", "status = open"},
+		{"status = open and label=backend and priority>=2", "((status = open AND label = backend) AND priority >= 2)"},
+		{"type=bug or type=feature and priority<P2", "(type = bug OR (type = feature AND priority < 2))"},
+		{"(type=bug or type=feature) and priority<=1", "((type = bug OR type = feature) AND priority <= 1)"},
+		{"not assignee=\"\" AND Labels!=wontfix", "(NOT assignee =  AND label != wontfix)"},
+		{"id=bd-a1b2 or title~'login page'", "(id = bd-a1b2 OR title ~ login page)"},
+		{"due<2024-12-31 and created_at>=2024-01-01", "(due < 2024-12-31 AND created >= 2024-01-01)"},
+		{`title="and" or title='it\'s'`, "(title = and OR title = it's)"},
+	}
+	for _, tt := range tests {
+		e, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got := format(e); got != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func format(e Expr) string {
+	switch e := e.(type) {
+	case *And:
+		return "(" + format(e.Left) + " AND " + format(e.Right) + ")"
+	case *Or:
+		return "(" + format(e.Left) + " OR " + format(e.Right) + ")"
+	case *Not:
+		return "NOT " + format(e.X)
+	case *Comparison:
+		return e.Field + " " + e.Op + " " + e.Value
+	}
+	return "?"
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr string
+	}{
+		{"", "empty expression"},
+		{"   ", "empty expression"},
+		{"colour=red", "unknown field"},
+		{"status", "expected an operator"},
+		{"status=", "expected a value"},
+		{"status=open and", "expected a field name"},
+		{"(status=open", "missing )"},
+		{"status=open)", "unexpected"},
+		{"status=open priority=1", "unexpected"},
+		{"title='unterminated", "unterminated string"},
+		{"status!open", "unexpected !"},
+		{"label>backend", "operator > is not supported for label"},
+		{"status<=open", "operator <= is not supported for status"},
+		{"priority~1", "operator ~ is not supported for priority"},
+		{"priority=high", "invalid priority"},
+		{"created>yesterday", "invalid created date"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		if err == nil {
+			t.Errorf("Parse(%q) succeeded, want error containing %q", tt.input, tt.wantErr)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Parse(%q) error = %q, want it to contain %q", tt.input, err, tt.wantErr)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	closed := time.Date(2024, 3, 2, 23, 30, 0, 0, time.FixedZone("PST", -8*3600))
+	issue := &types.Issue{
+		ID:        "bd-a1",
+		Title:     "Fix Login page",
+		Status:    types.StatusClosed,
+		Priority:  1,
+		IssueType: types.TypeBug,
+		CreatedAt: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2024, 3, 3, 7, 30, 0, 0, time.UTC),
+		ClosedAt:  &closed,
+	}
+	labels := []string{"backend", "auth"}
+
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"status=closed", true},
+		{"status!=closed", false},
+		{"priority<=1 and type=bug", true},
+		{"priority>1 or label=auth", true},
+		{"label=frontend", false},
+		{"label!=frontend", true},
+		{"label!=backend", false},
+		{"label~END", true},
+		{"title~login", true},
+		{"title=login", false},
+		{"assignee=''", true},
+		{"not (status=open or priority=P0)", true},
+		{"created<2024-02-01 and created>=2024-01-15", true},
+		// Dates compare by their UTC day: closed at 2024-03-03 07:30 UTC
+		{"closed=2024-03-03", true},
+		{"updated>2024-03-02", true},
+		{"due<2030-01-01", false},
+		{"not due<2030-01-01", true},
+	}
+	for _, tt := range tests {
+		e, err := Parse(tt.input)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.input, err)
+		}
+		if got := Match(e, issue, labels); got != tt.want {
+			t.Errorf("Match(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestStringRoundTrip(t *testing.T) {
+	for _, input := range []string{
+		"status=open and label=backend and priority>=2",
+		`(type=bug or title~'say "hi"') and not assignee="a\\b"`,
+		"assignee=me or assignee='me'",
+	} {
+		e, err := Parse(input)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", input, err)
+		}
+		again, err := Parse(e.String())
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", e.String(), err)
+		}
+		if format(again) != format(e) || again.String() != e.String() {
+			t.Errorf("round trip of %q: %s, want %s", input, again, e)
+		}
+	}
+}
+
+func TestResolveMe(t *testing.T) {
+	e, err := Parse(`assignee=me or (assignee="me" and title=me)`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	ResolveMe(e, "alice")
+	want := `(assignee="alice" or (assignee="me" and title="me"))`
+	if got := e.String(); got != want {
+		t.Errorf("after ResolveMe = %s, want %s", got, want)
+	}
+}